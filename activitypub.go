@@ -0,0 +1,707 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	// ActivityContentType is the media type of ActivityPub documents.
+	ActivityContentType = "application/activity+json"
+
+	activityStreamsContext = "https://www.w3.org/ns/activitystreams"
+	activityPublic         = "https://www.w3.org/ns/activitystreams#Public"
+
+	// maxActivityAttempts is how many times a delivery to an inbox is tried
+	// before giving up.
+	maxActivityAttempts = 8
+
+	// activityBackoff is the wait after the first failed delivery. It doubles
+	// after each failure.
+	activityBackoff = time.Minute
+
+	// signatureMaxSkew is how far the Date of a signed request can be from
+	// now. Mastodon allows the same.
+	signatureMaxSkew = 12 * time.Hour
+
+	// outboxSize is how many posts the outbox lists.
+	outboxSize = 20
+)
+
+var (
+	activityBaseURL  string
+	activityUsername string
+	activityKey      *rsa.PrivateKey
+	activityClient   = &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			DialContext: (&net.Dialer{
+				Timeout: 5 * time.Second,
+				Control: dialPublicOnly,
+			}).DialContext,
+			TLSHandshakeTimeout: 5 * time.Second,
+		},
+	}
+
+	// nonPublicNetworks are private, shared and unique local address ranges,
+	// which remote servers have no business sending us to.
+	nonPublicNetworks = mustParseCIDRs("10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "100.64.0.0/10", "fc00::/7")
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, len(cidrs))
+	for i, c := range cidrs {
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			panic(err)
+		}
+		nets[i] = n
+	}
+
+	return nets
+}
+
+// dialPublicOnly refuses connections to loopback, link local, private and
+// other addresses that aren't on the internet, so actors and inboxes can't
+// point requests at this server or its network. It runs after DNS
+// resolution, so hosts can't get around it by resolving to one.
+func dialPublicOnly(network, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return fmt.Errorf("%s is not a public address", host)
+	}
+	for _, n := range nonPublicNetworks {
+		if n.Contains(ip) {
+			return fmt.Errorf("%s is not a public address", host)
+		}
+	}
+
+	return nil
+}
+
+// ConfigureActivityPub turns on ActivityPub, so the blog can be followed from
+// Mastodon and other fediverse servers. The actor is served under baseURL,
+// the public URL of this server, as username, and signs its requests with a
+// PEM encoded RSA private key.
+func ConfigureActivityPub(privateKeyPEM []byte, baseURL, username string) error {
+	priv, err := parseRSAPrivateKey(privateKeyPEM)
+	if err != nil {
+		return err
+	}
+
+	if u, err := url.Parse(baseURL); err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("ActivityPub URL must be an https URL")
+	}
+
+	activityBaseURL = strings.TrimRight(baseURL, "/")
+	activityUsername = username
+	activityKey = priv
+
+	return nil
+}
+
+// ActivityPubEnabled returns true if ConfigureActivityPub has been called.
+func ActivityPubEnabled() bool {
+	return activityKey != nil
+}
+
+// ActivityActorID returns the ID, and URL, of the blog's actor.
+func ActivityActorID() string {
+	return activityBaseURL + "/activity/actor"
+}
+
+// Webfinger returns the WebFinger document for resource, which must be the
+// actor's acct: URI, like acct:blog@example.com, or its ID. See RFC 7033.
+func Webfinger(resource string) (map[string]interface{}, error) {
+	u, err := url.Parse(activityBaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	subject := fmt.Sprintf("acct:%s@%s", activityUsername, u.Host)
+	if resource != subject && resource != ActivityActorID() {
+		return nil, NotFound("No actor %s", resource)
+	}
+
+	return map[string]interface{}{
+		"subject": subject,
+		"aliases": []string{ActivityActorID()},
+		"links": []map[string]string{
+			{"rel": "self", "type": ActivityContentType, "href": ActivityActorID()},
+		},
+	}, nil
+}
+
+// ActivityActor returns the blog's actor document.
+func ActivityActor(ctx context.Context) (map[string]interface{}, error) {
+	site, err := GetSiteSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(&activityKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	publicKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	return map[string]interface{}{
+		"@context":          []string{activityStreamsContext, "https://w3id.org/security/v1"},
+		"id":                ActivityActorID(),
+		"type":              "Person",
+		"preferredUsername": activityUsername,
+		"name":              site.Title,
+		"summary":           site.Description,
+		"url":               site.URL,
+		"inbox":             activityBaseURL + "/activity/inbox",
+		"outbox":            activityBaseURL + "/activity/outbox",
+		"followers":         activityBaseURL + "/activity/followers",
+		"publicKey": map[string]string{
+			"id":           ActivityActorID() + "#main-key",
+			"owner":        ActivityActorID(),
+			"publicKeyPem": string(publicKeyPEM),
+		},
+	}, nil
+}
+
+// ActivityOutbox returns the outbox, with a Create activity for each of the
+// most recent posts.
+func ActivityOutbox(ctx context.Context) (map[string]interface{}, error) {
+	var total int
+	if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM posts WHERE site_id = $1 AND draft = false AND password_hash IS NULL", siteID(ctx)).Scan(&total); err != nil {
+		return nil, Internalf("Error running get query: %+v", err)
+	}
+
+	posts, err := PublishedPosts(ctx, outboxSize, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]map[string]interface{}, 0, len(posts))
+	for _, p := range posts {
+		a, err := p.createActivity(ctx)
+		if err != nil {
+			return nil, err
+		}
+		delete(a, "@context")
+		items = append(items, a)
+	}
+
+	return map[string]interface{}{
+		"@context":     activityStreamsContext,
+		"id":           activityBaseURL + "/activity/outbox",
+		"type":         "OrderedCollection",
+		"totalItems":   total,
+		"orderedItems": items,
+	}, nil
+}
+
+// ActivityFollowers returns the followers collection. Only the count is
+// public.
+func ActivityFollowers(ctx context.Context) (map[string]interface{}, error) {
+	var total int
+	if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM activitypub_followers").Scan(&total); err != nil {
+		return nil, Internalf("Error running get query: %+v", err)
+	}
+
+	return map[string]interface{}{
+		"@context":   activityStreamsContext,
+		"id":         activityBaseURL + "/activity/followers",
+		"type":       "OrderedCollection",
+		"totalItems": total,
+	}, nil
+}
+
+// ActivityPost returns a published post as an ActivityPub object.
+func ActivityPost(ctx context.Context, id int64) (map[string]interface{}, error) {
+	p, err := GetPost(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if p.Draft || p.Protected {
+		return nil, NotFound("No post with id %d", id)
+	}
+
+	obj, err := p.activityObject(ctx)
+	if err != nil {
+		return nil, err
+	}
+	obj["@context"] = activityStreamsContext
+
+	return obj, nil
+}
+
+// activityObject returns the post as an Article, addressed to the public and
+// the blog's followers. Its ID is on this server, as fediverse servers expect
+// objects to be on the same host as their actor, and its URL is the post, or
+// where it was first published if it is a cross-post.
+func (p *Post) activityObject(ctx context.Context) (map[string]interface{}, error) {
+	site, err := GetSiteSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	permalink, err := p.Permalink(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if p.CanonicalURL != nil {
+		permalink = *p.CanonicalURL
+	}
+
+	tags, err := ParseTags(" " + p.Content)
+	if err != nil {
+		return nil, err
+	}
+	hashtags := make([]map[string]string, 0, len(tags))
+	for _, t := range tags {
+		hashtags = append(hashtags, map[string]string{
+			"type": "Hashtag",
+			"name": "#" + t,
+			"href": fmt.Sprintf("%s/tags/%s", strings.TrimRight(site.URL, "/"), t),
+		})
+	}
+
+	return map[string]interface{}{
+		"id":           fmt.Sprintf("%s/activity/posts/%s", activityBaseURL, p.ID),
+		"type":         "Article",
+		"name":         p.Title,
+		"content":      p.HTML(),
+		"url":          permalink,
+		"attributedTo": ActivityActorID(),
+		"published":    p.Datetime.UTC().Format(time.RFC3339),
+		"to":           []string{activityPublic},
+		"cc":           []string{activityBaseURL + "/activity/followers"},
+		"tag":          hashtags,
+	}, nil
+}
+
+// createActivity returns the Create activity for a post.
+func (p *Post) createActivity(ctx context.Context) (map[string]interface{}, error) {
+	obj, err := p.activityObject(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"@context":  activityStreamsContext,
+		"id":        obj["id"].(string) + "/create",
+		"type":      "Create",
+		"actor":     ActivityActorID(),
+		"published": obj["published"],
+		"to":        obj["to"],
+		"cc":        obj["cc"],
+		"object":    obj,
+	}, nil
+}
+
+// queueActivity queues a delivery of a newly published post to every
+// follower. Followers on the same server share one delivery if the server
+// has a shared inbox.
+func (p *Post) queueActivity(ctx context.Context) error {
+	if !ActivityPubEnabled() {
+		return nil
+	}
+
+	a, err := p.createActivity(ctx)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecContext(ctx, `
+INSERT INTO activitypub_deliveries (inbox, activity, next_attempt_at, created_at)
+SELECT DISTINCT COALESCE(NULLIF(shared_inbox, ''), inbox), $1, $2, $2 FROM activitypub_followers
+`, string(data), time.Now())
+	return err
+}
+
+// RemoteActor is an actor on another server that has sent a signed request.
+type RemoteActor struct {
+	ID          string
+	Inbox       string
+	SharedInbox string
+}
+
+// VerifyActivityRequest checks the HTTP signature on a request to the inbox,
+// with the public key of the actor that signed it, and returns that actor.
+// Requests must sign (request-target), host, date and, as they have a body,
+// digest.
+func VerifyActivityRequest(ctx context.Context, r *http.Request, body []byte) (*RemoteActor, error) {
+	params := parseSignatureHeader(r.Header.Get("Signature"))
+	keyID, signature := params["keyId"], params["signature"]
+	if keyID == "" || signature == "" {
+		return nil, fmt.Errorf("Request is not signed")
+	}
+	if alg := params["algorithm"]; alg != "" && alg != "rsa-sha256" && alg != "hs2019" {
+		return nil, fmt.Errorf("Unsupported signature algorithm %q", alg)
+	}
+
+	headers := strings.Fields(strings.ToLower(params["headers"]))
+	for _, required := range []string{"(request-target)", "host", "date", "digest"} {
+		if !containsString(headers, required) {
+			return nil, fmt.Errorf("Signature must cover %s", required)
+		}
+	}
+
+	date, err := http.ParseTime(r.Header.Get("Date"))
+	if err != nil {
+		return nil, fmt.Errorf("Request has no valid Date")
+	}
+	if skew := time.Since(date); skew > signatureMaxSkew || skew < -signatureMaxSkew {
+		return nil, fmt.Errorf("Request Date is too far from now")
+	}
+
+	if r.Header.Get("Digest") != bodyDigest(body) {
+		return nil, fmt.Errorf("Request Digest does not match its body")
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return nil, fmt.Errorf("Signature is not valid base64")
+	}
+
+	actor, key, err := fetchRemoteActor(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	hashed := sha256.Sum256([]byte(signingString(r, headers)))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hashed[:], sig); err != nil {
+		return nil, fmt.Errorf("Signature does not match")
+	}
+
+	return actor, nil
+}
+
+// HandleActivity acts on an activity sent to the inbox by actor. Follows are
+// accepted, and undone follows are removed. Everything else is ignored.
+func HandleActivity(ctx context.Context, actor *RemoteActor, body []byte) error {
+	var a struct {
+		Type   string          `json:"type"`
+		Actor  string          `json:"actor"`
+		Object json.RawMessage `json:"object"`
+	}
+	if err := json.Unmarshal(body, &a); err != nil {
+		return fmt.Errorf("Activity is not valid JSON: %+v", err)
+	}
+	if a.Actor != actor.ID {
+		return fmt.Errorf("Activity was not signed by its actor")
+	}
+
+	switch a.Type {
+	case "Follow":
+		if activityID(a.Object) != ActivityActorID() {
+			return fmt.Errorf("Only %s can be followed", ActivityActorID())
+		}
+
+		if _, err := db.ExecContext(ctx, `
+INSERT INTO activitypub_followers (actor, inbox, shared_inbox, created_at) VALUES ($1, $2, $3, $4)
+ON CONFLICT (actor) DO UPDATE SET (inbox, shared_inbox) = ($2, $3)
+`, actor.ID, actor.Inbox, actor.SharedInbox, time.Now()); err != nil {
+			return err
+		}
+
+		id, err := randomHex(16)
+		if err != nil {
+			return err
+		}
+		accept, err := json.Marshal(map[string]interface{}{
+			"@context": activityStreamsContext,
+			"id":       ActivityActorID() + "#accepts/" + id,
+			"type":     "Accept",
+			"actor":    ActivityActorID(),
+			"object":   json.RawMessage(body),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = db.ExecContext(ctx, "INSERT INTO activitypub_deliveries (inbox, activity, next_attempt_at, created_at) VALUES ($1, $2, $3, $3)", actor.Inbox, string(accept), time.Now())
+		return err
+	case "Undo":
+		var undone struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(a.Object, &undone); err != nil || undone.Type != "Follow" {
+			return nil
+		}
+
+		_, err := db.ExecContext(ctx, "DELETE FROM activitypub_followers WHERE actor = $1", actor.ID)
+		return err
+	}
+
+	return nil
+}
+
+// StartActivityPubWorker sends due deliveries to followers' inboxes every
+// interval, until ctx is done. It is safe to run on several servers at once.
+func StartActivityPubWorker(ctx context.Context, interval time.Duration) {
+	startWorker(ctx, "activitypub", interval, deliverNextActivity)
+}
+
+// deliverNextActivity tries to send one due delivery. It returns false when
+// there was nothing to send.
+func deliverNextActivity(ctx context.Context) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var id, inbox, activity string
+	var attempts int
+	err = tx.QueryRowContext(ctx, `
+SELECT id, inbox, activity, attempts
+FROM activitypub_deliveries
+WHERE delivered_at IS NULL AND next_attempt_at <= $1
+ORDER BY next_attempt_at
+LIMIT 1
+FOR UPDATE SKIP LOCKED
+`, time.Now()).Scan(&id, &inbox, &activity, &attempts)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, Internalf("Error running get query: %+v", err)
+	}
+
+	status, sendErr := sendActivity(ctx, inbox, []byte(activity))
+	attempts++
+
+	var statusCode, errMsg, next, delivered interface{}
+	if status != 0 {
+		statusCode = status
+	}
+	if sendErr != nil {
+		errMsg = sendErr.Error()
+		if attempts < maxActivityAttempts {
+			next = time.Now().Add(activityBackoff << uint(attempts-1))
+		}
+	} else {
+		delivered = time.Now()
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE activitypub_deliveries SET attempts = $2, status_code = $3, error = $4, next_attempt_at = $5, delivered_at = $6 WHERE id = $1", id, attempts, statusCode, errMsg, next, delivered); err != nil {
+		return true, err
+	}
+
+	return true, tx.Commit()
+}
+
+// sendActivity POSTs a signed activity to an inbox, returning the response
+// status code.
+func sendActivity(ctx context.Context, inbox string, activity []byte) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, inbox, bytes.NewReader(activity))
+	if err != nil {
+		return 0, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", ActivityContentType)
+	if err := signRequest(req, activity); err != nil {
+		return 0, err
+	}
+
+	resp, err := activityClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("Inbox responded with %s", resp.Status)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// fetchRemoteActor fetches the actor that owns keyID, and its public key.
+// Like most servers, it expects the key to be embedded in the actor, with
+// keyID being the actor's URL with a fragment. The actor and its inboxes must
+// be on the same origin as the key, so a server can only speak for its own
+// actors.
+func fetchRemoteActor(ctx context.Context, keyID string) (*RemoteActor, *rsa.PublicKey, error) {
+	u, err := url.Parse(keyID)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return nil, nil, fmt.Errorf("Key ID must be an https URL")
+	}
+	u.Fragment = ""
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", ActivityContentType)
+	// Servers in secure mode only show actors to signed requests.
+	if err := signRequest(req, nil); err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := activityClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("Could not fetch %s: %s", u, resp.Status)
+	}
+
+	var doc struct {
+		ID        string `json:"id"`
+		Inbox     string `json:"inbox"`
+		Endpoints struct {
+			SharedInbox string `json:"sharedInbox"`
+		} `json:"endpoints"`
+		PublicKey struct {
+			ID           string `json:"id"`
+			Owner        string `json:"owner"`
+			PublicKeyPEM string `json:"publicKeyPem"`
+		} `json:"publicKey"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&doc); err != nil {
+		return nil, nil, fmt.Errorf("Could not parse actor %s: %+v", u, err)
+	}
+
+	if doc.PublicKey.ID != keyID || doc.ID == "" || doc.PublicKey.Owner != doc.ID || doc.Inbox == "" {
+		return nil, nil, fmt.Errorf("Actor %s does not have key %s", u, keyID)
+	}
+
+	for _, id := range []string{doc.ID, doc.Inbox, doc.Endpoints.SharedInbox} {
+		if id == "" {
+			continue
+		}
+		if v, err := url.Parse(id); err != nil || v.Scheme != u.Scheme || v.Host != u.Host {
+			return nil, nil, fmt.Errorf("Actor %s has %s, which is not on the same origin as key %s", u, id, keyID)
+		}
+	}
+
+	block, _ := pem.Decode([]byte(doc.PublicKey.PublicKeyPEM))
+	if block == nil {
+		return nil, nil, fmt.Errorf("Key %s is not PEM encoded", keyID)
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, ok := pub.(*rsa.PublicKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("Key %s is not an RSA key", keyID)
+	}
+
+	return &RemoteActor{ID: doc.ID, Inbox: doc.Inbox, SharedInbox: doc.Endpoints.SharedInbox}, key, nil
+}
+
+// signRequest signs req as the blog's actor, following the HTTP Signatures
+// draft that Mastodon uses. Requests with a body also get a Digest.
+func signRequest(req *http.Request, body []byte) error {
+	req.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	headers := []string{"(request-target)", "host", "date"}
+	if body != nil {
+		req.Header.Set("Digest", bodyDigest(body))
+		headers = append(headers, "digest")
+	}
+
+	hashed := sha256.Sum256([]byte(signingString(req, headers)))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, activityKey, crypto.SHA256, hashed[:])
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Signature", fmt.Sprintf(`keyId="%s#main-key",algorithm="rsa-sha256",headers="%s",signature="%s"`, ActivityActorID(), strings.Join(headers, " "), base64.StdEncoding.EncodeToString(sig)))
+	return nil
+}
+
+// signingString returns what is signed for the headers of req.
+func signingString(req *http.Request, headers []string) string {
+	lines := make([]string, 0, len(headers))
+	for _, h := range headers {
+		switch h {
+		case "(request-target)":
+			lines = append(lines, fmt.Sprintf("(request-target): %s %s", strings.ToLower(req.Method), req.URL.RequestURI()))
+		case "host":
+			host := req.Host
+			if host == "" {
+				host = req.URL.Host
+			}
+			lines = append(lines, "host: "+host)
+		default:
+			lines = append(lines, h+": "+strings.Join(req.Header[http.CanonicalHeaderKey(h)], ", "))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// parseSignatureHeader parses a Signature header, like
+// keyId="...",headers="...",signature="...".
+func parseSignatureHeader(header string) map[string]string {
+	params := map[string]string{}
+	for _, part := range strings.Split(header, ",") {
+		i := strings.Index(part, "=")
+		if i < 0 {
+			continue
+		}
+		params[strings.TrimSpace(part[:i])] = strings.Trim(strings.TrimSpace(part[i+1:]), `"`)
+	}
+
+	return params
+}
+
+// bodyDigest returns the Digest header for body.
+func bodyDigest(body []byte) string {
+	sum := sha256.Sum256(body)
+	return "SHA-256=" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// activityID returns the ID of an object, which can be just its ID.
+func activityID(raw json.RawMessage) string {
+	var id string
+	if err := json.Unmarshal(raw, &id); err == nil {
+		return id
+	}
+
+	var obj struct {
+		ID string `json:"id"`
+	}
+	json.Unmarshal(raw, &obj)
+	return obj.ID
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,221 @@
+package graphql
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	analyticsSaltSetting = "analytics_salt"
+
+	// TopReferrersLimit is how many referrers page view stats include.
+	TopReferrersLimit = 10
+)
+
+var (
+	analyticsSaltMu sync.Mutex
+	analyticsSalt   string
+)
+
+// PageViewStats summarize page views over a range of days.
+type PageViewStats struct {
+	Views     int             `json:"views"`
+	Visitors  int             `json:"visitors"`
+	Referrers []ReferrerCount `json:"referrers"`
+	Days      []DayCount      `json:"days"`
+}
+
+// ReferrerCount is how many views came from a referring site.
+type ReferrerCount struct {
+	Referrer string `json:"referrer"`
+	Views    int    `json:"views"`
+}
+
+// DayCount is how many views and visitors there were on a day.
+type DayCount struct {
+	Day      time.Time `json:"day"`
+	Views    int       `json:"views"`
+	Visitors int       `json:"visitors"`
+}
+
+// hashVisitor returns an anonymous ID for an IP address. It changes every
+// day, so visitors can be counted, but not followed from day to day, and IP
+// addresses are never stored. Ports are ignored, since every connection
+// gets a new one.
+func hashVisitor(ctx context.Context, ip string, t time.Time) (string, error) {
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+
+	analyticsSaltMu.Lock()
+	defer analyticsSaltMu.Unlock()
+
+	if analyticsSalt == "" {
+		salt, err := secretSetting(ctx, analyticsSaltSetting)
+		if err != nil {
+			return "", err
+		}
+		analyticsSalt = salt
+	}
+
+	mac := hmac.New(sha256.New, []byte(analyticsSalt))
+	fmt.Fprintf(mac, "%s|%s", t.UTC().Format("2006-01-02"), ip)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// referrerHost returns the host of a referrer, or "" for direct visits and
+// links from this site.
+func referrerHost(referrer, siteURL string) string {
+	u, err := url.Parse(referrer)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+
+	if site, err := url.Parse(siteURL); err == nil && strings.EqualFold(site.Host, u.Host) {
+		return ""
+	}
+
+	return strings.ToLower(strings.TrimPrefix(u.Host, "www."))
+}
+
+// RecordPageView stores a view of path.
+func RecordPageView(ctx context.Context, path, referrer, ip string) error {
+	if !strings.HasPrefix(path, "/") {
+		if u, err := url.Parse(path); err == nil && u.IsAbs() {
+			path = u.Path
+		} else {
+			return fmt.Errorf("Path must start with /")
+		}
+	}
+
+	site, err := GetSiteSettings(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	visitor, err := hashVisitor(ctx, ip, now)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecContext(ctx, "INSERT INTO page_views (path, referrer, visitor, created_at) VALUES ($1, $2, $3, $4)", NormalizeRedirectPath(path), referrerHost(referrer, site.URL), visitor, now)
+	return err
+}
+
+// StartAnalyticsWorker rolls up page views into daily totals every interval,
+// until ctx is done.
+func StartAnalyticsWorker(ctx context.Context, interval time.Duration) {
+	startWorker(ctx, "analytics", interval, func(ctx context.Context) (bool, error) {
+		return false, AggregatePageViews(ctx)
+	})
+}
+
+// AggregatePageViews rolls up page views from before today, in UTC, into
+// daily totals, and deletes them. Today's views are kept, so visitors are
+// only counted once a day.
+func AggregatePageViews(ctx context.Context) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	if _, err := tx.ExecContext(ctx, `
+INSERT INTO page_view_days (day, path, referrer, views, visitors)
+SELECT (created_at AT TIME ZONE 'UTC')::date, path, referrer, count(*), count(DISTINCT visitor)
+FROM page_views
+WHERE created_at < $1
+GROUP BY 1, 2, 3
+ON CONFLICT (day, path, referrer) DO UPDATE
+SET views = page_view_days.views + excluded.views, visitors = page_view_days.visitors + excluded.visitors
+`, today); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM page_views WHERE created_at < $1", today); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// pageViewsQuery is daily totals and today's page views, as one table.
+const pageViewsQuery = `
+SELECT day, path, referrer, views, visitors FROM page_view_days
+UNION ALL
+SELECT (created_at AT TIME ZONE 'UTC')::date, path, referrer, count(*), count(DISTINCT visitor)
+FROM page_views
+GROUP BY 1, 2, 3
+`
+
+// GetPageViewStats returns page view stats for the days from from to to,
+// inclusive, for path, or the whole site if path is empty. Which days from
+// and to are on is decided in the request's time zone, but views are counted
+// per UTC day, since visitors are only told apart within one. Visitors are
+// counted once per day and path.
+func GetPageViewStats(ctx context.Context, path string, from, to time.Time) (*PageViewStats, error) {
+	if to.Before(from) {
+		return nil, fmt.Errorf("Range must end after it starts")
+	}
+
+	loc, err := Location(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	where := "day BETWEEN $1::date AND $2::date AND ($3 = '' OR path = $3)"
+	args := []interface{}{from.In(loc).Format("2006-01-02"), to.In(loc).Format("2006-01-02"), path}
+	if path != "" {
+		args[2] = NormalizeRedirectPath(path)
+	}
+
+	stats := &PageViewStats{Referrers: []ReferrerCount{}, Days: []DayCount{}}
+	rows, err := db.QueryContext(ctx, fmt.Sprintf("SELECT day, sum(views), sum(visitors) FROM (%s) v WHERE %s GROUP BY day ORDER BY day", pageViewsQuery, where), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var d DayCount
+		if err := rows.Scan(&d.Day, &d.Views, &d.Visitors); err != nil {
+			return nil, err
+		}
+		stats.Views += d.Views
+		stats.Visitors += d.Visitors
+		stats.Days = append(stats.Days, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = db.QueryContext(ctx, fmt.Sprintf("SELECT referrer, sum(views) FROM (%s) v WHERE %s AND referrer <> '' GROUP BY referrer ORDER BY 2 DESC, referrer LIMIT %d", pageViewsQuery, where, TopReferrersLimit), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var r ReferrerCount
+		if err := rows.Scan(&r.Referrer, &r.Views); err != nil {
+			return nil, err
+		}
+		stats.Referrers = append(stats.Referrers, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
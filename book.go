@@ -0,0 +1,362 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Book is a book on one of the reading shelves.
+type Book struct {
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Author      string     `json:"author"`
+	Isbn        *string    `json:"isbn"`
+	GoodreadsID *string    `json:"goodreads_id"`
+	Shelf       Shelf      `json:"shelf"`
+	Rating      *int       `json:"rating"`
+	Pages       *int       `json:"pages"`
+	Started     *time.Time `json:"started"`
+	Finished    *time.Time `json:"finished"`
+	Created     time.Time  `json:"created"`
+	Modified    time.Time  `json:"modified"`
+}
+
+// ReadingStats summarize the books finished in a year.
+type ReadingStats struct {
+	Year          int          `json:"year"`
+	Books         int          `json:"books"`
+	Pages         int          `json:"pages"`
+	AverageRating *float64     `json:"average_rating"`
+	Months        []MonthCount `json:"months"`
+}
+
+// MonthCount is how many books, and pages, were finished in a month.
+type MonthCount struct {
+	Month int `json:"month"`
+	Books int `json:"books"`
+	Pages int `json:"pages"`
+}
+
+const bookColumns = "id, title, author, isbn, goodreads_id, shelf, rating, pages, started_at, finished_at, created_at, modified_at"
+
+func scanBook(row interface {
+	Scan(dest ...interface{}) error
+}) (*Book, error) {
+	b := new(Book)
+	var rating, pages sql.NullInt64
+	var started, finished pq.NullTime
+	if err := row.Scan(&b.ID, &b.Title, &b.Author, &b.Isbn, &b.GoodreadsID, &b.Shelf, &rating, &pages, &started, &finished, &b.Created, &b.Modified); err != nil {
+		return nil, err
+	}
+	b.Rating = nullIntPtr(rating)
+	b.Pages = nullIntPtr(pages)
+	b.Started = nullTimePtr(started)
+	b.Finished = nullTimePtr(finished)
+
+	return b, nil
+}
+
+func nullIntPtr(i sql.NullInt64) *int {
+	if !i.Valid {
+		return nil
+	}
+
+	n := int(i.Int64)
+	return &n
+}
+
+func validRating(rating *int) error {
+	if rating != nil && (*rating < 1 || *rating > 5) {
+		return Validation("rating", "Rating must be from 1 to 5")
+	}
+
+	return nil
+}
+
+// AddBook stores a new book. Books added to the reading shelf are started
+// now, unless input says otherwise.
+func AddBook(ctx context.Context, input NewBook) (*Book, error) {
+	b := &Book{
+		Title:   strings.TrimSpace(input.Title),
+		Author:  strings.TrimSpace(input.Author),
+		Isbn:    input.Isbn,
+		Shelf:   ShelfToRead,
+		Pages:   input.Pages,
+		Started: input.Started,
+	}
+	if input.Shelf != nil {
+		b.Shelf = *input.Shelf
+	}
+
+	if b.Title == "" || b.Author == "" {
+		return nil, fmt.Errorf("Title and author are required")
+	}
+
+	now := time.Now()
+	if b.Shelf == ShelfReading && b.Started == nil {
+		b.Started = &now
+	}
+	if b.Shelf == ShelfRead {
+		b.Finished = &now
+	}
+
+	row := db.QueryRowContext(ctx, "INSERT INTO books (title, author, isbn, shelf, pages, started_at, finished_at, created_at, modified_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8) RETURNING "+bookColumns, b.Title, b.Author, b.Isbn, b.Shelf, b.Pages, b.Started, b.Finished, now)
+	return scanBook(row)
+}
+
+// MarkRead moves a book to the read shelf. A nil rating keeps the book's
+// current rating.
+func MarkRead(ctx context.Context, id string, finished time.Time, rating *int) (*Book, error) {
+	if err := validRating(rating); err != nil {
+		return nil, err
+	}
+
+	row := db.QueryRowContext(ctx, "UPDATE books SET shelf = $2, finished_at = $3, rating = COALESCE($4, rating), modified_at = $5 WHERE id = $1 RETURNING "+bookColumns, id, ShelfRead, finished, rating, time.Now())
+	b, err := scanBook(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, NotFound("No book with id %s", id)
+	case err != nil:
+		return nil, err
+	default:
+		return b, nil
+	}
+}
+
+// GetBook returns a book by ID.
+func GetBook(ctx context.Context, id string) (*Book, error) {
+	row := db.QueryRowContext(ctx, "SELECT "+bookColumns+" FROM books WHERE id = $1", id)
+	b, err := scanBook(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, NotFound("No book with id %s", id)
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return b, nil
+	}
+}
+
+// Books returns books, most recently finished or added first. A shelf or
+// year of nil matches all books, otherwise year matches the year books were
+// finished.
+func Books(ctx context.Context, shelf *Shelf, year *int, limit, offset int) ([]*Book, error) {
+	where := []string{"TRUE"}
+	args := []interface{}{}
+	if shelf != nil {
+		args = append(args, *shelf)
+		where = append(where, fmt.Sprintf("shelf = $%d", len(args)))
+	}
+	if year != nil {
+		args = append(args, *year)
+		where = append(where, fmt.Sprintf("date_part('year', finished_at) = $%d", len(args)))
+	}
+	args = append(args, limit, offset)
+
+	query := fmt.Sprintf("SELECT %s FROM books WHERE %s ORDER BY COALESCE(finished_at, created_at) DESC LIMIT $%d OFFSET $%d", bookColumns, strings.Join(where, " AND "), len(args)-1, len(args))
+	return queryBooks(ctx, query, args...)
+}
+
+// CurrentlyReading returns the books on the reading shelf, most recently
+// started first.
+func CurrentlyReading(ctx context.Context) ([]*Book, error) {
+	return queryBooks(ctx, "SELECT "+bookColumns+" FROM books WHERE shelf = $1 ORDER BY started_at DESC NULLS LAST, created_at DESC", ShelfReading)
+}
+
+func queryBooks(ctx context.Context, query string, args ...interface{}) ([]*Book, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	books := make([]*Book, 0)
+	for rows.Next() {
+		b, err := scanBook(rows)
+		if err != nil {
+			return nil, err
+		}
+		books = append(books, b)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return books, nil
+}
+
+// GetReadingStats returns stats on the books finished in year. Which year and
+// month a book was finished in is decided in the request's time zone.
+func GetReadingStats(ctx context.Context, year int) (*ReadingStats, error) {
+	loc, err := Location(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &ReadingStats{Year: year, Months: make([]MonthCount, 12)}
+	for i := range stats.Months {
+		stats.Months[i].Month = i + 1
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT date_part('month', finished_at AT TIME ZONE $3)::int, COUNT(*), COALESCE(SUM(pages), 0) FROM books WHERE shelf = $1 AND date_part('year', finished_at AT TIME ZONE $3) = $2 GROUP BY 1", ShelfRead, year, loc.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var m MonthCount
+		if err := rows.Scan(&m.Month, &m.Books, &m.Pages); err != nil {
+			return nil, err
+		}
+		stats.Months[m.Month-1] = m
+		stats.Books += m.Books
+		stats.Pages += m.Pages
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var avg sql.NullFloat64
+	if err := db.QueryRowContext(ctx, "SELECT AVG(rating) FROM books WHERE shelf = $1 AND date_part('year', finished_at AT TIME ZONE $3) = $2 AND rating IS NOT NULL", ShelfRead, year, loc.String()).Scan(&avg); err != nil {
+		return nil, Internalf("Error running get query: %+v", err)
+	}
+	if avg.Valid {
+		stats.AverageRating = &avg.Float64
+	}
+
+	return stats, nil
+}
+
+// goodreadsShelves maps Goodreads' exclusive shelves to ours.
+var goodreadsShelves = map[string]Shelf{
+	"to-read":           ShelfToRead,
+	"currently-reading": ShelfReading,
+	"read":              ShelfRead,
+}
+
+// ParseGoodreads reads the CSV of a Goodreads library export. Goodreads'
+// own IDs are kept, so importing a newer export updates books instead of
+// adding them again.
+func ParseGoodreads(r io.Reader) ([]*Book, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("Could not read Goodreads CSV: %v", err)
+	}
+
+	if len(records) == 0 {
+		return nil, fmt.Errorf("Goodreads CSV is empty")
+	}
+
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range []string{"Book Id", "Title", "Author", "Exclusive Shelf"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("Goodreads CSV is missing the %q column", name)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+
+		// Goodreads wraps ISBNs like ="0345391802" so spreadsheets keep
+		// the leading zeros.
+		return strings.Trim(strings.TrimSpace(record[i]), `="`)
+	}
+
+	books := make([]*Book, 0, len(records)-1)
+	for _, record := range records[1:] {
+		id := field(record, "Book Id")
+		b := &Book{
+			Title:       field(record, "Title"),
+			Author:      field(record, "Author"),
+			GoodreadsID: &id,
+			Shelf:       ShelfToRead,
+		}
+
+		if s, ok := goodreadsShelves[field(record, "Exclusive Shelf")]; ok {
+			b.Shelf = s
+		}
+
+		for _, name := range []string{"ISBN13", "ISBN"} {
+			if isbn := field(record, name); isbn != "" {
+				b.Isbn = &isbn
+				break
+			}
+		}
+
+		if rating, err := strconv.Atoi(field(record, "My Rating")); err == nil && rating > 0 {
+			b.Rating = &rating
+		}
+
+		if pages, err := strconv.Atoi(field(record, "Number of Pages")); err == nil && pages > 0 {
+			b.Pages = &pages
+		}
+
+		if finished, err := time.Parse("2006/01/02", field(record, "Date Read")); err == nil {
+			b.Finished = &finished
+		}
+
+		if added, err := time.Parse("2006/01/02", field(record, "Date Added")); err == nil {
+			b.Created = added
+		} else {
+			b.Created = time.Now()
+		}
+
+		books = append(books, b)
+	}
+
+	return books, nil
+}
+
+// ImportGoodreads adds or updates the books in the CSV of a Goodreads library
+// export.
+func ImportGoodreads(ctx context.Context, r io.Reader) ([]*Book, error) {
+	parsed, err := ParseGoodreads(r)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	books := make([]*Book, 0, len(parsed))
+	for _, b := range parsed {
+		row := tx.QueryRowContext(ctx, `
+    INSERT INTO books (title, author, isbn, goodreads_id, shelf, rating, pages, finished_at, created_at, modified_at)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+    ON CONFLICT (goodreads_id) DO UPDATE
+    SET (title, author, isbn, shelf, rating, pages, finished_at, modified_at) = ($1, $2, $3, $5, $6, $7, $8, $10)
+    RETURNING `+bookColumns,
+			b.Title, b.Author, b.Isbn, b.GoodreadsID, b.Shelf, b.Rating, b.Pages, b.Finished, b.Created, now)
+		saved, err := scanBook(row)
+		if err != nil {
+			return nil, fmt.Errorf("Error importing %q: %+v", b.Title, err)
+		}
+		books = append(books, saved)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return books, nil
+}
@@ -0,0 +1,130 @@
+// Package client is a GraphQL client for the server, with types for the
+// public schema. It only uses the standard library, so unlike the server's
+// packages, which need Postgres and bbolt, it compiles to WebAssembly:
+//
+//	GOOS=js GOARCH=wasm go build ./client
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Client sends GraphQL operations to a server.
+type Client struct {
+	// URL is the GraphQL endpoint, like https://graphql.natwelch.com/graphql
+	// or the public API at /public/graphql.
+	URL string
+
+	// Token is sent as a bearer token, if set. In browsers, cookies are sent
+	// instead.
+	Token string
+
+	// HTTPClient defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// New returns a client for the GraphQL endpoint at url.
+func New(url string) *Client {
+	return &Client{URL: url}
+}
+
+// Error is a GraphQL error. Code is one of NOT_FOUND, UNAUTHORIZED,
+// VALIDATION, CONFLICT, REQUEST_TOO_LARGE, METHOD_NOT_ALLOWED, RATE_LIMITED,
+// UNAVAILABLE or INTERNAL, and Field names the input the error is about, if any. Retryable
+// errors can succeed if the request is sent again after backing off.
+type Error struct {
+	Message    string        `json:"message"`
+	Path       []interface{} `json:"path"`
+	Extensions struct {
+		Code      string `json:"code"`
+		Retryable bool   `json:"retryable"`
+		Field     string `json:"field"`
+	} `json:"extensions"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Errors are all the errors in a response.
+type Errors []*Error
+
+func (e Errors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Message
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// Retryable returns whether every error is retryable, so the request can be
+// sent again unchanged.
+func (e Errors) Retryable() bool {
+	for _, err := range e {
+		if !err.Extensions.Retryable {
+			return false
+		}
+	}
+
+	return len(e) > 0
+}
+
+// Query runs a GraphQL operation, and decodes its data into data. If the
+// response has errors, they are returned as Errors, and whatever data came
+// back is still decoded.
+func (c *Client) Query(ctx context.Context, query string, variables map[string]interface{}, data interface{}) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	prepareRequest(req)
+
+	hc := c.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+
+	resp, err := hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var res struct {
+		Data   json.RawMessage `json:"data"`
+		Errors Errors          `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return fmt.Errorf("%s responded with %s: %+v", c.URL, resp.Status, err)
+	}
+
+	if data != nil && len(res.Data) > 0 && string(res.Data) != "null" {
+		if err := json.Unmarshal(res.Data, data); err != nil {
+			return err
+		}
+	}
+
+	if len(res.Errors) > 0 {
+		return res.Errors
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+//go:build js && wasm
+// +build js,wasm
+
+package client
+
+import "net/http"
+
+// prepareRequest has the browser send cookies with the request, so a
+// logged in user's session is used. The header is read by Go's fetch
+// transport, and isn't sent.
+func prepareRequest(req *http.Request) {
+	req.Header.Set("js.fetch:credentials", "include")
+}
@@ -0,0 +1,9 @@
+//go:build !js || !wasm
+// +build !js !wasm
+
+package client
+
+import "net/http"
+
+// prepareRequest does nothing outside browsers.
+func prepareRequest(req *http.Request) {}
@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+// These types are the public schema's, in graphql.PublicFields. Their JSON
+// names are the GraphQL field names, so responses decode into them.
+
+// Post is a blog post.
+type Post struct {
+	ID              string           `json:"id"`
+	Title           string           `json:"title"`
+	Content         string           `json:"content"`
+	Summary         string           `json:"summary"`
+	Readtime        int              `json:"readtime"`
+	HTML            string           `json:"html"`
+	Datetime        time.Time        `json:"datetime"`
+	Created         time.Time        `json:"created"`
+	Modified        time.Time        `json:"modified"`
+	Tags            []string         `json:"tags"`
+	Protected       bool             `json:"protected"`
+	Links           []*Link          `json:"links"`
+	SyndicationURLs []string         `json:"syndicationUrls"`
+	Metadata        []*MetadataEntry `json:"metadata"`
+
+	// CanonicalURL and OriginallyPublishedAt are set on cross-posts.
+	CanonicalURL          *string    `json:"canonicalURL"`
+	OriginallyPublishedAt *time.Time `json:"originallyPublishedAt"`
+
+	// License is the post's license, or the site's default.
+	License *License `json:"license"`
+}
+
+// License is how a post can be reused. SPDX and URL are nil for custom
+// licenses, which have Text instead.
+type License struct {
+	ID   string  `json:"id"`
+	SPDX *string `json:"spdx"`
+	Name string  `json:"name"`
+	URL  *string `json:"url"`
+	Text *string `json:"text"`
+	HTML string  `json:"html"`
+}
+
+// MetadataEntry is a key and value in a post's metadata, like the video of a
+// talk.
+type MetadataEntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// MetadataValue returns the value of a key in the post's metadata, or "" if it
+// isn't set.
+func (p *Post) MetadataValue(key string) string {
+	for _, m := range p.Metadata {
+		if m.Key == key {
+			return m.Value
+		}
+	}
+
+	return ""
+}
+
+// Link is a saved link.
+type Link struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	URI         string    `json:"uri"`
+	Created     time.Time `json:"created"`
+	Modified    time.Time `json:"modified"`
+	Description string    `json:"description"`
+	Screenshot  string    `json:"screenshot"`
+	Tags        []string  `json:"tags"`
+}
+
+// TagCount is a tag, and how many published posts have it.
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+// Page is a standalone page, like /about.
+type Page struct {
+	Slug     string    `json:"slug"`
+	Title    string    `json:"title"`
+	Content  string    `json:"content"`
+	HTML     string    `json:"html"`
+	Created  time.Time `json:"created"`
+	Modified time.Time `json:"modified"`
+}
+
+// SiteSettings are the site's title, URL and other settings.
+type SiteSettings struct {
+	Title        string        `json:"title"`
+	Description  string        `json:"description"`
+	URL          string        `json:"url"`
+	FooterText   string        `json:"footerText"`
+	PostsPerPage int           `json:"postsPerPage"`
+	SocialLinks  []*SocialLink `json:"socialLinks"`
+	License      *License      `json:"license"`
+}
+
+// SocialLink is a link to a profile elsewhere.
+type SocialLink struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+const postFields = "id title content summary readtime html datetime created modified tags protected links { id title uri } syndicationUrls metadata { key value } canonicalURL originallyPublishedAt license { id spdx name url text html }"
+
+// Posts returns a page of published posts, newest first.
+func (c *Client) Posts(ctx context.Context, limit, offset int) ([]*Post, error) {
+	var data struct {
+		Posts []*Post `json:"posts"`
+	}
+	err := c.Query(ctx, "query Posts($limit: Int, $offset: Int) { posts(limit: $limit, offset: $offset) { "+postFields+" } }", map[string]interface{}{
+		"limit":  limit,
+		"offset": offset,
+	}, &data)
+	return data.Posts, err
+}
+
+// Post returns a post, or nil if there isn't one with the ID.
+func (c *Client) Post(ctx context.Context, id string) (*Post, error) {
+	var data struct {
+		Post *Post `json:"post"`
+	}
+	err := c.Query(ctx, "query Post($id: ID!) { post(id: $id) { "+postFields+" } }", map[string]interface{}{
+		"id": id,
+	}, &data)
+	return data.Post, err
+}
+
+// Tags returns the tags on published posts.
+func (c *Client) Tags(ctx context.Context) ([]*TagCount, error) {
+	var data struct {
+		Tags []*TagCount `json:"tags"`
+	}
+	err := c.Query(ctx, "query Tags { tags { tag count } }", nil, &data)
+	return data.Tags, err
+}
+
+// Page returns a published page, or nil if there isn't one with the slug.
+func (c *Client) Page(ctx context.Context, slug string) (*Page, error) {
+	var data struct {
+		Page *Page `json:"page"`
+	}
+	err := c.Query(ctx, "query Page($slug: String!) { page(slug: $slug) { slug title content html created modified } }", map[string]interface{}{
+		"slug": slug,
+	}, &data)
+	return data.Page, err
+}
+
+// SiteSettings returns the site's settings.
+func (c *Client) SiteSettings(ctx context.Context) (*SiteSettings, error) {
+	var data struct {
+		SiteSettings *SiteSettings `json:"siteSettings"`
+	}
+	err := c.Query(ctx, "query SiteSettings { siteSettings { title description url footerText postsPerPage socialLinks { name url } license { id spdx name url text html } } }", nil, &data)
+	return data.SiteSettings, err
+}
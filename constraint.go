@@ -0,0 +1,169 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/ast"
+)
+
+// constraintPatterns are the compiled patterns of the schema's @constraint
+// directives. A pattern that doesn't compile is a bug in the schema, so it
+// panics at startup, like regexp.MustCompile.
+var constraintPatterns = compileConstraintPatterns(parsedSchema)
+
+func compileConstraintPatterns(schema *ast.Schema) map[string]*regexp.Regexp {
+	patterns := map[string]*regexp.Regexp{}
+	add := func(dirs ast.DirectiveList) {
+		d := dirs.ForName("constraint")
+		if d == nil {
+			return
+		}
+		if p, ok := d.ArgumentMap(nil)["pattern"].(string); ok {
+			patterns[p] = regexp.MustCompile(p)
+		}
+	}
+
+	for _, def := range schema.Types {
+		for _, f := range def.Fields {
+			add(f.Directives)
+			for _, arg := range f.Arguments {
+				add(arg.Directives)
+			}
+		}
+	}
+
+	return patterns
+}
+
+// ConstraintMiddleware is a gqlgen resolver middleware that checks the
+// arguments of mutations against their @constraint directives before the
+// resolver runs. Every broken constraint is its own validation error, so
+// clients can show them all at once.
+func ConstraintMiddleware(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	rctx := graphql.GetResolverContext(ctx)
+	if rctx == nil || rctx.Object != "Mutation" || rctx.Field.Definition == nil {
+		return next(ctx)
+	}
+
+	// Clients that can't run the mutation get its @hasRole error instead.
+	if d := rctx.Field.Definition.Directives.ForName("hasRole"); d != nil {
+		if role, _ := d.ArgumentMap(nil)["role"].(string); !HasRole(ctx, Role(role)) {
+			return next(ctx)
+		}
+	}
+
+	args := rctx.Field.ArgumentMap(graphql.GetRequestContext(ctx).Variables)
+	var errs []error
+	for _, def := range rctx.Field.Definition.Arguments {
+		errs = append(errs, checkConstraints(def.Name, def.Type, def.Directives, args[def.Name])...)
+	}
+
+	if len(errs) == 0 {
+		return next(ctx)
+	}
+
+	for _, err := range errs[:len(errs)-1] {
+		graphql.AddError(ctx, err)
+	}
+	return nil, errs[len(errs)-1]
+}
+
+// checkConstraints checks v, the raw value of field, and the fields inside it
+// if it is an input object. Constraints on lists apply to each item.
+func checkConstraints(field string, t *ast.Type, dirs ast.DirectiveList, v interface{}) []error {
+	if v == nil {
+		return nil
+	}
+
+	var errs []error
+	if t.Elem != nil {
+		items, _ := v.([]interface{})
+		for i, item := range items {
+			errs = append(errs, checkConstraints(fmt.Sprintf("%s.%d", field, i), t.Elem, dirs, item)...)
+		}
+		return errs
+	}
+
+	if d := dirs.ForName("constraint"); d != nil {
+		if err := checkConstraint(field, d.ArgumentMap(nil), v); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	def := parsedSchema.Types[t.NamedType]
+	if obj, ok := v.(map[string]interface{}); ok && def != nil && def.Kind == ast.InputObject {
+		for _, f := range def.Fields {
+			errs = append(errs, checkConstraints(field+"."+f.Name, f.Type, f.Directives, obj[f.Name])...)
+		}
+	}
+
+	return errs
+}
+
+// checkConstraint checks v against the arguments of one @constraint.
+func checkConstraint(field string, c map[string]interface{}, v interface{}) error {
+	if s, ok := v.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if min, ok := constraintNumber(c["minLength"]); ok && float64(n) < min {
+			if min == 1 {
+				return Validation(field, "%s is required", field)
+			}
+			return Validation(field, "%s must be at least %v characters", field, min)
+		}
+		if max, ok := constraintNumber(c["maxLength"]); ok && float64(n) > max {
+			return Validation(field, "%s must be at most %v characters", field, max)
+		}
+		if p, ok := c["pattern"].(string); ok && !constraintPatterns[p].MatchString(s) {
+			return Validation(field, "%s must match %s", field, p)
+		}
+
+		switch c["format"] {
+		case "url":
+			if validLinkURL(s) != nil {
+				return Validation(field, "%s must be an absolute http or https URL", field)
+			}
+		case "email":
+			if _, err := mail.ParseAddress(s); err != nil {
+				return Validation(field, "%s must be an email address", field)
+			}
+		}
+
+		return nil
+	}
+
+	if n, ok := constraintNumber(v); ok {
+		if min, ok := constraintNumber(c["min"]); ok && n < min {
+			return Validation(field, "%s must be at least %v", field, min)
+		}
+		if max, ok := constraintNumber(c["max"]); ok && n > max {
+			return Validation(field, "%s must be at most %v", field, max)
+		}
+	}
+
+	return nil
+}
+
+// constraintNumber returns v as a float64, if it is a number. Numbers in
+// queries are int64s or float64s, and in variables can be json.Numbers.
+func constraintNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	case json.Number:
+		f, err := strconv.ParseFloat(string(n), 64)
+		return f, err == nil
+	}
+
+	return 0, false
+}
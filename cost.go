@@ -0,0 +1,155 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// CostWindow is how long a cost budget lasts before it is refilled.
+const CostWindow = time.Hour
+
+type responseHeaderCtxKeyType string
+
+const responseHeaderCtxKey responseHeaderCtxKeyType = "responseHeader"
+
+// Cost is what an operation cost, and what is left of the budget it was
+// charged to. It is returned in the cost response extension.
+type Cost struct {
+	Requested int       `json:"requested"`
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	Reset     time.Time `json:"reset"`
+}
+
+// WithResponseHeader lets GraphQL middleware set headers on the HTTP
+// response.
+func WithResponseHeader(ctx context.Context, h http.Header) context.Context {
+	return context.WithValue(ctx, responseHeaderCtxKey, h)
+}
+
+// costKey returns the budget the request is charged to. Anonymous requests
+// don't have a budget.
+func costKey(ctx context.Context) string {
+	if sa := ServiceAccountForContext(ctx); sa != nil {
+		return "service_account:" + sa.ID
+	}
+
+	if u := ForContext(ctx); u != nil {
+		return "user:" + u.ID
+	}
+
+	return ""
+}
+
+// chargeCost takes cost from key's budget for the current window, unless
+// that would overspend it. It returns what is left, and false if the budget
+// could not cover the cost.
+func chargeCost(ctx context.Context, key string, cost, budget int) (*Cost, bool, error) {
+	window := time.Now().Truncate(CostWindow)
+	c := &Cost{Requested: cost, Limit: budget, Reset: window.Add(CostWindow)}
+
+	var spent int
+	err := db.DB.QueryRowContext(ctx, `
+INSERT INTO cost_budgets (key, window_start, spent)
+SELECT $1, $2, $3 WHERE $3 <= $4
+ON CONFLICT (key) DO UPDATE
+SET (window_start, spent) = ($2, CASE WHEN cost_budgets.window_start = $2 THEN cost_budgets.spent + $3 ELSE $3 END)
+WHERE cost_budgets.window_start <> $2 OR cost_budgets.spent + $3 <= $4
+RETURNING spent
+`, key, window, cost, budget).Scan(&spent)
+	switch {
+	case err == sql.ErrNoRows:
+		// Over budget, so nothing was charged.
+		err := db.DB.QueryRowContext(ctx, "SELECT CASE WHEN window_start = $2 THEN spent ELSE 0 END FROM cost_budgets WHERE key = $1", key, window).Scan(&spent)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, false, Internalf("Error running get query: %+v", err)
+		}
+		c.Remaining = budget - spent
+		return c, false, nil
+	case err != nil:
+		return nil, false, err
+	}
+
+	c.Remaining = budget - spent
+	return c, true, nil
+}
+
+// CostMiddleware is a gqlgen request middleware that charges each
+// authenticated user or service account for the complexity of their
+// operations, from an hourly budget. Operations that would overspend the
+// budget are refused. The cost and remaining budget are returned in the cost
+// response extension and X-RateLimit headers, so clients can slow down
+// before they run out. A budget of zero turns this off.
+func CostMiddleware(es graphql.ExecutableSchema, budget int) graphql.RequestMiddleware {
+	return costMiddleware(es, budget, costKey)
+}
+
+// PublicCostMiddleware is like CostMiddleware, but charges every request,
+// authenticated or not, to a budget for the client's address. It is for the
+// public API, whose clients are anonymous.
+func PublicCostMiddleware(es graphql.ExecutableSchema, budget int) graphql.RequestMiddleware {
+	return costMiddleware(es, budget, func(ctx context.Context) string {
+		if addr := RemoteAddrForContext(ctx); addr != "" {
+			return "public:" + addr
+		}
+
+		return ""
+	})
+}
+
+func costMiddleware(es graphql.ExecutableSchema, budget int, costKey func(context.Context) string) graphql.RequestMiddleware {
+	return func(ctx context.Context, next func(ctx context.Context) []byte) []byte {
+		rctx := graphql.GetRequestContext(ctx)
+		key := costKey(ctx)
+		if budget <= 0 || key == "" || rctx == nil || rctx.Doc == nil {
+			return next(ctx)
+		}
+
+		// Documents can hold several operations, and we don't know which one
+		// is running, so charge for the most expensive.
+		cost := 0
+		for _, op := range rctx.Doc.Operations {
+			if c := complexity.Calculate(es, op, rctx.Variables); c > cost {
+				cost = c
+			}
+		}
+
+		c, ok, err := chargeCost(ctx, key, cost, budget)
+		if err != nil {
+			rctx.Error(ctx, Internalf("Could not check cost budget: %+v", err))
+			return []byte("null")
+		}
+
+		rctx.RegisterExtension("cost", c)
+		if h, _ := ctx.Value(responseHeaderCtxKey).(http.Header); h != nil {
+			h.Set("X-RateLimit-Limit", strconv.Itoa(c.Limit))
+			h.Set("X-RateLimit-Remaining", strconv.Itoa(c.Remaining))
+			h.Set("X-RateLimit-Reset", strconv.FormatInt(c.Reset.Unix(), 10))
+			h.Set("X-Cost", strconv.Itoa(c.Requested))
+		}
+
+		if !ok {
+			rctx.Error(ctx, RateLimited("Operation costs %d, but only %d of the hourly budget of %d is left. It resets at %s", c.Requested, c.Remaining, c.Limit, c.Reset.Format(time.RFC3339)))
+			return []byte("null")
+		}
+
+		return next(ctx)
+	}
+}
+
+// listComplexity is the complexity of a list field: its children, once for
+// each item it can return.
+func listComplexity(childComplexity int, limit *int, def int) int {
+	n := def
+	if limit != nil && *limit > 0 {
+		n = *limit
+	}
+
+	return 1 + n*childComplexity
+}
@@ -0,0 +1,364 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// cronLockID is the Postgres advisory lock held by the replica that runs
+// cron tasks.
+const cronLockID = 0x63726f6e
+
+// CronTask is a task run on a schedule by the replica that holds the cron
+// lock. Schedules are cron expressions, with five fields, or @hourly,
+// @daily, @weekly or @monthly. The schedule of a task can be changed with an
+// environment variable named CRON_ and the task's name in upper case, like
+// CRON_DIGEST, and "off" turns the task off.
+type CronTask struct {
+	Name     string
+	Schedule string
+	Run      func(ctx context.Context) error
+
+	schedule *cronSchedule
+}
+
+// CronTaskStatus is a cron task's schedule and how its last run went.
+type CronTaskStatus struct {
+	Name         string     `json:"name"`
+	Schedule     string     `json:"schedule"`
+	Next         *time.Time `json:"next"`
+	LastStarted  *time.Time `json:"last_started"`
+	LastFinished *time.Time `json:"last_finished"`
+	LastError    *string    `json:"last_error"`
+}
+
+// cronTasks are the tasks the cron runs.
+var cronTasks = []*CronTask{
+	{Name: "digest", Schedule: "@hourly", Run: sendDigests},
+	{Name: "purge_expired", Schedule: "30 * * * *", Run: purgeExpired},
+	{Name: "integrity", Schedule: "15 4 * * *", Run: checkIntegrity},
+}
+
+// StartCron runs cron tasks on their schedules until ctx is done. Every
+// replica can call it: they take turns to hold a Postgres advisory lock, and
+// only the holder runs tasks. It returns an error if a schedule is invalid.
+func StartCron(ctx context.Context) error {
+	for _, t := range cronTasks {
+		if s := os.Getenv("CRON_" + strings.ToUpper(t.Name)); s != "" {
+			t.Schedule = s
+		}
+		if t.Schedule == "off" {
+			continue
+		}
+
+		s, err := parseCron(t.Schedule)
+		if err != nil {
+			return fmt.Errorf("invalid schedule for %s: %+v", t.Name, err)
+		}
+		t.schedule = s
+	}
+
+	go pprof.Do(ctx, pprof.Labels("worker", "cron"), func(ctx context.Context) {
+		var lock *sql.Conn
+		var last time.Time
+		defer func() {
+			if lock != nil {
+				lock.Close()
+			}
+		}()
+
+		t := time.NewTicker(time.Minute)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+
+			if lock != nil {
+				if err := lock.PingContext(ctx); err != nil {
+					log.Printf("lost the cron lock: %+v", err)
+					lock.Close()
+					lock = nil
+				}
+			}
+
+			if lock == nil {
+				var err error
+				lock, err = takeCronLock(ctx)
+				if err != nil {
+					log.Printf("could not take the cron lock: %+v", err)
+				}
+				if lock == nil {
+					continue
+				}
+				last = time.Now().Truncate(time.Minute)
+			}
+
+			// Minutes can be skipped if a run takes longer than a minute, so
+			// every minute since the last check is checked.
+			now := time.Now().Truncate(time.Minute)
+			for _, task := range cronTasks {
+				if task.schedule == nil {
+					continue
+				}
+
+				for m := last.Add(time.Minute); !m.After(now); m = m.Add(time.Minute) {
+					if task.schedule.matches(m) {
+						runCronTask(ctx, task)
+						break
+					}
+				}
+			}
+			last = now
+		}
+	})
+
+	return nil
+}
+
+// takeCronLock returns the connection holding the cron lock, or nil if
+// another replica has it.
+func takeCronLock(ctx context.Context) (*sql.Conn, error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var locked bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", cronLockID).Scan(&locked); err != nil || !locked {
+		conn.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// runCronTask runs a task, and records how it went.
+func runCronTask(ctx context.Context, t *CronTask) {
+	started := time.Now()
+	if _, err := db.ExecContext(ctx, `
+INSERT INTO cron_tasks (name, last_started_at)
+VALUES ($1, $2)
+ON CONFLICT (name) DO UPDATE SET last_started_at = $2
+`, t.Name, started); err != nil {
+		log.Printf("could not record the start of cron task %s: %+v", t.Name, err)
+	}
+
+	var errMsg sql.NullString
+	if err := t.Run(ctx); err != nil {
+		log.Printf("cron task %s failed: %+v", t.Name, err)
+		errMsg = sql.NullString{String: err.Error(), Valid: true}
+	}
+
+	if _, err := db.ExecContext(ctx, "UPDATE cron_tasks SET last_finished_at = $2, last_error = $3 WHERE name = $1", t.Name, time.Now(), errMsg); err != nil {
+		log.Printf("could not record the end of cron task %s: %+v", t.Name, err)
+	}
+}
+
+// CronTasks returns the status of every cron task.
+func CronTasks(ctx context.Context) ([]CronTaskStatus, error) {
+	rows, err := db.QueryContext(ctx, "SELECT name, last_started_at, last_finished_at, last_error FROM cron_tasks")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	runs := map[string]CronTaskStatus{}
+	for rows.Next() {
+		var s CronTaskStatus
+		var started, finished pq.NullTime
+		if err := rows.Scan(&s.Name, &started, &finished, &s.LastError); err != nil {
+			return nil, err
+		}
+		s.LastStarted = nullTimePtr(started)
+		s.LastFinished = nullTimePtr(finished)
+		runs[s.Name] = s
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	statuses := make([]CronTaskStatus, 0, len(cronTasks))
+	for _, t := range cronTasks {
+		s := runs[t.Name]
+		s.Name, s.Schedule = t.Name, t.Schedule
+		if t.schedule != nil {
+			s.Next = t.schedule.next(time.Now())
+		}
+		statuses = append(statuses, s)
+	}
+
+	return statuses, nil
+}
+
+// sendDigests sends every digest that is due.
+func sendDigests(ctx context.Context) error {
+	for {
+		more, err := sendNextDigest(ctx)
+		if err != nil || !more {
+			return err
+		}
+	}
+}
+
+// purgeExpired deletes expired sessions, device codes, OpenID Connect codes
+// and old automation rule runs.
+func purgeExpired(ctx context.Context) error {
+	now := time.Now()
+	for _, p := range []struct {
+		query  string
+		before time.Time
+	}{
+		{"DELETE FROM sessions WHERE last_seen_at < $1", now.Add(-SessionTTL)},
+		{"DELETE FROM device_codes WHERE expires_at < $1", now},
+		{"DELETE FROM oidc_codes WHERE expires_at < $1", now},
+		{"DELETE FROM automation_rule_runs WHERE created_at < $1", now.Add(-RuleRunTTL)},
+		{"DELETE FROM cost_budgets WHERE window_start < $1", now.Truncate(CostWindow)},
+	} {
+		if _, err := db.ExecContext(ctx, p.query, p.before); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cronMacros are the schedules that have names.
+var cronMacros = map[string]string{
+	"@hourly":  "0 * * * *",
+	"@daily":   "0 0 * * *",
+	"@weekly":  "0 0 * * 0",
+	"@monthly": "0 0 1 * *",
+}
+
+// cronSchedule is a parsed cron expression. Each field is a bit set of the
+// values it matches.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+
+	// domAny and dowAny are set for * day fields. If neither is, a day
+	// matches if either field does, like in cron.
+	domAny, dowAny bool
+}
+
+// parseCron parses a cron expression: minute, hour, day of month, month and
+// day of week, each a *, a number, a range like 1-5, or a list of them, with
+// an optional step like */15. Sunday is 0 or 7.
+func parseCron(spec string) (*cronSchedule, error) {
+	if m, ok := cronMacros[spec]; ok {
+		spec = m
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("%q does not have five fields", spec)
+	}
+
+	s := &cronSchedule{domAny: fields[2] == "*", dowAny: fields[4] == "*"}
+	var err error
+	for i, f := range []struct {
+		bits     *uint64
+		min, max int
+	}{
+		{&s.minute, 0, 59},
+		{&s.hour, 0, 23},
+		{&s.dom, 1, 31},
+		{&s.month, 1, 12},
+		{&s.dow, 0, 7},
+	} {
+		if *f.bits, err = parseCronField(fields[i], f.min, f.max); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+
+	return s, nil
+}
+
+func parseCronField(field string, min, max int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step < 1 {
+				return 0, fmt.Errorf("%q has an invalid step", part)
+			}
+			part = part[:i]
+		}
+
+		lo, hi := min, max
+		if part != "*" {
+			bounds := strings.SplitN(part, "-", 2)
+			var err error
+			if lo, err = strconv.Atoi(bounds[0]); err != nil {
+				return 0, fmt.Errorf("%q is not a number", bounds[0])
+			}
+			hi = lo
+			if len(bounds) == 2 {
+				if hi, err = strconv.Atoi(bounds[1]); err != nil {
+					return 0, fmt.Errorf("%q is not a number", bounds[1])
+				}
+			}
+		}
+
+		if lo < min || hi > max || lo > hi {
+			return 0, fmt.Errorf("%q is not within %d-%d", part, min, max)
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+
+	return bits, nil
+}
+
+// matches returns whether the schedule runs in t's minute.
+func (s *cronSchedule) matches(t time.Time) bool {
+	if s.minute&(1<<uint(t.Minute())) == 0 || s.hour&(1<<uint(t.Hour())) == 0 || s.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	switch {
+	case s.domAny && s.dowAny:
+		return true
+	case s.domAny:
+		return dow
+	case s.dowAny:
+		return dom
+	default:
+		return dom || dow
+	}
+}
+
+// next returns the first minute after t that the schedule runs in, or nil if
+// it doesn't run within a year.
+func (s *cronSchedule) next(t time.Time) *time.Time {
+	m := t.Truncate(time.Minute).Add(time.Minute)
+	for end := m.AddDate(1, 0, 0); m.Before(end); m = m.Add(time.Minute) {
+		if s.matches(m) {
+			return &m
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package graphql
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// RecentErrorsLimit is how many errors admin stats include.
+	RecentErrorsLimit = 10
+
+	// recentErrorsWindow is how far back admin stats look for errors.
+	recentErrorsWindow = 24 * time.Hour
+)
+
+// AdminStats summarize the site for the admin dashboard.
+type AdminStats struct {
+	Posts                   int              `json:"posts"`
+	Drafts                  int              `json:"drafts"`
+	Users                   []RoleCount      `json:"users"`
+	DeactivatedUsers        int              `json:"deactivated_users"`
+	FailedWebhookDeliveries int              `json:"failed_webhook_deliveries"`
+	RecentErrors            []AdminError     `json:"recent_errors"`
+	CronTasks               []CronTaskStatus `json:"cron_tasks"`
+}
+
+// RoleCount is how many active users have a role.
+type RoleCount struct {
+	Role  Role `json:"role"`
+	Count int  `json:"count"`
+}
+
+// AdminError is something that went wrong in the background, like a failed
+// job or webhook delivery.
+type AdminError struct {
+	Source  string    `json:"source"`
+	Message string    `json:"message"`
+	Time    time.Time `json:"time"`
+}
+
+// GetAdminStats counts posts, users and failures for the admin dashboard.
+func GetAdminStats(ctx context.Context) (*AdminStats, error) {
+	s := &AdminStats{Users: []RoleCount{}, RecentErrors: []AdminError{}}
+	err := db.QueryRowContext(ctx, `
+SELECT
+  (SELECT COUNT(*) FROM posts WHERE site_id = $1 AND draft = false),
+  (SELECT COUNT(*) FROM posts WHERE site_id = $1 AND draft = true),
+  (SELECT COUNT(*) FROM users WHERE deactivated_at IS NOT NULL),
+  (SELECT COUNT(*) FROM webhook_deliveries WHERE delivered_at IS NULL AND attempts > 0)
+`, siteID(ctx)).Scan(&s.Posts, &s.Drafts, &s.DeactivatedUsers, &s.FailedWebhookDeliveries)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT role, COUNT(*) FROM users WHERE deactivated_at IS NULL GROUP BY role ORDER BY role")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var c RoleCount
+		if err := rows.Scan(&c.Role, &c.Count); err != nil {
+			return nil, err
+		}
+		s.Users = append(s.Users, c)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	s.RecentErrors, err = recentErrors(ctx, time.Now().Add(-recentErrorsWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	s.CronTasks, err = CronTasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// recentErrors returns the newest errors since since, from jobs, webhook
+// deliveries, syndications and ActivityPub deliveries.
+func recentErrors(ctx context.Context, since time.Time) ([]AdminError, error) {
+	rows, err := db.QueryContext(ctx, `
+SELECT source, message, at FROM (
+  SELECT 'job ' || kind AS source, error AS message, finished_at AS at FROM jobs WHERE error IS NOT NULL
+  UNION ALL
+  SELECT 'webhook ' || event, error, created_at FROM webhook_deliveries WHERE error IS NOT NULL AND delivered_at IS NULL
+  UNION ALL
+  SELECT 'syndication ' || service, error, created_at FROM syndications WHERE error IS NOT NULL AND syndicated_at IS NULL
+  UNION ALL
+  SELECT 'activitypub', error, created_at FROM activitypub_deliveries WHERE error IS NOT NULL AND delivered_at IS NULL
+) AS errors
+WHERE at >= $1
+ORDER BY at DESC
+LIMIT $2
+`, since, RecentErrorsLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	errs := make([]AdminError, 0)
+	for rows.Next() {
+		var e AdminError
+		if err := rows.Scan(&e.Source, &e.Message, &e.Time); err != nil {
+			return nil, err
+		}
+		errs = append(errs, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return errs, nil
+}
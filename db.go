@@ -4,77 +4,29 @@ import (
 	"context"
 	"database/sql"
 	"log"
+	"time"
 
-	"github.com/GuiaBolso/darwin"
 	"github.com/basvanbeek/ocsql"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
 
 	// Needed to talk to postgres
 	_ "github.com/lib/pq"
 )
 
 var (
-	db         *sql.DB
-	driver     = "postgres"
-	migrations = []darwin.Migration{
-		{
-			Version:     1,
-			Description: "Creating table posts",
-			Script: `
-      CREATE TABLE posts (
-        id serial primary key,
-        title text,
-        content text,
-        date timestamp with time zone,
-        tags text[],
-        draft boolean,
-        created_at timestamp with time zone,
-        modified_at timestamp with time zone
-      );
-      `,
-		},
-		{
-			Version:     2,
-			Description: "Creating table stats",
-			Script: `
-      CREATE TABLE stats (
-        id serial primary key,
-        key text,
-        value text,
-        created_at timestamp with time zone,
-        modified_at timestamp with time zone
-      );
-      `,
-		},
-		{
-			Version:     3,
-			Description: "Creating table users",
-			Script: `
-      CREATE TABLE users(
-        id serial primary key,
-        role text,
-        created_at timestamp with time zone,
-        modified_at timestamp with time zone
-      );
-      `,
-		},
-		{
-			Version:     4,
-			Description: "Cleanup users",
-			Script: `
-      DROP TABLE IF EXISTS users;
-      DROP TABLE IF EXISTS auth_identities;
-      CREATE TABLE users(
-        id text primary key,
-        role text,
-        created_at timestamp with time zone,
-        modified_at timestamp with time zone
-      );
-      `,
-		},
-	}
+	db     *database
+	driver = "postgres"
+
+	dbOpenConnections = stats.Int64("graphql/db/open_connections", "Number of open database connections", stats.UnitDimensionless)
+	dbInUse           = stats.Int64("graphql/db/in_use", "Number of database connections in use", stats.UnitDimensionless)
+	dbIdle            = stats.Int64("graphql/db/idle", "Number of idle database connections", stats.UnitDimensionless)
+	dbWaitCount       = stats.Int64("graphql/db/wait_count", "Total number of waits for a database connection", stats.UnitDimensionless)
+	dbWaitDuration    = stats.Float64("graphql/db/wait_duration", "Total time spent waiting for a database connection", stats.UnitMilliseconds)
 )
 
-// InitDB creates a package global db connection from a database string.
+// InitDB creates a package global db connection from a database string. It
+// does not run migrations, see MigrateUp.
 func InitDB(dataSourceName string) *sql.DB {
 	var err error
 
@@ -84,19 +36,66 @@ func InitDB(dataSourceName string) *sql.DB {
 		log.Fatalf("Failed to register the ocsql driver: %v", err)
 	}
 
-	db, _ = sql.Open(wrappedDriver, dataSourceName)
-	if err = db.PingContext(context.Background()); err != nil {
+	conn, _ := sql.Open(wrappedDriver, dataSourceName)
+	if err = conn.PingContext(context.Background()); err != nil {
 		log.Panic(err)
 	}
 
-	// Migrate
-	driver := darwin.NewGenericDriver(db, darwin.PostgresDialect{})
-	d := darwin.New(driver, migrations, nil)
-	err = d.Migrate()
-	if err != nil {
-		log.Panic(err)
+	db = &database{DB: conn}
+	log.Printf("Connected to %+v", dataSourceName)
+	return conn
+}
+
+// ConfigurePool sets the limits of the database connection pools. Zero values
+// keep the database/sql defaults.
+func ConfigurePool(maxOpen, maxIdle int, maxLifetime time.Duration) {
+	for _, conn := range []*sql.DB{db.DB, db.replica} {
+		if conn == nil {
+			continue
+		}
+
+		if maxOpen > 0 {
+			conn.SetMaxOpenConns(maxOpen)
+		}
+
+		if maxIdle > 0 {
+			conn.SetMaxIdleConns(maxIdle)
+		}
+
+		if maxLifetime > 0 {
+			conn.SetConnMaxLifetime(maxLifetime)
+		}
 	}
+}
 
-	log.Printf("Connected to %+v", dataSourceName)
-	return db
+// RecordDBStats registers views for the database connection pool, and
+// records its stats every interval.
+func RecordDBStats(interval time.Duration) error {
+	views := []*view.View{}
+	for _, m := range []stats.Measure{dbOpenConnections, dbInUse, dbIdle, dbWaitCount, dbWaitDuration} {
+		views = append(views, &view.View{
+			Name:        m.Name(),
+			Description: m.Description(),
+			Measure:     m,
+			Aggregation: view.LastValue(),
+		})
+	}
+
+	if err := view.Register(views...); err != nil {
+		return err
+	}
+
+	GoTask("db_stats", func() {
+		for range time.Tick(interval) {
+			s := db.Stats()
+			stats.Record(context.Background(),
+				dbOpenConnections.M(int64(s.OpenConnections)),
+				dbInUse.M(int64(s.InUse)),
+				dbIdle.M(int64(s.Idle)),
+				dbWaitCount.M(s.WaitCount),
+				dbWaitDuration.M(float64(s.WaitDuration)/float64(time.Millisecond)))
+		}
+	})
+
+	return nil
 }
@@ -0,0 +1,90 @@
+package graphql
+
+import (
+	"database/sql"
+	"runtime"
+	"runtime/debug"
+	"time"
+)
+
+var (
+	// Version and GitSHA are what the binary was built from. Set them with
+	// -ldflags "-X github.com/icco/graphql.Version=... -X
+	// github.com/icco/graphql.GitSHA=...".
+	Version = ""
+	GitSHA  = ""
+
+	started = time.Now()
+)
+
+// DebugInfo is what is running, and how it is doing, for investigating
+// performance in production.
+type DebugInfo struct {
+	Version     string       `json:"version"`
+	GitSHA      string       `json:"git_sha"`
+	GoVersion   string       `json:"go_version"`
+	Started     time.Time    `json:"started"`
+	Uptime      int          `json:"uptime"`
+	Goroutines  int          `json:"goroutines"`
+	HeapAlloc   int          `json:"heap_alloc"`
+	HeapObjects int          `json:"heap_objects"`
+	NumGC       int          `json:"num_gc"`
+	Database    *DBPoolStats `json:"database"`
+	Replica     *DBPoolStats `json:"replica"`
+}
+
+// DBPoolStats are the stats of a database connection pool. WaitDuration is
+// in milliseconds.
+type DBPoolStats struct {
+	MaxOpen      int     `json:"max_open"`
+	Open         int     `json:"open"`
+	InUse        int     `json:"in_use"`
+	Idle         int     `json:"idle"`
+	WaitCount    int     `json:"wait_count"`
+	WaitDuration float64 `json:"wait_duration"`
+}
+
+// GetDebugInfo returns what is running and how it is doing. Uptime is in
+// seconds. The replica's stats are nil if there isn't one.
+func GetDebugInfo() *DebugInfo {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	info := &DebugInfo{
+		Version:     Version,
+		GitSHA:      GitSHA,
+		GoVersion:   runtime.Version(),
+		Started:     started,
+		Uptime:      int(time.Since(started) / time.Second),
+		Goroutines:  runtime.NumGoroutine(),
+		HeapAlloc:   int(mem.HeapAlloc),
+		HeapObjects: int(mem.HeapObjects),
+		NumGC:       int(mem.NumGC),
+		Database:    newDBPoolStats(db.DB),
+	}
+	if db.replica != nil {
+		info.Replica = newDBPoolStats(db.replica)
+	}
+
+	// Builds without -ldflags still know the module's version, if it was
+	// built as a dependency.
+	if info.Version == "" {
+		if bi, ok := debug.ReadBuildInfo(); ok {
+			info.Version = bi.Main.Version
+		}
+	}
+
+	return info
+}
+
+func newDBPoolStats(conn *sql.DB) *DBPoolStats {
+	s := conn.Stats()
+	return &DBPoolStats{
+		MaxOpen:      s.MaxOpenConnections,
+		Open:         s.OpenConnections,
+		InUse:        s.InUse,
+		Idle:         s.Idle,
+		WaitCount:    int(s.WaitCount),
+		WaitDuration: float64(s.WaitDuration) / float64(time.Millisecond),
+	}
+}
@@ -0,0 +1,191 @@
+package graphql
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// DeviceCodeExpiry is how long a device code can be used before it must be
+	// requested again.
+	DeviceCodeExpiry = 10 * time.Minute
+
+	// DeviceCodeInterval is the minimum number of seconds a client should wait
+	// between polls for a token.
+	DeviceCodeInterval = 5
+
+	// DeviceCodeSlowDown is how many seconds are added to a device code's
+	// interval each time its client polls too soon, as RFC 8628 requires.
+	DeviceCodeSlowDown = 5
+
+	// userCodeCharset avoids vowels and ambiguous characters, as suggested by
+	// RFC 8628.
+	userCodeCharset = "BCDFGHJKLMNPQRSTVWXZ"
+)
+
+var (
+	// ErrAuthorizationPending is returned when a device code has not yet been
+	// approved by a user.
+	ErrAuthorizationPending = fmt.Errorf("authorization_pending")
+
+	// ErrExpiredToken is returned when a device code is used after it expired.
+	ErrExpiredToken = fmt.Errorf("expired_token")
+
+	// ErrSlowDown is returned when a device code is polled before its
+	// interval is up. Its interval goes up by DeviceCodeSlowDown.
+	ErrSlowDown = fmt.Errorf("slow_down")
+)
+
+// DeviceCode is a pending login from a device, like the CLI, that can not open
+// a browser itself.
+type DeviceCode struct {
+	DeviceCode string
+	UserCode   string
+	UserID     string
+	Expires    time.Time
+	Created    time.Time
+}
+
+// NewDeviceCode creates and stores a new device code.
+func NewDeviceCode(ctx context.Context) (*DeviceCode, error) {
+	deviceCode, err := randomHex(32)
+	if err != nil {
+		return nil, err
+	}
+
+	userCode, err := newUserCode()
+	if err != nil {
+		return nil, err
+	}
+
+	d := &DeviceCode{
+		DeviceCode: deviceCode,
+		UserCode:   userCode,
+		Created:    time.Now(),
+		Expires:    time.Now().Add(DeviceCodeExpiry),
+	}
+
+	if _, err := db.ExecContext(ctx, "INSERT INTO device_codes (device_code, user_code, expires_at, created_at) VALUES ($1, $2, $3, $4)", d.DeviceCode, d.UserCode, d.Expires, d.Created); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// newUserCode generates a short code like "BDFG-HJKL" for a user to type.
+func newUserCode() (string, error) {
+	// Bytes past the last whole multiple of the charset's length would make
+	// its first letters more likely than the rest, so they are thrown away.
+	max := 256 - 256%len(userCodeCharset)
+
+	code := make([]byte, 0, 8)
+	b := make([]byte, 8)
+	for len(code) < cap(code) {
+		if _, err := rand.Read(b); err != nil {
+			return "", err
+		}
+
+		for _, c := range b {
+			if int(c) < max && len(code) < cap(code) {
+				code = append(code, userCodeCharset[int(c)%len(userCodeCharset)])
+			}
+		}
+	}
+
+	return fmt.Sprintf("%s-%s", code[:4], code[4:]), nil
+}
+
+// NormalizeUserCode uppercases a user code and adds back the dash, so users
+// can type it however they like.
+func NormalizeUserCode(code string) string {
+	code = strings.ToUpper(strings.Replace(strings.TrimSpace(code), "-", "", -1))
+	if len(code) != 8 {
+		return code
+	}
+
+	return fmt.Sprintf("%s-%s", code[:4], code[4:])
+}
+
+// ApproveDeviceCode links a pending user code to a user.
+func ApproveDeviceCode(ctx context.Context, userCode string, u *User) error {
+	res, err := db.ExecContext(ctx, "UPDATE device_codes SET user_id = $1 WHERE user_code = $2 AND user_id IS NULL AND expires_at > $3", u.ID, NormalizeUserCode(userCode), time.Now())
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return NotFound("No pending device with code %s", userCode)
+	}
+
+	return nil
+}
+
+// ExchangeDeviceCode trades an approved device code for an API token. The
+// device code can only be exchanged once. Polling again before the code's
+// interval is up returns ErrSlowDown.
+func ExchangeDeviceCode(ctx context.Context, deviceCode string) (*Token, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var d DeviceCode
+	var userID sql.NullString
+	var lastPolled pq.NullTime
+	var interval int
+	row := tx.QueryRowContext(ctx, "SELECT device_code, user_code, user_id, expires_at, created_at, last_polled_at, poll_interval FROM device_codes WHERE device_code = $1 FOR UPDATE", deviceCode)
+	err = row.Scan(&d.DeviceCode, &d.UserCode, &userID, &d.Expires, &d.Created, &lastPolled, &interval)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, NotFound("No device with code %s", deviceCode)
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	}
+
+	now := time.Now()
+	if now.After(d.Expires) {
+		return nil, ErrExpiredToken
+	}
+
+	if lastPolled.Valid && now.Sub(lastPolled.Time) < time.Duration(interval)*time.Second {
+		if _, err := tx.ExecContext(ctx, "UPDATE device_codes SET last_polled_at = $2, poll_interval = poll_interval + $3 WHERE device_code = $1", d.DeviceCode, now, DeviceCodeSlowDown); err != nil {
+			return nil, err
+		}
+		if err := tx.Commit(); err != nil {
+			return nil, err
+		}
+		return nil, ErrSlowDown
+	}
+
+	if !userID.Valid {
+		if _, err := tx.ExecContext(ctx, "UPDATE device_codes SET last_polled_at = $2 WHERE device_code = $1", d.DeviceCode, now); err != nil {
+			return nil, err
+		}
+		if err := tx.Commit(); err != nil {
+			return nil, err
+		}
+		return nil, ErrAuthorizationPending
+	}
+	d.UserID = userID.String
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM device_codes WHERE device_code = $1", d.DeviceCode); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return (&User{ID: d.UserID}).NewToken(ctx)
+}
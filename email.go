@@ -0,0 +1,264 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"path"
+	"strings"
+	"text/template"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Email templates live in emails/ as name.tmpl, and define a "subject" and a
+// plain text "body".
+//
+//go:embed emails/*.tmpl
+var emailFiles embed.FS
+
+// mailConcurrency is the most emails sent at once.
+const mailConcurrency = 4
+
+var (
+	mailer      Mailer
+	emailClient = &http.Client{Timeout: 10 * time.Second}
+)
+
+// Email is a plain text email. RequestID is the request that caused it, if
+// one did, and is sent in an X-Request-Id header.
+type Email struct {
+	To        string
+	Subject   string
+	Body      string
+	RequestID string
+}
+
+// Mailer sends email.
+type Mailer interface {
+	Send(ctx context.Context, e *Email) error
+}
+
+// ConfigureMailer sets how email is sent. A nil mailer turns email off.
+func ConfigureMailer(m Mailer) {
+	mailer = m
+}
+
+// SMTP sends email through an SMTP server.
+type SMTP struct {
+	// Addr is the host:port of the server.
+	Addr     string
+	Username string
+	Password string
+	From     string
+}
+
+// Send implements Mailer.
+func (s *SMTP) Send(ctx context.Context, e *Email) error {
+	var auth smtp.Auth
+	if s.Username != "" {
+		host, _, err := net.SplitHostPort(s.Addr)
+		if err != nil {
+			return err
+		}
+		auth = smtp.PlainAuth("", s.Username, s.Password, host)
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", s.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", e.To)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", e.Subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	if e.RequestID != "" {
+		fmt.Fprintf(&msg, "X-Request-Id: %s\r\n", e.RequestID)
+	}
+	fmt.Fprintf(&msg, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	msg.WriteString(strings.Replace(e.Body, "\n", "\r\n", -1))
+
+	return s.sendMail(ctx, auth, e.To, msg.Bytes())
+}
+
+// sendMail is smtp.SendMail, but gives up when ctx is done.
+func (s *SMTP) sendMail(ctx context.Context, auth smtp.Auth, to string, msg []byte) error {
+	host, _, err := net.SplitHostPort(s.Addr)
+	if err != nil {
+		return err
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", s.Addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// net/smtp doesn't take a context, so interrupt the connection instead.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.SetDeadline(time.Now())
+		case <-done:
+		}
+	}()
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+
+	if auth != nil {
+		if err := c.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(s.From); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to); err != nil {
+		return err
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
+
+// SendGrid sends email with the SendGrid API.
+type SendGrid struct {
+	// Token is an API key with the Mail Send permission.
+	Token string
+	From  string
+}
+
+type sendGridAddress struct {
+	Email string `json:"email"`
+}
+
+type sendGridContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type sendGridPersonalization struct {
+	To []sendGridAddress `json:"to"`
+}
+
+// Send implements Mailer.
+func (s *SendGrid) Send(ctx context.Context, e *Email) error {
+	msg := map[string]interface{}{
+		"personalizations": []sendGridPersonalization{{To: []sendGridAddress{{Email: e.To}}}},
+		"from":             sendGridAddress{Email: s.From},
+		"subject":          e.Subject,
+		"content":          []sendGridContent{{Type: "text/plain", Value: e.Body}},
+	}
+	if e.RequestID != "" {
+		msg["headers"] = map[string]string{"X-Request-Id": e.RequestID}
+	}
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "https://api.sendgrid.com/v3/mail/send", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.Token)
+
+	resp, err := emailClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("SendGrid responded with %s", resp.Status)
+	}
+
+	return nil
+}
+
+// RenderEmail renders the email template name with data.
+func RenderEmail(name, to string, data interface{}) (*Email, error) {
+	t, err := template.ParseFS(emailFiles, path.Join("emails", name+".tmpl"))
+	if err != nil {
+		return nil, err
+	}
+
+	var subject, body bytes.Buffer
+	if err := t.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, err
+	}
+	if err := t.ExecuteTemplate(&body, "body", data); err != nil {
+		return nil, err
+	}
+
+	return &Email{
+		To:      to,
+		Subject: strings.TrimSpace(subject.String()),
+		Body:    body.String(),
+	}, nil
+}
+
+// sendEmail renders and sends the email template name to each address, at
+// most mailConcurrency at a time. It does nothing if email is off. Emails
+// sent for a request end with a reference to its ID.
+func sendEmail(ctx context.Context, name string, to []string, data interface{}) error {
+	if mailer == nil {
+		return nil
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(mailConcurrency)
+	for _, addr := range to {
+		addr := addr
+		g.Go(func() error {
+			e, err := RenderEmail(name, addr, data)
+			if err != nil {
+				return err
+			}
+			if e.RequestID = RequestID(ctx); e.RequestID != "" {
+				e.Body = strings.TrimRight(e.Body, "\n") + "\n\nReference: " + e.RequestID + "\n"
+			}
+
+			if err := mailer.Send(ctx, e); err != nil {
+				return fmt.Errorf("could not send %s email to %s: %+v", name, addr, err)
+			}
+
+			return nil
+		})
+	}
+
+	return g.Wait()
+}
@@ -0,0 +1,314 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	sqldriver "database/sql/driver"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/go-chi/chi/middleware"
+	"github.com/lib/pq"
+	"github.com/vektah/gqlparser/gqlerror"
+)
+
+// ErrorCode classifies an error for clients. It is returned in the code
+// extension of GraphQL errors, with whether the error is retryable.
+type ErrorCode string
+
+// Error codes.
+const (
+	// CodeNotFound is for things that don't exist, or that the client can't
+	// see.
+	CodeNotFound ErrorCode = "NOT_FOUND"
+
+	// CodeUnauthorized is for clients that aren't logged in, or aren't
+	// allowed to do something.
+	CodeUnauthorized ErrorCode = "UNAUTHORIZED"
+
+	// CodeValidation is for bad input. The field extension says which input
+	// field, if it is known.
+	CodeValidation ErrorCode = "VALIDATION"
+
+	// CodeInternal is for everything that's our fault. Clients only get a
+	// generic message; the details are logged.
+	CodeInternal ErrorCode = "INTERNAL"
+
+	// CodeTooLarge is for request bodies over the size limit. The limit
+	// extension says what it is, in bytes.
+	CodeTooLarge ErrorCode = "REQUEST_TOO_LARGE"
+
+	// CodeMethodNotAllowed is for requests with an HTTP method the endpoint
+	// doesn't support. The Allow header says which it does.
+	CodeMethodNotAllowed ErrorCode = "METHOD_NOT_ALLOWED"
+
+	// CodeConflict is for edits of something that was changed since the
+	// client read it. The version extension is its current version.
+	CodeConflict ErrorCode = "CONFLICT"
+
+	// CodeUnavailable is for failures that can go away on their own, like a
+	// lost database connection, a deadlock or a timeout. Like internal
+	// errors, clients only get a generic message.
+	CodeUnavailable ErrorCode = "UNAVAILABLE"
+
+	// CodeRateLimited is for clients that have used up their budget. It
+	// resets at the time in the X-RateLimit-Reset header.
+	CodeRateLimited ErrorCode = "RATE_LIMITED"
+)
+
+// Messages clients see instead of internal and unavailable errors.
+const (
+	internalMessage    = "Internal server error"
+	unavailableMessage = "Service unavailable, try again later"
+)
+
+// ErrForbidden is returned when the client isn't logged in, or isn't allowed
+// to do something.
+var ErrForbidden = Unauthorized("Forbidden")
+
+// HTTPStatus returns the HTTP status for errors with the code.
+func (c ErrorCode) HTTPStatus() int {
+	switch c {
+	case CodeNotFound:
+		return http.StatusNotFound
+	case CodeUnauthorized:
+		return http.StatusForbidden
+	case CodeValidation:
+		return http.StatusBadRequest
+	case CodeTooLarge:
+		return http.StatusRequestEntityTooLarge
+	case CodeMethodNotAllowed:
+		return http.StatusMethodNotAllowed
+	case CodeConflict:
+		return http.StatusConflict
+	case CodeUnavailable:
+		return http.StatusServiceUnavailable
+	case CodeRateLimited:
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// CodeForHTTPStatus returns the code for an HTTP error status, for errors
+// that only have a status.
+func CodeForHTTPStatus(status int) ErrorCode {
+	switch {
+	case status == http.StatusNotFound:
+		return CodeNotFound
+	case status == http.StatusUnauthorized, status == http.StatusForbidden:
+		return CodeUnauthorized
+	case status == http.StatusMethodNotAllowed:
+		return CodeMethodNotAllowed
+	case status == http.StatusConflict:
+		return CodeConflict
+	case status == http.StatusRequestEntityTooLarge:
+		return CodeTooLarge
+	case status == http.StatusTooManyRequests:
+		return CodeRateLimited
+	case status == http.StatusServiceUnavailable, status == http.StatusGatewayTimeout:
+		return CodeUnavailable
+	case status >= 500:
+		return CodeInternal
+	default:
+		return CodeValidation
+	}
+}
+
+// Retryable returns whether a request that failed with the code can succeed
+// if it is sent again unchanged, after backing off. Everything else needs the
+// request, or something else, to change first.
+func (c ErrorCode) Retryable() bool {
+	return c == CodeUnavailable || c == CodeRateLimited
+}
+
+// Error is an error with a code. Messages of internal errors are logged but
+// never shown to clients. Version is only set on conflicts.
+type Error struct {
+	Code    ErrorCode
+	Field   string
+	Message string
+	Version int
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Extensions returns the code, retryable, field and version extensions of the
+// error for GraphQL responses.
+func (e *Error) Extensions() map[string]interface{} {
+	ext := map[string]interface{}{"code": e.Code, "retryable": e.Code.Retryable()}
+	if e.Field != "" {
+		ext["field"] = e.Field
+	}
+	if e.Code == CodeConflict {
+		ext["version"] = e.Version
+	}
+
+	return ext
+}
+
+// ClientMessage returns the message that is safe to show clients.
+func (e *Error) ClientMessage() string {
+	switch e.Code {
+	case CodeInternal:
+		return internalMessage
+	case CodeUnavailable:
+		return unavailableMessage
+	}
+
+	return e.Message
+}
+
+// hidden returns whether the error's details are hidden from clients.
+func (e *Error) hidden() bool {
+	return e.Code == CodeInternal || e.Code == CodeUnavailable
+}
+
+// NotFound returns a CodeNotFound error.
+func NotFound(format string, args ...interface{}) error {
+	return &Error{Code: CodeNotFound, Message: fmt.Sprintf(format, args...)}
+}
+
+// Unauthorized returns a CodeUnauthorized error.
+func Unauthorized(format string, args ...interface{}) error {
+	return &Error{Code: CodeUnauthorized, Message: fmt.Sprintf(format, args...)}
+}
+
+// Validation returns a CodeValidation error about an input field, which can
+// be empty.
+func Validation(field, format string, args ...interface{}) error {
+	return &Error{Code: CodeValidation, Field: field, Message: fmt.Sprintf(format, args...)}
+}
+
+// Conflict returns a CodeConflict error about something that is now at
+// version.
+func Conflict(version int, format string, args ...interface{}) error {
+	return &Error{Code: CodeConflict, Version: version, Message: fmt.Sprintf(format, args...)}
+}
+
+// Unavailable returns a CodeUnavailable error.
+func Unavailable(format string, args ...interface{}) error {
+	return &Error{Code: CodeUnavailable, Message: fmt.Sprintf(format, args...)}
+}
+
+// RateLimited returns a CodeRateLimited error.
+func RateLimited(format string, args ...interface{}) error {
+	return &Error{Code: CodeRateLimited, Message: fmt.Sprintf(format, args...)}
+}
+
+// Internalf returns a CodeInternal error, or a CodeUnavailable one if any of
+// args is a transient error, like in Internalf("Error running get query: %+v",
+// err).
+func Internalf(format string, args ...interface{}) error {
+	code := CodeInternal
+	for _, arg := range args {
+		if err, ok := arg.(error); ok && transient(err) {
+			code = CodeUnavailable
+		}
+	}
+
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// transient returns whether err is a failure that can go away on its own.
+func transient(err error) bool {
+	switch e := err.(type) {
+	case *Error:
+		return e.Code == CodeUnavailable
+	case *pq.Error:
+		// Connection exceptions, serialization failures and deadlocks,
+		// running out of connections or memory, and shutdowns and
+		// cancelled statements.
+		switch e.Code.Class() {
+		case "08", "40", "53", "57":
+			return true
+		}
+		return false
+	case net.Error:
+		return true
+	}
+
+	switch err {
+	case sql.ErrConnDone, sqldriver.ErrBadConn, context.DeadlineExceeded:
+		return true
+	}
+
+	return false
+}
+
+// ClassifyError returns err as an *Error. Transient errors from the database
+// or the network are unavailable, and other database errors are internal.
+// Other errors without a code get fallback, so resolvers, whose plain errors
+// are written for clients, can fall back to CodeValidation, and HTTP handlers
+// to CodeInternal.
+func ClassifyError(err error, fallback ErrorCode) *Error {
+	if e, ok := err.(*Error); ok {
+		return e
+	}
+	if transient(err) {
+		return &Error{Code: CodeUnavailable, Message: err.Error()}
+	}
+
+	if _, ok := err.(*pq.Error); ok {
+		return &Error{Code: CodeInternal, Message: err.Error()}
+	}
+
+	switch err {
+	case sql.ErrNoRows:
+		return &Error{Code: CodeNotFound, Message: "Not found"}
+	case sql.ErrTxDone:
+		return &Error{Code: CodeInternal, Message: err.Error()}
+	}
+
+	return &Error{Code: fallback, Message: err.Error()}
+}
+
+// AddCodeExtensions gives a GraphQL error that doesn't have a code extension
+// code, and the matching retryable extension. It is for errors that don't go
+// through ErrorPresenter, like gqlgen's own errors for queries that don't
+// parse.
+func AddCodeExtensions(gqlerr *gqlerror.Error, code ErrorCode) {
+	if gqlerr.Extensions == nil {
+		gqlerr.Extensions = map[string]interface{}{}
+	}
+
+	c, ok := gqlerr.Extensions["code"]
+	if !ok {
+		c = code
+		gqlerr.Extensions["code"] = code
+	}
+	if _, ok := gqlerr.Extensions["retryable"]; !ok {
+		gqlerr.Extensions["retryable"] = ErrorCode(fmt.Sprint(c)).Retryable()
+	}
+}
+
+// ErrorPresenter is a gqlgen error presenter that adds the code, retryable
+// and field extensions to errors, and hides the details of internal and
+// unavailable errors from clients, logging them instead. Those errors get a
+// requestId extension.
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	if gqlerr, ok := err.(*gqlerror.Error); ok {
+		gqlerr = graphql.DefaultErrorPresenter(ctx, gqlerr)
+		AddCodeExtensions(gqlerr, CodeValidation)
+		return gqlerr
+	}
+
+	e := ClassifyError(err, CodeValidation)
+	gqlerr := graphql.DefaultErrorPresenter(ctx, e)
+	gqlerr.Message = e.ClientMessage()
+	if e.hidden() {
+		// The request ID lets clients' reports be found in the logs.
+		id := middleware.GetReqID(ctx)
+		if id != "" {
+			gqlerr.Extensions["requestId"] = id
+		}
+		log.Printf("internal error in request %s at %v: %s", id, gqlerr.Path, e.Message)
+	}
+
+	return gqlerr
+}
@@ -0,0 +1,274 @@
+package graphql
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"time"
+)
+
+// ExportVersion is the version of the export archive format.
+const ExportVersion = 1
+
+// exportTables are the tables in an export, in the order they are imported so
+// foreign keys are satisfied. Credentials, like tokens and service accounts,
+// are never exported.
+var exportTables = []struct {
+	Name     string
+	Key      string
+	Query    string
+	Sequence string
+}{
+	{"users", "id", "SELECT * FROM users ORDER BY created_at", ""},
+	{"sites", "id", "SELECT * FROM sites ORDER BY id", "sites_id_seq"},
+	{"posts", "id", "SELECT * FROM posts ORDER BY id", "posts_id_seq"},
+	{"post_metadata", "post_id, key", "SELECT * FROM post_metadata ORDER BY post_id, key", ""},
+	{"revisions", "id", "SELECT * FROM revisions ORDER BY id", "revisions_id_seq"},
+	{"stats", "id", "SELECT * FROM stats ORDER BY id", "stats_id_seq"},
+	{"pages", "site_id, slug", "SELECT * FROM pages ORDER BY site_id, slug", ""},
+	{"redirects", "id", "SELECT * FROM redirects ORDER BY id", "redirects_id_seq"},
+	{"links", "id", "SELECT * FROM links ORDER BY id", "links_id_seq"},
+	{"short_links", "id", "SELECT * FROM short_links ORDER BY id", "short_links_id_seq"},
+	{"books", "id", "SELECT * FROM books ORDER BY id", "books_id_seq"},
+	{"groups", "id", "SELECT * FROM groups ORDER BY id", "groups_id_seq"},
+	{"group_members", "group_id, user_id", "SELECT * FROM group_members ORDER BY created_at", ""},
+	{"logs", "id", "SELECT * FROM logs ORDER BY id", "logs_id_seq"},
+	{"identities", "provider, provider_user_id", "SELECT * FROM identities ORDER BY created_at", ""},
+	{"read_later", "id", "SELECT * FROM read_later ORDER BY id", "read_later_id_seq"},
+	{"reading_progress", "user_id, post_id", "SELECT * FROM reading_progress ORDER BY modified_at", ""},
+	{"highlights", "id", "SELECT * FROM highlights ORDER BY id", "highlights_id_seq"},
+	{"activitypub_followers", "actor", "SELECT * FROM activitypub_followers ORDER BY created_at", ""},
+	{"automation_rules", "id", "SELECT * FROM automation_rules ORDER BY id", "automation_rules_id_seq"},
+	{"settings", "site_id, key", "SELECT * FROM settings WHERE key NOT IN ('session_secret', 'analytics_salt', 'post_unlock_secret') ORDER BY site_id, key", ""},
+}
+
+// exportManifest describes an export archive.
+type exportManifest struct {
+	Version   int            `json:"version"`
+	Created   time.Time      `json:"created"`
+	Migration int            `json:"migration"`
+	Counts    map[string]int `json:"counts"`
+}
+
+// MediaReference is an image used by a post. Media is not stored by this
+// server, so exports only list where it lives.
+type MediaReference struct {
+	PostID string `json:"post_id"`
+	URL    string `json:"url"`
+}
+
+// ImportResult is how many rows were imported into a table.
+type ImportResult struct {
+	Table    string `json:"table"`
+	Imported int    `json:"imported"`
+}
+
+// ExportFilename is the name to save an export made at t as.
+func ExportFilename(t time.Time) string {
+	return fmt.Sprintf("graphql-export-%s.tar.gz", t.UTC().Format("20060102-150405"))
+}
+
+// latestMigration returns the newest applied migration version.
+func latestMigration() (int, error) {
+	infos, err := Migrations()
+	if err != nil {
+		return 0, err
+	}
+
+	latest := 0
+	for _, info := range infos {
+		if info.Applied != nil && info.Version > latest {
+			latest = info.Version
+		}
+	}
+
+	return latest, nil
+}
+
+// Export writes a tar.gz archive of the site's content, users and settings
+// as JSON to w, for backups and moving between servers. Use Import to load
+// it.
+func Export(ctx context.Context, w io.Writer) error {
+	migration, err := latestMigration()
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+
+	add := func(name string, data []byte) error {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(data)), ModTime: now}); err != nil {
+			return err
+		}
+		_, err := tw.Write(data)
+		return err
+	}
+
+	manifest := exportManifest{Version: ExportVersion, Created: now, Migration: migration, Counts: map[string]int{}}
+	for _, t := range exportTables {
+		var data []byte
+		row := db.QueryRowContext(ctx, fmt.Sprintf("SELECT COALESCE(json_agg(t), '[]') FROM (%s) t", t.Query))
+		if err := row.Scan(&data); err != nil {
+			return fmt.Errorf("Error exporting %s: %+v", t.Name, err)
+		}
+
+		var rows []json.RawMessage
+		if err := json.Unmarshal(data, &rows); err != nil {
+			return err
+		}
+		manifest.Counts[t.Name] = len(rows)
+
+		if err := add(t.Name+".json", data); err != nil {
+			return err
+		}
+	}
+
+	media, err := mediaReferences(ctx)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(media)
+	if err != nil {
+		return err
+	}
+	if err := add("media.json", data); err != nil {
+		return err
+	}
+
+	data, err = json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := add("manifest.json", data); err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// mediaReferences returns every image used by a post.
+func mediaReferences(ctx context.Context) ([]*MediaReference, error) {
+	rows, err := db.QueryContext(ctx, "SELECT id, content FROM posts ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	media := make([]*MediaReference, 0)
+	for rows.Next() {
+		var id, content string
+		if err := rows.Scan(&id, &content); err != nil {
+			return nil, err
+		}
+
+		for _, m := range ImageRegex.FindAllStringSubmatch(content, -1) {
+			url := m[1]
+			if url == "" {
+				url = m[2]
+			}
+			media = append(media, &MediaReference{PostID: id, URL: url})
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return media, nil
+}
+
+// Import loads an archive made by Export. Rows that already exist are left
+// alone, so importing into a fresh database restores everything, and
+// importing twice is harmless.
+func Import(ctx context.Context, r io.Reader) ([]*ImportResult, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("Archive is not a tar.gz: %+v", err)
+	}
+
+	files := map[string][]byte{}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		files[path.Clean(hdr.Name)] = data
+	}
+
+	var manifest exportManifest
+	if err := json.Unmarshal(files["manifest.json"], &manifest); err != nil {
+		return nil, fmt.Errorf("Archive has no valid manifest.json: %+v", err)
+	}
+
+	if manifest.Version != ExportVersion {
+		return nil, fmt.Errorf("Unsupported export version %d", manifest.Version)
+	}
+
+	migration, err := latestMigration()
+	if err != nil {
+		return nil, err
+	}
+	if manifest.Migration > migration {
+		return nil, fmt.Errorf("Archive is from migration %d, but the database is at %d. Migrate first.", manifest.Migration, migration)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	results := []*ImportResult{}
+	for _, t := range exportTables {
+		data, ok := files[t.Name+".json"]
+		if !ok {
+			continue
+		}
+
+		query := fmt.Sprintf("INSERT INTO %[1]s SELECT * FROM json_populate_recordset(NULL::%[1]s, $1) ON CONFLICT (%[2]s) DO NOTHING", t.Name, t.Key)
+		res, err := tx.ExecContext(ctx, query, string(data))
+		if err != nil {
+			return nil, fmt.Errorf("Error importing %s: %+v", t.Name, err)
+		}
+
+		n, err := res.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &ImportResult{Table: t.Name, Imported: int(n)})
+
+		if t.Sequence != "" {
+			if _, err := tx.ExecContext(ctx, fmt.Sprintf("SELECT setval('%s', COALESCE((SELECT MAX(id) FROM %s), 0) + 1, false)", t.Sequence, t.Name)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	invalidateSites()
+	invalidateSiteSettings()
+	invalidateTheme()
+
+	return results, nil
+}
@@ -31,86 +31,783 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	AutomationRule() AutomationRuleResolver
+	Group() GroupResolver
+	Highlight() HighlightResolver
 	Mutation() MutationResolver
+	Post() PostResolver
 	Query() QueryResolver
+	ReadLaterItem() ReadLaterItemResolver
+	ServiceAccount() ServiceAccountResolver
+	Session() SessionResolver
+	User() UserResolver
 }
 
 type DirectiveRoot struct {
+	Constraint func(ctx context.Context, obj interface{}, next graphql.Resolver, minLength *int, maxLength *int, pattern *string, format *string, min *float64, max *float64) (res interface{}, err error)
+
 	HasRole func(ctx context.Context, obj interface{}, next graphql.Resolver, role Role) (res interface{}, err error)
 }
 
 type ComplexityRoot struct {
+	AdminError struct {
+		Source  func(childComplexity int) int
+		Message func(childComplexity int) int
+		Time    func(childComplexity int) int
+	}
+
+	AdminStats struct {
+		Posts                   func(childComplexity int) int
+		Drafts                  func(childComplexity int) int
+		Users                   func(childComplexity int) int
+		DeactivatedUsers        func(childComplexity int) int
+		FailedWebhookDeliveries func(childComplexity int) int
+		RecentErrors            func(childComplexity int) int
+		CronTasks               func(childComplexity int) int
+	}
+
+	AutomationRule struct {
+		Id        func(childComplexity int) int
+		Name      func(childComplexity int) int
+		Event     func(childComplexity int) int
+		Condition func(childComplexity int) int
+		Actions   func(childComplexity int) int
+		Enabled   func(childComplexity int) int
+		Runs      func(childComplexity int, limit *int) int
+		Created   func(childComplexity int) int
+		Modified  func(childComplexity int) int
+	}
+
+	AutomationRuleRun struct {
+		Id      func(childComplexity int) int
+		RuleId  func(childComplexity int) int
+		Subject func(childComplexity int) int
+		Matched func(childComplexity int) int
+		Actions func(childComplexity int) int
+		Error   func(childComplexity int) int
+		Created func(childComplexity int) int
+	}
+
+	Book struct {
+		Id          func(childComplexity int) int
+		Title       func(childComplexity int) int
+		Author      func(childComplexity int) int
+		Isbn        func(childComplexity int) int
+		GoodreadsId func(childComplexity int) int
+		Shelf       func(childComplexity int) int
+		Rating      func(childComplexity int) int
+		Pages       func(childComplexity int) int
+		Started     func(childComplexity int) int
+		Finished    func(childComplexity int) int
+		Created     func(childComplexity int) int
+		Modified    func(childComplexity int) int
+	}
+
 	Comment struct {
 		Id func(childComplexity int) int
 	}
 
+	CronTaskStatus struct {
+		Name         func(childComplexity int) int
+		Schedule     func(childComplexity int) int
+		Next         func(childComplexity int) int
+		LastStarted  func(childComplexity int) int
+		LastFinished func(childComplexity int) int
+		LastError    func(childComplexity int) int
+	}
+
+	DbpoolStats struct {
+		MaxOpen      func(childComplexity int) int
+		Open         func(childComplexity int) int
+		InUse        func(childComplexity int) int
+		Idle         func(childComplexity int) int
+		WaitCount    func(childComplexity int) int
+		WaitDuration func(childComplexity int) int
+	}
+
+	DataExport struct {
+		Filename func(childComplexity int) int
+		Archive  func(childComplexity int) int
+		Created  func(childComplexity int) int
+	}
+
+	DayCount struct {
+		Day      func(childComplexity int) int
+		Views    func(childComplexity int) int
+		Visitors func(childComplexity int) int
+	}
+
+	DebugInfo struct {
+		Version     func(childComplexity int) int
+		GitSha      func(childComplexity int) int
+		GoVersion   func(childComplexity int) int
+		Started     func(childComplexity int) int
+		Uptime      func(childComplexity int) int
+		Goroutines  func(childComplexity int) int
+		HeapAlloc   func(childComplexity int) int
+		HeapObjects func(childComplexity int) int
+		NumGc       func(childComplexity int) int
+		Database    func(childComplexity int) int
+		Replica     func(childComplexity int) int
+	}
+
+	Geo struct {
+		Lat  func(childComplexity int) int
+		Long func(childComplexity int) int
+	}
+
+	GoroutineCount struct {
+		Labels func(childComplexity int) int
+		Count  func(childComplexity int) int
+	}
+
+	Group struct {
+		Id       func(childComplexity int) int
+		Name     func(childComplexity int) int
+		OwnerId  func(childComplexity int) int
+		Members  func(childComplexity int) int
+		Created  func(childComplexity int) int
+		Modified func(childComplexity int) int
+	}
+
+	Highlight struct {
+		Id       func(childComplexity int) int
+		PostId   func(childComplexity int) int
+		Quote    func(childComplexity int) int
+		Start    func(childComplexity int) int
+		End      func(childComplexity int) int
+		Note     func(childComplexity int) int
+		Public   func(childComplexity int) int
+		Mine     func(childComplexity int) int
+		Created  func(childComplexity int) int
+		Modified func(childComplexity int) int
+	}
+
+	Identity struct {
+		Provider       func(childComplexity int) int
+		ProviderUserId func(childComplexity int) int
+		Created        func(childComplexity int) int
+	}
+
+	ImportResult struct {
+		Table    func(childComplexity int) int
+		Imported func(childComplexity int) int
+	}
+
+	IntegrityIssue struct {
+		Id       func(childComplexity int) int
+		Check    func(childComplexity int) int
+		Subject  func(childComplexity int) int
+		Message  func(childComplexity int) int
+		Repaired func(childComplexity int) int
+		Created  func(childComplexity int) int
+	}
+
+	Invite struct {
+		Code    func(childComplexity int) int
+		Created func(childComplexity int) int
+		Used    func(childComplexity int) int
+		UsedBy  func(childComplexity int) int
+	}
+
+	Job struct {
+		Id        func(childComplexity int) int
+		Kind      func(childComplexity int) int
+		Status    func(childComplexity int) int
+		Total     func(childComplexity int) int
+		Done      func(childComplexity int) int
+		Error     func(childComplexity int) int
+		Payload   func(childComplexity int) int
+		Report    func(childComplexity int) int
+		Attempts  func(childComplexity int) int
+		RunAt     func(childComplexity int) int
+		RequestId func(childComplexity int) int
+		Created   func(childComplexity int) int
+		Finished  func(childComplexity int) int
+	}
+
+	License struct {
+		Id   func(childComplexity int) int
+		Spdx func(childComplexity int) int
+		Name func(childComplexity int) int
+		Url  func(childComplexity int) int
+		Text func(childComplexity int) int
+		Html func(childComplexity int) int
+	}
+
 	Link struct {
 		Id          func(childComplexity int) int
 		Title       func(childComplexity int) int
 		Uri         func(childComplexity int) int
 		Created     func(childComplexity int) int
+		Modified    func(childComplexity int) int
 		Description func(childComplexity int) int
 		Screenshot  func(childComplexity int) int
 		Tags        func(childComplexity int) int
+		Checked     func(childComplexity int) int
+		StatusCode  func(childComplexity int) int
+		Dead        func(childComplexity int) int
+	}
+
+	Log struct {
+		Id       func(childComplexity int) int
+		UserId   func(childComplexity int) int
+		GroupId  func(childComplexity int) int
+		Content  func(childComplexity int) int
+		Location func(childComplexity int) int
+		Datetime func(childComplexity int) int
+		Created  func(childComplexity int) int
+		Modified func(childComplexity int) int
+	}
+
+	MetadataEntry struct {
+		Key   func(childComplexity int) int
+		Value func(childComplexity int) int
+	}
+
+	MetadataKey struct {
+		Name        func(childComplexity int) int
+		Type        func(childComplexity int) int
+		Description func(childComplexity int) int
+	}
+
+	MonthCount struct {
+		Month func(childComplexity int) int
+		Books func(childComplexity int) int
+		Pages func(childComplexity int) int
 	}
 
 	Mutation struct {
-		CreatePost func(childComplexity int, input NewPost) int
-		EditPost   func(childComplexity int, Id string, input NewPost) int
-		CreateLink func(childComplexity int, input NewLink) int
-		UpsertStat func(childComplexity int, input NewStat) int
+		CreatePost                 func(childComplexity int, input NewPost) int
+		EditPost                   func(childComplexity int, Id string, input NewPost, version int) int
+		CreateLink                 func(childComplexity int, input NewLink) int
+		SaveLink                   func(childComplexity int, url string, tags []string) int
+		UpsertStat                 func(childComplexity int, input NewStat) int
+		RevertPost                 func(childComplexity int, id string, revision int, version *int) int
+		SetPostMetadata            func(childComplexity int, id string, metadata []MetadataInput) int
+		SetPostPassword            func(childComplexity int, id string, password *string) int
+		UnlockPost                 func(childComplexity int, id string, password string) int
+		CreateServiceAccount       func(childComplexity int, input NewServiceAccount) int
+		RotateServiceAccountSecret func(childComplexity int, id string) int
+		RevokeServiceAccountSecret func(childComplexity int, id string) int
+		DeleteServiceAccount       func(childComplexity int, id string) int
+		CreateOidcclient           func(childComplexity int, input NewOIDCClient) int
+		DeleteOidcclient           func(childComplexity int, id string) int
+		ProvisionUsers             func(childComplexity int, input []NewUser) int
+		DeactivateUsers            func(childComplexity int, ids []string) int
+		ReactivateUsers            func(childComplexity int, ids []string) int
+		DeactivateUsersMatching    func(childComplexity int, filter UserFilter) int
+		ReassignUserContent        func(childComplexity int, from string, to string) int
+		MergeUsers                 func(childComplexity int, from string, into string) int
+		RequeueJob                 func(childComplexity int, id string) int
+		CreateInvite               func(childComplexity int) int
+		CreateSite                 func(childComplexity int, host string, allowedUsers []string) int
+		UpdateSite                 func(childComplexity int, id string, host *string, allowedUsers []string) int
+		UpdateSiteSettings         func(childComplexity int, input SiteSettingsInput) int
+		UpdateTheme                func(childComplexity int, input ThemeInput) int
+		ResetTheme                 func(childComplexity int) int
+		CreateRedirect             func(childComplexity int, input NewRedirect) int
+		UpdateRedirect             func(childComplexity int, id string, input NewRedirect) int
+		DeleteRedirect             func(childComplexity int, id string) int
+		CreateShortLink            func(childComplexity int, url string, slug *string) int
+		AddBook                    func(childComplexity int, input NewBook) int
+		UpsertPage                 func(childComplexity int, slug string, content string, title *string, draft *bool, version *int) int
+		MarkRead                   func(childComplexity int, id string, finished *time.Time, rating *int) int
+		ImportGoodreads            func(childComplexity int, csv string) int
+		CreateWebhook              func(childComplexity int, input NewWebhook) int
+		DeleteWebhook              func(childComplexity int, id string) int
+		CreateAutomationRule       func(childComplexity int, input AutomationRuleInput) int
+		UpdateAutomationRule       func(childComplexity int, id string, input AutomationRuleInput) int
+		DeleteAutomationRule       func(childComplexity int, id string) int
+		TestAutomationRule         func(childComplexity int, condition string, postId string) int
+		CheckIntegrity             func(childComplexity int, repair *bool) int
+		ExportData                 func(childComplexity int) int
+		ExportHugo                 func(childComplexity int, media *bool) int
+		ImportData                 func(childComplexity int, archive string) int
+		ImportWordPress            func(childComplexity int, file string, baseURL *string) int
+		ImportStaticSite           func(childComplexity int, archive string, baseURL *string, permalink *string) int
+		PurgeCache                 func(childComplexity int, paths []string) int
+		InsertLog                  func(childComplexity int, input NewLog) int
+		ShareLog                   func(childComplexity int, id string, groupId *string) int
+		CreateGroup                func(childComplexity int, name string) int
+		CreateGroupInvite          func(childComplexity int, groupId string) int
+		JoinGroup                  func(childComplexity int, code string) int
+		LeaveGroup                 func(childComplexity int, id string) int
+		DeleteGroup                func(childComplexity int, id string) int
+		RevokeSession              func(childComplexity int, id string) int
+		RevokeAllOtherSessions     func(childComplexity int) int
+		ReadLater                  func(childComplexity int, url *string, postId *string) int
+		RemoveReadLater            func(childComplexity int, id string) int
+		CreateHighlight            func(childComplexity int, input NewHighlight) int
+		UpdateHighlight            func(childComplexity int, id string, note *string, public *bool) int
+		DeleteHighlight            func(childComplexity int, id string) int
+		ReadingProgress            func(childComplexity int, postId string, percent int) int
+		UpdateNotificationSettings func(childComplexity int, input NotificationSettingsInput) int
+	}
+
+	NavItem struct {
+		Label func(childComplexity int) int
+		Url   func(childComplexity int) int
+	}
+
+	NotificationSettings struct {
+		Email              func(childComplexity int) int
+		Comments           func(childComplexity int) int
+		Digest             func(childComplexity int) int
+		AuthAlerts         func(childComplexity int) int
+		IntegrityAlerts    func(childComplexity int) int
+		CommentsDelivery   func(childComplexity int) int
+		AuthAlertsDelivery func(childComplexity int) int
+		Timezone           func(childComplexity int) int
+		QuietStart         func(childComplexity int) int
+		QuietEnd           func(childComplexity int) int
+	}
+
+	Oidcclient struct {
+		Id           func(childComplexity int) int
+		Name         func(childComplexity int) int
+		RedirectUris func(childComplexity int) int
+		Created      func(childComplexity int) int
+	}
+
+	OidcclientCredentials struct {
+		Client func(childComplexity int) int
+		Secret func(childComplexity int) int
+	}
+
+	Page struct {
+		Slug     func(childComplexity int) int
+		Title    func(childComplexity int) int
+		Content  func(childComplexity int) int
+		Html     func(childComplexity int) int
+		Draft    func(childComplexity int) int
+		Created  func(childComplexity int) int
+		Modified func(childComplexity int) int
+		Version  func(childComplexity int) int
+	}
+
+	PageViewStats struct {
+		Views     func(childComplexity int) int
+		Visitors  func(childComplexity int) int
+		Referrers func(childComplexity int) int
+		Days      func(childComplexity int) int
 	}
 
 	Post struct {
+		Id                    func(childComplexity int) int
+		Title                 func(childComplexity int) int
+		Content               func(childComplexity int) int
+		Summary               func(childComplexity int) int
+		Readtime              func(childComplexity int) int
+		Html                  func(childComplexity int) int
+		Datetime              func(childComplexity int) int
+		Created               func(childComplexity int) int
+		Modified              func(childComplexity int) int
+		Draft                 func(childComplexity int) int
+		Tags                  func(childComplexity int) int
+		Protected             func(childComplexity int) int
+		Links                 func(childComplexity int) int
+		Revisions             func(childComplexity int, limit *int, offset *int) int
+		SyndicationUrls       func(childComplexity int) int
+		Metadata              func(childComplexity int) int
+		CanonicalUrl          func(childComplexity int) int
+		OriginallyPublishedAt func(childComplexity int) int
+		License               func(childComplexity int) int
+		Version               func(childComplexity int) int
+	}
+
+	Query struct {
+		AllPosts          func(childComplexity int) int
+		Drafts            func(childComplexity int) int
+		Posts             func(childComplexity int, limit *int, offset *int) int
+		Post              func(childComplexity int, id string, unlock *string) int
+		SuggestPosts      func(childComplexity int, path string, limit *int) int
+		NextPost          func(childComplexity int, id string) int
+		PrevPost          func(childComplexity int, id string) int
+		Tags              func(childComplexity int) int
+		SitemapPosts      func(childComplexity int) int
+		MetadataKeys      func(childComplexity int) int
+		AllLinks          func(childComplexity int) int
+		Links             func(childComplexity int, filter *LinkFilter, limit *int, offset *int) int
+		Link              func(childComplexity int, id string) int
+		Stats             func(childComplexity int, count *int) int
+		PostDiff          func(childComplexity int, id string, from int, to int) int
+		ServiceAccounts   func(childComplexity int) int
+		OidcClients       func(childComplexity int) int
+		Users             func(childComplexity int, filter *UserFilter, limit *int, offset *int) int
+		Invites           func(childComplexity int) int
+		Jobs              func(childComplexity int, limit *int, status *JobStatus, requestId *string) int
+		Job               func(childComplexity int, id string) int
+		Sites             func(childComplexity int) int
+		SiteSettings      func(childComplexity int) int
+		Theme             func(childComplexity int) int
+		Redirects         func(childComplexity int) int
+		ShortLinks        func(childComplexity int) int
+		ShortLink         func(childComplexity int, slug string) int
+		Logs              func(childComplexity int, rangeArg *DateRange) int
+		SharedWithMe      func(childComplexity int, rangeArg *DateRange) int
+		Groups            func(childComplexity int) int
+		MySessions        func(childComplexity int) int
+		ReadLater         func(childComplexity int, order *ReadLaterOrder, limit *int, offset *int) int
+		ReadingProgress   func(childComplexity int, postId string) int
+		Highlights        func(childComplexity int, postId string, includePublic *bool) int
+		ExportHighlights  func(childComplexity int) int
+		AdminStats        func(childComplexity int) int
+		Goroutines        func(childComplexity int) int
+		DebugInfo         func(childComplexity int) int
+		Webhooks          func(childComplexity int) int
+		WebhookDeliveries func(childComplexity int, webhookId *string, failed *bool, requestId *string, limit *int) int
+		AutomationRules   func(childComplexity int) int
+		IntegrityIssues   func(childComplexity int) int
+		PageViews         func(childComplexity int, path *string, rangeArg *DateRange) int
+		Books             func(childComplexity int, shelf *Shelf, year *int, limit *int, offset *int) int
+		Book              func(childComplexity int, id string) int
+		CurrentlyReading  func(childComplexity int) int
+		ReadingStats      func(childComplexity int, year int) int
+		Page              func(childComplexity int, slug string) int
+		Pages             func(childComplexity int) int
+	}
+
+	ReadLaterItem struct {
+		Id       func(childComplexity int) int
+		Url      func(childComplexity int) int
+		Post     func(childComplexity int) int
+		Progress func(childComplexity int) int
+		Created  func(childComplexity int) int
+	}
+
+	ReadingProgress struct {
+		PostId   func(childComplexity int) int
+		Percent  func(childComplexity int) int
+		Modified func(childComplexity int) int
+	}
+
+	ReadingStats struct {
+		Year          func(childComplexity int) int
+		Books         func(childComplexity int) int
+		Pages         func(childComplexity int) int
+		AverageRating func(childComplexity int) int
+		Months        func(childComplexity int) int
+	}
+
+	Redirect struct {
 		Id       func(childComplexity int) int
+		From     func(childComplexity int) int
+		To       func(childComplexity int) int
+		Status   func(childComplexity int) int
+		Hits     func(childComplexity int) int
+		LastHit  func(childComplexity int) int
+		Created  func(childComplexity int) int
+		Modified func(childComplexity int) int
+	}
+
+	ReferrerCount struct {
+		Referrer func(childComplexity int) int
+		Views    func(childComplexity int) int
+	}
+
+	Revision struct {
+		Id       func(childComplexity int) int
+		Revision func(childComplexity int) int
 		Title    func(childComplexity int) int
 		Content  func(childComplexity int) int
-		Summary  func(childComplexity int) int
-		Readtime func(childComplexity int) int
 		Datetime func(childComplexity int) int
-		Created  func(childComplexity int) int
-		Modified func(childComplexity int) int
 		Draft    func(childComplexity int) int
-		Tags     func(childComplexity int) int
-		Links    func(childComplexity int) int
+		Created  func(childComplexity int) int
 	}
 
-	Query struct {
-		AllPosts func(childComplexity int) int
-		Drafts   func(childComplexity int) int
-		Posts    func(childComplexity int, limit *int, offset *int) int
-		Post     func(childComplexity int, id string) int
-		NextPost func(childComplexity int, id string) int
-		PrevPost func(childComplexity int, id string) int
-		AllLinks func(childComplexity int) int
-		Links    func(childComplexity int, limit *int, offset *int) int
-		Link     func(childComplexity int, id string) int
-		Stats    func(childComplexity int, count *int) int
+	RoleCount struct {
+		Role  func(childComplexity int) int
+		Count func(childComplexity int) int
+	}
+
+	ServiceAccount struct {
+		Id       func(childComplexity int) int
+		Name     func(childComplexity int) int
+		Scopes   func(childComplexity int) int
+		Expires  func(childComplexity int) int
+		LastUsed func(childComplexity int) int
+		Created  func(childComplexity int) int
+		Secrets  func(childComplexity int) int
+	}
+
+	ServiceAccountCredentials struct {
+		ServiceAccount func(childComplexity int) int
+		Secret         func(childComplexity int) int
+	}
+
+	ServiceAccountSecret struct {
+		Id       func(childComplexity int) int
+		Created  func(childComplexity int) int
+		LastUsed func(childComplexity int) int
+	}
+
+	Session struct {
+		Id        func(childComplexity int) int
+		UserAgent func(childComplexity int) int
+		Ip        func(childComplexity int) int
+		Country   func(childComplexity int) int
+		Created   func(childComplexity int) int
+		LastSeen  func(childComplexity int) int
+		Current   func(childComplexity int) int
+	}
+
+	ShortLink struct {
+		Id        func(childComplexity int) int
+		Slug      func(childComplexity int) int
+		Path      func(childComplexity int) int
+		Url       func(childComplexity int) int
+		Clicks    func(childComplexity int) int
+		LastClick func(childComplexity int) int
+		Created   func(childComplexity int) int
+	}
+
+	Site struct {
+		Id           func(childComplexity int) int
+		Host         func(childComplexity int) int
+		AllowedUsers func(childComplexity int) int
+		Created      func(childComplexity int) int
+	}
+
+	SiteSettings struct {
+		Title        func(childComplexity int) int
+		Description  func(childComplexity int) int
+		Url          func(childComplexity int) int
+		FooterText   func(childComplexity int) int
+		PostsPerPage func(childComplexity int) int
+		SocialLinks  func(childComplexity int) int
+		License      func(childComplexity int) int
+	}
+
+	SocialLink struct {
+		Name func(childComplexity int) int
+		Url  func(childComplexity int) int
 	}
 
 	Stat struct {
 		Key   func(childComplexity int) int
 		Value func(childComplexity int) int
 	}
+
+	TagCount struct {
+		Tag   func(childComplexity int) int
+		Count func(childComplexity int) int
+	}
+
+	Theme struct {
+		AccentColor func(childComplexity int) int
+		LightImage  func(childComplexity int) int
+		DarkImage   func(childComplexity int) int
+		NavItems    func(childComplexity int) int
+	}
+
+	User struct {
+		Id                   func(childComplexity int) int
+		Role                 func(childComplexity int) int
+		Created              func(childComplexity int) int
+		Modified             func(childComplexity int) int
+		Deactivated          func(childComplexity int) int
+		NotificationSettings func(childComplexity int) int
+		Identities           func(childComplexity int) int
+	}
+
+	Webhook struct {
+		Id       func(childComplexity int) int
+		Url      func(childComplexity int) int
+		Events   func(childComplexity int) int
+		Created  func(childComplexity int) int
+		Modified func(childComplexity int) int
+	}
+
+	WebhookCredentials struct {
+		Webhook func(childComplexity int) int
+		Secret  func(childComplexity int) int
+	}
+
+	WebhookDelivery struct {
+		Id          func(childComplexity int) int
+		WebhookId   func(childComplexity int) int
+		Event       func(childComplexity int) int
+		Payload     func(childComplexity int) int
+		RequestId   func(childComplexity int) int
+		Attempts    func(childComplexity int) int
+		StatusCode  func(childComplexity int) int
+		Error       func(childComplexity int) int
+		NextAttempt func(childComplexity int) int
+		Delivered   func(childComplexity int) int
+		Created     func(childComplexity int) int
+	}
 }
 
+type AutomationRuleResolver interface {
+	Runs(ctx context.Context, obj *AutomationRule, limit *int) ([]*AutomationRuleRun, error)
+}
+type GroupResolver interface {
+	Members(ctx context.Context, obj *Group) ([]string, error)
+}
+type HighlightResolver interface {
+	Mine(ctx context.Context, obj *Highlight) (bool, error)
+}
 type MutationResolver interface {
 	CreatePost(ctx context.Context, input NewPost) (Post, error)
-	EditPost(ctx context.Context, Id string, input NewPost) (Post, error)
+	EditPost(ctx context.Context, Id string, input NewPost, version int) (Post, error)
 	CreateLink(ctx context.Context, input NewLink) (Link, error)
+	SaveLink(ctx context.Context, url string, tags []string) (Link, error)
 	UpsertStat(ctx context.Context, input NewStat) (Stat, error)
+	RevertPost(ctx context.Context, id string, revision int, version *int) (Post, error)
+	SetPostMetadata(ctx context.Context, id string, metadata []MetadataInput) (Post, error)
+	SetPostPassword(ctx context.Context, id string, password *string) (Post, error)
+	UnlockPost(ctx context.Context, id string, password string) (string, error)
+	CreateServiceAccount(ctx context.Context, input NewServiceAccount) (ServiceAccountCredentials, error)
+	RotateServiceAccountSecret(ctx context.Context, id string) (ServiceAccountCredentials, error)
+	RevokeServiceAccountSecret(ctx context.Context, id string) (bool, error)
+	DeleteServiceAccount(ctx context.Context, id string) (ServiceAccount, error)
+	CreateOIDCClient(ctx context.Context, input NewOIDCClient) (OIDCClientCredentials, error)
+	DeleteOIDCClient(ctx context.Context, id string) (OIDCClient, error)
+	ProvisionUsers(ctx context.Context, input []NewUser) ([]*User, error)
+	DeactivateUsers(ctx context.Context, ids []string) ([]*User, error)
+	ReactivateUsers(ctx context.Context, ids []string) ([]*User, error)
+	DeactivateUsersMatching(ctx context.Context, filter UserFilter) (Job, error)
+	ReassignUserContent(ctx context.Context, from string, to string) (Job, error)
+	MergeUsers(ctx context.Context, from string, into string) (Job, error)
+	RequeueJob(ctx context.Context, id string) (Job, error)
+	CreateInvite(ctx context.Context) (Invite, error)
+	CreateSite(ctx context.Context, host string, allowedUsers []string) (Site, error)
+	UpdateSite(ctx context.Context, id string, host *string, allowedUsers []string) (Site, error)
+	UpdateSiteSettings(ctx context.Context, input SiteSettingsInput) (SiteSettings, error)
+	UpdateTheme(ctx context.Context, input ThemeInput) (Theme, error)
+	ResetTheme(ctx context.Context) (Theme, error)
+	CreateRedirect(ctx context.Context, input NewRedirect) (Redirect, error)
+	UpdateRedirect(ctx context.Context, id string, input NewRedirect) (Redirect, error)
+	DeleteRedirect(ctx context.Context, id string) (Redirect, error)
+	CreateShortLink(ctx context.Context, url string, slug *string) (ShortLink, error)
+	AddBook(ctx context.Context, input NewBook) (Book, error)
+	UpsertPage(ctx context.Context, slug string, content string, title *string, draft *bool, version *int) (Page, error)
+	MarkRead(ctx context.Context, id string, finished *time.Time, rating *int) (Book, error)
+	ImportGoodreads(ctx context.Context, csv string) ([]*Book, error)
+	CreateWebhook(ctx context.Context, input NewWebhook) (WebhookCredentials, error)
+	DeleteWebhook(ctx context.Context, id string) (Webhook, error)
+	CreateAutomationRule(ctx context.Context, input AutomationRuleInput) (AutomationRule, error)
+	UpdateAutomationRule(ctx context.Context, id string, input AutomationRuleInput) (AutomationRule, error)
+	DeleteAutomationRule(ctx context.Context, id string) (AutomationRule, error)
+	TestAutomationRule(ctx context.Context, condition string, postId string) (bool, error)
+	CheckIntegrity(ctx context.Context, repair *bool) ([]*IntegrityIssue, error)
+	ExportData(ctx context.Context) (DataExport, error)
+	ExportHugo(ctx context.Context, media *bool) (DataExport, error)
+	ImportData(ctx context.Context, archive string) ([]*ImportResult, error)
+	ImportWordPress(ctx context.Context, file string, baseURL *string) (Job, error)
+	ImportStaticSite(ctx context.Context, archive string, baseURL *string, permalink *string) (Job, error)
+	PurgeCache(ctx context.Context, paths []string) ([]string, error)
+	InsertLog(ctx context.Context, input NewLog) (Log, error)
+	ShareLog(ctx context.Context, id string, groupId *string) (Log, error)
+	CreateGroup(ctx context.Context, name string) (Group, error)
+	CreateGroupInvite(ctx context.Context, groupId string) (string, error)
+	JoinGroup(ctx context.Context, code string) (Group, error)
+	LeaveGroup(ctx context.Context, id string) (Group, error)
+	DeleteGroup(ctx context.Context, id string) (Group, error)
+	RevokeSession(ctx context.Context, id string) (bool, error)
+	RevokeAllOtherSessions(ctx context.Context) (int, error)
+	ReadLater(ctx context.Context, url *string, postId *string) (ReadLaterItem, error)
+	RemoveReadLater(ctx context.Context, id string) (bool, error)
+	CreateHighlight(ctx context.Context, input NewHighlight) (Highlight, error)
+	UpdateHighlight(ctx context.Context, id string, note *string, public *bool) (Highlight, error)
+	DeleteHighlight(ctx context.Context, id string) (bool, error)
+	ReadingProgress(ctx context.Context, postId string, percent int) (ReadingProgress, error)
+	UpdateNotificationSettings(ctx context.Context, input NotificationSettingsInput) (NotificationSettings, error)
+}
+type PostResolver interface {
+	Revisions(ctx context.Context, obj *Post, limit *int, offset *int) ([]*Revision, error)
+	SyndicationUrls(ctx context.Context, obj *Post) ([]string, error)
+	Metadata(ctx context.Context, obj *Post) ([]MetadataEntry, error)
+
+	License(ctx context.Context, obj *Post) (*License, error)
 }
 type QueryResolver interface {
 	AllPosts(ctx context.Context) ([]*Post, error)
 	Drafts(ctx context.Context) ([]*Post, error)
 	Posts(ctx context.Context, limit *int, offset *int) ([]*Post, error)
-	Post(ctx context.Context, id string) (*Post, error)
+	Post(ctx context.Context, id string, unlock *string) (*Post, error)
+	SuggestPosts(ctx context.Context, path string, limit *int) ([]*Post, error)
 	NextPost(ctx context.Context, id string) (*Post, error)
 	PrevPost(ctx context.Context, id string) (*Post, error)
+	Tags(ctx context.Context) ([]*TagCount, error)
+	SitemapPosts(ctx context.Context) ([]*Post, error)
+	MetadataKeys(ctx context.Context) ([]MetadataKey, error)
 	AllLinks(ctx context.Context) ([]*Link, error)
-	Links(ctx context.Context, limit *int, offset *int) ([]*Link, error)
+	Links(ctx context.Context, filter *LinkFilter, limit *int, offset *int) ([]*Link, error)
 	Link(ctx context.Context, id string) (*Link, error)
 	Stats(ctx context.Context, count *int) ([]*Stat, error)
+	PostDiff(ctx context.Context, id string, from int, to int) (string, error)
+	ServiceAccounts(ctx context.Context) ([]*ServiceAccount, error)
+	OidcClients(ctx context.Context) ([]*OIDCClient, error)
+	Users(ctx context.Context, filter *UserFilter, limit *int, offset *int) ([]*User, error)
+	Invites(ctx context.Context) ([]*Invite, error)
+	Jobs(ctx context.Context, limit *int, status *JobStatus, requestId *string) ([]*Job, error)
+	Job(ctx context.Context, id string) (*Job, error)
+	Sites(ctx context.Context) ([]Site, error)
+	SiteSettings(ctx context.Context) (SiteSettings, error)
+	Theme(ctx context.Context) (Theme, error)
+	Redirects(ctx context.Context) ([]*Redirect, error)
+	ShortLinks(ctx context.Context) ([]*ShortLink, error)
+	ShortLink(ctx context.Context, slug string) (*ShortLink, error)
+	Logs(ctx context.Context, rangeArg *DateRange) ([]*Log, error)
+	SharedWithMe(ctx context.Context, rangeArg *DateRange) ([]*Log, error)
+	Groups(ctx context.Context) ([]*Group, error)
+	MySessions(ctx context.Context) ([]*Session, error)
+	ReadLater(ctx context.Context, order *ReadLaterOrder, limit *int, offset *int) ([]*ReadLaterItem, error)
+	ReadingProgress(ctx context.Context, postId string) (*ReadingProgress, error)
+	Highlights(ctx context.Context, postId string, includePublic *bool) ([]*Highlight, error)
+	ExportHighlights(ctx context.Context) (string, error)
+	AdminStats(ctx context.Context) (AdminStats, error)
+	Goroutines(ctx context.Context) ([]*GoroutineCount, error)
+	DebugInfo(ctx context.Context) (DebugInfo, error)
+	Webhooks(ctx context.Context) ([]*Webhook, error)
+	WebhookDeliveries(ctx context.Context, webhookId *string, failed *bool, requestId *string, limit *int) ([]*WebhookDelivery, error)
+	AutomationRules(ctx context.Context) ([]*AutomationRule, error)
+	IntegrityIssues(ctx context.Context) ([]*IntegrityIssue, error)
+	PageViews(ctx context.Context, path *string, rangeArg *DateRange) (PageViewStats, error)
+	Books(ctx context.Context, shelf *Shelf, year *int, limit *int, offset *int) ([]*Book, error)
+	Book(ctx context.Context, id string) (*Book, error)
+	CurrentlyReading(ctx context.Context) ([]*Book, error)
+	ReadingStats(ctx context.Context, year int) (ReadingStats, error)
+	Page(ctx context.Context, slug string) (*Page, error)
+	Pages(ctx context.Context) ([]*Page, error)
+}
+type ReadLaterItemResolver interface {
+	Post(ctx context.Context, obj *ReadLaterItem) (*Post, error)
+	Progress(ctx context.Context, obj *ReadLaterItem) (*int, error)
+}
+type ServiceAccountResolver interface {
+	Secrets(ctx context.Context, obj *ServiceAccount) ([]*ServiceAccountSecret, error)
+}
+type SessionResolver interface {
+	Current(ctx context.Context, obj *Session) (bool, error)
+}
+type UserResolver interface {
+	Role(ctx context.Context, obj *User) (Role, error)
+
+	NotificationSettings(ctx context.Context, obj *User) (*NotificationSettings, error)
+	Identities(ctx context.Context, obj *User) ([]Identity, error)
+}
+
+func field_AutomationRule_runs_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg0 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg0
+	return args, nil
+
 }
 
 func field_Mutation_createPost_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
@@ -148,6 +845,15 @@ func field_Mutation_editPost_args(rawArgs map[string]interface{}) (map[string]in
 		}
 	}
 	args["input"] = arg1
+	var arg2 int
+	if tmp, ok := rawArgs["version"]; ok {
+		var err error
+		arg2, err = graphql.UnmarshalInt(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["version"] = arg2
 	return args, nil
 
 }
@@ -167,6 +873,41 @@ func field_Mutation_createLink_args(rawArgs map[string]interface{}) (map[string]
 
 }
 
+func field_Mutation_saveLink_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["url"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["url"] = arg0
+	var arg1 []string
+	if tmp, ok := rawArgs["tags"]; ok {
+		var err error
+		var rawIf1 []interface{}
+		if tmp != nil {
+			if tmp1, ok := tmp.([]interface{}); ok {
+				rawIf1 = tmp1
+			} else {
+				rawIf1 = []interface{}{tmp}
+			}
+		}
+		arg1 = make([]string, len(rawIf1))
+		for idx1 := range rawIf1 {
+			arg1[idx1], err = graphql.UnmarshalString(rawIf1[idx1])
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["tags"] = arg1
+	return args, nil
+
+}
+
 func field_Mutation_upsertStat_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	args := map[string]interface{}{}
 	var arg0 NewStat
@@ -182,41 +923,45 @@ func field_Mutation_upsertStat_args(rawArgs map[string]interface{}) (map[string]
 
 }
 
-func field_Query_posts_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func field_Mutation_revertPost_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	args := map[string]interface{}{}
-	var arg0 *int
-	if tmp, ok := rawArgs["limit"]; ok {
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
 		var err error
-		var ptr1 int
-		if tmp != nil {
-			ptr1, err = graphql.UnmarshalInt(tmp)
-			arg0 = &ptr1
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
-
+	}
+	args["id"] = arg0
+	var arg1 int
+	if tmp, ok := rawArgs["revision"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalInt(tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["limit"] = arg0
-	var arg1 *int
-	if tmp, ok := rawArgs["offset"]; ok {
+	args["revision"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["version"]; ok {
 		var err error
 		var ptr1 int
 		if tmp != nil {
 			ptr1, err = graphql.UnmarshalInt(tmp)
-			arg1 = &ptr1
+			arg2 = &ptr1
 		}
 
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["offset"] = arg1
+	args["version"] = arg2
 	return args, nil
 
 }
 
-func field_Query_post_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func field_Mutation_setPostMetadata_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	args := map[string]interface{}{}
 	var arg0 string
 	if tmp, ok := rawArgs["id"]; ok {
@@ -227,26 +972,31 @@ func field_Query_post_args(rawArgs map[string]interface{}) (map[string]interface
 		}
 	}
 	args["id"] = arg0
-	return args, nil
-
-}
-
-func field_Query_nextPost_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["id"]; ok {
+	var arg1 []MetadataInput
+	if tmp, ok := rawArgs["metadata"]; ok {
 		var err error
-		arg0, err = graphql.UnmarshalID(tmp)
+		var rawIf1 []interface{}
+		if tmp != nil {
+			if tmp1, ok := tmp.([]interface{}); ok {
+				rawIf1 = tmp1
+			} else {
+				rawIf1 = []interface{}{tmp}
+			}
+		}
+		arg1 = make([]MetadataInput, len(rawIf1))
+		for idx1 := range rawIf1 {
+			arg1[idx1], err = UnmarshalMetadataInput(rawIf1[idx1])
+		}
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["id"] = arg0
+	args["metadata"] = arg1
 	return args, nil
 
 }
 
-func field_Query_prevPost_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func field_Mutation_setPostPassword_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	args := map[string]interface{}{}
 	var arg0 string
 	if tmp, ok := rawArgs["id"]; ok {
@@ -257,32 +1007,12 @@ func field_Query_prevPost_args(rawArgs map[string]interface{}) (map[string]inter
 		}
 	}
 	args["id"] = arg0
-	return args, nil
-
-}
-
-func field_Query_links_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	args := map[string]interface{}{}
-	var arg0 *int
-	if tmp, ok := rawArgs["limit"]; ok {
-		var err error
-		var ptr1 int
-		if tmp != nil {
-			ptr1, err = graphql.UnmarshalInt(tmp)
-			arg0 = &ptr1
-		}
-
-		if err != nil {
-			return nil, err
-		}
-	}
-	args["limit"] = arg0
-	var arg1 *int
-	if tmp, ok := rawArgs["offset"]; ok {
+	var arg1 *string
+	if tmp, ok := rawArgs["password"]; ok {
 		var err error
-		var ptr1 int
+		var ptr1 string
 		if tmp != nil {
-			ptr1, err = graphql.UnmarshalInt(tmp)
+			ptr1, err = graphql.UnmarshalString(tmp)
 			arg1 = &ptr1
 		}
 
@@ -290,12 +1020,12 @@ func field_Query_links_args(rawArgs map[string]interface{}) (map[string]interfac
 			return nil, err
 		}
 	}
-	args["offset"] = arg1
+	args["password"] = arg1
 	return args, nil
 
 }
 
-func field_Query_link_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func field_Mutation_unlockPost_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	args := map[string]interface{}{}
 	var arg0 string
 	if tmp, ok := rawArgs["id"]; ok {
@@ -306,3582 +1036,24811 @@ func field_Query_link_args(rawArgs map[string]interface{}) (map[string]interface
 		}
 	}
 	args["id"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["password"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["password"] = arg1
 	return args, nil
 
 }
 
-func field_Query_stats_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func field_Mutation_createServiceAccount_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	args := map[string]interface{}{}
-	var arg0 *int
-	if tmp, ok := rawArgs["count"]; ok {
+	var arg0 NewServiceAccount
+	if tmp, ok := rawArgs["input"]; ok {
 		var err error
-		var ptr1 int
-		if tmp != nil {
-			ptr1, err = graphql.UnmarshalInt(tmp)
-			arg0 = &ptr1
-		}
-
+		arg0, err = UnmarshalNewServiceAccount(tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["count"] = arg0
+	args["input"] = arg0
 	return args, nil
 
 }
 
-func field_Query___type_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func field_Mutation_rotateServiceAccountSecret_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["name"]; ok {
+	if tmp, ok := rawArgs["id"]; ok {
 		var err error
-		arg0, err = graphql.UnmarshalString(tmp)
+		arg0, err = graphql.UnmarshalID(tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["name"] = arg0
+	args["id"] = arg0
 	return args, nil
 
 }
 
-func field___Type_fields_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func field_Mutation_revokeServiceAccountSecret_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
 		var err error
-		arg0, err = graphql.UnmarshalBoolean(tmp)
+		arg0, err = graphql.UnmarshalID(tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["includeDeprecated"] = arg0
+	args["id"] = arg0
 	return args, nil
 
 }
 
-func field___Type_enumValues_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func field_Mutation_deleteServiceAccount_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
 		var err error
-		arg0, err = graphql.UnmarshalBoolean(tmp)
+		arg0, err = graphql.UnmarshalID(tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["includeDeprecated"] = arg0
+	args["id"] = arg0
 	return args, nil
 
 }
 
-func dir_hasRole_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func field_Mutation_createOIDCClient_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	args := map[string]interface{}{}
-	var arg0 Role
-	if tmp, ok := rawArgs["role"]; ok {
+	var arg0 NewOIDCClient
+	if tmp, ok := rawArgs["input"]; ok {
 		var err error
-		err = (&arg0).UnmarshalGQL(tmp)
+		arg0, err = UnmarshalNewOIDCClient(tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["role"] = arg0
+	args["input"] = arg0
 	return args, nil
 
 }
 
-type executableSchema struct {
-	resolvers  ResolverRoot
-	directives DirectiveRoot
-	complexity ComplexityRoot
-}
+func field_Mutation_deleteOIDCClient_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
 
-func (e *executableSchema) Schema() *ast.Schema {
-	return parsedSchema
 }
 
-func (e *executableSchema) Complexity(typeName, field string, childComplexity int, rawArgs map[string]interface{}) (int, bool) {
-	switch typeName + "." + field {
-
-	case "Comment.id":
-		if e.complexity.Comment.Id == nil {
-			break
+func field_Mutation_provisionUsers_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 []NewUser
+	if tmp, ok := rawArgs["input"]; ok {
+		var err error
+		var rawIf1 []interface{}
+		if tmp != nil {
+			if tmp1, ok := tmp.([]interface{}); ok {
+				rawIf1 = tmp1
+			} else {
+				rawIf1 = []interface{}{tmp}
+			}
 		}
-
-		return e.complexity.Comment.Id(childComplexity), true
-
-	case "Link.id":
-		if e.complexity.Link.Id == nil {
-			break
+		arg0 = make([]NewUser, len(rawIf1))
+		for idx1 := range rawIf1 {
+			arg0[idx1], err = UnmarshalNewUser(rawIf1[idx1])
 		}
-
-		return e.complexity.Link.Id(childComplexity), true
-
-	case "Link.title":
-		if e.complexity.Link.Title == nil {
-			break
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["input"] = arg0
+	return args, nil
 
-		return e.complexity.Link.Title(childComplexity), true
+}
 
-	case "Link.uri":
-		if e.complexity.Link.Uri == nil {
-			break
+func field_Mutation_deactivateUsers_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 []string
+	if tmp, ok := rawArgs["ids"]; ok {
+		var err error
+		var rawIf1 []interface{}
+		if tmp != nil {
+			if tmp1, ok := tmp.([]interface{}); ok {
+				rawIf1 = tmp1
+			} else {
+				rawIf1 = []interface{}{tmp}
+			}
 		}
-
-		return e.complexity.Link.Uri(childComplexity), true
-
-	case "Link.created":
-		if e.complexity.Link.Created == nil {
-			break
+		arg0 = make([]string, len(rawIf1))
+		for idx1 := range rawIf1 {
+			arg0[idx1], err = graphql.UnmarshalID(rawIf1[idx1])
 		}
-
-		return e.complexity.Link.Created(childComplexity), true
-
-	case "Link.description":
-		if e.complexity.Link.Description == nil {
-			break
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["ids"] = arg0
+	return args, nil
 
-		return e.complexity.Link.Description(childComplexity), true
+}
 
-	case "Link.screenshot":
-		if e.complexity.Link.Screenshot == nil {
-			break
+func field_Mutation_reactivateUsers_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 []string
+	if tmp, ok := rawArgs["ids"]; ok {
+		var err error
+		var rawIf1 []interface{}
+		if tmp != nil {
+			if tmp1, ok := tmp.([]interface{}); ok {
+				rawIf1 = tmp1
+			} else {
+				rawIf1 = []interface{}{tmp}
+			}
+		}
+		arg0 = make([]string, len(rawIf1))
+		for idx1 := range rawIf1 {
+			arg0[idx1], err = graphql.UnmarshalID(rawIf1[idx1])
 		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["ids"] = arg0
+	return args, nil
 
-		return e.complexity.Link.Screenshot(childComplexity), true
+}
 
-	case "Link.tags":
-		if e.complexity.Link.Tags == nil {
-			break
+func field_Mutation_deactivateUsersMatching_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 UserFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		var err error
+		arg0, err = UnmarshalUserFilter(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["filter"] = arg0
+	return args, nil
 
-		return e.complexity.Link.Tags(childComplexity), true
+}
 
-	case "Mutation.createPost":
-		if e.complexity.Mutation.CreatePost == nil {
-			break
+func field_Mutation_reassignUserContent_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["from"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		args, err := field_Mutation_createPost_args(rawArgs)
+	}
+	args["from"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["to"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalID(tmp)
 		if err != nil {
-			return 0, false
+			return nil, err
 		}
+	}
+	args["to"] = arg1
+	return args, nil
 
-		return e.complexity.Mutation.CreatePost(childComplexity, args["input"].(NewPost)), true
+}
 
-	case "Mutation.editPost":
-		if e.complexity.Mutation.EditPost == nil {
-			break
+func field_Mutation_mergeUsers_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["from"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		args, err := field_Mutation_editPost_args(rawArgs)
+	}
+	args["from"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["into"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalID(tmp)
 		if err != nil {
-			return 0, false
+			return nil, err
 		}
+	}
+	args["into"] = arg1
+	return args, nil
 
-		return e.complexity.Mutation.EditPost(childComplexity, args["Id"].(string), args["input"].(NewPost)), true
+}
 
-	case "Mutation.createLink":
-		if e.complexity.Mutation.CreateLink == nil {
-			break
+func field_Mutation_requeueJob_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["id"] = arg0
+	return args, nil
 
-		args, err := field_Mutation_createLink_args(rawArgs)
+}
+
+func field_Mutation_createSite_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["host"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
 		if err != nil {
-			return 0, false
+			return nil, err
+		}
+	}
+	args["host"] = arg0
+	var arg1 []string
+	if tmp, ok := rawArgs["allowedUsers"]; ok {
+		var err error
+		var rawIf1 []interface{}
+		if tmp != nil {
+			if tmp1, ok := tmp.([]interface{}); ok {
+				rawIf1 = tmp1
+			} else {
+				rawIf1 = []interface{}{tmp}
+			}
+		}
+		arg1 = make([]string, len(rawIf1))
+		for idx1 := range rawIf1 {
+			arg1[idx1], err = graphql.UnmarshalString(rawIf1[idx1])
+		}
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["allowedUsers"] = arg1
+	return args, nil
 
-		return e.complexity.Mutation.CreateLink(childComplexity, args["input"].(NewLink)), true
+}
 
-	case "Mutation.upsertStat":
-		if e.complexity.Mutation.UpsertStat == nil {
-			break
+func field_Mutation_updateSite_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["host"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg1 = &ptr1
 		}
 
-		args, err := field_Mutation_upsertStat_args(rawArgs)
 		if err != nil {
-			return 0, false
+			return nil, err
+		}
+	}
+	args["host"] = arg1
+	var arg2 []string
+	if tmp, ok := rawArgs["allowedUsers"]; ok {
+		var err error
+		var rawIf1 []interface{}
+		if tmp != nil {
+			if tmp1, ok := tmp.([]interface{}); ok {
+				rawIf1 = tmp1
+			} else {
+				rawIf1 = []interface{}{tmp}
+			}
+		}
+		arg2 = make([]string, len(rawIf1))
+		for idx1 := range rawIf1 {
+			arg2[idx1], err = graphql.UnmarshalString(rawIf1[idx1])
+		}
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["allowedUsers"] = arg2
+	return args, nil
 
-		return e.complexity.Mutation.UpsertStat(childComplexity, args["input"].(NewStat)), true
+}
 
-	case "Post.id":
-		if e.complexity.Post.Id == nil {
-			break
+func field_Mutation_updateSiteSettings_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 SiteSettingsInput
+	if tmp, ok := rawArgs["input"]; ok {
+		var err error
+		arg0, err = UnmarshalSiteSettingsInput(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["input"] = arg0
+	return args, nil
 
-		return e.complexity.Post.Id(childComplexity), true
+}
 
-	case "Post.title":
-		if e.complexity.Post.Title == nil {
-			break
+func field_Mutation_updateTheme_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 ThemeInput
+	if tmp, ok := rawArgs["input"]; ok {
+		var err error
+		arg0, err = UnmarshalThemeInput(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["input"] = arg0
+	return args, nil
 
-		return e.complexity.Post.Title(childComplexity), true
+}
 
-	case "Post.content":
-		if e.complexity.Post.Content == nil {
-			break
+func field_Mutation_createRedirect_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 NewRedirect
+	if tmp, ok := rawArgs["input"]; ok {
+		var err error
+		arg0, err = UnmarshalNewRedirect(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["input"] = arg0
+	return args, nil
 
-		return e.complexity.Post.Content(childComplexity), true
+}
 
-	case "Post.summary":
-		if e.complexity.Post.Summary == nil {
-			break
+func field_Mutation_updateRedirect_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		return e.complexity.Post.Summary(childComplexity), true
-
-	case "Post.readtime":
-		if e.complexity.Post.Readtime == nil {
-			break
+	}
+	args["id"] = arg0
+	var arg1 NewRedirect
+	if tmp, ok := rawArgs["input"]; ok {
+		var err error
+		arg1, err = UnmarshalNewRedirect(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["input"] = arg1
+	return args, nil
 
-		return e.complexity.Post.Readtime(childComplexity), true
+}
 
-	case "Post.datetime":
-		if e.complexity.Post.Datetime == nil {
-			break
+func field_Mutation_deleteRedirect_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["id"] = arg0
+	return args, nil
 
-		return e.complexity.Post.Datetime(childComplexity), true
+}
 
-	case "Post.created":
-		if e.complexity.Post.Created == nil {
-			break
+func field_Mutation_createShortLink_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["url"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		return e.complexity.Post.Created(childComplexity), true
-
-	case "Post.modified":
-		if e.complexity.Post.Modified == nil {
-			break
+	}
+	args["url"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["slug"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg1 = &ptr1
 		}
 
-		return e.complexity.Post.Modified(childComplexity), true
-
-	case "Post.draft":
-		if e.complexity.Post.Draft == nil {
-			break
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["slug"] = arg1
+	return args, nil
 
-		return e.complexity.Post.Draft(childComplexity), true
+}
 
-	case "Post.tags":
-		if e.complexity.Post.Tags == nil {
-			break
+func field_Mutation_addBook_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 NewBook
+	if tmp, ok := rawArgs["input"]; ok {
+		var err error
+		arg0, err = UnmarshalNewBook(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["input"] = arg0
+	return args, nil
 
-		return e.complexity.Post.Tags(childComplexity), true
+}
 
-	case "Post.links":
-		if e.complexity.Post.Links == nil {
-			break
+func field_Mutation_upsertPage_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["slug"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		return e.complexity.Post.Links(childComplexity), true
-
-	case "Query.allPosts":
-		if e.complexity.Query.AllPosts == nil {
-			break
+	}
+	args["slug"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["content"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		return e.complexity.Query.AllPosts(childComplexity), true
-
-	case "Query.drafts":
-		if e.complexity.Query.Drafts == nil {
-			break
+	}
+	args["content"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["title"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg2 = &ptr1
 		}
 
-		return e.complexity.Query.Drafts(childComplexity), true
-
-	case "Query.posts":
-		if e.complexity.Query.Posts == nil {
-			break
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["title"] = arg2
+	var arg3 *bool
+	if tmp, ok := rawArgs["draft"]; ok {
+		var err error
+		var ptr1 bool
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalBoolean(tmp)
+			arg3 = &ptr1
 		}
 
-		args, err := field_Query_posts_args(rawArgs)
 		if err != nil {
-			return 0, false
+			return nil, err
 		}
-
-		return e.complexity.Query.Posts(childComplexity, args["limit"].(*int), args["offset"].(*int)), true
-
-	case "Query.post":
-		if e.complexity.Query.Post == nil {
-			break
+	}
+	args["draft"] = arg3
+	var arg4 *int
+	if tmp, ok := rawArgs["version"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg4 = &ptr1
 		}
 
-		args, err := field_Query_post_args(rawArgs)
 		if err != nil {
-			return 0, false
+			return nil, err
 		}
+	}
+	args["version"] = arg4
+	return args, nil
 
-		return e.complexity.Query.Post(childComplexity, args["id"].(string)), true
+}
 
-	case "Query.nextPost":
-		if e.complexity.Query.NextPost == nil {
-			break
+func field_Mutation_markRead_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	var arg1 *time.Time
+	if tmp, ok := rawArgs["finished"]; ok {
+		var err error
+		var ptr1 time.Time
+		if tmp != nil {
+			ptr1, err = UnmarshalTime(tmp)
+			arg1 = &ptr1
 		}
 
-		args, err := field_Query_nextPost_args(rawArgs)
 		if err != nil {
-			return 0, false
+			return nil, err
 		}
-
-		return e.complexity.Query.NextPost(childComplexity, args["id"].(string)), true
-
-	case "Query.prevPost":
-		if e.complexity.Query.PrevPost == nil {
-			break
+	}
+	args["finished"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["rating"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg2 = &ptr1
 		}
 
-		args, err := field_Query_prevPost_args(rawArgs)
 		if err != nil {
-			return 0, false
+			return nil, err
 		}
+	}
+	args["rating"] = arg2
+	return args, nil
 
-		return e.complexity.Query.PrevPost(childComplexity, args["id"].(string)), true
+}
 
-	case "Query.allLinks":
-		if e.complexity.Query.AllLinks == nil {
-			break
+func field_Mutation_importGoodreads_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["csv"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["csv"] = arg0
+	return args, nil
 
-		return e.complexity.Query.AllLinks(childComplexity), true
-
-	case "Query.links":
-		if e.complexity.Query.Links == nil {
-			break
-		}
+}
 
-		args, err := field_Query_links_args(rawArgs)
+func field_Mutation_createWebhook_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 NewWebhook
+	if tmp, ok := rawArgs["input"]; ok {
+		var err error
+		arg0, err = UnmarshalNewWebhook(tmp)
 		if err != nil {
-			return 0, false
+			return nil, err
 		}
+	}
+	args["input"] = arg0
+	return args, nil
 
-		return e.complexity.Query.Links(childComplexity, args["limit"].(*int), args["offset"].(*int)), true
-
-	case "Query.link":
-		if e.complexity.Query.Link == nil {
-			break
-		}
+}
 
-		args, err := field_Query_link_args(rawArgs)
+func field_Mutation_deleteWebhook_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
 		if err != nil {
-			return 0, false
+			return nil, err
 		}
+	}
+	args["id"] = arg0
+	return args, nil
 
-		return e.complexity.Query.Link(childComplexity, args["id"].(string)), true
+}
 
-	case "Query.stats":
-		if e.complexity.Query.Stats == nil {
-			break
-		}
-
-		args, err := field_Query_stats_args(rawArgs)
+func field_Mutation_createAutomationRule_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 AutomationRuleInput
+	if tmp, ok := rawArgs["input"]; ok {
+		var err error
+		arg0, err = UnmarshalAutomationRuleInput(tmp)
 		if err != nil {
-			return 0, false
+			return nil, err
 		}
+	}
+	args["input"] = arg0
+	return args, nil
 
-		return e.complexity.Query.Stats(childComplexity, args["count"].(*int)), true
+}
 
-	case "Stat.key":
-		if e.complexity.Stat.Key == nil {
-			break
+func field_Mutation_updateAutomationRule_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	var arg1 AutomationRuleInput
+	if tmp, ok := rawArgs["input"]; ok {
+		var err error
+		arg1, err = UnmarshalAutomationRuleInput(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["input"] = arg1
+	return args, nil
 
-		return e.complexity.Stat.Key(childComplexity), true
+}
 
-	case "Stat.value":
-		if e.complexity.Stat.Value == nil {
-			break
+func field_Mutation_deleteAutomationRule_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["id"] = arg0
+	return args, nil
 
-		return e.complexity.Stat.Value(childComplexity), true
+}
 
+func field_Mutation_testAutomationRule_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["condition"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return 0, false
+	args["condition"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["postId"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["postId"] = arg1
+	return args, nil
+
 }
 
-func (e *executableSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
-	ec := executionContext{graphql.GetRequestContext(ctx), e}
+func field_Mutation_checkIntegrity_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 *bool
+	if tmp, ok := rawArgs["repair"]; ok {
+		var err error
+		var ptr1 bool
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalBoolean(tmp)
+			arg0 = &ptr1
+		}
 
-	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
-		data := ec._Query(ctx, op.SelectionSet)
-		var buf bytes.Buffer
-		data.MarshalGQL(&buf)
-		return buf.Bytes()
-	})
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["repair"] = arg0
+	return args, nil
 
-	return &graphql.Response{
-		Data:       buf,
-		Errors:     ec.Errors,
-		Extensions: ec.Extensions}
 }
 
-func (e *executableSchema) Mutation(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
-	ec := executionContext{graphql.GetRequestContext(ctx), e}
-
-	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
-		data := ec._Mutation(ctx, op.SelectionSet)
-		var buf bytes.Buffer
-		data.MarshalGQL(&buf)
-		return buf.Bytes()
-	})
+func field_Mutation_exportHugo_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 *bool
+	if tmp, ok := rawArgs["media"]; ok {
+		var err error
+		var ptr1 bool
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalBoolean(tmp)
+			arg0 = &ptr1
+		}
 
-	return &graphql.Response{
-		Data:       buf,
-		Errors:     ec.Errors,
-		Extensions: ec.Extensions,
+		if err != nil {
+			return nil, err
+		}
 	}
-}
+	args["media"] = arg0
+	return args, nil
 
-func (e *executableSchema) Subscription(ctx context.Context, op *ast.OperationDefinition) func() *graphql.Response {
-	return graphql.OneShot(graphql.ErrorResponse(ctx, "subscriptions are not supported"))
 }
 
-type executionContext struct {
-	*graphql.RequestContext
-	*executableSchema
+func field_Mutation_importData_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["archive"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["archive"] = arg0
+	return args, nil
+
 }
 
-var commentImplementors = []string{"Comment"}
+func field_Mutation_importWordPress_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["file"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["file"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["baseURL"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg1 = &ptr1
+		}
 
-// nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) _Comment(ctx context.Context, sel ast.SelectionSet, obj *Comment) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, commentImplementors)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["baseURL"] = arg1
+	return args, nil
 
-	out := graphql.NewOrderedMap(len(fields))
-	invalid := false
-	for i, field := range fields {
-		out.Keys[i] = field.Alias
+}
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Comment")
-		case "id":
-			out.Values[i] = ec._Comment_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func field_Mutation_importStaticSite_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["archive"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
 	}
+	args["archive"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["baseURL"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg1 = &ptr1
+		}
 
-	if invalid {
-		return graphql.Null
+		if err != nil {
+			return nil, err
+		}
 	}
-	return out
+	args["baseURL"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["permalink"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg2 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["permalink"] = arg2
+	return args, nil
+
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Comment_id(ctx context.Context, field graphql.CollectedField, obj *Comment) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Comment",
-		Args:   nil,
-		Field:  field,
+func field_Mutation_purgeCache_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 []string
+	if tmp, ok := rawArgs["paths"]; ok {
+		var err error
+		var rawIf1 []interface{}
+		if tmp != nil {
+			if tmp1, ok := tmp.([]interface{}); ok {
+				rawIf1 = tmp1
+			} else {
+				rawIf1 = []interface{}{tmp}
+			}
+		}
+		arg0 = make([]string, len(rawIf1))
+		for idx1 := range rawIf1 {
+			arg0[idx1], err = graphql.UnmarshalString(rawIf1[idx1])
+		}
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	args["paths"] = arg0
+	return args, nil
+
+}
+
+func field_Mutation_insertLog_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 NewLog
+	if tmp, ok := rawArgs["input"]; ok {
+		var err error
+		arg0, err = UnmarshalNewLog(tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalID(res)
+	args["input"] = arg0
+	return args, nil
+
 }
 
-var linkImplementors = []string{"Link"}
+func field_Mutation_shareLog_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["groupId"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalID(tmp)
+			arg1 = &ptr1
+		}
 
-// nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) _Link(ctx context.Context, sel ast.SelectionSet, obj *Link) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, linkImplementors)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["groupId"] = arg1
+	return args, nil
 
-	out := graphql.NewOrderedMap(len(fields))
-	invalid := false
-	for i, field := range fields {
-		out.Keys[i] = field.Alias
+}
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Link")
-		case "id":
-			out.Values[i] = ec._Link_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "title":
-			out.Values[i] = ec._Link_title(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "uri":
-			out.Values[i] = ec._Link_uri(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "created":
-			out.Values[i] = ec._Link_created(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "description":
-			out.Values[i] = ec._Link_description(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "screenshot":
-			out.Values[i] = ec._Link_screenshot(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "tags":
-			out.Values[i] = ec._Link_tags(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func field_Mutation_createGroup_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
 	}
+	args["name"] = arg0
+	return args, nil
 
-	if invalid {
-		return graphql.Null
-	}
-	return out
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Link_id(ctx context.Context, field graphql.CollectedField, obj *Link) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Link",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+func field_Mutation_createGroupInvite_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["groupId"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalID(res)
+	args["groupId"] = arg0
+	return args, nil
+
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Link_title(ctx context.Context, field graphql.CollectedField, obj *Link) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Link",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+func field_Mutation_joinGroup_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["code"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
+	args["code"] = arg0
+	return args, nil
+
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Link_uri(ctx context.Context, field graphql.CollectedField, obj *Link) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Link",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.URI, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+func field_Mutation_leaveGroup_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
+	args["id"] = arg0
+	return args, nil
+
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Link_created(ctx context.Context, field graphql.CollectedField, obj *Link) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Link",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Created, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+func field_Mutation_deleteGroup_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(time.Time)
-	rctx.Result = res
-	return graphql.MarshalTime(res)
+	args["id"] = arg0
+	return args, nil
+
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Link_description(ctx context.Context, field graphql.CollectedField, obj *Link) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Link",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+func field_Mutation_revokeSession_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
+	args["id"] = arg0
+	return args, nil
+
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Link_screenshot(ctx context.Context, field graphql.CollectedField, obj *Link) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Link",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Screenshot, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+func field_Mutation_readLater_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["url"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg0 = &ptr1
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
-}
 
-// nolint: vetshadow
-func (ec *executionContext) _Link_tags(ctx context.Context, field graphql.CollectedField, obj *Link) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Link",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Tags, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.([]string)
-	rctx.Result = res
-
-	arr1 := make(graphql.Array, len(res))
+	args["url"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["postId"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalID(tmp)
+			arg1 = &ptr1
+		}
 
-	for idx1 := range res {
-		arr1[idx1] = func() graphql.Marshaler {
-			return graphql.MarshalString(res[idx1])
-		}()
+		if err != nil {
+			return nil, err
+		}
 	}
+	args["postId"] = arg1
+	return args, nil
 
-	return arr1
 }
 
-var mutationImplementors = []string{"Mutation"}
-
-// nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, mutationImplementors)
-
-	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
-		Object: "Mutation",
-	})
+func field_Mutation_removeReadLater_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
 
-	out := graphql.NewOrderedMap(len(fields))
-	invalid := false
-	for i, field := range fields {
-		out.Keys[i] = field.Alias
+}
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "createPost":
-			out.Values[i] = ec._Mutation_createPost(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "editPost":
-			out.Values[i] = ec._Mutation_editPost(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "createLink":
-			out.Values[i] = ec._Mutation_createLink(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "upsertStat":
-			out.Values[i] = ec._Mutation_upsertStat(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func field_Mutation_createHighlight_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 NewHighlight
+	if tmp, ok := rawArgs["input"]; ok {
+		var err error
+		arg0, err = UnmarshalNewHighlight(tmp)
+		if err != nil {
+			return nil, err
 		}
 	}
+	args["input"] = arg0
+	return args, nil
 
-	if invalid {
-		return graphql.Null
-	}
-	return out
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Mutation_createPost(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Mutation_createPost_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+func field_Mutation_updateHighlight_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	rctx := &graphql.ResolverContext{
-		Object: "Mutation",
-		Args:   args,
-		Field:  field,
+	args["id"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["note"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg1 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreatePost(rctx, args["input"].(NewPost))
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	args["note"] = arg1
+	var arg2 *bool
+	if tmp, ok := rawArgs["public"]; ok {
+		var err error
+		var ptr1 bool
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalBoolean(tmp)
+			arg2 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(Post)
-	rctx.Result = res
+	args["public"] = arg2
+	return args, nil
 
-	return ec._Post(ctx, field.Selections, &res)
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Mutation_editPost(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Mutation_editPost_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx := &graphql.ResolverContext{
-		Object: "Mutation",
-		Args:   args,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().EditPost(rctx, args["Id"].(string), args["input"].(NewPost))
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+func field_Mutation_deleteHighlight_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(Post)
-	rctx.Result = res
+	args["id"] = arg0
+	return args, nil
 
-	return ec._Post(ctx, field.Selections, &res)
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Mutation_createLink(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Mutation_createLink_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx := &graphql.ResolverContext{
-		Object: "Mutation",
-		Args:   args,
-		Field:  field,
+func field_Mutation_readingProgress_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["postId"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateLink(rctx, args["input"].(NewLink))
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	args["postId"] = arg0
+	var arg1 int
+	if tmp, ok := rawArgs["percent"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalInt(tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(Link)
-	rctx.Result = res
+	args["percent"] = arg1
+	return args, nil
 
-	return ec._Link(ctx, field.Selections, &res)
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Mutation_upsertStat(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Mutation_upsertStat_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx := &graphql.ResolverContext{
-		Object: "Mutation",
-		Args:   args,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpsertStat(rctx, args["input"].(NewStat))
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+func field_Mutation_updateNotificationSettings_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 NotificationSettingsInput
+	if tmp, ok := rawArgs["input"]; ok {
+		var err error
+		arg0, err = UnmarshalNotificationSettingsInput(tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(Stat)
-	rctx.Result = res
+	args["input"] = arg0
+	return args, nil
 
-	return ec._Stat(ctx, field.Selections, &res)
 }
 
-var postImplementors = []string{"Post"}
+func field_Post_revisions_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg0 = &ptr1
+		}
 
-// nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj *Post) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, postImplementors)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["offset"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg1 = &ptr1
+		}
 
-	out := graphql.NewOrderedMap(len(fields))
-	invalid := false
-	for i, field := range fields {
-		out.Keys[i] = field.Alias
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["offset"] = arg1
+	return args, nil
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Post")
-		case "id":
-			out.Values[i] = ec._Post_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "title":
-			out.Values[i] = ec._Post_title(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "content":
-			out.Values[i] = ec._Post_content(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "summary":
-			out.Values[i] = ec._Post_summary(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "readtime":
-			out.Values[i] = ec._Post_readtime(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "datetime":
-			out.Values[i] = ec._Post_datetime(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "created":
-			out.Values[i] = ec._Post_created(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "modified":
-			out.Values[i] = ec._Post_modified(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "draft":
-			out.Values[i] = ec._Post_draft(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "tags":
-			out.Values[i] = ec._Post_tags(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "links":
-			out.Values[i] = ec._Post_links(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+}
+
+func field_Query_posts_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg0 = &ptr1
 		}
-	}
 
-	if invalid {
-		return graphql.Null
+		if err != nil {
+			return nil, err
+		}
 	}
-	return out
-}
+	args["limit"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["offset"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg1 = &ptr1
+		}
 
-// nolint: vetshadow
-func (ec *executionContext) _Post_id(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Post",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalID(res)
+	args["offset"] = arg1
+	return args, nil
+
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Post_title(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Post",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+func field_Query_post_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
-}
+	args["id"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["unlock"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg1 = &ptr1
+		}
 
-// nolint: vetshadow
-func (ec *executionContext) _Post_content(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Post",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Content, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
+	args["unlock"] = arg1
+	return args, nil
+
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Post_summary(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Post",
-		Args:   nil,
-		Field:  field,
+func field_Query_suggestPosts_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["path"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Summary(), nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	args["path"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg1 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
+	args["limit"] = arg1
+	return args, nil
+
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Post_readtime(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Post",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Readtime, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+func field_Query_nextPost_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(int)
-	rctx.Result = res
-	return graphql.MarshalInt(res)
+	args["id"] = arg0
+	return args, nil
+
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Post_datetime(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Post",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Datetime, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+func field_Query_prevPost_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(time.Time)
-	rctx.Result = res
-	return graphql.MarshalTime(res)
+	args["id"] = arg0
+	return args, nil
+
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Post_created(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Post",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Created, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+func field_Query_links_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 *LinkFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		var err error
+		var ptr1 LinkFilter
+		if tmp != nil {
+			ptr1, err = UnmarshalLinkFilter(tmp)
+			arg0 = &ptr1
 		}
-		return graphql.Null
-	}
-	res := resTmp.(time.Time)
-	rctx.Result = res
-	return graphql.MarshalTime(res)
-}
 
-// nolint: vetshadow
-func (ec *executionContext) _Post_modified(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Post",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Modified, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(time.Time)
-	rctx.Result = res
-	return graphql.MarshalTime(res)
-}
+	args["filter"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg1 = &ptr1
+		}
 
-// nolint: vetshadow
-func (ec *executionContext) _Post_draft(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Post",
-		Args:   nil,
-		Field:  field,
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Draft, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	args["limit"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["offset"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg2 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(bool)
-	rctx.Result = res
-	return graphql.MarshalBoolean(res)
+	args["offset"] = arg2
+	return args, nil
+
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Post_tags(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Post",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Tags, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+func field_Query_link_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.([]string)
-	rctx.Result = res
+	args["id"] = arg0
+	return args, nil
 
-	arr1 := make(graphql.Array, len(res))
+}
 
-	for idx1 := range res {
-		arr1[idx1] = func() graphql.Marshaler {
-			return graphql.MarshalString(res[idx1])
-		}()
+func field_Query_stats_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["count"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg0 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
+		}
 	}
+	args["count"] = arg0
+	return args, nil
 
-	return arr1
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Post_links(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Post",
-		Args:   nil,
-		Field:  field,
+func field_Query_postDiff_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Links, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	args["id"] = arg0
+	var arg1 int
+	if tmp, ok := rawArgs["from"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalInt(tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.([]*Link)
-	rctx.Result = res
-
-	arr1 := make(graphql.Array, len(res))
-	var wg sync.WaitGroup
-
-	isLen1 := len(res) == 1
-	if !isLen1 {
-		wg.Add(len(res))
+	args["from"] = arg1
+	var arg2 int
+	if tmp, ok := rawArgs["to"]; ok {
+		var err error
+		arg2, err = graphql.UnmarshalInt(tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
+	args["to"] = arg2
+	return args, nil
 
-	for idx1 := range res {
-		idx1 := idx1
-		rctx := &graphql.ResolverContext{
-			Index:  &idx1,
-			Result: res[idx1],
+}
+
+func field_Query_users_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 *UserFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		var err error
+		var ptr1 UserFilter
+		if tmp != nil {
+			ptr1, err = UnmarshalUserFilter(tmp)
+			arg0 = &ptr1
 		}
-		ctx := graphql.WithResolverContext(ctx, rctx)
-		f := func(idx1 int) {
-			if !isLen1 {
-				defer wg.Done()
-			}
-			arr1[idx1] = func() graphql.Marshaler {
 
-				if res[idx1] == nil {
-					return graphql.Null
-				}
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg1 = &ptr1
+		}
 
-				return ec._Link(ctx, field.Selections, res[idx1])
-			}()
+		if err != nil {
+			return nil, err
 		}
-		if isLen1 {
-			f(idx1)
-		} else {
-			go f(idx1)
+	}
+	args["limit"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["offset"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg2 = &ptr1
 		}
 
+		if err != nil {
+			return nil, err
+		}
 	}
-	wg.Wait()
-	return arr1
+	args["offset"] = arg2
+	return args, nil
+
 }
 
-var queryImplementors = []string{"Query"}
+func field_Query_jobs_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg0 = &ptr1
+		}
 
-// nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, queryImplementors)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg0
+	var arg1 *JobStatus
+	if tmp, ok := rawArgs["status"]; ok {
+		var err error
+		var ptr1 JobStatus
+		if tmp != nil {
+			err = (&ptr1).UnmarshalGQL(tmp)
+			arg1 = &ptr1
+		}
 
-	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
-		Object: "Query",
-	})
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["status"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["requestId"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg2 = &ptr1
+		}
 
-	var wg sync.WaitGroup
-	out := graphql.NewOrderedMap(len(fields))
-	invalid := false
-	for i, field := range fields {
-		out.Keys[i] = field.Alias
-
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "allPosts":
-			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._Query_allPosts(ctx, field)
-				if out.Values[i] == graphql.Null {
-					invalid = true
-				}
-				wg.Done()
-			}(i, field)
-		case "drafts":
-			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._Query_drafts(ctx, field)
-				if out.Values[i] == graphql.Null {
-					invalid = true
-				}
-				wg.Done()
-			}(i, field)
-		case "posts":
-			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._Query_posts(ctx, field)
-				if out.Values[i] == graphql.Null {
-					invalid = true
-				}
-				wg.Done()
-			}(i, field)
-		case "post":
-			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._Query_post(ctx, field)
-				wg.Done()
-			}(i, field)
-		case "nextPost":
-			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._Query_nextPost(ctx, field)
-				wg.Done()
-			}(i, field)
-		case "prevPost":
-			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._Query_prevPost(ctx, field)
-				wg.Done()
-			}(i, field)
-		case "allLinks":
-			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._Query_allLinks(ctx, field)
-				if out.Values[i] == graphql.Null {
-					invalid = true
-				}
-				wg.Done()
-			}(i, field)
-		case "links":
-			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._Query_links(ctx, field)
-				if out.Values[i] == graphql.Null {
-					invalid = true
-				}
-				wg.Done()
-			}(i, field)
-		case "link":
-			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._Query_link(ctx, field)
-				wg.Done()
-			}(i, field)
-		case "stats":
-			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._Query_stats(ctx, field)
-				if out.Values[i] == graphql.Null {
-					invalid = true
-				}
-				wg.Done()
-			}(i, field)
-		case "__type":
-			out.Values[i] = ec._Query___type(ctx, field)
-		case "__schema":
-			out.Values[i] = ec._Query___schema(ctx, field)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+		if err != nil {
+			return nil, err
 		}
 	}
-	wg.Wait()
-	if invalid {
-		return graphql.Null
-	}
-	return out
+	args["requestId"] = arg2
+	return args, nil
+
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Query_allPosts(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Query",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().AllPosts(rctx)
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+func field_Query_job_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.([]*Post)
-	rctx.Result = res
-
-	arr1 := make(graphql.Array, len(res))
-	var wg sync.WaitGroup
+	args["id"] = arg0
+	return args, nil
 
-	isLen1 := len(res) == 1
-	if !isLen1 {
-		wg.Add(len(res))
-	}
+}
 
-	for idx1 := range res {
-		idx1 := idx1
-		rctx := &graphql.ResolverContext{
-			Index:  &idx1,
-			Result: res[idx1],
+func field_Query_shortLink_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["slug"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
-		ctx := graphql.WithResolverContext(ctx, rctx)
-		f := func(idx1 int) {
-			if !isLen1 {
-				defer wg.Done()
-			}
-			arr1[idx1] = func() graphql.Marshaler {
+	}
+	args["slug"] = arg0
+	return args, nil
 
-				if res[idx1] == nil {
-					return graphql.Null
-				}
+}
 
-				return ec._Post(ctx, field.Selections, res[idx1])
-			}()
-		}
-		if isLen1 {
-			f(idx1)
-		} else {
-			go f(idx1)
+func field_Query_logs_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 *DateRange
+	if tmp, ok := rawArgs["range"]; ok {
+		var err error
+		var ptr1 DateRange
+		if tmp != nil {
+			ptr1, err = UnmarshalDateRange(tmp)
+			arg0 = &ptr1
 		}
 
+		if err != nil {
+			return nil, err
+		}
 	}
-	wg.Wait()
-	return arr1
+	args["range"] = arg0
+	return args, nil
+
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Query_drafts(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Query",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Drafts(rctx)
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+func field_Query_sharedWithMe_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 *DateRange
+	if tmp, ok := rawArgs["range"]; ok {
+		var err error
+		var ptr1 DateRange
+		if tmp != nil {
+			ptr1, err = UnmarshalDateRange(tmp)
+			arg0 = &ptr1
 		}
-		return graphql.Null
-	}
-	res := resTmp.([]*Post)
-	rctx.Result = res
-
-	arr1 := make(graphql.Array, len(res))
-	var wg sync.WaitGroup
 
-	isLen1 := len(res) == 1
-	if !isLen1 {
-		wg.Add(len(res))
+		if err != nil {
+			return nil, err
+		}
 	}
+	args["range"] = arg0
+	return args, nil
 
-	for idx1 := range res {
-		idx1 := idx1
-		rctx := &graphql.ResolverContext{
-			Index:  &idx1,
-			Result: res[idx1],
-		}
-		ctx := graphql.WithResolverContext(ctx, rctx)
-		f := func(idx1 int) {
-			if !isLen1 {
-				defer wg.Done()
-			}
-			arr1[idx1] = func() graphql.Marshaler {
+}
 
-				if res[idx1] == nil {
-					return graphql.Null
-				}
+func field_Query_readLater_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 *ReadLaterOrder
+	if tmp, ok := rawArgs["order"]; ok {
+		var err error
+		var ptr1 ReadLaterOrder
+		if tmp != nil {
+			err = (&ptr1).UnmarshalGQL(tmp)
+			arg0 = &ptr1
+		}
 
-				return ec._Post(ctx, field.Selections, res[idx1])
-			}()
+		if err != nil {
+			return nil, err
 		}
-		if isLen1 {
-			f(idx1)
-		} else {
-			go f(idx1)
+	}
+	args["order"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg1 = &ptr1
 		}
 
+		if err != nil {
+			return nil, err
+		}
 	}
-	wg.Wait()
-	return arr1
-}
-
-// nolint: vetshadow
-func (ec *executionContext) _Query_posts(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Query_posts_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx := &graphql.ResolverContext{
-		Object: "Query",
-		Args:   args,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Posts(rctx, args["limit"].(*int), args["offset"].(*int))
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	args["limit"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["offset"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg2 = &ptr1
 		}
-		return graphql.Null
-	}
-	res := resTmp.([]*Post)
-	rctx.Result = res
-
-	arr1 := make(graphql.Array, len(res))
-	var wg sync.WaitGroup
 
-	isLen1 := len(res) == 1
-	if !isLen1 {
-		wg.Add(len(res))
+		if err != nil {
+			return nil, err
+		}
 	}
+	args["offset"] = arg2
+	return args, nil
 
-	for idx1 := range res {
-		idx1 := idx1
-		rctx := &graphql.ResolverContext{
-			Index:  &idx1,
-			Result: res[idx1],
+}
+
+func field_Query_readingProgress_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["postId"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
-		ctx := graphql.WithResolverContext(ctx, rctx)
-		f := func(idx1 int) {
-			if !isLen1 {
-				defer wg.Done()
-			}
-			arr1[idx1] = func() graphql.Marshaler {
+	}
+	args["postId"] = arg0
+	return args, nil
 
-				if res[idx1] == nil {
-					return graphql.Null
-				}
+}
 
-				return ec._Post(ctx, field.Selections, res[idx1])
-			}()
+func field_Query_highlights_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["postId"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
-		if isLen1 {
-			f(idx1)
-		} else {
-			go f(idx1)
+	}
+	args["postId"] = arg0
+	var arg1 *bool
+	if tmp, ok := rawArgs["includePublic"]; ok {
+		var err error
+		var ptr1 bool
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalBoolean(tmp)
+			arg1 = &ptr1
 		}
 
+		if err != nil {
+			return nil, err
+		}
 	}
-	wg.Wait()
-	return arr1
+	args["includePublic"] = arg1
+	return args, nil
+
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Query_post(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Query_post_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+func field_Query_webhookDeliveries_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["webhookId"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalID(tmp)
+			arg0 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
+		}
 	}
-	rctx := &graphql.ResolverContext{
-		Object: "Query",
-		Args:   args,
-		Field:  field,
+	args["webhookId"] = arg0
+	var arg1 *bool
+	if tmp, ok := rawArgs["failed"]; ok {
+		var err error
+		var ptr1 bool
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalBoolean(tmp)
+			arg1 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Post(rctx, args["id"].(string))
-	})
-	if resTmp == nil {
-		return graphql.Null
+	args["failed"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["requestId"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg2 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
+		}
 	}
-	res := resTmp.(*Post)
-	rctx.Result = res
+	args["requestId"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg3 = &ptr1
+		}
 
-	if res == nil {
-		return graphql.Null
+		if err != nil {
+			return nil, err
+		}
 	}
+	args["limit"] = arg3
+	return args, nil
 
-	return ec._Post(ctx, field.Selections, res)
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Query_nextPost(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Query_nextPost_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx := &graphql.ResolverContext{
-		Object: "Query",
-		Args:   args,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().NextPost(rctx, args["id"].(string))
-	})
-	if resTmp == nil {
-		return graphql.Null
+func field_Query_pageViews_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["path"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg0 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
+		}
 	}
-	res := resTmp.(*Post)
-	rctx.Result = res
+	args["path"] = arg0
+	var arg1 *DateRange
+	if tmp, ok := rawArgs["range"]; ok {
+		var err error
+		var ptr1 DateRange
+		if tmp != nil {
+			ptr1, err = UnmarshalDateRange(tmp)
+			arg1 = &ptr1
+		}
 
-	if res == nil {
-		return graphql.Null
+		if err != nil {
+			return nil, err
+		}
 	}
+	args["range"] = arg1
+	return args, nil
 
-	return ec._Post(ctx, field.Selections, res)
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Query_prevPost(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Query_prevPost_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+func field_Query_books_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 *Shelf
+	if tmp, ok := rawArgs["shelf"]; ok {
+		var err error
+		var ptr1 Shelf
+		if tmp != nil {
+			err = (&ptr1).UnmarshalGQL(tmp)
+			arg0 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
+		}
 	}
-	rctx := &graphql.ResolverContext{
-		Object: "Query",
-		Args:   args,
-		Field:  field,
+	args["shelf"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["year"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg1 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().PrevPost(rctx, args["id"].(string))
-	})
-	if resTmp == nil {
-		return graphql.Null
+	args["year"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg2 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
+		}
 	}
-	res := resTmp.(*Post)
-	rctx.Result = res
+	args["limit"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["offset"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg3 = &ptr1
+		}
 
-	if res == nil {
-		return graphql.Null
+		if err != nil {
+			return nil, err
+		}
 	}
+	args["offset"] = arg3
+	return args, nil
 
-	return ec._Post(ctx, field.Selections, res)
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Query_allLinks(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Query",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().AllLinks(rctx)
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+func field_Query_book_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.([]*Link)
-	rctx.Result = res
+	args["id"] = arg0
+	return args, nil
 
-	arr1 := make(graphql.Array, len(res))
-	var wg sync.WaitGroup
+}
 
-	isLen1 := len(res) == 1
-	if !isLen1 {
-		wg.Add(len(res))
+func field_Query_readingStats_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["year"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalInt(tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
+	args["year"] = arg0
+	return args, nil
 
-	for idx1 := range res {
-		idx1 := idx1
-		rctx := &graphql.ResolverContext{
-			Index:  &idx1,
-			Result: res[idx1],
+}
+
+func field_Query_page_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["slug"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
-		ctx := graphql.WithResolverContext(ctx, rctx)
-		f := func(idx1 int) {
-			if !isLen1 {
-				defer wg.Done()
-			}
-			arr1[idx1] = func() graphql.Marshaler {
+	}
+	args["slug"] = arg0
+	return args, nil
 
-				if res[idx1] == nil {
-					return graphql.Null
-				}
+}
 
-				return ec._Link(ctx, field.Selections, res[idx1])
-			}()
-		}
-		if isLen1 {
-			f(idx1)
-		} else {
-			go f(idx1)
+func field_Query___type_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
-
 	}
-	wg.Wait()
-	return arr1
+	args["name"] = arg0
+	return args, nil
+
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Query_links(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Query_links_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx := &graphql.ResolverContext{
-		Object: "Query",
-		Args:   args,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Links(rctx, args["limit"].(*int), args["offset"].(*int))
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+func field___Type_fields_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalBoolean(tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.([]*Link)
-	rctx.Result = res
+	args["includeDeprecated"] = arg0
+	return args, nil
 
-	arr1 := make(graphql.Array, len(res))
-	var wg sync.WaitGroup
+}
 
-	isLen1 := len(res) == 1
-	if !isLen1 {
-		wg.Add(len(res))
+func field___Type_enumValues_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalBoolean(tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
+	args["includeDeprecated"] = arg0
+	return args, nil
 
-	for idx1 := range res {
-		idx1 := idx1
-		rctx := &graphql.ResolverContext{
-			Index:  &idx1,
-			Result: res[idx1],
-		}
-		ctx := graphql.WithResolverContext(ctx, rctx)
-		f := func(idx1 int) {
-			if !isLen1 {
-				defer wg.Done()
-			}
-			arr1[idx1] = func() graphql.Marshaler {
+}
 
-				if res[idx1] == nil {
-					return graphql.Null
-				}
+func dir_constraint_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["minLength"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg0 = &ptr1
+		}
 
-				return ec._Link(ctx, field.Selections, res[idx1])
-			}()
+		if err != nil {
+			return nil, err
 		}
-		if isLen1 {
-			f(idx1)
-		} else {
-			go f(idx1)
+	}
+	args["minLength"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["maxLength"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg1 = &ptr1
 		}
 
+		if err != nil {
+			return nil, err
+		}
 	}
-	wg.Wait()
-	return arr1
-}
+	args["maxLength"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["pattern"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg2 = &ptr1
+		}
 
-// nolint: vetshadow
-func (ec *executionContext) _Query_link(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Query_link_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+		if err != nil {
+			return nil, err
+		}
 	}
-	rctx := &graphql.ResolverContext{
-		Object: "Query",
-		Args:   args,
-		Field:  field,
+	args["pattern"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["format"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg3 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Link(rctx, args["id"].(string))
-	})
-	if resTmp == nil {
-		return graphql.Null
+	args["format"] = arg3
+	var arg4 *float64
+	if tmp, ok := rawArgs["min"]; ok {
+		var err error
+		var ptr1 float64
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalFloat(tmp)
+			arg4 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
+		}
 	}
-	res := resTmp.(*Link)
-	rctx.Result = res
+	args["min"] = arg4
+	var arg5 *float64
+	if tmp, ok := rawArgs["max"]; ok {
+		var err error
+		var ptr1 float64
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalFloat(tmp)
+			arg5 = &ptr1
+		}
 
-	if res == nil {
-		return graphql.Null
+		if err != nil {
+			return nil, err
+		}
 	}
+	args["max"] = arg5
+	return args, nil
 
-	return ec._Link(ctx, field.Selections, res)
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Query_stats(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Query_stats_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx := &graphql.ResolverContext{
-		Object: "Query",
-		Args:   args,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Stats(rctx, args["count"].(*int))
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+func dir_hasRole_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 Role
+	if tmp, ok := rawArgs["role"]; ok {
+		var err error
+		err = (&arg0).UnmarshalGQL(tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.([]*Stat)
-	rctx.Result = res
+	args["role"] = arg0
+	return args, nil
 
-	arr1 := make(graphql.Array, len(res))
-	var wg sync.WaitGroup
+}
 
-	isLen1 := len(res) == 1
-	if !isLen1 {
-		wg.Add(len(res))
-	}
+type executableSchema struct {
+	resolvers  ResolverRoot
+	directives DirectiveRoot
+	complexity ComplexityRoot
+}
 
-	for idx1 := range res {
-		idx1 := idx1
-		rctx := &graphql.ResolverContext{
-			Index:  &idx1,
-			Result: res[idx1],
+func (e *executableSchema) Schema() *ast.Schema {
+	return parsedSchema
+}
+
+func (e *executableSchema) Complexity(typeName, field string, childComplexity int, rawArgs map[string]interface{}) (int, bool) {
+	switch typeName + "." + field {
+
+	case "AdminError.source":
+		if e.complexity.AdminError.Source == nil {
+			break
 		}
-		ctx := graphql.WithResolverContext(ctx, rctx)
-		f := func(idx1 int) {
-			if !isLen1 {
-				defer wg.Done()
-			}
-			arr1[idx1] = func() graphql.Marshaler {
 
-				if res[idx1] == nil {
-					return graphql.Null
-				}
+		return e.complexity.AdminError.Source(childComplexity), true
 
-				return ec._Stat(ctx, field.Selections, res[idx1])
-			}()
+	case "AdminError.message":
+		if e.complexity.AdminError.Message == nil {
+			break
 		}
-		if isLen1 {
-			f(idx1)
-		} else {
-			go f(idx1)
+
+		return e.complexity.AdminError.Message(childComplexity), true
+
+	case "AdminError.time":
+		if e.complexity.AdminError.Time == nil {
+			break
 		}
 
-	}
-	wg.Wait()
-	return arr1
-}
+		return e.complexity.AdminError.Time(childComplexity), true
 
-// nolint: vetshadow
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Query___type_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx := &graphql.ResolverContext{
-		Object: "Query",
-		Args:   args,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(args["name"].(string)), nil
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Type)
-	rctx.Result = res
+	case "AdminStats.posts":
+		if e.complexity.AdminStats.Posts == nil {
+			break
+		}
 
-	if res == nil {
-		return graphql.Null
-	}
+		return e.complexity.AdminStats.Posts(childComplexity), true
 
-	return ec.___Type(ctx, field.Selections, res)
-}
+	case "AdminStats.drafts":
+		if e.complexity.AdminStats.Drafts == nil {
+			break
+		}
 
-// nolint: vetshadow
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Query",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema(), nil
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Schema)
-	rctx.Result = res
+		return e.complexity.AdminStats.Drafts(childComplexity), true
 
-	if res == nil {
-		return graphql.Null
-	}
+	case "AdminStats.users":
+		if e.complexity.AdminStats.Users == nil {
+			break
+		}
 
-	return ec.___Schema(ctx, field.Selections, res)
-}
+		return e.complexity.AdminStats.Users(childComplexity), true
 
-var statImplementors = []string{"Stat"}
+	case "AdminStats.deactivatedUsers":
+		if e.complexity.AdminStats.DeactivatedUsers == nil {
+			break
+		}
 
-// nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) _Stat(ctx context.Context, sel ast.SelectionSet, obj *Stat) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, statImplementors)
+		return e.complexity.AdminStats.DeactivatedUsers(childComplexity), true
 
-	out := graphql.NewOrderedMap(len(fields))
-	invalid := false
-	for i, field := range fields {
-		out.Keys[i] = field.Alias
+	case "AdminStats.failedWebhookDeliveries":
+		if e.complexity.AdminStats.FailedWebhookDeliveries == nil {
+			break
+		}
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Stat")
-		case "key":
-			out.Values[i] = ec._Stat_key(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "value":
-			out.Values[i] = ec._Stat_value(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+		return e.complexity.AdminStats.FailedWebhookDeliveries(childComplexity), true
+
+	case "AdminStats.recentErrors":
+		if e.complexity.AdminStats.RecentErrors == nil {
+			break
 		}
-	}
 
-	if invalid {
-		return graphql.Null
-	}
-	return out
-}
+		return e.complexity.AdminStats.RecentErrors(childComplexity), true
 
-// nolint: vetshadow
-func (ec *executionContext) _Stat_key(ctx context.Context, field graphql.CollectedField, obj *Stat) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Stat",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	case "AdminStats.cronTasks":
+		if e.complexity.AdminStats.CronTasks == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
-}
 
-// nolint: vetshadow
-func (ec *executionContext) _Stat_value(ctx context.Context, field graphql.CollectedField, obj *Stat) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "Stat",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+		return e.complexity.AdminStats.CronTasks(childComplexity), true
+
+	case "AutomationRule.id":
+		if e.complexity.AutomationRule.Id == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
-}
 
-var __DirectiveImplementors = []string{"__Directive"}
+		return e.complexity.AutomationRule.Id(childComplexity), true
 
-// nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, __DirectiveImplementors)
+	case "AutomationRule.name":
+		if e.complexity.AutomationRule.Name == nil {
+			break
+		}
 
-	out := graphql.NewOrderedMap(len(fields))
-	invalid := false
-	for i, field := range fields {
-		out.Keys[i] = field.Alias
+		return e.complexity.AutomationRule.Name(childComplexity), true
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "description":
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-		case "locations":
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "args":
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	case "AutomationRule.event":
+		if e.complexity.AutomationRule.Event == nil {
+			break
 		}
-	}
 
-	if invalid {
-		return graphql.Null
-	}
-	return out
-}
+		return e.complexity.AutomationRule.Event(childComplexity), true
 
-// nolint: vetshadow
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Directive",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	case "AutomationRule.condition":
+		if e.complexity.AutomationRule.Condition == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
-}
 
-// nolint: vetshadow
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Directive",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
-}
+		return e.complexity.AutomationRule.Condition(childComplexity), true
 
-// nolint: vetshadow
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Directive",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	case "AutomationRule.actions":
+		if e.complexity.AutomationRule.Actions == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.([]string)
-	rctx.Result = res
 
-	arr1 := make(graphql.Array, len(res))
+		return e.complexity.AutomationRule.Actions(childComplexity), true
 
-	for idx1 := range res {
-		arr1[idx1] = func() graphql.Marshaler {
-			return graphql.MarshalString(res[idx1])
-		}()
-	}
+	case "AutomationRule.enabled":
+		if e.complexity.AutomationRule.Enabled == nil {
+			break
+		}
 
-	return arr1
-}
+		return e.complexity.AutomationRule.Enabled(childComplexity), true
 
-// nolint: vetshadow
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Directive",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	case "AutomationRule.runs":
+		if e.complexity.AutomationRule.Runs == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.InputValue)
-	rctx.Result = res
 
-	arr1 := make(graphql.Array, len(res))
-	var wg sync.WaitGroup
+		args, err := field_AutomationRule_runs_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	isLen1 := len(res) == 1
-	if !isLen1 {
-		wg.Add(len(res))
-	}
+		return e.complexity.AutomationRule.Runs(childComplexity, args["limit"].(*int)), true
 
-	for idx1 := range res {
-		idx1 := idx1
-		rctx := &graphql.ResolverContext{
-			Index:  &idx1,
-			Result: &res[idx1],
+	case "AutomationRule.created":
+		if e.complexity.AutomationRule.Created == nil {
+			break
 		}
-		ctx := graphql.WithResolverContext(ctx, rctx)
-		f := func(idx1 int) {
-			if !isLen1 {
-				defer wg.Done()
-			}
-			arr1[idx1] = func() graphql.Marshaler {
 
-				return ec.___InputValue(ctx, field.Selections, &res[idx1])
-			}()
-		}
-		if isLen1 {
-			f(idx1)
-		} else {
-			go f(idx1)
+		return e.complexity.AutomationRule.Created(childComplexity), true
+
+	case "AutomationRule.modified":
+		if e.complexity.AutomationRule.Modified == nil {
+			break
 		}
 
-	}
-	wg.Wait()
-	return arr1
-}
+		return e.complexity.AutomationRule.Modified(childComplexity), true
 
-var __EnumValueImplementors = []string{"__EnumValue"}
+	case "AutomationRuleRun.id":
+		if e.complexity.AutomationRuleRun.Id == nil {
+			break
+		}
 
-// nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, __EnumValueImplementors)
+		return e.complexity.AutomationRuleRun.Id(childComplexity), true
 
-	out := graphql.NewOrderedMap(len(fields))
-	invalid := false
-	for i, field := range fields {
-		out.Keys[i] = field.Alias
+	case "AutomationRuleRun.ruleId":
+		if e.complexity.AutomationRuleRun.RuleId == nil {
+			break
+		}
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "description":
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+		return e.complexity.AutomationRuleRun.RuleId(childComplexity), true
+
+	case "AutomationRuleRun.subject":
+		if e.complexity.AutomationRuleRun.Subject == nil {
+			break
 		}
-	}
 
-	if invalid {
-		return graphql.Null
-	}
-	return out
-}
+		return e.complexity.AutomationRuleRun.Subject(childComplexity), true
 
-// nolint: vetshadow
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__EnumValue",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	case "AutomationRuleRun.matched":
+		if e.complexity.AutomationRuleRun.Matched == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
-}
 
-// nolint: vetshadow
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__EnumValue",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
-}
+		return e.complexity.AutomationRuleRun.Matched(childComplexity), true
 
-// nolint: vetshadow
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__EnumValue",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	case "AutomationRuleRun.actions":
+		if e.complexity.AutomationRuleRun.Actions == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	rctx.Result = res
-	return graphql.MarshalBoolean(res)
-}
 
-// nolint: vetshadow
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__EnumValue",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason, nil
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
-}
+		return e.complexity.AutomationRuleRun.Actions(childComplexity), true
 
-var __FieldImplementors = []string{"__Field"}
+	case "AutomationRuleRun.error":
+		if e.complexity.AutomationRuleRun.Error == nil {
+			break
+		}
 
-// nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, __FieldImplementors)
+		return e.complexity.AutomationRuleRun.Error(childComplexity), true
 
-	out := graphql.NewOrderedMap(len(fields))
-	invalid := false
-	for i, field := range fields {
-		out.Keys[i] = field.Alias
+	case "AutomationRuleRun.created":
+		if e.complexity.AutomationRuleRun.Created == nil {
+			break
+		}
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "description":
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
-		case "args":
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "type":
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+		return e.complexity.AutomationRuleRun.Created(childComplexity), true
+
+	case "Book.id":
+		if e.complexity.Book.Id == nil {
+			break
 		}
-	}
 
-	if invalid {
-		return graphql.Null
-	}
-	return out
-}
+		return e.complexity.Book.Id(childComplexity), true
 
-// nolint: vetshadow
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Field",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	case "Book.title":
+		if e.complexity.Book.Title == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
-}
 
-// nolint: vetshadow
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Field",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
-}
+		return e.complexity.Book.Title(childComplexity), true
 
-// nolint: vetshadow
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Field",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	case "Book.author":
+		if e.complexity.Book.Author == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.InputValue)
-	rctx.Result = res
 
-	arr1 := make(graphql.Array, len(res))
-	var wg sync.WaitGroup
+		return e.complexity.Book.Author(childComplexity), true
 
-	isLen1 := len(res) == 1
-	if !isLen1 {
-		wg.Add(len(res))
-	}
+	case "Book.isbn":
+		if e.complexity.Book.Isbn == nil {
+			break
+		}
 
-	for idx1 := range res {
-		idx1 := idx1
-		rctx := &graphql.ResolverContext{
-			Index:  &idx1,
-			Result: &res[idx1],
+		return e.complexity.Book.Isbn(childComplexity), true
+
+	case "Book.goodreadsId":
+		if e.complexity.Book.GoodreadsId == nil {
+			break
 		}
-		ctx := graphql.WithResolverContext(ctx, rctx)
-		f := func(idx1 int) {
-			if !isLen1 {
-				defer wg.Done()
-			}
-			arr1[idx1] = func() graphql.Marshaler {
 
-				return ec.___InputValue(ctx, field.Selections, &res[idx1])
-			}()
+		return e.complexity.Book.GoodreadsId(childComplexity), true
+
+	case "Book.shelf":
+		if e.complexity.Book.Shelf == nil {
+			break
 		}
-		if isLen1 {
-			f(idx1)
-		} else {
-			go f(idx1)
+
+		return e.complexity.Book.Shelf(childComplexity), true
+
+	case "Book.rating":
+		if e.complexity.Book.Rating == nil {
+			break
 		}
 
-	}
-	wg.Wait()
-	return arr1
-}
+		return e.complexity.Book.Rating(childComplexity), true
 
-// nolint: vetshadow
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Field",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	case "Book.pages":
+		if e.complexity.Book.Pages == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Type)
-	rctx.Result = res
 
-	if res == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+		return e.complexity.Book.Pages(childComplexity), true
+
+	case "Book.started":
+		if e.complexity.Book.Started == nil {
+			break
 		}
-		return graphql.Null
-	}
 
-	return ec.___Type(ctx, field.Selections, res)
-}
+		return e.complexity.Book.Started(childComplexity), true
 
-// nolint: vetshadow
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Field",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	case "Book.finished":
+		if e.complexity.Book.Finished == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	rctx.Result = res
-	return graphql.MarshalBoolean(res)
-}
 
-// nolint: vetshadow
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Field",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason, nil
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
-}
+		return e.complexity.Book.Finished(childComplexity), true
 
-var __InputValueImplementors = []string{"__InputValue"}
+	case "Book.created":
+		if e.complexity.Book.Created == nil {
+			break
+		}
 
-// nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, __InputValueImplementors)
+		return e.complexity.Book.Created(childComplexity), true
 
-	out := graphql.NewOrderedMap(len(fields))
-	invalid := false
-	for i, field := range fields {
-		out.Keys[i] = field.Alias
+	case "Book.modified":
+		if e.complexity.Book.Modified == nil {
+			break
+		}
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "description":
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-		case "type":
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "defaultValue":
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+		return e.complexity.Book.Modified(childComplexity), true
+
+	case "Comment.id":
+		if e.complexity.Comment.Id == nil {
+			break
 		}
-	}
 
-	if invalid {
-		return graphql.Null
-	}
-	return out
-}
+		return e.complexity.Comment.Id(childComplexity), true
 
-// nolint: vetshadow
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__InputValue",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	case "CronTaskStatus.name":
+		if e.complexity.CronTaskStatus.Name == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
-}
 
-// nolint: vetshadow
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__InputValue",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
-}
+		return e.complexity.CronTaskStatus.Name(childComplexity), true
 
-// nolint: vetshadow
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) graphql.Marshaler {
+	case "CronTaskStatus.schedule":
+		if e.complexity.CronTaskStatus.Schedule == nil {
+			break
+		}
+
+		return e.complexity.CronTaskStatus.Schedule(childComplexity), true
+
+	case "CronTaskStatus.next":
+		if e.complexity.CronTaskStatus.Next == nil {
+			break
+		}
+
+		return e.complexity.CronTaskStatus.Next(childComplexity), true
+
+	case "CronTaskStatus.lastStarted":
+		if e.complexity.CronTaskStatus.LastStarted == nil {
+			break
+		}
+
+		return e.complexity.CronTaskStatus.LastStarted(childComplexity), true
+
+	case "CronTaskStatus.lastFinished":
+		if e.complexity.CronTaskStatus.LastFinished == nil {
+			break
+		}
+
+		return e.complexity.CronTaskStatus.LastFinished(childComplexity), true
+
+	case "CronTaskStatus.lastError":
+		if e.complexity.CronTaskStatus.LastError == nil {
+			break
+		}
+
+		return e.complexity.CronTaskStatus.LastError(childComplexity), true
+
+	case "DBPoolStats.maxOpen":
+		if e.complexity.DbpoolStats.MaxOpen == nil {
+			break
+		}
+
+		return e.complexity.DbpoolStats.MaxOpen(childComplexity), true
+
+	case "DBPoolStats.open":
+		if e.complexity.DbpoolStats.Open == nil {
+			break
+		}
+
+		return e.complexity.DbpoolStats.Open(childComplexity), true
+
+	case "DBPoolStats.inUse":
+		if e.complexity.DbpoolStats.InUse == nil {
+			break
+		}
+
+		return e.complexity.DbpoolStats.InUse(childComplexity), true
+
+	case "DBPoolStats.idle":
+		if e.complexity.DbpoolStats.Idle == nil {
+			break
+		}
+
+		return e.complexity.DbpoolStats.Idle(childComplexity), true
+
+	case "DBPoolStats.waitCount":
+		if e.complexity.DbpoolStats.WaitCount == nil {
+			break
+		}
+
+		return e.complexity.DbpoolStats.WaitCount(childComplexity), true
+
+	case "DBPoolStats.waitDuration":
+		if e.complexity.DbpoolStats.WaitDuration == nil {
+			break
+		}
+
+		return e.complexity.DbpoolStats.WaitDuration(childComplexity), true
+
+	case "DataExport.filename":
+		if e.complexity.DataExport.Filename == nil {
+			break
+		}
+
+		return e.complexity.DataExport.Filename(childComplexity), true
+
+	case "DataExport.archive":
+		if e.complexity.DataExport.Archive == nil {
+			break
+		}
+
+		return e.complexity.DataExport.Archive(childComplexity), true
+
+	case "DataExport.created":
+		if e.complexity.DataExport.Created == nil {
+			break
+		}
+
+		return e.complexity.DataExport.Created(childComplexity), true
+
+	case "DayCount.day":
+		if e.complexity.DayCount.Day == nil {
+			break
+		}
+
+		return e.complexity.DayCount.Day(childComplexity), true
+
+	case "DayCount.views":
+		if e.complexity.DayCount.Views == nil {
+			break
+		}
+
+		return e.complexity.DayCount.Views(childComplexity), true
+
+	case "DayCount.visitors":
+		if e.complexity.DayCount.Visitors == nil {
+			break
+		}
+
+		return e.complexity.DayCount.Visitors(childComplexity), true
+
+	case "DebugInfo.version":
+		if e.complexity.DebugInfo.Version == nil {
+			break
+		}
+
+		return e.complexity.DebugInfo.Version(childComplexity), true
+
+	case "DebugInfo.gitSHA":
+		if e.complexity.DebugInfo.GitSha == nil {
+			break
+		}
+
+		return e.complexity.DebugInfo.GitSha(childComplexity), true
+
+	case "DebugInfo.goVersion":
+		if e.complexity.DebugInfo.GoVersion == nil {
+			break
+		}
+
+		return e.complexity.DebugInfo.GoVersion(childComplexity), true
+
+	case "DebugInfo.started":
+		if e.complexity.DebugInfo.Started == nil {
+			break
+		}
+
+		return e.complexity.DebugInfo.Started(childComplexity), true
+
+	case "DebugInfo.uptime":
+		if e.complexity.DebugInfo.Uptime == nil {
+			break
+		}
+
+		return e.complexity.DebugInfo.Uptime(childComplexity), true
+
+	case "DebugInfo.goroutines":
+		if e.complexity.DebugInfo.Goroutines == nil {
+			break
+		}
+
+		return e.complexity.DebugInfo.Goroutines(childComplexity), true
+
+	case "DebugInfo.heapAlloc":
+		if e.complexity.DebugInfo.HeapAlloc == nil {
+			break
+		}
+
+		return e.complexity.DebugInfo.HeapAlloc(childComplexity), true
+
+	case "DebugInfo.heapObjects":
+		if e.complexity.DebugInfo.HeapObjects == nil {
+			break
+		}
+
+		return e.complexity.DebugInfo.HeapObjects(childComplexity), true
+
+	case "DebugInfo.numGC":
+		if e.complexity.DebugInfo.NumGc == nil {
+			break
+		}
+
+		return e.complexity.DebugInfo.NumGc(childComplexity), true
+
+	case "DebugInfo.database":
+		if e.complexity.DebugInfo.Database == nil {
+			break
+		}
+
+		return e.complexity.DebugInfo.Database(childComplexity), true
+
+	case "DebugInfo.replica":
+		if e.complexity.DebugInfo.Replica == nil {
+			break
+		}
+
+		return e.complexity.DebugInfo.Replica(childComplexity), true
+
+	case "Geo.lat":
+		if e.complexity.Geo.Lat == nil {
+			break
+		}
+
+		return e.complexity.Geo.Lat(childComplexity), true
+
+	case "Geo.long":
+		if e.complexity.Geo.Long == nil {
+			break
+		}
+
+		return e.complexity.Geo.Long(childComplexity), true
+
+	case "GoroutineCount.labels":
+		if e.complexity.GoroutineCount.Labels == nil {
+			break
+		}
+
+		return e.complexity.GoroutineCount.Labels(childComplexity), true
+
+	case "GoroutineCount.count":
+		if e.complexity.GoroutineCount.Count == nil {
+			break
+		}
+
+		return e.complexity.GoroutineCount.Count(childComplexity), true
+
+	case "Group.id":
+		if e.complexity.Group.Id == nil {
+			break
+		}
+
+		return e.complexity.Group.Id(childComplexity), true
+
+	case "Group.name":
+		if e.complexity.Group.Name == nil {
+			break
+		}
+
+		return e.complexity.Group.Name(childComplexity), true
+
+	case "Group.ownerId":
+		if e.complexity.Group.OwnerId == nil {
+			break
+		}
+
+		return e.complexity.Group.OwnerId(childComplexity), true
+
+	case "Group.members":
+		if e.complexity.Group.Members == nil {
+			break
+		}
+
+		return e.complexity.Group.Members(childComplexity), true
+
+	case "Group.created":
+		if e.complexity.Group.Created == nil {
+			break
+		}
+
+		return e.complexity.Group.Created(childComplexity), true
+
+	case "Group.modified":
+		if e.complexity.Group.Modified == nil {
+			break
+		}
+
+		return e.complexity.Group.Modified(childComplexity), true
+
+	case "Highlight.id":
+		if e.complexity.Highlight.Id == nil {
+			break
+		}
+
+		return e.complexity.Highlight.Id(childComplexity), true
+
+	case "Highlight.postId":
+		if e.complexity.Highlight.PostId == nil {
+			break
+		}
+
+		return e.complexity.Highlight.PostId(childComplexity), true
+
+	case "Highlight.quote":
+		if e.complexity.Highlight.Quote == nil {
+			break
+		}
+
+		return e.complexity.Highlight.Quote(childComplexity), true
+
+	case "Highlight.start":
+		if e.complexity.Highlight.Start == nil {
+			break
+		}
+
+		return e.complexity.Highlight.Start(childComplexity), true
+
+	case "Highlight.end":
+		if e.complexity.Highlight.End == nil {
+			break
+		}
+
+		return e.complexity.Highlight.End(childComplexity), true
+
+	case "Highlight.note":
+		if e.complexity.Highlight.Note == nil {
+			break
+		}
+
+		return e.complexity.Highlight.Note(childComplexity), true
+
+	case "Highlight.public":
+		if e.complexity.Highlight.Public == nil {
+			break
+		}
+
+		return e.complexity.Highlight.Public(childComplexity), true
+
+	case "Highlight.mine":
+		if e.complexity.Highlight.Mine == nil {
+			break
+		}
+
+		return e.complexity.Highlight.Mine(childComplexity), true
+
+	case "Highlight.created":
+		if e.complexity.Highlight.Created == nil {
+			break
+		}
+
+		return e.complexity.Highlight.Created(childComplexity), true
+
+	case "Highlight.modified":
+		if e.complexity.Highlight.Modified == nil {
+			break
+		}
+
+		return e.complexity.Highlight.Modified(childComplexity), true
+
+	case "Identity.provider":
+		if e.complexity.Identity.Provider == nil {
+			break
+		}
+
+		return e.complexity.Identity.Provider(childComplexity), true
+
+	case "Identity.providerUserId":
+		if e.complexity.Identity.ProviderUserId == nil {
+			break
+		}
+
+		return e.complexity.Identity.ProviderUserId(childComplexity), true
+
+	case "Identity.created":
+		if e.complexity.Identity.Created == nil {
+			break
+		}
+
+		return e.complexity.Identity.Created(childComplexity), true
+
+	case "ImportResult.table":
+		if e.complexity.ImportResult.Table == nil {
+			break
+		}
+
+		return e.complexity.ImportResult.Table(childComplexity), true
+
+	case "ImportResult.imported":
+		if e.complexity.ImportResult.Imported == nil {
+			break
+		}
+
+		return e.complexity.ImportResult.Imported(childComplexity), true
+
+	case "IntegrityIssue.id":
+		if e.complexity.IntegrityIssue.Id == nil {
+			break
+		}
+
+		return e.complexity.IntegrityIssue.Id(childComplexity), true
+
+	case "IntegrityIssue.check":
+		if e.complexity.IntegrityIssue.Check == nil {
+			break
+		}
+
+		return e.complexity.IntegrityIssue.Check(childComplexity), true
+
+	case "IntegrityIssue.subject":
+		if e.complexity.IntegrityIssue.Subject == nil {
+			break
+		}
+
+		return e.complexity.IntegrityIssue.Subject(childComplexity), true
+
+	case "IntegrityIssue.message":
+		if e.complexity.IntegrityIssue.Message == nil {
+			break
+		}
+
+		return e.complexity.IntegrityIssue.Message(childComplexity), true
+
+	case "IntegrityIssue.repaired":
+		if e.complexity.IntegrityIssue.Repaired == nil {
+			break
+		}
+
+		return e.complexity.IntegrityIssue.Repaired(childComplexity), true
+
+	case "IntegrityIssue.created":
+		if e.complexity.IntegrityIssue.Created == nil {
+			break
+		}
+
+		return e.complexity.IntegrityIssue.Created(childComplexity), true
+
+	case "Invite.code":
+		if e.complexity.Invite.Code == nil {
+			break
+		}
+
+		return e.complexity.Invite.Code(childComplexity), true
+
+	case "Invite.created":
+		if e.complexity.Invite.Created == nil {
+			break
+		}
+
+		return e.complexity.Invite.Created(childComplexity), true
+
+	case "Invite.used":
+		if e.complexity.Invite.Used == nil {
+			break
+		}
+
+		return e.complexity.Invite.Used(childComplexity), true
+
+	case "Invite.usedBy":
+		if e.complexity.Invite.UsedBy == nil {
+			break
+		}
+
+		return e.complexity.Invite.UsedBy(childComplexity), true
+
+	case "Job.id":
+		if e.complexity.Job.Id == nil {
+			break
+		}
+
+		return e.complexity.Job.Id(childComplexity), true
+
+	case "Job.kind":
+		if e.complexity.Job.Kind == nil {
+			break
+		}
+
+		return e.complexity.Job.Kind(childComplexity), true
+
+	case "Job.status":
+		if e.complexity.Job.Status == nil {
+			break
+		}
+
+		return e.complexity.Job.Status(childComplexity), true
+
+	case "Job.total":
+		if e.complexity.Job.Total == nil {
+			break
+		}
+
+		return e.complexity.Job.Total(childComplexity), true
+
+	case "Job.done":
+		if e.complexity.Job.Done == nil {
+			break
+		}
+
+		return e.complexity.Job.Done(childComplexity), true
+
+	case "Job.error":
+		if e.complexity.Job.Error == nil {
+			break
+		}
+
+		return e.complexity.Job.Error(childComplexity), true
+
+	case "Job.payload":
+		if e.complexity.Job.Payload == nil {
+			break
+		}
+
+		return e.complexity.Job.Payload(childComplexity), true
+
+	case "Job.report":
+		if e.complexity.Job.Report == nil {
+			break
+		}
+
+		return e.complexity.Job.Report(childComplexity), true
+
+	case "Job.attempts":
+		if e.complexity.Job.Attempts == nil {
+			break
+		}
+
+		return e.complexity.Job.Attempts(childComplexity), true
+
+	case "Job.runAt":
+		if e.complexity.Job.RunAt == nil {
+			break
+		}
+
+		return e.complexity.Job.RunAt(childComplexity), true
+
+	case "Job.requestId":
+		if e.complexity.Job.RequestId == nil {
+			break
+		}
+
+		return e.complexity.Job.RequestId(childComplexity), true
+
+	case "Job.created":
+		if e.complexity.Job.Created == nil {
+			break
+		}
+
+		return e.complexity.Job.Created(childComplexity), true
+
+	case "Job.finished":
+		if e.complexity.Job.Finished == nil {
+			break
+		}
+
+		return e.complexity.Job.Finished(childComplexity), true
+
+	case "License.id":
+		if e.complexity.License.Id == nil {
+			break
+		}
+
+		return e.complexity.License.Id(childComplexity), true
+
+	case "License.spdx":
+		if e.complexity.License.Spdx == nil {
+			break
+		}
+
+		return e.complexity.License.Spdx(childComplexity), true
+
+	case "License.name":
+		if e.complexity.License.Name == nil {
+			break
+		}
+
+		return e.complexity.License.Name(childComplexity), true
+
+	case "License.url":
+		if e.complexity.License.Url == nil {
+			break
+		}
+
+		return e.complexity.License.Url(childComplexity), true
+
+	case "License.text":
+		if e.complexity.License.Text == nil {
+			break
+		}
+
+		return e.complexity.License.Text(childComplexity), true
+
+	case "License.html":
+		if e.complexity.License.Html == nil {
+			break
+		}
+
+		return e.complexity.License.Html(childComplexity), true
+
+	case "Link.id":
+		if e.complexity.Link.Id == nil {
+			break
+		}
+
+		return e.complexity.Link.Id(childComplexity), true
+
+	case "Link.title":
+		if e.complexity.Link.Title == nil {
+			break
+		}
+
+		return e.complexity.Link.Title(childComplexity), true
+
+	case "Link.uri":
+		if e.complexity.Link.Uri == nil {
+			break
+		}
+
+		return e.complexity.Link.Uri(childComplexity), true
+
+	case "Link.created":
+		if e.complexity.Link.Created == nil {
+			break
+		}
+
+		return e.complexity.Link.Created(childComplexity), true
+
+	case "Link.modified":
+		if e.complexity.Link.Modified == nil {
+			break
+		}
+
+		return e.complexity.Link.Modified(childComplexity), true
+
+	case "Link.description":
+		if e.complexity.Link.Description == nil {
+			break
+		}
+
+		return e.complexity.Link.Description(childComplexity), true
+
+	case "Link.screenshot":
+		if e.complexity.Link.Screenshot == nil {
+			break
+		}
+
+		return e.complexity.Link.Screenshot(childComplexity), true
+
+	case "Link.tags":
+		if e.complexity.Link.Tags == nil {
+			break
+		}
+
+		return e.complexity.Link.Tags(childComplexity), true
+
+	case "Link.checked":
+		if e.complexity.Link.Checked == nil {
+			break
+		}
+
+		return e.complexity.Link.Checked(childComplexity), true
+
+	case "Link.statusCode":
+		if e.complexity.Link.StatusCode == nil {
+			break
+		}
+
+		return e.complexity.Link.StatusCode(childComplexity), true
+
+	case "Link.dead":
+		if e.complexity.Link.Dead == nil {
+			break
+		}
+
+		return e.complexity.Link.Dead(childComplexity), true
+
+	case "Log.id":
+		if e.complexity.Log.Id == nil {
+			break
+		}
+
+		return e.complexity.Log.Id(childComplexity), true
+
+	case "Log.userId":
+		if e.complexity.Log.UserId == nil {
+			break
+		}
+
+		return e.complexity.Log.UserId(childComplexity), true
+
+	case "Log.groupId":
+		if e.complexity.Log.GroupId == nil {
+			break
+		}
+
+		return e.complexity.Log.GroupId(childComplexity), true
+
+	case "Log.content":
+		if e.complexity.Log.Content == nil {
+			break
+		}
+
+		return e.complexity.Log.Content(childComplexity), true
+
+	case "Log.location":
+		if e.complexity.Log.Location == nil {
+			break
+		}
+
+		return e.complexity.Log.Location(childComplexity), true
+
+	case "Log.datetime":
+		if e.complexity.Log.Datetime == nil {
+			break
+		}
+
+		return e.complexity.Log.Datetime(childComplexity), true
+
+	case "Log.created":
+		if e.complexity.Log.Created == nil {
+			break
+		}
+
+		return e.complexity.Log.Created(childComplexity), true
+
+	case "Log.modified":
+		if e.complexity.Log.Modified == nil {
+			break
+		}
+
+		return e.complexity.Log.Modified(childComplexity), true
+
+	case "MetadataEntry.key":
+		if e.complexity.MetadataEntry.Key == nil {
+			break
+		}
+
+		return e.complexity.MetadataEntry.Key(childComplexity), true
+
+	case "MetadataEntry.value":
+		if e.complexity.MetadataEntry.Value == nil {
+			break
+		}
+
+		return e.complexity.MetadataEntry.Value(childComplexity), true
+
+	case "MetadataKey.name":
+		if e.complexity.MetadataKey.Name == nil {
+			break
+		}
+
+		return e.complexity.MetadataKey.Name(childComplexity), true
+
+	case "MetadataKey.type":
+		if e.complexity.MetadataKey.Type == nil {
+			break
+		}
+
+		return e.complexity.MetadataKey.Type(childComplexity), true
+
+	case "MetadataKey.description":
+		if e.complexity.MetadataKey.Description == nil {
+			break
+		}
+
+		return e.complexity.MetadataKey.Description(childComplexity), true
+
+	case "MonthCount.month":
+		if e.complexity.MonthCount.Month == nil {
+			break
+		}
+
+		return e.complexity.MonthCount.Month(childComplexity), true
+
+	case "MonthCount.books":
+		if e.complexity.MonthCount.Books == nil {
+			break
+		}
+
+		return e.complexity.MonthCount.Books(childComplexity), true
+
+	case "MonthCount.pages":
+		if e.complexity.MonthCount.Pages == nil {
+			break
+		}
+
+		return e.complexity.MonthCount.Pages(childComplexity), true
+
+	case "Mutation.createPost":
+		if e.complexity.Mutation.CreatePost == nil {
+			break
+		}
+
+		args, err := field_Mutation_createPost_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreatePost(childComplexity, args["input"].(NewPost)), true
+
+	case "Mutation.editPost":
+		if e.complexity.Mutation.EditPost == nil {
+			break
+		}
+
+		args, err := field_Mutation_editPost_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.EditPost(childComplexity, args["Id"].(string), args["input"].(NewPost), args["version"].(int)), true
+
+	case "Mutation.createLink":
+		if e.complexity.Mutation.CreateLink == nil {
+			break
+		}
+
+		args, err := field_Mutation_createLink_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateLink(childComplexity, args["input"].(NewLink)), true
+
+	case "Mutation.saveLink":
+		if e.complexity.Mutation.SaveLink == nil {
+			break
+		}
+
+		args, err := field_Mutation_saveLink_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SaveLink(childComplexity, args["url"].(string), args["tags"].([]string)), true
+
+	case "Mutation.upsertStat":
+		if e.complexity.Mutation.UpsertStat == nil {
+			break
+		}
+
+		args, err := field_Mutation_upsertStat_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpsertStat(childComplexity, args["input"].(NewStat)), true
+
+	case "Mutation.revertPost":
+		if e.complexity.Mutation.RevertPost == nil {
+			break
+		}
+
+		args, err := field_Mutation_revertPost_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevertPost(childComplexity, args["id"].(string), args["revision"].(int), args["version"].(*int)), true
+
+	case "Mutation.setPostMetadata":
+		if e.complexity.Mutation.SetPostMetadata == nil {
+			break
+		}
+
+		args, err := field_Mutation_setPostMetadata_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetPostMetadata(childComplexity, args["id"].(string), args["metadata"].([]MetadataInput)), true
+
+	case "Mutation.setPostPassword":
+		if e.complexity.Mutation.SetPostPassword == nil {
+			break
+		}
+
+		args, err := field_Mutation_setPostPassword_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetPostPassword(childComplexity, args["id"].(string), args["password"].(*string)), true
+
+	case "Mutation.unlockPost":
+		if e.complexity.Mutation.UnlockPost == nil {
+			break
+		}
+
+		args, err := field_Mutation_unlockPost_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnlockPost(childComplexity, args["id"].(string), args["password"].(string)), true
+
+	case "Mutation.createServiceAccount":
+		if e.complexity.Mutation.CreateServiceAccount == nil {
+			break
+		}
+
+		args, err := field_Mutation_createServiceAccount_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateServiceAccount(childComplexity, args["input"].(NewServiceAccount)), true
+
+	case "Mutation.rotateServiceAccountSecret":
+		if e.complexity.Mutation.RotateServiceAccountSecret == nil {
+			break
+		}
+
+		args, err := field_Mutation_rotateServiceAccountSecret_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RotateServiceAccountSecret(childComplexity, args["id"].(string)), true
+
+	case "Mutation.revokeServiceAccountSecret":
+		if e.complexity.Mutation.RevokeServiceAccountSecret == nil {
+			break
+		}
+
+		args, err := field_Mutation_revokeServiceAccountSecret_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeServiceAccountSecret(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteServiceAccount":
+		if e.complexity.Mutation.DeleteServiceAccount == nil {
+			break
+		}
+
+		args, err := field_Mutation_deleteServiceAccount_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteServiceAccount(childComplexity, args["id"].(string)), true
+
+	case "Mutation.createOIDCClient":
+		if e.complexity.Mutation.CreateOidcclient == nil {
+			break
+		}
+
+		args, err := field_Mutation_createOIDCClient_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateOidcclient(childComplexity, args["input"].(NewOIDCClient)), true
+
+	case "Mutation.deleteOIDCClient":
+		if e.complexity.Mutation.DeleteOidcclient == nil {
+			break
+		}
+
+		args, err := field_Mutation_deleteOIDCClient_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteOidcclient(childComplexity, args["id"].(string)), true
+
+	case "Mutation.provisionUsers":
+		if e.complexity.Mutation.ProvisionUsers == nil {
+			break
+		}
+
+		args, err := field_Mutation_provisionUsers_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ProvisionUsers(childComplexity, args["input"].([]NewUser)), true
+
+	case "Mutation.deactivateUsers":
+		if e.complexity.Mutation.DeactivateUsers == nil {
+			break
+		}
+
+		args, err := field_Mutation_deactivateUsers_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeactivateUsers(childComplexity, args["ids"].([]string)), true
+
+	case "Mutation.reactivateUsers":
+		if e.complexity.Mutation.ReactivateUsers == nil {
+			break
+		}
+
+		args, err := field_Mutation_reactivateUsers_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ReactivateUsers(childComplexity, args["ids"].([]string)), true
+
+	case "Mutation.deactivateUsersMatching":
+		if e.complexity.Mutation.DeactivateUsersMatching == nil {
+			break
+		}
+
+		args, err := field_Mutation_deactivateUsersMatching_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeactivateUsersMatching(childComplexity, args["filter"].(UserFilter)), true
+
+	case "Mutation.reassignUserContent":
+		if e.complexity.Mutation.ReassignUserContent == nil {
+			break
+		}
+
+		args, err := field_Mutation_reassignUserContent_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ReassignUserContent(childComplexity, args["from"].(string), args["to"].(string)), true
+
+	case "Mutation.mergeUsers":
+		if e.complexity.Mutation.MergeUsers == nil {
+			break
+		}
+
+		args, err := field_Mutation_mergeUsers_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.MergeUsers(childComplexity, args["from"].(string), args["into"].(string)), true
+
+	case "Mutation.requeueJob":
+		if e.complexity.Mutation.RequeueJob == nil {
+			break
+		}
+
+		args, err := field_Mutation_requeueJob_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RequeueJob(childComplexity, args["id"].(string)), true
+
+	case "Mutation.createInvite":
+		if e.complexity.Mutation.CreateInvite == nil {
+			break
+		}
+
+		return e.complexity.Mutation.CreateInvite(childComplexity), true
+
+	case "Mutation.createSite":
+		if e.complexity.Mutation.CreateSite == nil {
+			break
+		}
+
+		args, err := field_Mutation_createSite_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateSite(childComplexity, args["host"].(string), args["allowedUsers"].([]string)), true
+
+	case "Mutation.updateSite":
+		if e.complexity.Mutation.UpdateSite == nil {
+			break
+		}
+
+		args, err := field_Mutation_updateSite_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateSite(childComplexity, args["id"].(string), args["host"].(*string), args["allowedUsers"].([]string)), true
+
+	case "Mutation.updateSiteSettings":
+		if e.complexity.Mutation.UpdateSiteSettings == nil {
+			break
+		}
+
+		args, err := field_Mutation_updateSiteSettings_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateSiteSettings(childComplexity, args["input"].(SiteSettingsInput)), true
+
+	case "Mutation.updateTheme":
+		if e.complexity.Mutation.UpdateTheme == nil {
+			break
+		}
+
+		args, err := field_Mutation_updateTheme_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateTheme(childComplexity, args["input"].(ThemeInput)), true
+
+	case "Mutation.resetTheme":
+		if e.complexity.Mutation.ResetTheme == nil {
+			break
+		}
+
+		return e.complexity.Mutation.ResetTheme(childComplexity), true
+
+	case "Mutation.createRedirect":
+		if e.complexity.Mutation.CreateRedirect == nil {
+			break
+		}
+
+		args, err := field_Mutation_createRedirect_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateRedirect(childComplexity, args["input"].(NewRedirect)), true
+
+	case "Mutation.updateRedirect":
+		if e.complexity.Mutation.UpdateRedirect == nil {
+			break
+		}
+
+		args, err := field_Mutation_updateRedirect_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateRedirect(childComplexity, args["id"].(string), args["input"].(NewRedirect)), true
+
+	case "Mutation.deleteRedirect":
+		if e.complexity.Mutation.DeleteRedirect == nil {
+			break
+		}
+
+		args, err := field_Mutation_deleteRedirect_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteRedirect(childComplexity, args["id"].(string)), true
+
+	case "Mutation.createShortLink":
+		if e.complexity.Mutation.CreateShortLink == nil {
+			break
+		}
+
+		args, err := field_Mutation_createShortLink_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateShortLink(childComplexity, args["url"].(string), args["slug"].(*string)), true
+
+	case "Mutation.addBook":
+		if e.complexity.Mutation.AddBook == nil {
+			break
+		}
+
+		args, err := field_Mutation_addBook_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddBook(childComplexity, args["input"].(NewBook)), true
+
+	case "Mutation.upsertPage":
+		if e.complexity.Mutation.UpsertPage == nil {
+			break
+		}
+
+		args, err := field_Mutation_upsertPage_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpsertPage(childComplexity, args["slug"].(string), args["content"].(string), args["title"].(*string), args["draft"].(*bool), args["version"].(*int)), true
+
+	case "Mutation.markRead":
+		if e.complexity.Mutation.MarkRead == nil {
+			break
+		}
+
+		args, err := field_Mutation_markRead_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.MarkRead(childComplexity, args["id"].(string), args["finished"].(*time.Time), args["rating"].(*int)), true
+
+	case "Mutation.importGoodreads":
+		if e.complexity.Mutation.ImportGoodreads == nil {
+			break
+		}
+
+		args, err := field_Mutation_importGoodreads_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ImportGoodreads(childComplexity, args["csv"].(string)), true
+
+	case "Mutation.createWebhook":
+		if e.complexity.Mutation.CreateWebhook == nil {
+			break
+		}
+
+		args, err := field_Mutation_createWebhook_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateWebhook(childComplexity, args["input"].(NewWebhook)), true
+
+	case "Mutation.deleteWebhook":
+		if e.complexity.Mutation.DeleteWebhook == nil {
+			break
+		}
+
+		args, err := field_Mutation_deleteWebhook_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteWebhook(childComplexity, args["id"].(string)), true
+
+	case "Mutation.createAutomationRule":
+		if e.complexity.Mutation.CreateAutomationRule == nil {
+			break
+		}
+
+		args, err := field_Mutation_createAutomationRule_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateAutomationRule(childComplexity, args["input"].(AutomationRuleInput)), true
+
+	case "Mutation.updateAutomationRule":
+		if e.complexity.Mutation.UpdateAutomationRule == nil {
+			break
+		}
+
+		args, err := field_Mutation_updateAutomationRule_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateAutomationRule(childComplexity, args["id"].(string), args["input"].(AutomationRuleInput)), true
+
+	case "Mutation.deleteAutomationRule":
+		if e.complexity.Mutation.DeleteAutomationRule == nil {
+			break
+		}
+
+		args, err := field_Mutation_deleteAutomationRule_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteAutomationRule(childComplexity, args["id"].(string)), true
+
+	case "Mutation.testAutomationRule":
+		if e.complexity.Mutation.TestAutomationRule == nil {
+			break
+		}
+
+		args, err := field_Mutation_testAutomationRule_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.TestAutomationRule(childComplexity, args["condition"].(string), args["postId"].(string)), true
+
+	case "Mutation.checkIntegrity":
+		if e.complexity.Mutation.CheckIntegrity == nil {
+			break
+		}
+
+		args, err := field_Mutation_checkIntegrity_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CheckIntegrity(childComplexity, args["repair"].(*bool)), true
+
+	case "Mutation.exportData":
+		if e.complexity.Mutation.ExportData == nil {
+			break
+		}
+
+		return e.complexity.Mutation.ExportData(childComplexity), true
+
+	case "Mutation.exportHugo":
+		if e.complexity.Mutation.ExportHugo == nil {
+			break
+		}
+
+		args, err := field_Mutation_exportHugo_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ExportHugo(childComplexity, args["media"].(*bool)), true
+
+	case "Mutation.importData":
+		if e.complexity.Mutation.ImportData == nil {
+			break
+		}
+
+		args, err := field_Mutation_importData_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ImportData(childComplexity, args["archive"].(string)), true
+
+	case "Mutation.importWordPress":
+		if e.complexity.Mutation.ImportWordPress == nil {
+			break
+		}
+
+		args, err := field_Mutation_importWordPress_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ImportWordPress(childComplexity, args["file"].(string), args["baseURL"].(*string)), true
+
+	case "Mutation.importStaticSite":
+		if e.complexity.Mutation.ImportStaticSite == nil {
+			break
+		}
+
+		args, err := field_Mutation_importStaticSite_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ImportStaticSite(childComplexity, args["archive"].(string), args["baseURL"].(*string), args["permalink"].(*string)), true
+
+	case "Mutation.purgeCache":
+		if e.complexity.Mutation.PurgeCache == nil {
+			break
+		}
+
+		args, err := field_Mutation_purgeCache_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PurgeCache(childComplexity, args["paths"].([]string)), true
+
+	case "Mutation.insertLog":
+		if e.complexity.Mutation.InsertLog == nil {
+			break
+		}
+
+		args, err := field_Mutation_insertLog_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.InsertLog(childComplexity, args["input"].(NewLog)), true
+
+	case "Mutation.shareLog":
+		if e.complexity.Mutation.ShareLog == nil {
+			break
+		}
+
+		args, err := field_Mutation_shareLog_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ShareLog(childComplexity, args["id"].(string), args["groupId"].(*string)), true
+
+	case "Mutation.createGroup":
+		if e.complexity.Mutation.CreateGroup == nil {
+			break
+		}
+
+		args, err := field_Mutation_createGroup_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateGroup(childComplexity, args["name"].(string)), true
+
+	case "Mutation.createGroupInvite":
+		if e.complexity.Mutation.CreateGroupInvite == nil {
+			break
+		}
+
+		args, err := field_Mutation_createGroupInvite_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateGroupInvite(childComplexity, args["groupId"].(string)), true
+
+	case "Mutation.joinGroup":
+		if e.complexity.Mutation.JoinGroup == nil {
+			break
+		}
+
+		args, err := field_Mutation_joinGroup_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.JoinGroup(childComplexity, args["code"].(string)), true
+
+	case "Mutation.leaveGroup":
+		if e.complexity.Mutation.LeaveGroup == nil {
+			break
+		}
+
+		args, err := field_Mutation_leaveGroup_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.LeaveGroup(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteGroup":
+		if e.complexity.Mutation.DeleteGroup == nil {
+			break
+		}
+
+		args, err := field_Mutation_deleteGroup_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteGroup(childComplexity, args["id"].(string)), true
+
+	case "Mutation.revokeSession":
+		if e.complexity.Mutation.RevokeSession == nil {
+			break
+		}
+
+		args, err := field_Mutation_revokeSession_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeSession(childComplexity, args["id"].(string)), true
+
+	case "Mutation.revokeAllOtherSessions":
+		if e.complexity.Mutation.RevokeAllOtherSessions == nil {
+			break
+		}
+
+		return e.complexity.Mutation.RevokeAllOtherSessions(childComplexity), true
+
+	case "Mutation.readLater":
+		if e.complexity.Mutation.ReadLater == nil {
+			break
+		}
+
+		args, err := field_Mutation_readLater_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ReadLater(childComplexity, args["url"].(*string), args["postId"].(*string)), true
+
+	case "Mutation.removeReadLater":
+		if e.complexity.Mutation.RemoveReadLater == nil {
+			break
+		}
+
+		args, err := field_Mutation_removeReadLater_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveReadLater(childComplexity, args["id"].(string)), true
+
+	case "Mutation.createHighlight":
+		if e.complexity.Mutation.CreateHighlight == nil {
+			break
+		}
+
+		args, err := field_Mutation_createHighlight_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateHighlight(childComplexity, args["input"].(NewHighlight)), true
+
+	case "Mutation.updateHighlight":
+		if e.complexity.Mutation.UpdateHighlight == nil {
+			break
+		}
+
+		args, err := field_Mutation_updateHighlight_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateHighlight(childComplexity, args["id"].(string), args["note"].(*string), args["public"].(*bool)), true
+
+	case "Mutation.deleteHighlight":
+		if e.complexity.Mutation.DeleteHighlight == nil {
+			break
+		}
+
+		args, err := field_Mutation_deleteHighlight_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteHighlight(childComplexity, args["id"].(string)), true
+
+	case "Mutation.readingProgress":
+		if e.complexity.Mutation.ReadingProgress == nil {
+			break
+		}
+
+		args, err := field_Mutation_readingProgress_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ReadingProgress(childComplexity, args["postId"].(string), args["percent"].(int)), true
+
+	case "Mutation.updateNotificationSettings":
+		if e.complexity.Mutation.UpdateNotificationSettings == nil {
+			break
+		}
+
+		args, err := field_Mutation_updateNotificationSettings_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateNotificationSettings(childComplexity, args["input"].(NotificationSettingsInput)), true
+
+	case "NavItem.label":
+		if e.complexity.NavItem.Label == nil {
+			break
+		}
+
+		return e.complexity.NavItem.Label(childComplexity), true
+
+	case "NavItem.url":
+		if e.complexity.NavItem.Url == nil {
+			break
+		}
+
+		return e.complexity.NavItem.Url(childComplexity), true
+
+	case "NotificationSettings.email":
+		if e.complexity.NotificationSettings.Email == nil {
+			break
+		}
+
+		return e.complexity.NotificationSettings.Email(childComplexity), true
+
+	case "NotificationSettings.comments":
+		if e.complexity.NotificationSettings.Comments == nil {
+			break
+		}
+
+		return e.complexity.NotificationSettings.Comments(childComplexity), true
+
+	case "NotificationSettings.digest":
+		if e.complexity.NotificationSettings.Digest == nil {
+			break
+		}
+
+		return e.complexity.NotificationSettings.Digest(childComplexity), true
+
+	case "NotificationSettings.authAlerts":
+		if e.complexity.NotificationSettings.AuthAlerts == nil {
+			break
+		}
+
+		return e.complexity.NotificationSettings.AuthAlerts(childComplexity), true
+
+	case "NotificationSettings.integrityAlerts":
+		if e.complexity.NotificationSettings.IntegrityAlerts == nil {
+			break
+		}
+
+		return e.complexity.NotificationSettings.IntegrityAlerts(childComplexity), true
+
+	case "NotificationSettings.commentsDelivery":
+		if e.complexity.NotificationSettings.CommentsDelivery == nil {
+			break
+		}
+
+		return e.complexity.NotificationSettings.CommentsDelivery(childComplexity), true
+
+	case "NotificationSettings.authAlertsDelivery":
+		if e.complexity.NotificationSettings.AuthAlertsDelivery == nil {
+			break
+		}
+
+		return e.complexity.NotificationSettings.AuthAlertsDelivery(childComplexity), true
+
+	case "NotificationSettings.timezone":
+		if e.complexity.NotificationSettings.Timezone == nil {
+			break
+		}
+
+		return e.complexity.NotificationSettings.Timezone(childComplexity), true
+
+	case "NotificationSettings.quietStart":
+		if e.complexity.NotificationSettings.QuietStart == nil {
+			break
+		}
+
+		return e.complexity.NotificationSettings.QuietStart(childComplexity), true
+
+	case "NotificationSettings.quietEnd":
+		if e.complexity.NotificationSettings.QuietEnd == nil {
+			break
+		}
+
+		return e.complexity.NotificationSettings.QuietEnd(childComplexity), true
+
+	case "OIDCClient.id":
+		if e.complexity.Oidcclient.Id == nil {
+			break
+		}
+
+		return e.complexity.Oidcclient.Id(childComplexity), true
+
+	case "OIDCClient.name":
+		if e.complexity.Oidcclient.Name == nil {
+			break
+		}
+
+		return e.complexity.Oidcclient.Name(childComplexity), true
+
+	case "OIDCClient.redirectURIs":
+		if e.complexity.Oidcclient.RedirectUris == nil {
+			break
+		}
+
+		return e.complexity.Oidcclient.RedirectUris(childComplexity), true
+
+	case "OIDCClient.created":
+		if e.complexity.Oidcclient.Created == nil {
+			break
+		}
+
+		return e.complexity.Oidcclient.Created(childComplexity), true
+
+	case "OIDCClientCredentials.client":
+		if e.complexity.OidcclientCredentials.Client == nil {
+			break
+		}
+
+		return e.complexity.OidcclientCredentials.Client(childComplexity), true
+
+	case "OIDCClientCredentials.secret":
+		if e.complexity.OidcclientCredentials.Secret == nil {
+			break
+		}
+
+		return e.complexity.OidcclientCredentials.Secret(childComplexity), true
+
+	case "Page.slug":
+		if e.complexity.Page.Slug == nil {
+			break
+		}
+
+		return e.complexity.Page.Slug(childComplexity), true
+
+	case "Page.title":
+		if e.complexity.Page.Title == nil {
+			break
+		}
+
+		return e.complexity.Page.Title(childComplexity), true
+
+	case "Page.content":
+		if e.complexity.Page.Content == nil {
+			break
+		}
+
+		return e.complexity.Page.Content(childComplexity), true
+
+	case "Page.html":
+		if e.complexity.Page.Html == nil {
+			break
+		}
+
+		return e.complexity.Page.Html(childComplexity), true
+
+	case "Page.draft":
+		if e.complexity.Page.Draft == nil {
+			break
+		}
+
+		return e.complexity.Page.Draft(childComplexity), true
+
+	case "Page.created":
+		if e.complexity.Page.Created == nil {
+			break
+		}
+
+		return e.complexity.Page.Created(childComplexity), true
+
+	case "Page.modified":
+		if e.complexity.Page.Modified == nil {
+			break
+		}
+
+		return e.complexity.Page.Modified(childComplexity), true
+
+	case "Page.version":
+		if e.complexity.Page.Version == nil {
+			break
+		}
+
+		return e.complexity.Page.Version(childComplexity), true
+
+	case "PageViewStats.views":
+		if e.complexity.PageViewStats.Views == nil {
+			break
+		}
+
+		return e.complexity.PageViewStats.Views(childComplexity), true
+
+	case "PageViewStats.visitors":
+		if e.complexity.PageViewStats.Visitors == nil {
+			break
+		}
+
+		return e.complexity.PageViewStats.Visitors(childComplexity), true
+
+	case "PageViewStats.referrers":
+		if e.complexity.PageViewStats.Referrers == nil {
+			break
+		}
+
+		return e.complexity.PageViewStats.Referrers(childComplexity), true
+
+	case "PageViewStats.days":
+		if e.complexity.PageViewStats.Days == nil {
+			break
+		}
+
+		return e.complexity.PageViewStats.Days(childComplexity), true
+
+	case "Post.id":
+		if e.complexity.Post.Id == nil {
+			break
+		}
+
+		return e.complexity.Post.Id(childComplexity), true
+
+	case "Post.title":
+		if e.complexity.Post.Title == nil {
+			break
+		}
+
+		return e.complexity.Post.Title(childComplexity), true
+
+	case "Post.content":
+		if e.complexity.Post.Content == nil {
+			break
+		}
+
+		return e.complexity.Post.Content(childComplexity), true
+
+	case "Post.summary":
+		if e.complexity.Post.Summary == nil {
+			break
+		}
+
+		return e.complexity.Post.Summary(childComplexity), true
+
+	case "Post.readtime":
+		if e.complexity.Post.Readtime == nil {
+			break
+		}
+
+		return e.complexity.Post.Readtime(childComplexity), true
+
+	case "Post.html":
+		if e.complexity.Post.Html == nil {
+			break
+		}
+
+		return e.complexity.Post.Html(childComplexity), true
+
+	case "Post.datetime":
+		if e.complexity.Post.Datetime == nil {
+			break
+		}
+
+		return e.complexity.Post.Datetime(childComplexity), true
+
+	case "Post.created":
+		if e.complexity.Post.Created == nil {
+			break
+		}
+
+		return e.complexity.Post.Created(childComplexity), true
+
+	case "Post.modified":
+		if e.complexity.Post.Modified == nil {
+			break
+		}
+
+		return e.complexity.Post.Modified(childComplexity), true
+
+	case "Post.draft":
+		if e.complexity.Post.Draft == nil {
+			break
+		}
+
+		return e.complexity.Post.Draft(childComplexity), true
+
+	case "Post.tags":
+		if e.complexity.Post.Tags == nil {
+			break
+		}
+
+		return e.complexity.Post.Tags(childComplexity), true
+
+	case "Post.protected":
+		if e.complexity.Post.Protected == nil {
+			break
+		}
+
+		return e.complexity.Post.Protected(childComplexity), true
+
+	case "Post.links":
+		if e.complexity.Post.Links == nil {
+			break
+		}
+
+		return e.complexity.Post.Links(childComplexity), true
+
+	case "Post.revisions":
+		if e.complexity.Post.Revisions == nil {
+			break
+		}
+
+		args, err := field_Post_revisions_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Post.Revisions(childComplexity, args["limit"].(*int), args["offset"].(*int)), true
+
+	case "Post.syndicationUrls":
+		if e.complexity.Post.SyndicationUrls == nil {
+			break
+		}
+
+		return e.complexity.Post.SyndicationUrls(childComplexity), true
+
+	case "Post.metadata":
+		if e.complexity.Post.Metadata == nil {
+			break
+		}
+
+		return e.complexity.Post.Metadata(childComplexity), true
+
+	case "Post.canonicalURL":
+		if e.complexity.Post.CanonicalUrl == nil {
+			break
+		}
+
+		return e.complexity.Post.CanonicalUrl(childComplexity), true
+
+	case "Post.originallyPublishedAt":
+		if e.complexity.Post.OriginallyPublishedAt == nil {
+			break
+		}
+
+		return e.complexity.Post.OriginallyPublishedAt(childComplexity), true
+
+	case "Post.license":
+		if e.complexity.Post.License == nil {
+			break
+		}
+
+		return e.complexity.Post.License(childComplexity), true
+
+	case "Post.version":
+		if e.complexity.Post.Version == nil {
+			break
+		}
+
+		return e.complexity.Post.Version(childComplexity), true
+
+	case "Query.allPosts":
+		if e.complexity.Query.AllPosts == nil {
+			break
+		}
+
+		return e.complexity.Query.AllPosts(childComplexity), true
+
+	case "Query.drafts":
+		if e.complexity.Query.Drafts == nil {
+			break
+		}
+
+		return e.complexity.Query.Drafts(childComplexity), true
+
+	case "Query.posts":
+		if e.complexity.Query.Posts == nil {
+			break
+		}
+
+		args, err := field_Query_posts_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Posts(childComplexity, args["limit"].(*int), args["offset"].(*int)), true
+
+	case "Query.post":
+		if e.complexity.Query.Post == nil {
+			break
+		}
+
+		args, err := field_Query_post_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Post(childComplexity, args["id"].(string), args["unlock"].(*string)), true
+
+	case "Query.suggestPosts":
+		if e.complexity.Query.SuggestPosts == nil {
+			break
+		}
+
+		args, err := field_Query_suggestPosts_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SuggestPosts(childComplexity, args["path"].(string), args["limit"].(*int)), true
+
+	case "Query.nextPost":
+		if e.complexity.Query.NextPost == nil {
+			break
+		}
+
+		args, err := field_Query_nextPost_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.NextPost(childComplexity, args["id"].(string)), true
+
+	case "Query.prevPost":
+		if e.complexity.Query.PrevPost == nil {
+			break
+		}
+
+		args, err := field_Query_prevPost_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PrevPost(childComplexity, args["id"].(string)), true
+
+	case "Query.tags":
+		if e.complexity.Query.Tags == nil {
+			break
+		}
+
+		return e.complexity.Query.Tags(childComplexity), true
+
+	case "Query.sitemapPosts":
+		if e.complexity.Query.SitemapPosts == nil {
+			break
+		}
+
+		return e.complexity.Query.SitemapPosts(childComplexity), true
+
+	case "Query.metadataKeys":
+		if e.complexity.Query.MetadataKeys == nil {
+			break
+		}
+
+		return e.complexity.Query.MetadataKeys(childComplexity), true
+
+	case "Query.allLinks":
+		if e.complexity.Query.AllLinks == nil {
+			break
+		}
+
+		return e.complexity.Query.AllLinks(childComplexity), true
+
+	case "Query.links":
+		if e.complexity.Query.Links == nil {
+			break
+		}
+
+		args, err := field_Query_links_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Links(childComplexity, args["filter"].(*LinkFilter), args["limit"].(*int), args["offset"].(*int)), true
+
+	case "Query.link":
+		if e.complexity.Query.Link == nil {
+			break
+		}
+
+		args, err := field_Query_link_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Link(childComplexity, args["id"].(string)), true
+
+	case "Query.stats":
+		if e.complexity.Query.Stats == nil {
+			break
+		}
+
+		args, err := field_Query_stats_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Stats(childComplexity, args["count"].(*int)), true
+
+	case "Query.postDiff":
+		if e.complexity.Query.PostDiff == nil {
+			break
+		}
+
+		args, err := field_Query_postDiff_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PostDiff(childComplexity, args["id"].(string), args["from"].(int), args["to"].(int)), true
+
+	case "Query.serviceAccounts":
+		if e.complexity.Query.ServiceAccounts == nil {
+			break
+		}
+
+		return e.complexity.Query.ServiceAccounts(childComplexity), true
+
+	case "Query.oidcClients":
+		if e.complexity.Query.OidcClients == nil {
+			break
+		}
+
+		return e.complexity.Query.OidcClients(childComplexity), true
+
+	case "Query.users":
+		if e.complexity.Query.Users == nil {
+			break
+		}
+
+		args, err := field_Query_users_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Users(childComplexity, args["filter"].(*UserFilter), args["limit"].(*int), args["offset"].(*int)), true
+
+	case "Query.invites":
+		if e.complexity.Query.Invites == nil {
+			break
+		}
+
+		return e.complexity.Query.Invites(childComplexity), true
+
+	case "Query.jobs":
+		if e.complexity.Query.Jobs == nil {
+			break
+		}
+
+		args, err := field_Query_jobs_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Jobs(childComplexity, args["limit"].(*int), args["status"].(*JobStatus), args["requestId"].(*string)), true
+
+	case "Query.job":
+		if e.complexity.Query.Job == nil {
+			break
+		}
+
+		args, err := field_Query_job_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Job(childComplexity, args["id"].(string)), true
+
+	case "Query.sites":
+		if e.complexity.Query.Sites == nil {
+			break
+		}
+
+		return e.complexity.Query.Sites(childComplexity), true
+
+	case "Query.siteSettings":
+		if e.complexity.Query.SiteSettings == nil {
+			break
+		}
+
+		return e.complexity.Query.SiteSettings(childComplexity), true
+
+	case "Query.theme":
+		if e.complexity.Query.Theme == nil {
+			break
+		}
+
+		return e.complexity.Query.Theme(childComplexity), true
+
+	case "Query.redirects":
+		if e.complexity.Query.Redirects == nil {
+			break
+		}
+
+		return e.complexity.Query.Redirects(childComplexity), true
+
+	case "Query.shortLinks":
+		if e.complexity.Query.ShortLinks == nil {
+			break
+		}
+
+		return e.complexity.Query.ShortLinks(childComplexity), true
+
+	case "Query.shortLink":
+		if e.complexity.Query.ShortLink == nil {
+			break
+		}
+
+		args, err := field_Query_shortLink_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ShortLink(childComplexity, args["slug"].(string)), true
+
+	case "Query.logs":
+		if e.complexity.Query.Logs == nil {
+			break
+		}
+
+		args, err := field_Query_logs_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Logs(childComplexity, args["range"].(*DateRange)), true
+
+	case "Query.sharedWithMe":
+		if e.complexity.Query.SharedWithMe == nil {
+			break
+		}
+
+		args, err := field_Query_sharedWithMe_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SharedWithMe(childComplexity, args["range"].(*DateRange)), true
+
+	case "Query.groups":
+		if e.complexity.Query.Groups == nil {
+			break
+		}
+
+		return e.complexity.Query.Groups(childComplexity), true
+
+	case "Query.mySessions":
+		if e.complexity.Query.MySessions == nil {
+			break
+		}
+
+		return e.complexity.Query.MySessions(childComplexity), true
+
+	case "Query.readLater":
+		if e.complexity.Query.ReadLater == nil {
+			break
+		}
+
+		args, err := field_Query_readLater_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ReadLater(childComplexity, args["order"].(*ReadLaterOrder), args["limit"].(*int), args["offset"].(*int)), true
+
+	case "Query.readingProgress":
+		if e.complexity.Query.ReadingProgress == nil {
+			break
+		}
+
+		args, err := field_Query_readingProgress_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ReadingProgress(childComplexity, args["postId"].(string)), true
+
+	case "Query.highlights":
+		if e.complexity.Query.Highlights == nil {
+			break
+		}
+
+		args, err := field_Query_highlights_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Highlights(childComplexity, args["postId"].(string), args["includePublic"].(*bool)), true
+
+	case "Query.exportHighlights":
+		if e.complexity.Query.ExportHighlights == nil {
+			break
+		}
+
+		return e.complexity.Query.ExportHighlights(childComplexity), true
+
+	case "Query.adminStats":
+		if e.complexity.Query.AdminStats == nil {
+			break
+		}
+
+		return e.complexity.Query.AdminStats(childComplexity), true
+
+	case "Query.goroutines":
+		if e.complexity.Query.Goroutines == nil {
+			break
+		}
+
+		return e.complexity.Query.Goroutines(childComplexity), true
+
+	case "Query.debugInfo":
+		if e.complexity.Query.DebugInfo == nil {
+			break
+		}
+
+		return e.complexity.Query.DebugInfo(childComplexity), true
+
+	case "Query.webhooks":
+		if e.complexity.Query.Webhooks == nil {
+			break
+		}
+
+		return e.complexity.Query.Webhooks(childComplexity), true
+
+	case "Query.webhookDeliveries":
+		if e.complexity.Query.WebhookDeliveries == nil {
+			break
+		}
+
+		args, err := field_Query_webhookDeliveries_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.WebhookDeliveries(childComplexity, args["webhookId"].(*string), args["failed"].(*bool), args["requestId"].(*string), args["limit"].(*int)), true
+
+	case "Query.automationRules":
+		if e.complexity.Query.AutomationRules == nil {
+			break
+		}
+
+		return e.complexity.Query.AutomationRules(childComplexity), true
+
+	case "Query.integrityIssues":
+		if e.complexity.Query.IntegrityIssues == nil {
+			break
+		}
+
+		return e.complexity.Query.IntegrityIssues(childComplexity), true
+
+	case "Query.pageViews":
+		if e.complexity.Query.PageViews == nil {
+			break
+		}
+
+		args, err := field_Query_pageViews_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PageViews(childComplexity, args["path"].(*string), args["range"].(*DateRange)), true
+
+	case "Query.books":
+		if e.complexity.Query.Books == nil {
+			break
+		}
+
+		args, err := field_Query_books_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Books(childComplexity, args["shelf"].(*Shelf), args["year"].(*int), args["limit"].(*int), args["offset"].(*int)), true
+
+	case "Query.book":
+		if e.complexity.Query.Book == nil {
+			break
+		}
+
+		args, err := field_Query_book_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Book(childComplexity, args["id"].(string)), true
+
+	case "Query.currentlyReading":
+		if e.complexity.Query.CurrentlyReading == nil {
+			break
+		}
+
+		return e.complexity.Query.CurrentlyReading(childComplexity), true
+
+	case "Query.readingStats":
+		if e.complexity.Query.ReadingStats == nil {
+			break
+		}
+
+		args, err := field_Query_readingStats_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ReadingStats(childComplexity, args["year"].(int)), true
+
+	case "Query.page":
+		if e.complexity.Query.Page == nil {
+			break
+		}
+
+		args, err := field_Query_page_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Page(childComplexity, args["slug"].(string)), true
+
+	case "Query.pages":
+		if e.complexity.Query.Pages == nil {
+			break
+		}
+
+		return e.complexity.Query.Pages(childComplexity), true
+
+	case "ReadLaterItem.id":
+		if e.complexity.ReadLaterItem.Id == nil {
+			break
+		}
+
+		return e.complexity.ReadLaterItem.Id(childComplexity), true
+
+	case "ReadLaterItem.url":
+		if e.complexity.ReadLaterItem.Url == nil {
+			break
+		}
+
+		return e.complexity.ReadLaterItem.Url(childComplexity), true
+
+	case "ReadLaterItem.post":
+		if e.complexity.ReadLaterItem.Post == nil {
+			break
+		}
+
+		return e.complexity.ReadLaterItem.Post(childComplexity), true
+
+	case "ReadLaterItem.progress":
+		if e.complexity.ReadLaterItem.Progress == nil {
+			break
+		}
+
+		return e.complexity.ReadLaterItem.Progress(childComplexity), true
+
+	case "ReadLaterItem.created":
+		if e.complexity.ReadLaterItem.Created == nil {
+			break
+		}
+
+		return e.complexity.ReadLaterItem.Created(childComplexity), true
+
+	case "ReadingProgress.postId":
+		if e.complexity.ReadingProgress.PostId == nil {
+			break
+		}
+
+		return e.complexity.ReadingProgress.PostId(childComplexity), true
+
+	case "ReadingProgress.percent":
+		if e.complexity.ReadingProgress.Percent == nil {
+			break
+		}
+
+		return e.complexity.ReadingProgress.Percent(childComplexity), true
+
+	case "ReadingProgress.modified":
+		if e.complexity.ReadingProgress.Modified == nil {
+			break
+		}
+
+		return e.complexity.ReadingProgress.Modified(childComplexity), true
+
+	case "ReadingStats.year":
+		if e.complexity.ReadingStats.Year == nil {
+			break
+		}
+
+		return e.complexity.ReadingStats.Year(childComplexity), true
+
+	case "ReadingStats.books":
+		if e.complexity.ReadingStats.Books == nil {
+			break
+		}
+
+		return e.complexity.ReadingStats.Books(childComplexity), true
+
+	case "ReadingStats.pages":
+		if e.complexity.ReadingStats.Pages == nil {
+			break
+		}
+
+		return e.complexity.ReadingStats.Pages(childComplexity), true
+
+	case "ReadingStats.averageRating":
+		if e.complexity.ReadingStats.AverageRating == nil {
+			break
+		}
+
+		return e.complexity.ReadingStats.AverageRating(childComplexity), true
+
+	case "ReadingStats.months":
+		if e.complexity.ReadingStats.Months == nil {
+			break
+		}
+
+		return e.complexity.ReadingStats.Months(childComplexity), true
+
+	case "Redirect.id":
+		if e.complexity.Redirect.Id == nil {
+			break
+		}
+
+		return e.complexity.Redirect.Id(childComplexity), true
+
+	case "Redirect.from":
+		if e.complexity.Redirect.From == nil {
+			break
+		}
+
+		return e.complexity.Redirect.From(childComplexity), true
+
+	case "Redirect.to":
+		if e.complexity.Redirect.To == nil {
+			break
+		}
+
+		return e.complexity.Redirect.To(childComplexity), true
+
+	case "Redirect.status":
+		if e.complexity.Redirect.Status == nil {
+			break
+		}
+
+		return e.complexity.Redirect.Status(childComplexity), true
+
+	case "Redirect.hits":
+		if e.complexity.Redirect.Hits == nil {
+			break
+		}
+
+		return e.complexity.Redirect.Hits(childComplexity), true
+
+	case "Redirect.lastHit":
+		if e.complexity.Redirect.LastHit == nil {
+			break
+		}
+
+		return e.complexity.Redirect.LastHit(childComplexity), true
+
+	case "Redirect.created":
+		if e.complexity.Redirect.Created == nil {
+			break
+		}
+
+		return e.complexity.Redirect.Created(childComplexity), true
+
+	case "Redirect.modified":
+		if e.complexity.Redirect.Modified == nil {
+			break
+		}
+
+		return e.complexity.Redirect.Modified(childComplexity), true
+
+	case "ReferrerCount.referrer":
+		if e.complexity.ReferrerCount.Referrer == nil {
+			break
+		}
+
+		return e.complexity.ReferrerCount.Referrer(childComplexity), true
+
+	case "ReferrerCount.views":
+		if e.complexity.ReferrerCount.Views == nil {
+			break
+		}
+
+		return e.complexity.ReferrerCount.Views(childComplexity), true
+
+	case "Revision.id":
+		if e.complexity.Revision.Id == nil {
+			break
+		}
+
+		return e.complexity.Revision.Id(childComplexity), true
+
+	case "Revision.revision":
+		if e.complexity.Revision.Revision == nil {
+			break
+		}
+
+		return e.complexity.Revision.Revision(childComplexity), true
+
+	case "Revision.title":
+		if e.complexity.Revision.Title == nil {
+			break
+		}
+
+		return e.complexity.Revision.Title(childComplexity), true
+
+	case "Revision.content":
+		if e.complexity.Revision.Content == nil {
+			break
+		}
+
+		return e.complexity.Revision.Content(childComplexity), true
+
+	case "Revision.datetime":
+		if e.complexity.Revision.Datetime == nil {
+			break
+		}
+
+		return e.complexity.Revision.Datetime(childComplexity), true
+
+	case "Revision.draft":
+		if e.complexity.Revision.Draft == nil {
+			break
+		}
+
+		return e.complexity.Revision.Draft(childComplexity), true
+
+	case "Revision.created":
+		if e.complexity.Revision.Created == nil {
+			break
+		}
+
+		return e.complexity.Revision.Created(childComplexity), true
+
+	case "RoleCount.role":
+		if e.complexity.RoleCount.Role == nil {
+			break
+		}
+
+		return e.complexity.RoleCount.Role(childComplexity), true
+
+	case "RoleCount.count":
+		if e.complexity.RoleCount.Count == nil {
+			break
+		}
+
+		return e.complexity.RoleCount.Count(childComplexity), true
+
+	case "ServiceAccount.id":
+		if e.complexity.ServiceAccount.Id == nil {
+			break
+		}
+
+		return e.complexity.ServiceAccount.Id(childComplexity), true
+
+	case "ServiceAccount.name":
+		if e.complexity.ServiceAccount.Name == nil {
+			break
+		}
+
+		return e.complexity.ServiceAccount.Name(childComplexity), true
+
+	case "ServiceAccount.scopes":
+		if e.complexity.ServiceAccount.Scopes == nil {
+			break
+		}
+
+		return e.complexity.ServiceAccount.Scopes(childComplexity), true
+
+	case "ServiceAccount.expires":
+		if e.complexity.ServiceAccount.Expires == nil {
+			break
+		}
+
+		return e.complexity.ServiceAccount.Expires(childComplexity), true
+
+	case "ServiceAccount.lastUsed":
+		if e.complexity.ServiceAccount.LastUsed == nil {
+			break
+		}
+
+		return e.complexity.ServiceAccount.LastUsed(childComplexity), true
+
+	case "ServiceAccount.created":
+		if e.complexity.ServiceAccount.Created == nil {
+			break
+		}
+
+		return e.complexity.ServiceAccount.Created(childComplexity), true
+
+	case "ServiceAccount.secrets":
+		if e.complexity.ServiceAccount.Secrets == nil {
+			break
+		}
+
+		return e.complexity.ServiceAccount.Secrets(childComplexity), true
+
+	case "ServiceAccountCredentials.serviceAccount":
+		if e.complexity.ServiceAccountCredentials.ServiceAccount == nil {
+			break
+		}
+
+		return e.complexity.ServiceAccountCredentials.ServiceAccount(childComplexity), true
+
+	case "ServiceAccountCredentials.secret":
+		if e.complexity.ServiceAccountCredentials.Secret == nil {
+			break
+		}
+
+		return e.complexity.ServiceAccountCredentials.Secret(childComplexity), true
+
+	case "ServiceAccountSecret.id":
+		if e.complexity.ServiceAccountSecret.Id == nil {
+			break
+		}
+
+		return e.complexity.ServiceAccountSecret.Id(childComplexity), true
+
+	case "ServiceAccountSecret.created":
+		if e.complexity.ServiceAccountSecret.Created == nil {
+			break
+		}
+
+		return e.complexity.ServiceAccountSecret.Created(childComplexity), true
+
+	case "ServiceAccountSecret.lastUsed":
+		if e.complexity.ServiceAccountSecret.LastUsed == nil {
+			break
+		}
+
+		return e.complexity.ServiceAccountSecret.LastUsed(childComplexity), true
+
+	case "Session.id":
+		if e.complexity.Session.Id == nil {
+			break
+		}
+
+		return e.complexity.Session.Id(childComplexity), true
+
+	case "Session.userAgent":
+		if e.complexity.Session.UserAgent == nil {
+			break
+		}
+
+		return e.complexity.Session.UserAgent(childComplexity), true
+
+	case "Session.ip":
+		if e.complexity.Session.Ip == nil {
+			break
+		}
+
+		return e.complexity.Session.Ip(childComplexity), true
+
+	case "Session.country":
+		if e.complexity.Session.Country == nil {
+			break
+		}
+
+		return e.complexity.Session.Country(childComplexity), true
+
+	case "Session.created":
+		if e.complexity.Session.Created == nil {
+			break
+		}
+
+		return e.complexity.Session.Created(childComplexity), true
+
+	case "Session.lastSeen":
+		if e.complexity.Session.LastSeen == nil {
+			break
+		}
+
+		return e.complexity.Session.LastSeen(childComplexity), true
+
+	case "Session.current":
+		if e.complexity.Session.Current == nil {
+			break
+		}
+
+		return e.complexity.Session.Current(childComplexity), true
+
+	case "ShortLink.id":
+		if e.complexity.ShortLink.Id == nil {
+			break
+		}
+
+		return e.complexity.ShortLink.Id(childComplexity), true
+
+	case "ShortLink.slug":
+		if e.complexity.ShortLink.Slug == nil {
+			break
+		}
+
+		return e.complexity.ShortLink.Slug(childComplexity), true
+
+	case "ShortLink.path":
+		if e.complexity.ShortLink.Path == nil {
+			break
+		}
+
+		return e.complexity.ShortLink.Path(childComplexity), true
+
+	case "ShortLink.url":
+		if e.complexity.ShortLink.Url == nil {
+			break
+		}
+
+		return e.complexity.ShortLink.Url(childComplexity), true
+
+	case "ShortLink.clicks":
+		if e.complexity.ShortLink.Clicks == nil {
+			break
+		}
+
+		return e.complexity.ShortLink.Clicks(childComplexity), true
+
+	case "ShortLink.lastClick":
+		if e.complexity.ShortLink.LastClick == nil {
+			break
+		}
+
+		return e.complexity.ShortLink.LastClick(childComplexity), true
+
+	case "ShortLink.created":
+		if e.complexity.ShortLink.Created == nil {
+			break
+		}
+
+		return e.complexity.ShortLink.Created(childComplexity), true
+
+	case "Site.id":
+		if e.complexity.Site.Id == nil {
+			break
+		}
+
+		return e.complexity.Site.Id(childComplexity), true
+
+	case "Site.host":
+		if e.complexity.Site.Host == nil {
+			break
+		}
+
+		return e.complexity.Site.Host(childComplexity), true
+
+	case "Site.allowedUsers":
+		if e.complexity.Site.AllowedUsers == nil {
+			break
+		}
+
+		return e.complexity.Site.AllowedUsers(childComplexity), true
+
+	case "Site.created":
+		if e.complexity.Site.Created == nil {
+			break
+		}
+
+		return e.complexity.Site.Created(childComplexity), true
+
+	case "SiteSettings.title":
+		if e.complexity.SiteSettings.Title == nil {
+			break
+		}
+
+		return e.complexity.SiteSettings.Title(childComplexity), true
+
+	case "SiteSettings.description":
+		if e.complexity.SiteSettings.Description == nil {
+			break
+		}
+
+		return e.complexity.SiteSettings.Description(childComplexity), true
+
+	case "SiteSettings.url":
+		if e.complexity.SiteSettings.Url == nil {
+			break
+		}
+
+		return e.complexity.SiteSettings.Url(childComplexity), true
+
+	case "SiteSettings.footerText":
+		if e.complexity.SiteSettings.FooterText == nil {
+			break
+		}
+
+		return e.complexity.SiteSettings.FooterText(childComplexity), true
+
+	case "SiteSettings.postsPerPage":
+		if e.complexity.SiteSettings.PostsPerPage == nil {
+			break
+		}
+
+		return e.complexity.SiteSettings.PostsPerPage(childComplexity), true
+
+	case "SiteSettings.socialLinks":
+		if e.complexity.SiteSettings.SocialLinks == nil {
+			break
+		}
+
+		return e.complexity.SiteSettings.SocialLinks(childComplexity), true
+
+	case "SiteSettings.license":
+		if e.complexity.SiteSettings.License == nil {
+			break
+		}
+
+		return e.complexity.SiteSettings.License(childComplexity), true
+
+	case "SocialLink.name":
+		if e.complexity.SocialLink.Name == nil {
+			break
+		}
+
+		return e.complexity.SocialLink.Name(childComplexity), true
+
+	case "SocialLink.url":
+		if e.complexity.SocialLink.Url == nil {
+			break
+		}
+
+		return e.complexity.SocialLink.Url(childComplexity), true
+
+	case "Stat.key":
+		if e.complexity.Stat.Key == nil {
+			break
+		}
+
+		return e.complexity.Stat.Key(childComplexity), true
+
+	case "Stat.value":
+		if e.complexity.Stat.Value == nil {
+			break
+		}
+
+		return e.complexity.Stat.Value(childComplexity), true
+
+	case "TagCount.tag":
+		if e.complexity.TagCount.Tag == nil {
+			break
+		}
+
+		return e.complexity.TagCount.Tag(childComplexity), true
+
+	case "TagCount.count":
+		if e.complexity.TagCount.Count == nil {
+			break
+		}
+
+		return e.complexity.TagCount.Count(childComplexity), true
+
+	case "Theme.accentColor":
+		if e.complexity.Theme.AccentColor == nil {
+			break
+		}
+
+		return e.complexity.Theme.AccentColor(childComplexity), true
+
+	case "Theme.lightImage":
+		if e.complexity.Theme.LightImage == nil {
+			break
+		}
+
+		return e.complexity.Theme.LightImage(childComplexity), true
+
+	case "Theme.darkImage":
+		if e.complexity.Theme.DarkImage == nil {
+			break
+		}
+
+		return e.complexity.Theme.DarkImage(childComplexity), true
+
+	case "Theme.navItems":
+		if e.complexity.Theme.NavItems == nil {
+			break
+		}
+
+		return e.complexity.Theme.NavItems(childComplexity), true
+
+	case "User.id":
+		if e.complexity.User.Id == nil {
+			break
+		}
+
+		return e.complexity.User.Id(childComplexity), true
+
+	case "User.role":
+		if e.complexity.User.Role == nil {
+			break
+		}
+
+		return e.complexity.User.Role(childComplexity), true
+
+	case "User.created":
+		if e.complexity.User.Created == nil {
+			break
+		}
+
+		return e.complexity.User.Created(childComplexity), true
+
+	case "User.modified":
+		if e.complexity.User.Modified == nil {
+			break
+		}
+
+		return e.complexity.User.Modified(childComplexity), true
+
+	case "User.deactivated":
+		if e.complexity.User.Deactivated == nil {
+			break
+		}
+
+		return e.complexity.User.Deactivated(childComplexity), true
+
+	case "User.notificationSettings":
+		if e.complexity.User.NotificationSettings == nil {
+			break
+		}
+
+		return e.complexity.User.NotificationSettings(childComplexity), true
+
+	case "User.identities":
+		if e.complexity.User.Identities == nil {
+			break
+		}
+
+		return e.complexity.User.Identities(childComplexity), true
+
+	case "Webhook.id":
+		if e.complexity.Webhook.Id == nil {
+			break
+		}
+
+		return e.complexity.Webhook.Id(childComplexity), true
+
+	case "Webhook.url":
+		if e.complexity.Webhook.Url == nil {
+			break
+		}
+
+		return e.complexity.Webhook.Url(childComplexity), true
+
+	case "Webhook.events":
+		if e.complexity.Webhook.Events == nil {
+			break
+		}
+
+		return e.complexity.Webhook.Events(childComplexity), true
+
+	case "Webhook.created":
+		if e.complexity.Webhook.Created == nil {
+			break
+		}
+
+		return e.complexity.Webhook.Created(childComplexity), true
+
+	case "Webhook.modified":
+		if e.complexity.Webhook.Modified == nil {
+			break
+		}
+
+		return e.complexity.Webhook.Modified(childComplexity), true
+
+	case "WebhookCredentials.webhook":
+		if e.complexity.WebhookCredentials.Webhook == nil {
+			break
+		}
+
+		return e.complexity.WebhookCredentials.Webhook(childComplexity), true
+
+	case "WebhookCredentials.secret":
+		if e.complexity.WebhookCredentials.Secret == nil {
+			break
+		}
+
+		return e.complexity.WebhookCredentials.Secret(childComplexity), true
+
+	case "WebhookDelivery.id":
+		if e.complexity.WebhookDelivery.Id == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Id(childComplexity), true
+
+	case "WebhookDelivery.webhookId":
+		if e.complexity.WebhookDelivery.WebhookId == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.WebhookId(childComplexity), true
+
+	case "WebhookDelivery.event":
+		if e.complexity.WebhookDelivery.Event == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Event(childComplexity), true
+
+	case "WebhookDelivery.payload":
+		if e.complexity.WebhookDelivery.Payload == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Payload(childComplexity), true
+
+	case "WebhookDelivery.requestId":
+		if e.complexity.WebhookDelivery.RequestId == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.RequestId(childComplexity), true
+
+	case "WebhookDelivery.attempts":
+		if e.complexity.WebhookDelivery.Attempts == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Attempts(childComplexity), true
+
+	case "WebhookDelivery.statusCode":
+		if e.complexity.WebhookDelivery.StatusCode == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.StatusCode(childComplexity), true
+
+	case "WebhookDelivery.error":
+		if e.complexity.WebhookDelivery.Error == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Error(childComplexity), true
+
+	case "WebhookDelivery.nextAttempt":
+		if e.complexity.WebhookDelivery.NextAttempt == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.NextAttempt(childComplexity), true
+
+	case "WebhookDelivery.delivered":
+		if e.complexity.WebhookDelivery.Delivered == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Delivered(childComplexity), true
+
+	case "WebhookDelivery.created":
+		if e.complexity.WebhookDelivery.Created == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Created(childComplexity), true
+
+	}
+	return 0, false
+}
+
+func (e *executableSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+	ec := executionContext{graphql.GetRequestContext(ctx), e}
+
+	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
+		data := ec._Query(ctx, op.SelectionSet)
+		var buf bytes.Buffer
+		data.MarshalGQL(&buf)
+		return buf.Bytes()
+	})
+
+	return &graphql.Response{
+		Data:       buf,
+		Errors:     ec.Errors,
+		Extensions: ec.Extensions}
+}
+
+func (e *executableSchema) Mutation(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+	ec := executionContext{graphql.GetRequestContext(ctx), e}
+
+	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
+		data := ec._Mutation(ctx, op.SelectionSet)
+		var buf bytes.Buffer
+		data.MarshalGQL(&buf)
+		return buf.Bytes()
+	})
+
+	return &graphql.Response{
+		Data:       buf,
+		Errors:     ec.Errors,
+		Extensions: ec.Extensions,
+	}
+}
+
+func (e *executableSchema) Subscription(ctx context.Context, op *ast.OperationDefinition) func() *graphql.Response {
+	return graphql.OneShot(graphql.ErrorResponse(ctx, "subscriptions are not supported"))
+}
+
+type executionContext struct {
+	*graphql.RequestContext
+	*executableSchema
+}
+
+var adminErrorImplementors = []string{"AdminError"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _AdminError(ctx context.Context, sel ast.SelectionSet, obj *AdminError) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, adminErrorImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AdminError")
+		case "source":
+			out.Values[i] = ec._AdminError_source(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "message":
+			out.Values[i] = ec._AdminError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "time":
+			out.Values[i] = ec._AdminError_time(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AdminError_source(ctx context.Context, field graphql.CollectedField, obj *AdminError) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AdminError",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Source, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AdminError_message(ctx context.Context, field graphql.CollectedField, obj *AdminError) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AdminError",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AdminError_time(ctx context.Context, field graphql.CollectedField, obj *AdminError) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AdminError",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+var adminStatsImplementors = []string{"AdminStats"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _AdminStats(ctx context.Context, sel ast.SelectionSet, obj *AdminStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, adminStatsImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AdminStats")
+		case "posts":
+			out.Values[i] = ec._AdminStats_posts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "drafts":
+			out.Values[i] = ec._AdminStats_drafts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "users":
+			out.Values[i] = ec._AdminStats_users(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "deactivatedUsers":
+			out.Values[i] = ec._AdminStats_deactivatedUsers(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "failedWebhookDeliveries":
+			out.Values[i] = ec._AdminStats_failedWebhookDeliveries(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "recentErrors":
+			out.Values[i] = ec._AdminStats_recentErrors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "cronTasks":
+			out.Values[i] = ec._AdminStats_cronTasks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AdminStats_posts(ctx context.Context, field graphql.CollectedField, obj *AdminStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AdminStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Posts, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AdminStats_drafts(ctx context.Context, field graphql.CollectedField, obj *AdminStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AdminStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Drafts, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AdminStats_users(ctx context.Context, field graphql.CollectedField, obj *AdminStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AdminStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Users, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]RoleCount)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec._RoleCount(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AdminStats_deactivatedUsers(ctx context.Context, field graphql.CollectedField, obj *AdminStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AdminStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeactivatedUsers, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AdminStats_failedWebhookDeliveries(ctx context.Context, field graphql.CollectedField, obj *AdminStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AdminStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FailedWebhookDeliveries, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AdminStats_recentErrors(ctx context.Context, field graphql.CollectedField, obj *AdminStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AdminStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RecentErrors, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]AdminError)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec._AdminError(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AdminStats_cronTasks(ctx context.Context, field graphql.CollectedField, obj *AdminStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AdminStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CronTasks, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]CronTaskStatus)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec._CronTaskStatus(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+var automationRuleImplementors = []string{"AutomationRule"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _AutomationRule(ctx context.Context, sel ast.SelectionSet, obj *AutomationRule) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, automationRuleImplementors)
+
+	var wg sync.WaitGroup
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AutomationRule")
+		case "id":
+			out.Values[i] = ec._AutomationRule_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "name":
+			out.Values[i] = ec._AutomationRule_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "event":
+			out.Values[i] = ec._AutomationRule_event(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "condition":
+			out.Values[i] = ec._AutomationRule_condition(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "actions":
+			out.Values[i] = ec._AutomationRule_actions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "enabled":
+			out.Values[i] = ec._AutomationRule_enabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "runs":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._AutomationRule_runs(ctx, field, obj)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "created":
+			out.Values[i] = ec._AutomationRule_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "modified":
+			out.Values[i] = ec._AutomationRule_modified(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	wg.Wait()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AutomationRule_id(ctx context.Context, field graphql.CollectedField, obj *AutomationRule) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AutomationRule",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AutomationRule_name(ctx context.Context, field graphql.CollectedField, obj *AutomationRule) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AutomationRule",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AutomationRule_event(ctx context.Context, field graphql.CollectedField, obj *AutomationRule) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AutomationRule",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Event, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AutomationRule_condition(ctx context.Context, field graphql.CollectedField, obj *AutomationRule) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AutomationRule",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Condition, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AutomationRule_actions(ctx context.Context, field graphql.CollectedField, obj *AutomationRule) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AutomationRule",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Actions, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+
+	for idx1 := range res {
+		arr1[idx1] = func() graphql.Marshaler {
+			return graphql.MarshalString(res[idx1])
+		}()
+	}
+
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AutomationRule_enabled(ctx context.Context, field graphql.CollectedField, obj *AutomationRule) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AutomationRule",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Enabled, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AutomationRule_runs(ctx context.Context, field graphql.CollectedField, obj *AutomationRule) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_AutomationRule_runs_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "AutomationRule",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.AutomationRule().Runs(rctx, obj, args["limit"].(*int))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*AutomationRuleRun)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._AutomationRuleRun(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AutomationRule_created(ctx context.Context, field graphql.CollectedField, obj *AutomationRule) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AutomationRule",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AutomationRule_modified(ctx context.Context, field graphql.CollectedField, obj *AutomationRule) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AutomationRule",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Modified, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+var automationRuleRunImplementors = []string{"AutomationRuleRun"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _AutomationRuleRun(ctx context.Context, sel ast.SelectionSet, obj *AutomationRuleRun) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, automationRuleRunImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AutomationRuleRun")
+		case "id":
+			out.Values[i] = ec._AutomationRuleRun_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "ruleId":
+			out.Values[i] = ec._AutomationRuleRun_ruleId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "subject":
+			out.Values[i] = ec._AutomationRuleRun_subject(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "matched":
+			out.Values[i] = ec._AutomationRuleRun_matched(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "actions":
+			out.Values[i] = ec._AutomationRuleRun_actions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "error":
+			out.Values[i] = ec._AutomationRuleRun_error(ctx, field, obj)
+		case "created":
+			out.Values[i] = ec._AutomationRuleRun_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AutomationRuleRun_id(ctx context.Context, field graphql.CollectedField, obj *AutomationRuleRun) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AutomationRuleRun",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AutomationRuleRun_ruleId(ctx context.Context, field graphql.CollectedField, obj *AutomationRuleRun) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AutomationRuleRun",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RuleID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AutomationRuleRun_subject(ctx context.Context, field graphql.CollectedField, obj *AutomationRuleRun) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AutomationRuleRun",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Subject, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AutomationRuleRun_matched(ctx context.Context, field graphql.CollectedField, obj *AutomationRuleRun) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AutomationRuleRun",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Matched, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AutomationRuleRun_actions(ctx context.Context, field graphql.CollectedField, obj *AutomationRuleRun) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AutomationRuleRun",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Actions, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+
+	for idx1 := range res {
+		arr1[idx1] = func() graphql.Marshaler {
+			return graphql.MarshalString(res[idx1])
+		}()
+	}
+
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AutomationRuleRun_error(ctx context.Context, field graphql.CollectedField, obj *AutomationRuleRun) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AutomationRuleRun",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Error, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _AutomationRuleRun_created(ctx context.Context, field graphql.CollectedField, obj *AutomationRuleRun) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "AutomationRuleRun",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+var bookImplementors = []string{"Book"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Book(ctx context.Context, sel ast.SelectionSet, obj *Book) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, bookImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Book")
+		case "id":
+			out.Values[i] = ec._Book_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "title":
+			out.Values[i] = ec._Book_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "author":
+			out.Values[i] = ec._Book_author(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "isbn":
+			out.Values[i] = ec._Book_isbn(ctx, field, obj)
+		case "goodreadsId":
+			out.Values[i] = ec._Book_goodreadsId(ctx, field, obj)
+		case "shelf":
+			out.Values[i] = ec._Book_shelf(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "rating":
+			out.Values[i] = ec._Book_rating(ctx, field, obj)
+		case "pages":
+			out.Values[i] = ec._Book_pages(ctx, field, obj)
+		case "started":
+			out.Values[i] = ec._Book_started(ctx, field, obj)
+		case "finished":
+			out.Values[i] = ec._Book_finished(ctx, field, obj)
+		case "created":
+			out.Values[i] = ec._Book_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "modified":
+			out.Values[i] = ec._Book_modified(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Book_id(ctx context.Context, field graphql.CollectedField, obj *Book) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Book",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Book_title(ctx context.Context, field graphql.CollectedField, obj *Book) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Book",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Title, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Book_author(ctx context.Context, field graphql.CollectedField, obj *Book) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Book",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Author, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Book_isbn(ctx context.Context, field graphql.CollectedField, obj *Book) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Book",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Isbn, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Book_goodreadsId(ctx context.Context, field graphql.CollectedField, obj *Book) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Book",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.GoodreadsID, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Book_shelf(ctx context.Context, field graphql.CollectedField, obj *Book) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Book",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Shelf, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Shelf)
+	rctx.Result = res
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Book_rating(ctx context.Context, field graphql.CollectedField, obj *Book) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Book",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Rating, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalInt(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Book_pages(ctx context.Context, field graphql.CollectedField, obj *Book) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Book",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Pages, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalInt(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Book_started(ctx context.Context, field graphql.CollectedField, obj *Book) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Book",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Started, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return MarshalTime(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Book_finished(ctx context.Context, field graphql.CollectedField, obj *Book) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Book",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Finished, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return MarshalTime(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Book_created(ctx context.Context, field graphql.CollectedField, obj *Book) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Book",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Book_modified(ctx context.Context, field graphql.CollectedField, obj *Book) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Book",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Modified, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+var commentImplementors = []string{"Comment"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Comment(ctx context.Context, sel ast.SelectionSet, obj *Comment) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, commentImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Comment")
+		case "id":
+			out.Values[i] = ec._Comment_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Comment_id(ctx context.Context, field graphql.CollectedField, obj *Comment) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Comment",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+var cronTaskStatusImplementors = []string{"CronTaskStatus"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _CronTaskStatus(ctx context.Context, sel ast.SelectionSet, obj *CronTaskStatus) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, cronTaskStatusImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CronTaskStatus")
+		case "name":
+			out.Values[i] = ec._CronTaskStatus_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "schedule":
+			out.Values[i] = ec._CronTaskStatus_schedule(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "next":
+			out.Values[i] = ec._CronTaskStatus_next(ctx, field, obj)
+		case "lastStarted":
+			out.Values[i] = ec._CronTaskStatus_lastStarted(ctx, field, obj)
+		case "lastFinished":
+			out.Values[i] = ec._CronTaskStatus_lastFinished(ctx, field, obj)
+		case "lastError":
+			out.Values[i] = ec._CronTaskStatus_lastError(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _CronTaskStatus_name(ctx context.Context, field graphql.CollectedField, obj *CronTaskStatus) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "CronTaskStatus",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _CronTaskStatus_schedule(ctx context.Context, field graphql.CollectedField, obj *CronTaskStatus) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "CronTaskStatus",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Schedule, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _CronTaskStatus_next(ctx context.Context, field graphql.CollectedField, obj *CronTaskStatus) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "CronTaskStatus",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Next, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return MarshalTime(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _CronTaskStatus_lastStarted(ctx context.Context, field graphql.CollectedField, obj *CronTaskStatus) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "CronTaskStatus",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastStarted, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return MarshalTime(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _CronTaskStatus_lastFinished(ctx context.Context, field graphql.CollectedField, obj *CronTaskStatus) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "CronTaskStatus",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastFinished, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return MarshalTime(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _CronTaskStatus_lastError(ctx context.Context, field graphql.CollectedField, obj *CronTaskStatus) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "CronTaskStatus",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastError, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+var dBPoolStatsImplementors = []string{"DBPoolStats"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _DBPoolStats(ctx context.Context, sel ast.SelectionSet, obj *DBPoolStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, dBPoolStatsImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DBPoolStats")
+		case "maxOpen":
+			out.Values[i] = ec._DBPoolStats_maxOpen(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "open":
+			out.Values[i] = ec._DBPoolStats_open(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "inUse":
+			out.Values[i] = ec._DBPoolStats_inUse(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "idle":
+			out.Values[i] = ec._DBPoolStats_idle(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "waitCount":
+			out.Values[i] = ec._DBPoolStats_waitCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "waitDuration":
+			out.Values[i] = ec._DBPoolStats_waitDuration(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DBPoolStats_maxOpen(ctx context.Context, field graphql.CollectedField, obj *DBPoolStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DBPoolStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaxOpen, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DBPoolStats_open(ctx context.Context, field graphql.CollectedField, obj *DBPoolStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DBPoolStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Open, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DBPoolStats_inUse(ctx context.Context, field graphql.CollectedField, obj *DBPoolStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DBPoolStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InUse, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DBPoolStats_idle(ctx context.Context, field graphql.CollectedField, obj *DBPoolStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DBPoolStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Idle, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DBPoolStats_waitCount(ctx context.Context, field graphql.CollectedField, obj *DBPoolStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DBPoolStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.WaitCount, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DBPoolStats_waitDuration(ctx context.Context, field graphql.CollectedField, obj *DBPoolStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DBPoolStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.WaitDuration, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	rctx.Result = res
+	return graphql.MarshalFloat(res)
+}
+
+var dataExportImplementors = []string{"DataExport"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _DataExport(ctx context.Context, sel ast.SelectionSet, obj *DataExport) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, dataExportImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DataExport")
+		case "filename":
+			out.Values[i] = ec._DataExport_filename(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "archive":
+			out.Values[i] = ec._DataExport_archive(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "created":
+			out.Values[i] = ec._DataExport_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DataExport_filename(ctx context.Context, field graphql.CollectedField, obj *DataExport) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DataExport",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Filename, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DataExport_archive(ctx context.Context, field graphql.CollectedField, obj *DataExport) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DataExport",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Archive, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DataExport_created(ctx context.Context, field graphql.CollectedField, obj *DataExport) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DataExport",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+var dayCountImplementors = []string{"DayCount"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _DayCount(ctx context.Context, sel ast.SelectionSet, obj *DayCount) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, dayCountImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DayCount")
+		case "day":
+			out.Values[i] = ec._DayCount_day(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "views":
+			out.Values[i] = ec._DayCount_views(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "visitors":
+			out.Values[i] = ec._DayCount_visitors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DayCount_day(ctx context.Context, field graphql.CollectedField, obj *DayCount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DayCount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Day, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DayCount_views(ctx context.Context, field graphql.CollectedField, obj *DayCount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DayCount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Views, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DayCount_visitors(ctx context.Context, field graphql.CollectedField, obj *DayCount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DayCount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Visitors, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+var debugInfoImplementors = []string{"DebugInfo"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _DebugInfo(ctx context.Context, sel ast.SelectionSet, obj *DebugInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, debugInfoImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DebugInfo")
+		case "version":
+			out.Values[i] = ec._DebugInfo_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "gitSHA":
+			out.Values[i] = ec._DebugInfo_gitSHA(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "goVersion":
+			out.Values[i] = ec._DebugInfo_goVersion(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "started":
+			out.Values[i] = ec._DebugInfo_started(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "uptime":
+			out.Values[i] = ec._DebugInfo_uptime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "goroutines":
+			out.Values[i] = ec._DebugInfo_goroutines(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "heapAlloc":
+			out.Values[i] = ec._DebugInfo_heapAlloc(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "heapObjects":
+			out.Values[i] = ec._DebugInfo_heapObjects(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "numGC":
+			out.Values[i] = ec._DebugInfo_numGC(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "database":
+			out.Values[i] = ec._DebugInfo_database(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "replica":
+			out.Values[i] = ec._DebugInfo_replica(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DebugInfo_version(ctx context.Context, field graphql.CollectedField, obj *DebugInfo) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DebugInfo",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Version, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DebugInfo_gitSHA(ctx context.Context, field graphql.CollectedField, obj *DebugInfo) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DebugInfo",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.GitSHA, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DebugInfo_goVersion(ctx context.Context, field graphql.CollectedField, obj *DebugInfo) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DebugInfo",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.GoVersion, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DebugInfo_started(ctx context.Context, field graphql.CollectedField, obj *DebugInfo) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DebugInfo",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Started, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DebugInfo_uptime(ctx context.Context, field graphql.CollectedField, obj *DebugInfo) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DebugInfo",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Uptime, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DebugInfo_goroutines(ctx context.Context, field graphql.CollectedField, obj *DebugInfo) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DebugInfo",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Goroutines, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DebugInfo_heapAlloc(ctx context.Context, field graphql.CollectedField, obj *DebugInfo) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DebugInfo",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HeapAlloc, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DebugInfo_heapObjects(ctx context.Context, field graphql.CollectedField, obj *DebugInfo) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DebugInfo",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HeapObjects, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DebugInfo_numGC(ctx context.Context, field graphql.CollectedField, obj *DebugInfo) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DebugInfo",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NumGC, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DebugInfo_database(ctx context.Context, field graphql.CollectedField, obj *DebugInfo) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DebugInfo",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Database, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*DBPoolStats)
+	rctx.Result = res
+
+	if res == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+
+	return ec._DBPoolStats(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _DebugInfo_replica(ctx context.Context, field graphql.CollectedField, obj *DebugInfo) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "DebugInfo",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Replica, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*DBPoolStats)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec._DBPoolStats(ctx, field.Selections, res)
+}
+
+var geoImplementors = []string{"Geo"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Geo(ctx context.Context, sel ast.SelectionSet, obj *Geo) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, geoImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Geo")
+		case "lat":
+			out.Values[i] = ec._Geo_lat(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "long":
+			out.Values[i] = ec._Geo_long(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Geo_lat(ctx context.Context, field graphql.CollectedField, obj *Geo) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Geo",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Lat, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	rctx.Result = res
+	return graphql.MarshalFloat(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Geo_long(ctx context.Context, field graphql.CollectedField, obj *Geo) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Geo",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Long, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	rctx.Result = res
+	return graphql.MarshalFloat(res)
+}
+
+var goroutineCountImplementors = []string{"GoroutineCount"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _GoroutineCount(ctx context.Context, sel ast.SelectionSet, obj *GoroutineCount) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, goroutineCountImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GoroutineCount")
+		case "labels":
+			out.Values[i] = ec._GoroutineCount_labels(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "count":
+			out.Values[i] = ec._GoroutineCount_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _GoroutineCount_labels(ctx context.Context, field graphql.CollectedField, obj *GoroutineCount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "GoroutineCount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Labels, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _GoroutineCount_count(ctx context.Context, field graphql.CollectedField, obj *GoroutineCount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "GoroutineCount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Count, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+var groupImplementors = []string{"Group"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Group(ctx context.Context, sel ast.SelectionSet, obj *Group) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, groupImplementors)
+
+	var wg sync.WaitGroup
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Group")
+		case "id":
+			out.Values[i] = ec._Group_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "name":
+			out.Values[i] = ec._Group_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "ownerId":
+			out.Values[i] = ec._Group_ownerId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "members":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Group_members(ctx, field, obj)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "created":
+			out.Values[i] = ec._Group_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "modified":
+			out.Values[i] = ec._Group_modified(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	wg.Wait()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Group_id(ctx context.Context, field graphql.CollectedField, obj *Group) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Group",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Group_name(ctx context.Context, field graphql.CollectedField, obj *Group) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Group",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Group_ownerId(ctx context.Context, field graphql.CollectedField, obj *Group) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Group",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OwnerID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Group_members(ctx context.Context, field graphql.CollectedField, obj *Group) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Group",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Group().Members(rctx, obj)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+
+	for idx1 := range res {
+		arr1[idx1] = func() graphql.Marshaler {
+			return graphql.MarshalID(res[idx1])
+		}()
+	}
+
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Group_created(ctx context.Context, field graphql.CollectedField, obj *Group) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Group",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Group_modified(ctx context.Context, field graphql.CollectedField, obj *Group) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Group",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Modified, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+var highlightImplementors = []string{"Highlight"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Highlight(ctx context.Context, sel ast.SelectionSet, obj *Highlight) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, highlightImplementors)
+
+	var wg sync.WaitGroup
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Highlight")
+		case "id":
+			out.Values[i] = ec._Highlight_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "postId":
+			out.Values[i] = ec._Highlight_postId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "quote":
+			out.Values[i] = ec._Highlight_quote(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "start":
+			out.Values[i] = ec._Highlight_start(ctx, field, obj)
+		case "end":
+			out.Values[i] = ec._Highlight_end(ctx, field, obj)
+		case "note":
+			out.Values[i] = ec._Highlight_note(ctx, field, obj)
+		case "public":
+			out.Values[i] = ec._Highlight_public(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "mine":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Highlight_mine(ctx, field, obj)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "created":
+			out.Values[i] = ec._Highlight_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "modified":
+			out.Values[i] = ec._Highlight_modified(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	wg.Wait()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Highlight_id(ctx context.Context, field graphql.CollectedField, obj *Highlight) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Highlight",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Highlight_postId(ctx context.Context, field graphql.CollectedField, obj *Highlight) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Highlight",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PostID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Highlight_quote(ctx context.Context, field graphql.CollectedField, obj *Highlight) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Highlight",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Quote, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Highlight_start(ctx context.Context, field graphql.CollectedField, obj *Highlight) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Highlight",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Start, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalInt(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Highlight_end(ctx context.Context, field graphql.CollectedField, obj *Highlight) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Highlight",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.End, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalInt(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Highlight_note(ctx context.Context, field graphql.CollectedField, obj *Highlight) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Highlight",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Note, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Highlight_public(ctx context.Context, field graphql.CollectedField, obj *Highlight) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Highlight",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Public, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Highlight_mine(ctx context.Context, field graphql.CollectedField, obj *Highlight) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Highlight",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Highlight().Mine(rctx, obj)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Highlight_created(ctx context.Context, field graphql.CollectedField, obj *Highlight) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Highlight",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Highlight_modified(ctx context.Context, field graphql.CollectedField, obj *Highlight) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Highlight",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Modified, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+var identityImplementors = []string{"Identity"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Identity(ctx context.Context, sel ast.SelectionSet, obj *Identity) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, identityImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Identity")
+		case "provider":
+			out.Values[i] = ec._Identity_provider(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "providerUserId":
+			out.Values[i] = ec._Identity_providerUserId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "created":
+			out.Values[i] = ec._Identity_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Identity_provider(ctx context.Context, field graphql.CollectedField, obj *Identity) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Identity",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Provider, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Identity_providerUserId(ctx context.Context, field graphql.CollectedField, obj *Identity) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Identity",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ProviderUserID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Identity_created(ctx context.Context, field graphql.CollectedField, obj *Identity) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Identity",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+var importResultImplementors = []string{"ImportResult"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _ImportResult(ctx context.Context, sel ast.SelectionSet, obj *ImportResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, importResultImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ImportResult")
+		case "table":
+			out.Values[i] = ec._ImportResult_table(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "imported":
+			out.Values[i] = ec._ImportResult_imported(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ImportResult_table(ctx context.Context, field graphql.CollectedField, obj *ImportResult) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ImportResult",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Table, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ImportResult_imported(ctx context.Context, field graphql.CollectedField, obj *ImportResult) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ImportResult",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Imported, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+var integrityIssueImplementors = []string{"IntegrityIssue"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _IntegrityIssue(ctx context.Context, sel ast.SelectionSet, obj *IntegrityIssue) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, integrityIssueImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("IntegrityIssue")
+		case "id":
+			out.Values[i] = ec._IntegrityIssue_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "check":
+			out.Values[i] = ec._IntegrityIssue_check(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "subject":
+			out.Values[i] = ec._IntegrityIssue_subject(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "message":
+			out.Values[i] = ec._IntegrityIssue_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "repaired":
+			out.Values[i] = ec._IntegrityIssue_repaired(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "created":
+			out.Values[i] = ec._IntegrityIssue_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _IntegrityIssue_id(ctx context.Context, field graphql.CollectedField, obj *IntegrityIssue) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "IntegrityIssue",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _IntegrityIssue_check(ctx context.Context, field graphql.CollectedField, obj *IntegrityIssue) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "IntegrityIssue",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Check, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _IntegrityIssue_subject(ctx context.Context, field graphql.CollectedField, obj *IntegrityIssue) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "IntegrityIssue",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Subject, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _IntegrityIssue_message(ctx context.Context, field graphql.CollectedField, obj *IntegrityIssue) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "IntegrityIssue",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _IntegrityIssue_repaired(ctx context.Context, field graphql.CollectedField, obj *IntegrityIssue) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "IntegrityIssue",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Repaired, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _IntegrityIssue_created(ctx context.Context, field graphql.CollectedField, obj *IntegrityIssue) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "IntegrityIssue",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+var inviteImplementors = []string{"Invite"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Invite(ctx context.Context, sel ast.SelectionSet, obj *Invite) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, inviteImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Invite")
+		case "code":
+			out.Values[i] = ec._Invite_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "created":
+			out.Values[i] = ec._Invite_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "used":
+			out.Values[i] = ec._Invite_used(ctx, field, obj)
+		case "usedBy":
+			out.Values[i] = ec._Invite_usedBy(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Invite_code(ctx context.Context, field graphql.CollectedField, obj *Invite) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Invite",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Code, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Invite_created(ctx context.Context, field graphql.CollectedField, obj *Invite) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Invite",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Invite_used(ctx context.Context, field graphql.CollectedField, obj *Invite) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Invite",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Used, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return MarshalTime(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Invite_usedBy(ctx context.Context, field graphql.CollectedField, obj *Invite) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Invite",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UsedBy, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalID(*res)
+}
+
+var jobImplementors = []string{"Job"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Job(ctx context.Context, sel ast.SelectionSet, obj *Job) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, jobImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Job")
+		case "id":
+			out.Values[i] = ec._Job_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "kind":
+			out.Values[i] = ec._Job_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "status":
+			out.Values[i] = ec._Job_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "total":
+			out.Values[i] = ec._Job_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "done":
+			out.Values[i] = ec._Job_done(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "error":
+			out.Values[i] = ec._Job_error(ctx, field, obj)
+		case "payload":
+			out.Values[i] = ec._Job_payload(ctx, field, obj)
+		case "report":
+			out.Values[i] = ec._Job_report(ctx, field, obj)
+		case "attempts":
+			out.Values[i] = ec._Job_attempts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "runAt":
+			out.Values[i] = ec._Job_runAt(ctx, field, obj)
+		case "requestId":
+			out.Values[i] = ec._Job_requestId(ctx, field, obj)
+		case "created":
+			out.Values[i] = ec._Job_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "finished":
+			out.Values[i] = ec._Job_finished(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Job_id(ctx context.Context, field graphql.CollectedField, obj *Job) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Job",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Job_kind(ctx context.Context, field graphql.CollectedField, obj *Job) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Job",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Job_status(ctx context.Context, field graphql.CollectedField, obj *Job) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Job",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(JobStatus)
+	rctx.Result = res
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Job_total(ctx context.Context, field graphql.CollectedField, obj *Job) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Job",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Total, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Job_done(ctx context.Context, field graphql.CollectedField, obj *Job) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Job",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Done, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Job_error(ctx context.Context, field graphql.CollectedField, obj *Job) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Job",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Error, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Job_payload(ctx context.Context, field graphql.CollectedField, obj *Job) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Job",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Payload, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Job_report(ctx context.Context, field graphql.CollectedField, obj *Job) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Job",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Report, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Job_attempts(ctx context.Context, field graphql.CollectedField, obj *Job) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Job",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Attempts, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Job_runAt(ctx context.Context, field graphql.CollectedField, obj *Job) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Job",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RunAt, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return MarshalTime(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Job_requestId(ctx context.Context, field graphql.CollectedField, obj *Job) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Job",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RequestID, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Job_created(ctx context.Context, field graphql.CollectedField, obj *Job) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Job",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Job_finished(ctx context.Context, field graphql.CollectedField, obj *Job) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Job",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Finished, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return MarshalTime(*res)
+}
+
+var licenseImplementors = []string{"License"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _License(ctx context.Context, sel ast.SelectionSet, obj *License) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, licenseImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("License")
+		case "id":
+			out.Values[i] = ec._License_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "spdx":
+			out.Values[i] = ec._License_spdx(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._License_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "url":
+			out.Values[i] = ec._License_url(ctx, field, obj)
+		case "text":
+			out.Values[i] = ec._License_text(ctx, field, obj)
+		case "html":
+			out.Values[i] = ec._License_html(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _License_id(ctx context.Context, field graphql.CollectedField, obj *License) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "License",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(LicenseId)
+	rctx.Result = res
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _License_spdx(ctx context.Context, field graphql.CollectedField, obj *License) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "License",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SPDX(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _License_name(ctx context.Context, field graphql.CollectedField, obj *License) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "License",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _License_url(ctx context.Context, field graphql.CollectedField, obj *License) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "License",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _License_text(ctx context.Context, field graphql.CollectedField, obj *License) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "License",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Text, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _License_html(ctx context.Context, field graphql.CollectedField, obj *License) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "License",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HTML(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+var linkImplementors = []string{"Link"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Link(ctx context.Context, sel ast.SelectionSet, obj *Link) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, linkImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Link")
+		case "id":
+			out.Values[i] = ec._Link_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "title":
+			out.Values[i] = ec._Link_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "uri":
+			out.Values[i] = ec._Link_uri(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "created":
+			out.Values[i] = ec._Link_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "modified":
+			out.Values[i] = ec._Link_modified(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "description":
+			out.Values[i] = ec._Link_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "screenshot":
+			out.Values[i] = ec._Link_screenshot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "tags":
+			out.Values[i] = ec._Link_tags(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "checked":
+			out.Values[i] = ec._Link_checked(ctx, field, obj)
+		case "statusCode":
+			out.Values[i] = ec._Link_statusCode(ctx, field, obj)
+		case "dead":
+			out.Values[i] = ec._Link_dead(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Link_id(ctx context.Context, field graphql.CollectedField, obj *Link) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Link",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Link_title(ctx context.Context, field graphql.CollectedField, obj *Link) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Link",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Title, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Link_uri(ctx context.Context, field graphql.CollectedField, obj *Link) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Link",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URI, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Link_created(ctx context.Context, field graphql.CollectedField, obj *Link) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Link",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Link_modified(ctx context.Context, field graphql.CollectedField, obj *Link) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Link",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Modified, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Link_description(ctx context.Context, field graphql.CollectedField, obj *Link) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Link",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Link_screenshot(ctx context.Context, field graphql.CollectedField, obj *Link) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Link",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Screenshot, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Link_tags(ctx context.Context, field graphql.CollectedField, obj *Link) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Link",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Tags, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+
+	for idx1 := range res {
+		arr1[idx1] = func() graphql.Marshaler {
+			return graphql.MarshalString(res[idx1])
+		}()
+	}
+
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Link_checked(ctx context.Context, field graphql.CollectedField, obj *Link) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Link",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Checked, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return MarshalTime(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Link_statusCode(ctx context.Context, field graphql.CollectedField, obj *Link) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Link",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StatusCode, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalInt(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Link_dead(ctx context.Context, field graphql.CollectedField, obj *Link) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Link",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Dead, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+var logImplementors = []string{"Log"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Log(ctx context.Context, sel ast.SelectionSet, obj *Log) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, logImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Log")
+		case "id":
+			out.Values[i] = ec._Log_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "userId":
+			out.Values[i] = ec._Log_userId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "groupId":
+			out.Values[i] = ec._Log_groupId(ctx, field, obj)
+		case "content":
+			out.Values[i] = ec._Log_content(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "location":
+			out.Values[i] = ec._Log_location(ctx, field, obj)
+		case "datetime":
+			out.Values[i] = ec._Log_datetime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "created":
+			out.Values[i] = ec._Log_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "modified":
+			out.Values[i] = ec._Log_modified(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Log_id(ctx context.Context, field graphql.CollectedField, obj *Log) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Log",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Log_userId(ctx context.Context, field graphql.CollectedField, obj *Log) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Log",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UserID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Log_groupId(ctx context.Context, field graphql.CollectedField, obj *Log) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Log",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.GroupID, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalID(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Log_content(ctx context.Context, field graphql.CollectedField, obj *Log) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Log",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Content, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Log_location(ctx context.Context, field graphql.CollectedField, obj *Log) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Log",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Location, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Geo)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec._Geo(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Log_datetime(ctx context.Context, field graphql.CollectedField, obj *Log) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Log",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Datetime, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Log_created(ctx context.Context, field graphql.CollectedField, obj *Log) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Log",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Log_modified(ctx context.Context, field graphql.CollectedField, obj *Log) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Log",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Modified, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+var metadataEntryImplementors = []string{"MetadataEntry"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _MetadataEntry(ctx context.Context, sel ast.SelectionSet, obj *MetadataEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, metadataEntryImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MetadataEntry")
+		case "key":
+			out.Values[i] = ec._MetadataEntry_key(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "value":
+			out.Values[i] = ec._MetadataEntry_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _MetadataEntry_key(ctx context.Context, field graphql.CollectedField, obj *MetadataEntry) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "MetadataEntry",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Key, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _MetadataEntry_value(ctx context.Context, field graphql.CollectedField, obj *MetadataEntry) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "MetadataEntry",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+var metadataKeyImplementors = []string{"MetadataKey"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _MetadataKey(ctx context.Context, sel ast.SelectionSet, obj *MetadataKey) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, metadataKeyImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MetadataKey")
+		case "name":
+			out.Values[i] = ec._MetadataKey_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "type":
+			out.Values[i] = ec._MetadataKey_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "description":
+			out.Values[i] = ec._MetadataKey_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _MetadataKey_name(ctx context.Context, field graphql.CollectedField, obj *MetadataKey) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "MetadataKey",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _MetadataKey_type(ctx context.Context, field graphql.CollectedField, obj *MetadataKey) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "MetadataKey",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(MetadataType)
+	rctx.Result = res
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _MetadataKey_description(ctx context.Context, field graphql.CollectedField, obj *MetadataKey) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "MetadataKey",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+var monthCountImplementors = []string{"MonthCount"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _MonthCount(ctx context.Context, sel ast.SelectionSet, obj *MonthCount) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, monthCountImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MonthCount")
+		case "month":
+			out.Values[i] = ec._MonthCount_month(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "books":
+			out.Values[i] = ec._MonthCount_books(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "pages":
+			out.Values[i] = ec._MonthCount_pages(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _MonthCount_month(ctx context.Context, field graphql.CollectedField, obj *MonthCount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "MonthCount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Month, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _MonthCount_books(ctx context.Context, field graphql.CollectedField, obj *MonthCount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "MonthCount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Books, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _MonthCount_pages(ctx context.Context, field graphql.CollectedField, obj *MonthCount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "MonthCount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Pages, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, mutationImplementors)
+
+	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "createPost":
+			out.Values[i] = ec._Mutation_createPost(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "editPost":
+			out.Values[i] = ec._Mutation_editPost(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "createLink":
+			out.Values[i] = ec._Mutation_createLink(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "saveLink":
+			out.Values[i] = ec._Mutation_saveLink(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "upsertStat":
+			out.Values[i] = ec._Mutation_upsertStat(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "revertPost":
+			out.Values[i] = ec._Mutation_revertPost(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "setPostMetadata":
+			out.Values[i] = ec._Mutation_setPostMetadata(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "setPostPassword":
+			out.Values[i] = ec._Mutation_setPostPassword(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "unlockPost":
+			out.Values[i] = ec._Mutation_unlockPost(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "createServiceAccount":
+			out.Values[i] = ec._Mutation_createServiceAccount(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "rotateServiceAccountSecret":
+			out.Values[i] = ec._Mutation_rotateServiceAccountSecret(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "revokeServiceAccountSecret":
+			out.Values[i] = ec._Mutation_revokeServiceAccountSecret(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "deleteServiceAccount":
+			out.Values[i] = ec._Mutation_deleteServiceAccount(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "createOIDCClient":
+			out.Values[i] = ec._Mutation_createOIDCClient(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "deleteOIDCClient":
+			out.Values[i] = ec._Mutation_deleteOIDCClient(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "provisionUsers":
+			out.Values[i] = ec._Mutation_provisionUsers(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "deactivateUsers":
+			out.Values[i] = ec._Mutation_deactivateUsers(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "reactivateUsers":
+			out.Values[i] = ec._Mutation_reactivateUsers(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "deactivateUsersMatching":
+			out.Values[i] = ec._Mutation_deactivateUsersMatching(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "reassignUserContent":
+			out.Values[i] = ec._Mutation_reassignUserContent(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "mergeUsers":
+			out.Values[i] = ec._Mutation_mergeUsers(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "requeueJob":
+			out.Values[i] = ec._Mutation_requeueJob(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "createInvite":
+			out.Values[i] = ec._Mutation_createInvite(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "createSite":
+			out.Values[i] = ec._Mutation_createSite(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "updateSite":
+			out.Values[i] = ec._Mutation_updateSite(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "updateSiteSettings":
+			out.Values[i] = ec._Mutation_updateSiteSettings(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "updateTheme":
+			out.Values[i] = ec._Mutation_updateTheme(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "resetTheme":
+			out.Values[i] = ec._Mutation_resetTheme(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "createRedirect":
+			out.Values[i] = ec._Mutation_createRedirect(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "updateRedirect":
+			out.Values[i] = ec._Mutation_updateRedirect(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "deleteRedirect":
+			out.Values[i] = ec._Mutation_deleteRedirect(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "createShortLink":
+			out.Values[i] = ec._Mutation_createShortLink(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "addBook":
+			out.Values[i] = ec._Mutation_addBook(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "upsertPage":
+			out.Values[i] = ec._Mutation_upsertPage(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "markRead":
+			out.Values[i] = ec._Mutation_markRead(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "importGoodreads":
+			out.Values[i] = ec._Mutation_importGoodreads(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "createWebhook":
+			out.Values[i] = ec._Mutation_createWebhook(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "deleteWebhook":
+			out.Values[i] = ec._Mutation_deleteWebhook(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "createAutomationRule":
+			out.Values[i] = ec._Mutation_createAutomationRule(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "updateAutomationRule":
+			out.Values[i] = ec._Mutation_updateAutomationRule(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "deleteAutomationRule":
+			out.Values[i] = ec._Mutation_deleteAutomationRule(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "testAutomationRule":
+			out.Values[i] = ec._Mutation_testAutomationRule(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "checkIntegrity":
+			out.Values[i] = ec._Mutation_checkIntegrity(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "exportData":
+			out.Values[i] = ec._Mutation_exportData(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "exportHugo":
+			out.Values[i] = ec._Mutation_exportHugo(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "importData":
+			out.Values[i] = ec._Mutation_importData(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "importWordPress":
+			out.Values[i] = ec._Mutation_importWordPress(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "importStaticSite":
+			out.Values[i] = ec._Mutation_importStaticSite(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "purgeCache":
+			out.Values[i] = ec._Mutation_purgeCache(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "insertLog":
+			out.Values[i] = ec._Mutation_insertLog(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "shareLog":
+			out.Values[i] = ec._Mutation_shareLog(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "createGroup":
+			out.Values[i] = ec._Mutation_createGroup(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "createGroupInvite":
+			out.Values[i] = ec._Mutation_createGroupInvite(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "joinGroup":
+			out.Values[i] = ec._Mutation_joinGroup(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "leaveGroup":
+			out.Values[i] = ec._Mutation_leaveGroup(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "deleteGroup":
+			out.Values[i] = ec._Mutation_deleteGroup(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "revokeSession":
+			out.Values[i] = ec._Mutation_revokeSession(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "revokeAllOtherSessions":
+			out.Values[i] = ec._Mutation_revokeAllOtherSessions(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "readLater":
+			out.Values[i] = ec._Mutation_readLater(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "removeReadLater":
+			out.Values[i] = ec._Mutation_removeReadLater(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "createHighlight":
+			out.Values[i] = ec._Mutation_createHighlight(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "updateHighlight":
+			out.Values[i] = ec._Mutation_updateHighlight(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "deleteHighlight":
+			out.Values[i] = ec._Mutation_deleteHighlight(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "readingProgress":
+			out.Values[i] = ec._Mutation_readingProgress(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "updateNotificationSettings":
+			out.Values[i] = ec._Mutation_updateNotificationSettings(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_createPost(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_createPost_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreatePost(rctx, args["input"].(NewPost))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Post)
+	rctx.Result = res
+
+	return ec._Post(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_editPost(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_editPost_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().EditPost(rctx, args["Id"].(string), args["input"].(NewPost), args["version"].(int))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Post)
+	rctx.Result = res
+
+	return ec._Post(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_createLink(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_createLink_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateLink(rctx, args["input"].(NewLink))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Link)
+	rctx.Result = res
+
+	return ec._Link(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_saveLink(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_saveLink_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SaveLink(rctx, args["url"].(string), args["tags"].([]string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Link)
+	rctx.Result = res
+
+	return ec._Link(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_upsertStat(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_upsertStat_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpsertStat(rctx, args["input"].(NewStat))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Stat)
+	rctx.Result = res
+
+	return ec._Stat(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_revertPost(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_revertPost_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RevertPost(rctx, args["id"].(string), args["revision"].(int), args["version"].(*int))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Post)
+	rctx.Result = res
+
+	return ec._Post(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_setPostMetadata(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_setPostMetadata_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SetPostMetadata(rctx, args["id"].(string), args["metadata"].([]MetadataInput))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Post)
+	rctx.Result = res
+
+	return ec._Post(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_setPostPassword(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_setPostPassword_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SetPostPassword(rctx, args["id"].(string), args["password"].(*string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Post)
+	rctx.Result = res
+
+	return ec._Post(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_unlockPost(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_unlockPost_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UnlockPost(rctx, args["id"].(string), args["password"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_createServiceAccount(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_createServiceAccount_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateServiceAccount(rctx, args["input"].(NewServiceAccount))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(ServiceAccountCredentials)
+	rctx.Result = res
+
+	return ec._ServiceAccountCredentials(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_rotateServiceAccountSecret(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_rotateServiceAccountSecret_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RotateServiceAccountSecret(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(ServiceAccountCredentials)
+	rctx.Result = res
+
+	return ec._ServiceAccountCredentials(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_revokeServiceAccountSecret(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_revokeServiceAccountSecret_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RevokeServiceAccountSecret(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_deleteServiceAccount(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_deleteServiceAccount_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteServiceAccount(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(ServiceAccount)
+	rctx.Result = res
+
+	return ec._ServiceAccount(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_createOIDCClient(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_createOIDCClient_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateOIDCClient(rctx, args["input"].(NewOIDCClient))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(OIDCClientCredentials)
+	rctx.Result = res
+
+	return ec._OIDCClientCredentials(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_deleteOIDCClient(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_deleteOIDCClient_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteOIDCClient(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(OIDCClient)
+	rctx.Result = res
+
+	return ec._OIDCClient(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_provisionUsers(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_provisionUsers_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ProvisionUsers(rctx, args["input"].([]NewUser))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*User)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._User(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_deactivateUsers(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_deactivateUsers_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeactivateUsers(rctx, args["ids"].([]string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*User)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._User(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_reactivateUsers(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_reactivateUsers_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ReactivateUsers(rctx, args["ids"].([]string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*User)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._User(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_deactivateUsersMatching(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_deactivateUsersMatching_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeactivateUsersMatching(rctx, args["filter"].(UserFilter))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Job)
+	rctx.Result = res
+
+	return ec._Job(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_reassignUserContent(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_reassignUserContent_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ReassignUserContent(rctx, args["from"].(string), args["to"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Job)
+	rctx.Result = res
+
+	return ec._Job(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_mergeUsers(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_mergeUsers_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().MergeUsers(rctx, args["from"].(string), args["into"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Job)
+	rctx.Result = res
+
+	return ec._Job(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_requeueJob(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_requeueJob_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RequeueJob(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Job)
+	rctx.Result = res
+
+	return ec._Job(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_createInvite(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateInvite(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Invite)
+	rctx.Result = res
+
+	return ec._Invite(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_createSite(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_createSite_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateSite(rctx, args["host"].(string), args["allowedUsers"].([]string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Site)
+	rctx.Result = res
+
+	return ec._Site(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_updateSite(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_updateSite_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateSite(rctx, args["id"].(string), args["host"].(*string), args["allowedUsers"].([]string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Site)
+	rctx.Result = res
+
+	return ec._Site(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_updateSiteSettings(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_updateSiteSettings_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateSiteSettings(rctx, args["input"].(SiteSettingsInput))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(SiteSettings)
+	rctx.Result = res
+
+	return ec._SiteSettings(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_updateTheme(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_updateTheme_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateTheme(rctx, args["input"].(ThemeInput))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Theme)
+	rctx.Result = res
+
+	return ec._Theme(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_resetTheme(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ResetTheme(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Theme)
+	rctx.Result = res
+
+	return ec._Theme(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_createRedirect(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_createRedirect_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateRedirect(rctx, args["input"].(NewRedirect))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Redirect)
+	rctx.Result = res
+
+	return ec._Redirect(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_updateRedirect(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_updateRedirect_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateRedirect(rctx, args["id"].(string), args["input"].(NewRedirect))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Redirect)
+	rctx.Result = res
+
+	return ec._Redirect(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_deleteRedirect(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_deleteRedirect_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteRedirect(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Redirect)
+	rctx.Result = res
+
+	return ec._Redirect(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_createShortLink(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_createShortLink_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateShortLink(rctx, args["url"].(string), args["slug"].(*string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(ShortLink)
+	rctx.Result = res
+
+	return ec._ShortLink(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_addBook(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_addBook_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().AddBook(rctx, args["input"].(NewBook))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Book)
+	rctx.Result = res
+
+	return ec._Book(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_upsertPage(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_upsertPage_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpsertPage(rctx, args["slug"].(string), args["content"].(string), args["title"].(*string), args["draft"].(*bool), args["version"].(*int))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Page)
+	rctx.Result = res
+
+	return ec._Page(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_markRead(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_markRead_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().MarkRead(rctx, args["id"].(string), args["finished"].(*time.Time), args["rating"].(*int))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Book)
+	rctx.Result = res
+
+	return ec._Book(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_importGoodreads(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_importGoodreads_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ImportGoodreads(rctx, args["csv"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Book)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Book(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_createWebhook(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_createWebhook_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateWebhook(rctx, args["input"].(NewWebhook))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(WebhookCredentials)
+	rctx.Result = res
+
+	return ec._WebhookCredentials(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_deleteWebhook(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_deleteWebhook_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteWebhook(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Webhook)
+	rctx.Result = res
+
+	return ec._Webhook(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_createAutomationRule(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_createAutomationRule_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateAutomationRule(rctx, args["input"].(AutomationRuleInput))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(AutomationRule)
+	rctx.Result = res
+
+	return ec._AutomationRule(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_updateAutomationRule(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_updateAutomationRule_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateAutomationRule(rctx, args["id"].(string), args["input"].(AutomationRuleInput))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(AutomationRule)
+	rctx.Result = res
+
+	return ec._AutomationRule(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_deleteAutomationRule(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_deleteAutomationRule_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteAutomationRule(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(AutomationRule)
+	rctx.Result = res
+
+	return ec._AutomationRule(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_testAutomationRule(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_testAutomationRule_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().TestAutomationRule(rctx, args["condition"].(string), args["postId"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_checkIntegrity(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_checkIntegrity_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CheckIntegrity(rctx, args["repair"].(*bool))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*IntegrityIssue)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._IntegrityIssue(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_exportData(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ExportData(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(DataExport)
+	rctx.Result = res
+
+	return ec._DataExport(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_exportHugo(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_exportHugo_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ExportHugo(rctx, args["media"].(*bool))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(DataExport)
+	rctx.Result = res
+
+	return ec._DataExport(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_importData(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_importData_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ImportData(rctx, args["archive"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*ImportResult)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._ImportResult(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_importWordPress(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_importWordPress_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ImportWordPress(rctx, args["file"].(string), args["baseURL"].(*string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Job)
+	rctx.Result = res
+
+	return ec._Job(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_importStaticSite(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_importStaticSite_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ImportStaticSite(rctx, args["archive"].(string), args["baseURL"].(*string), args["permalink"].(*string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Job)
+	rctx.Result = res
+
+	return ec._Job(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_purgeCache(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_purgeCache_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().PurgeCache(rctx, args["paths"].([]string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+
+	for idx1 := range res {
+		arr1[idx1] = func() graphql.Marshaler {
+			return graphql.MarshalString(res[idx1])
+		}()
+	}
+
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_insertLog(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_insertLog_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().InsertLog(rctx, args["input"].(NewLog))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Log)
+	rctx.Result = res
+
+	return ec._Log(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_shareLog(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_shareLog_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ShareLog(rctx, args["id"].(string), args["groupId"].(*string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Log)
+	rctx.Result = res
+
+	return ec._Log(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_createGroup(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_createGroup_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateGroup(rctx, args["name"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Group)
+	rctx.Result = res
+
+	return ec._Group(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_createGroupInvite(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_createGroupInvite_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateGroupInvite(rctx, args["groupId"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_joinGroup(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_joinGroup_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().JoinGroup(rctx, args["code"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Group)
+	rctx.Result = res
+
+	return ec._Group(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_leaveGroup(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_leaveGroup_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().LeaveGroup(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Group)
+	rctx.Result = res
+
+	return ec._Group(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_deleteGroup(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_deleteGroup_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteGroup(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Group)
+	rctx.Result = res
+
+	return ec._Group(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_revokeSession(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_revokeSession_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RevokeSession(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_revokeAllOtherSessions(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RevokeAllOtherSessions(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_readLater(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_readLater_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ReadLater(rctx, args["url"].(*string), args["postId"].(*string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(ReadLaterItem)
+	rctx.Result = res
+
+	return ec._ReadLaterItem(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_removeReadLater(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_removeReadLater_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RemoveReadLater(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_createHighlight(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_createHighlight_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateHighlight(rctx, args["input"].(NewHighlight))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Highlight)
+	rctx.Result = res
+
+	return ec._Highlight(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_updateHighlight(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_updateHighlight_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateHighlight(rctx, args["id"].(string), args["note"].(*string), args["public"].(*bool))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Highlight)
+	rctx.Result = res
+
+	return ec._Highlight(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_deleteHighlight(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_deleteHighlight_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteHighlight(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_readingProgress(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_readingProgress_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ReadingProgress(rctx, args["postId"].(string), args["percent"].(int))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(ReadingProgress)
+	rctx.Result = res
+
+	return ec._ReadingProgress(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_updateNotificationSettings(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_updateNotificationSettings_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateNotificationSettings(rctx, args["input"].(NotificationSettingsInput))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(NotificationSettings)
+	rctx.Result = res
+
+	return ec._NotificationSettings(ctx, field.Selections, &res)
+}
+
+var navItemImplementors = []string{"NavItem"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _NavItem(ctx context.Context, sel ast.SelectionSet, obj *NavItem) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, navItemImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("NavItem")
+		case "label":
+			out.Values[i] = ec._NavItem_label(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "url":
+			out.Values[i] = ec._NavItem_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NavItem_label(ctx context.Context, field graphql.CollectedField, obj *NavItem) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "NavItem",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Label, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NavItem_url(ctx context.Context, field graphql.CollectedField, obj *NavItem) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "NavItem",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+var notificationSettingsImplementors = []string{"NotificationSettings"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _NotificationSettings(ctx context.Context, sel ast.SelectionSet, obj *NotificationSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, notificationSettingsImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("NotificationSettings")
+		case "email":
+			out.Values[i] = ec._NotificationSettings_email(ctx, field, obj)
+		case "comments":
+			out.Values[i] = ec._NotificationSettings_comments(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "digest":
+			out.Values[i] = ec._NotificationSettings_digest(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "authAlerts":
+			out.Values[i] = ec._NotificationSettings_authAlerts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "integrityAlerts":
+			out.Values[i] = ec._NotificationSettings_integrityAlerts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "commentsDelivery":
+			out.Values[i] = ec._NotificationSettings_commentsDelivery(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "authAlertsDelivery":
+			out.Values[i] = ec._NotificationSettings_authAlertsDelivery(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "timezone":
+			out.Values[i] = ec._NotificationSettings_timezone(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "quietStart":
+			out.Values[i] = ec._NotificationSettings_quietStart(ctx, field, obj)
+		case "quietEnd":
+			out.Values[i] = ec._NotificationSettings_quietEnd(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NotificationSettings_email(ctx context.Context, field graphql.CollectedField, obj *NotificationSettings) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "NotificationSettings",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Email, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NotificationSettings_comments(ctx context.Context, field graphql.CollectedField, obj *NotificationSettings) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "NotificationSettings",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Comments, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NotificationSettings_digest(ctx context.Context, field graphql.CollectedField, obj *NotificationSettings) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "NotificationSettings",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Digest, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NotificationSettings_authAlerts(ctx context.Context, field graphql.CollectedField, obj *NotificationSettings) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "NotificationSettings",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AuthAlerts, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NotificationSettings_integrityAlerts(ctx context.Context, field graphql.CollectedField, obj *NotificationSettings) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "NotificationSettings",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IntegrityAlerts, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NotificationSettings_commentsDelivery(ctx context.Context, field graphql.CollectedField, obj *NotificationSettings) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "NotificationSettings",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CommentsDelivery, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(NotificationDelivery)
+	rctx.Result = res
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NotificationSettings_authAlertsDelivery(ctx context.Context, field graphql.CollectedField, obj *NotificationSettings) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "NotificationSettings",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AuthAlertsDelivery, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(NotificationDelivery)
+	rctx.Result = res
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NotificationSettings_timezone(ctx context.Context, field graphql.CollectedField, obj *NotificationSettings) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "NotificationSettings",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Timezone, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NotificationSettings_quietStart(ctx context.Context, field graphql.CollectedField, obj *NotificationSettings) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "NotificationSettings",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QuietStart, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalInt(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NotificationSettings_quietEnd(ctx context.Context, field graphql.CollectedField, obj *NotificationSettings) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "NotificationSettings",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QuietEnd, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalInt(*res)
+}
+
+var oIDCClientImplementors = []string{"OIDCClient"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _OIDCClient(ctx context.Context, sel ast.SelectionSet, obj *OIDCClient) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, oIDCClientImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OIDCClient")
+		case "id":
+			out.Values[i] = ec._OIDCClient_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "name":
+			out.Values[i] = ec._OIDCClient_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "redirectURIs":
+			out.Values[i] = ec._OIDCClient_redirectURIs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "created":
+			out.Values[i] = ec._OIDCClient_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _OIDCClient_id(ctx context.Context, field graphql.CollectedField, obj *OIDCClient) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "OIDCClient",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _OIDCClient_name(ctx context.Context, field graphql.CollectedField, obj *OIDCClient) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "OIDCClient",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _OIDCClient_redirectURIs(ctx context.Context, field graphql.CollectedField, obj *OIDCClient) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "OIDCClient",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RedirectURIs, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+
+	for idx1 := range res {
+		arr1[idx1] = func() graphql.Marshaler {
+			return graphql.MarshalString(res[idx1])
+		}()
+	}
+
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _OIDCClient_created(ctx context.Context, field graphql.CollectedField, obj *OIDCClient) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "OIDCClient",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+var oIDCClientCredentialsImplementors = []string{"OIDCClientCredentials"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _OIDCClientCredentials(ctx context.Context, sel ast.SelectionSet, obj *OIDCClientCredentials) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, oIDCClientCredentialsImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OIDCClientCredentials")
+		case "client":
+			out.Values[i] = ec._OIDCClientCredentials_client(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "secret":
+			out.Values[i] = ec._OIDCClientCredentials_secret(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _OIDCClientCredentials_client(ctx context.Context, field graphql.CollectedField, obj *OIDCClientCredentials) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "OIDCClientCredentials",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Client, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(OIDCClient)
+	rctx.Result = res
+
+	return ec._OIDCClient(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _OIDCClientCredentials_secret(ctx context.Context, field graphql.CollectedField, obj *OIDCClientCredentials) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "OIDCClientCredentials",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Secret, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+var pageImplementors = []string{"Page"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Page(ctx context.Context, sel ast.SelectionSet, obj *Page) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, pageImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Page")
+		case "slug":
+			out.Values[i] = ec._Page_slug(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "title":
+			out.Values[i] = ec._Page_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "content":
+			out.Values[i] = ec._Page_content(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "html":
+			out.Values[i] = ec._Page_html(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "draft":
+			out.Values[i] = ec._Page_draft(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "created":
+			out.Values[i] = ec._Page_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "modified":
+			out.Values[i] = ec._Page_modified(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "version":
+			out.Values[i] = ec._Page_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Page_slug(ctx context.Context, field graphql.CollectedField, obj *Page) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Page",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Slug, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Page_title(ctx context.Context, field graphql.CollectedField, obj *Page) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Page",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Title, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Page_content(ctx context.Context, field graphql.CollectedField, obj *Page) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Page",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Content, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Page_html(ctx context.Context, field graphql.CollectedField, obj *Page) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Page",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HTML(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Page_draft(ctx context.Context, field graphql.CollectedField, obj *Page) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Page",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Draft, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Page_created(ctx context.Context, field graphql.CollectedField, obj *Page) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Page",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Page_modified(ctx context.Context, field graphql.CollectedField, obj *Page) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Page",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Modified, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Page_version(ctx context.Context, field graphql.CollectedField, obj *Page) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Page",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Version, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+var pageViewStatsImplementors = []string{"PageViewStats"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _PageViewStats(ctx context.Context, sel ast.SelectionSet, obj *PageViewStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, pageViewStatsImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PageViewStats")
+		case "views":
+			out.Values[i] = ec._PageViewStats_views(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "visitors":
+			out.Values[i] = ec._PageViewStats_visitors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "referrers":
+			out.Values[i] = ec._PageViewStats_referrers(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "days":
+			out.Values[i] = ec._PageViewStats_days(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _PageViewStats_views(ctx context.Context, field graphql.CollectedField, obj *PageViewStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "PageViewStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Views, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _PageViewStats_visitors(ctx context.Context, field graphql.CollectedField, obj *PageViewStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "PageViewStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Visitors, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _PageViewStats_referrers(ctx context.Context, field graphql.CollectedField, obj *PageViewStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "PageViewStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Referrers, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]ReferrerCount)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec._ReferrerCount(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _PageViewStats_days(ctx context.Context, field graphql.CollectedField, obj *PageViewStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "PageViewStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Days, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]DayCount)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec._DayCount(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+var postImplementors = []string{"Post"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj *Post) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, postImplementors)
+
+	var wg sync.WaitGroup
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Post")
+		case "id":
+			out.Values[i] = ec._Post_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "title":
+			out.Values[i] = ec._Post_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "content":
+			out.Values[i] = ec._Post_content(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "summary":
+			out.Values[i] = ec._Post_summary(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "readtime":
+			out.Values[i] = ec._Post_readtime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "html":
+			out.Values[i] = ec._Post_html(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "datetime":
+			out.Values[i] = ec._Post_datetime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "created":
+			out.Values[i] = ec._Post_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "modified":
+			out.Values[i] = ec._Post_modified(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "draft":
+			out.Values[i] = ec._Post_draft(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "tags":
+			out.Values[i] = ec._Post_tags(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "protected":
+			out.Values[i] = ec._Post_protected(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "links":
+			out.Values[i] = ec._Post_links(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "revisions":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Post_revisions(ctx, field, obj)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "syndicationUrls":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Post_syndicationUrls(ctx, field, obj)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "metadata":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Post_metadata(ctx, field, obj)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "canonicalURL":
+			out.Values[i] = ec._Post_canonicalURL(ctx, field, obj)
+		case "originallyPublishedAt":
+			out.Values[i] = ec._Post_originallyPublishedAt(ctx, field, obj)
+		case "license":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Post_license(ctx, field, obj)
+				wg.Done()
+			}(i, field)
+		case "version":
+			out.Values[i] = ec._Post_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	wg.Wait()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_id(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_title(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Title, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_content(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Content, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_summary(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Summary(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_readtime(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Readtime, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_html(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HTML(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_datetime(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Datetime, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_created(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_modified(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Modified, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_draft(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Draft, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_tags(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Tags, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+
+	for idx1 := range res {
+		arr1[idx1] = func() graphql.Marshaler {
+			return graphql.MarshalString(res[idx1])
+		}()
+	}
+
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_protected(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Protected, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_links(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Links, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Link)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Link(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_revisions(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Post_revisions_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().Revisions(rctx, obj, args["limit"].(*int), args["offset"].(*int))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Revision)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Revision(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_syndicationUrls(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().SyndicationUrls(rctx, obj)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+
+	for idx1 := range res {
+		arr1[idx1] = func() graphql.Marshaler {
+			return graphql.MarshalString(res[idx1])
+		}()
+	}
+
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_metadata(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().Metadata(rctx, obj)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]MetadataEntry)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec._MetadataEntry(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_canonicalURL(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CanonicalURL, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_originallyPublishedAt(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OriginallyPublishedAt, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return MarshalTime(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_license(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().License(rctx, obj)
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*License)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec._License(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Post_version(ctx context.Context, field graphql.CollectedField, obj *Post) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Post",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Version, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+var queryImplementors = []string{"Query"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, queryImplementors)
+
+	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
+		Object: "Query",
+	})
+
+	var wg sync.WaitGroup
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "allPosts":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_allPosts(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "drafts":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_drafts(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "posts":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_posts(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "post":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_post(ctx, field)
+				wg.Done()
+			}(i, field)
+		case "suggestPosts":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_suggestPosts(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "nextPost":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_nextPost(ctx, field)
+				wg.Done()
+			}(i, field)
+		case "prevPost":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_prevPost(ctx, field)
+				wg.Done()
+			}(i, field)
+		case "tags":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_tags(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "sitemapPosts":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_sitemapPosts(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "metadataKeys":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_metadataKeys(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "allLinks":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_allLinks(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "links":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_links(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "link":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_link(ctx, field)
+				wg.Done()
+			}(i, field)
+		case "stats":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_stats(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "postDiff":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_postDiff(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "serviceAccounts":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_serviceAccounts(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "oidcClients":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_oidcClients(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "users":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_users(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "invites":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_invites(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "jobs":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_jobs(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "job":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_job(ctx, field)
+				wg.Done()
+			}(i, field)
+		case "sites":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_sites(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "siteSettings":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_siteSettings(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "theme":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_theme(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "redirects":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_redirects(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "shortLinks":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_shortLinks(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "shortLink":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_shortLink(ctx, field)
+				wg.Done()
+			}(i, field)
+		case "logs":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_logs(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "sharedWithMe":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_sharedWithMe(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "groups":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_groups(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "mySessions":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_mySessions(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "readLater":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_readLater(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "readingProgress":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_readingProgress(ctx, field)
+				wg.Done()
+			}(i, field)
+		case "highlights":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_highlights(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "exportHighlights":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_exportHighlights(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "adminStats":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_adminStats(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "goroutines":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_goroutines(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "debugInfo":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_debugInfo(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "webhooks":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_webhooks(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "webhookDeliveries":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_webhookDeliveries(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "automationRules":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_automationRules(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "integrityIssues":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_integrityIssues(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "pageViews":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_pageViews(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "books":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_books(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "book":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_book(ctx, field)
+				wg.Done()
+			}(i, field)
+		case "currentlyReading":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_currentlyReading(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "readingStats":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_readingStats(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "page":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_page(ctx, field)
+				wg.Done()
+			}(i, field)
+		case "pages":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_pages(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "__type":
+			out.Values[i] = ec._Query___type(ctx, field)
+		case "__schema":
+			out.Values[i] = ec._Query___schema(ctx, field)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	wg.Wait()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_allPosts(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().AllPosts(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Post)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Post(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_drafts(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Drafts(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Post)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Post(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_posts(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_posts_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Posts(rctx, args["limit"].(*int), args["offset"].(*int))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Post)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Post(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_post(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_post_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Post(rctx, args["id"].(string), args["unlock"].(*string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Post)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec._Post(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_suggestPosts(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_suggestPosts_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SuggestPosts(rctx, args["path"].(string), args["limit"].(*int))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Post)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Post(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_nextPost(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_nextPost_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().NextPost(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Post)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec._Post(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_prevPost(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_prevPost_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().PrevPost(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Post)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec._Post(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_tags(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Tags(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*TagCount)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._TagCount(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_sitemapPosts(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SitemapPosts(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Post)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Post(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_metadataKeys(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().MetadataKeys(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]MetadataKey)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec._MetadataKey(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_allLinks(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().AllLinks(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Link)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Link(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_links(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_links_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Links(rctx, args["filter"].(*LinkFilter), args["limit"].(*int), args["offset"].(*int))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Link)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Link(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_link(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_link_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Link(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Link)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec._Link(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_stats(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_stats_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Stats(rctx, args["count"].(*int))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Stat)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Stat(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_postDiff(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_postDiff_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().PostDiff(rctx, args["id"].(string), args["from"].(int), args["to"].(int))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_serviceAccounts(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ServiceAccounts(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*ServiceAccount)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._ServiceAccount(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_oidcClients(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().OidcClients(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*OIDCClient)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._OIDCClient(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_users(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_users_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Users(rctx, args["filter"].(*UserFilter), args["limit"].(*int), args["offset"].(*int))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*User)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._User(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_invites(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Invites(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Invite)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Invite(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_jobs(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_jobs_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Jobs(rctx, args["limit"].(*int), args["status"].(*JobStatus), args["requestId"].(*string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Job)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Job(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_job(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_job_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Job(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Job)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec._Job(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_sites(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Sites(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]Site)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec._Site(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_siteSettings(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SiteSettings(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(SiteSettings)
+	rctx.Result = res
+
+	return ec._SiteSettings(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_theme(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Theme(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Theme)
+	rctx.Result = res
+
+	return ec._Theme(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_redirects(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Redirects(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Redirect)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Redirect(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_shortLinks(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ShortLinks(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*ShortLink)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._ShortLink(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_shortLink(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_shortLink_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ShortLink(rctx, args["slug"].(string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*ShortLink)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec._ShortLink(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_logs(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_logs_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Logs(rctx, args["range"].(*DateRange))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Log)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Log(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_sharedWithMe(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_sharedWithMe_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SharedWithMe(rctx, args["range"].(*DateRange))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Log)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Log(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_groups(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Groups(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Group)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Group(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_mySessions(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().MySessions(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Session)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Session(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_readLater(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_readLater_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ReadLater(rctx, args["order"].(*ReadLaterOrder), args["limit"].(*int), args["offset"].(*int))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*ReadLaterItem)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._ReadLaterItem(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_readingProgress(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_readingProgress_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ReadingProgress(rctx, args["postId"].(string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*ReadingProgress)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec._ReadingProgress(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_highlights(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_highlights_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Highlights(rctx, args["postId"].(string), args["includePublic"].(*bool))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Highlight)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Highlight(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_exportHighlights(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ExportHighlights(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_adminStats(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().AdminStats(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(AdminStats)
+	rctx.Result = res
+
+	return ec._AdminStats(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_goroutines(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Goroutines(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*GoroutineCount)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._GoroutineCount(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_debugInfo(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().DebugInfo(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(DebugInfo)
+	rctx.Result = res
+
+	return ec._DebugInfo(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_webhooks(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Webhooks(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Webhook)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Webhook(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_webhookDeliveries(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_webhookDeliveries_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().WebhookDeliveries(rctx, args["webhookId"].(*string), args["failed"].(*bool), args["requestId"].(*string), args["limit"].(*int))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*WebhookDelivery)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._WebhookDelivery(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_automationRules(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().AutomationRules(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*AutomationRule)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._AutomationRule(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_integrityIssues(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().IntegrityIssues(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*IntegrityIssue)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._IntegrityIssue(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_pageViews(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_pageViews_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().PageViews(rctx, args["path"].(*string), args["range"].(*DateRange))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(PageViewStats)
+	rctx.Result = res
+
+	return ec._PageViewStats(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_books(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_books_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Books(rctx, args["shelf"].(*Shelf), args["year"].(*int), args["limit"].(*int), args["offset"].(*int))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Book)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Book(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_book(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_book_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Book(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Book)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec._Book(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_currentlyReading(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().CurrentlyReading(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Book)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Book(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_readingStats(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_readingStats_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ReadingStats(rctx, args["year"].(int))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(ReadingStats)
+	rctx.Result = res
+
+	return ec._ReadingStats(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_page(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_page_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Page(rctx, args["slug"].(string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Page)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec._Page(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_pages(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Pages(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Page)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._Page(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query___type_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(args["name"].(string)), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec.___Type(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec.___Schema(ctx, field.Selections, res)
+}
+
+var readLaterItemImplementors = []string{"ReadLaterItem"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _ReadLaterItem(ctx context.Context, sel ast.SelectionSet, obj *ReadLaterItem) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, readLaterItemImplementors)
+
+	var wg sync.WaitGroup
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ReadLaterItem")
+		case "id":
+			out.Values[i] = ec._ReadLaterItem_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "url":
+			out.Values[i] = ec._ReadLaterItem_url(ctx, field, obj)
+		case "post":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._ReadLaterItem_post(ctx, field, obj)
+				wg.Done()
+			}(i, field)
+		case "progress":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._ReadLaterItem_progress(ctx, field, obj)
+				wg.Done()
+			}(i, field)
+		case "created":
+			out.Values[i] = ec._ReadLaterItem_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	wg.Wait()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ReadLaterItem_id(ctx context.Context, field graphql.CollectedField, obj *ReadLaterItem) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ReadLaterItem",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ReadLaterItem_url(ctx context.Context, field graphql.CollectedField, obj *ReadLaterItem) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ReadLaterItem",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ReadLaterItem_post(ctx context.Context, field graphql.CollectedField, obj *ReadLaterItem) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ReadLaterItem",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ReadLaterItem().Post(rctx, obj)
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Post)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec._Post(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ReadLaterItem_progress(ctx context.Context, field graphql.CollectedField, obj *ReadLaterItem) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ReadLaterItem",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ReadLaterItem().Progress(rctx, obj)
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalInt(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ReadLaterItem_created(ctx context.Context, field graphql.CollectedField, obj *ReadLaterItem) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ReadLaterItem",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+var readingProgressImplementors = []string{"ReadingProgress"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _ReadingProgress(ctx context.Context, sel ast.SelectionSet, obj *ReadingProgress) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, readingProgressImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ReadingProgress")
+		case "postId":
+			out.Values[i] = ec._ReadingProgress_postId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "percent":
+			out.Values[i] = ec._ReadingProgress_percent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "modified":
+			out.Values[i] = ec._ReadingProgress_modified(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ReadingProgress_postId(ctx context.Context, field graphql.CollectedField, obj *ReadingProgress) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ReadingProgress",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PostID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ReadingProgress_percent(ctx context.Context, field graphql.CollectedField, obj *ReadingProgress) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ReadingProgress",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Percent, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ReadingProgress_modified(ctx context.Context, field graphql.CollectedField, obj *ReadingProgress) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ReadingProgress",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Modified, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+var readingStatsImplementors = []string{"ReadingStats"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _ReadingStats(ctx context.Context, sel ast.SelectionSet, obj *ReadingStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, readingStatsImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ReadingStats")
+		case "year":
+			out.Values[i] = ec._ReadingStats_year(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "books":
+			out.Values[i] = ec._ReadingStats_books(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "pages":
+			out.Values[i] = ec._ReadingStats_pages(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "averageRating":
+			out.Values[i] = ec._ReadingStats_averageRating(ctx, field, obj)
+		case "months":
+			out.Values[i] = ec._ReadingStats_months(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ReadingStats_year(ctx context.Context, field graphql.CollectedField, obj *ReadingStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ReadingStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Year, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ReadingStats_books(ctx context.Context, field graphql.CollectedField, obj *ReadingStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ReadingStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Books, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ReadingStats_pages(ctx context.Context, field graphql.CollectedField, obj *ReadingStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ReadingStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Pages, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ReadingStats_averageRating(ctx context.Context, field graphql.CollectedField, obj *ReadingStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ReadingStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AverageRating, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalFloat(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ReadingStats_months(ctx context.Context, field graphql.CollectedField, obj *ReadingStats) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ReadingStats",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Months, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]MonthCount)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec._MonthCount(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+var redirectImplementors = []string{"Redirect"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Redirect(ctx context.Context, sel ast.SelectionSet, obj *Redirect) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, redirectImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Redirect")
+		case "id":
+			out.Values[i] = ec._Redirect_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "from":
+			out.Values[i] = ec._Redirect_from(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "to":
+			out.Values[i] = ec._Redirect_to(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "status":
+			out.Values[i] = ec._Redirect_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "hits":
+			out.Values[i] = ec._Redirect_hits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "lastHit":
+			out.Values[i] = ec._Redirect_lastHit(ctx, field, obj)
+		case "created":
+			out.Values[i] = ec._Redirect_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "modified":
+			out.Values[i] = ec._Redirect_modified(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Redirect_id(ctx context.Context, field graphql.CollectedField, obj *Redirect) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Redirect",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Redirect_from(ctx context.Context, field graphql.CollectedField, obj *Redirect) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Redirect",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.From, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Redirect_to(ctx context.Context, field graphql.CollectedField, obj *Redirect) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Redirect",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.To, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Redirect_status(ctx context.Context, field graphql.CollectedField, obj *Redirect) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Redirect",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Redirect_hits(ctx context.Context, field graphql.CollectedField, obj *Redirect) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Redirect",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hits, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Redirect_lastHit(ctx context.Context, field graphql.CollectedField, obj *Redirect) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Redirect",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastHit, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return MarshalTime(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Redirect_created(ctx context.Context, field graphql.CollectedField, obj *Redirect) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Redirect",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Redirect_modified(ctx context.Context, field graphql.CollectedField, obj *Redirect) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Redirect",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Modified, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+var referrerCountImplementors = []string{"ReferrerCount"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _ReferrerCount(ctx context.Context, sel ast.SelectionSet, obj *ReferrerCount) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, referrerCountImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ReferrerCount")
+		case "referrer":
+			out.Values[i] = ec._ReferrerCount_referrer(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "views":
+			out.Values[i] = ec._ReferrerCount_views(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ReferrerCount_referrer(ctx context.Context, field graphql.CollectedField, obj *ReferrerCount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ReferrerCount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Referrer, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ReferrerCount_views(ctx context.Context, field graphql.CollectedField, obj *ReferrerCount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ReferrerCount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Views, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+var revisionImplementors = []string{"Revision"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Revision(ctx context.Context, sel ast.SelectionSet, obj *Revision) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, revisionImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Revision")
+		case "id":
+			out.Values[i] = ec._Revision_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "revision":
+			out.Values[i] = ec._Revision_revision(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "title":
+			out.Values[i] = ec._Revision_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "content":
+			out.Values[i] = ec._Revision_content(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "datetime":
+			out.Values[i] = ec._Revision_datetime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "draft":
+			out.Values[i] = ec._Revision_draft(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "created":
+			out.Values[i] = ec._Revision_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Revision_id(ctx context.Context, field graphql.CollectedField, obj *Revision) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Revision",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Revision_revision(ctx context.Context, field graphql.CollectedField, obj *Revision) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Revision",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Revision, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Revision_title(ctx context.Context, field graphql.CollectedField, obj *Revision) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Revision",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Title, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Revision_content(ctx context.Context, field graphql.CollectedField, obj *Revision) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Revision",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Content, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Revision_datetime(ctx context.Context, field graphql.CollectedField, obj *Revision) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Revision",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Datetime, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Revision_draft(ctx context.Context, field graphql.CollectedField, obj *Revision) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Revision",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Draft, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Revision_created(ctx context.Context, field graphql.CollectedField, obj *Revision) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Revision",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+var roleCountImplementors = []string{"RoleCount"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _RoleCount(ctx context.Context, sel ast.SelectionSet, obj *RoleCount) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, roleCountImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RoleCount")
+		case "role":
+			out.Values[i] = ec._RoleCount_role(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "count":
+			out.Values[i] = ec._RoleCount_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _RoleCount_role(ctx context.Context, field graphql.CollectedField, obj *RoleCount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "RoleCount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Role, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Role)
+	rctx.Result = res
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _RoleCount_count(ctx context.Context, field graphql.CollectedField, obj *RoleCount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "RoleCount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Count, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+var serviceAccountImplementors = []string{"ServiceAccount"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _ServiceAccount(ctx context.Context, sel ast.SelectionSet, obj *ServiceAccount) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, serviceAccountImplementors)
+
+	var wg sync.WaitGroup
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ServiceAccount")
+		case "id":
+			out.Values[i] = ec._ServiceAccount_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "name":
+			out.Values[i] = ec._ServiceAccount_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "scopes":
+			out.Values[i] = ec._ServiceAccount_scopes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "expires":
+			out.Values[i] = ec._ServiceAccount_expires(ctx, field, obj)
+		case "lastUsed":
+			out.Values[i] = ec._ServiceAccount_lastUsed(ctx, field, obj)
+		case "created":
+			out.Values[i] = ec._ServiceAccount_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "secrets":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._ServiceAccount_secrets(ctx, field, obj)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	wg.Wait()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ServiceAccount_id(ctx context.Context, field graphql.CollectedField, obj *ServiceAccount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ServiceAccount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ServiceAccount_name(ctx context.Context, field graphql.CollectedField, obj *ServiceAccount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ServiceAccount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ServiceAccount_scopes(ctx context.Context, field graphql.CollectedField, obj *ServiceAccount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ServiceAccount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Scopes, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+
+	for idx1 := range res {
+		arr1[idx1] = func() graphql.Marshaler {
+			return graphql.MarshalString(res[idx1])
+		}()
+	}
+
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ServiceAccount_expires(ctx context.Context, field graphql.CollectedField, obj *ServiceAccount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ServiceAccount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Expires, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return MarshalTime(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ServiceAccount_lastUsed(ctx context.Context, field graphql.CollectedField, obj *ServiceAccount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ServiceAccount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastUsed, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return MarshalTime(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ServiceAccount_created(ctx context.Context, field graphql.CollectedField, obj *ServiceAccount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ServiceAccount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ServiceAccount_secrets(ctx context.Context, field graphql.CollectedField, obj *ServiceAccount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ServiceAccount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ServiceAccount().Secrets(rctx, obj)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*ServiceAccountSecret)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				if res[idx1] == nil {
+					return graphql.Null
+				}
+
+				return ec._ServiceAccountSecret(ctx, field.Selections, res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+var serviceAccountCredentialsImplementors = []string{"ServiceAccountCredentials"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _ServiceAccountCredentials(ctx context.Context, sel ast.SelectionSet, obj *ServiceAccountCredentials) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, serviceAccountCredentialsImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ServiceAccountCredentials")
+		case "serviceAccount":
+			out.Values[i] = ec._ServiceAccountCredentials_serviceAccount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "secret":
+			out.Values[i] = ec._ServiceAccountCredentials_secret(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ServiceAccountCredentials_serviceAccount(ctx context.Context, field graphql.CollectedField, obj *ServiceAccountCredentials) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ServiceAccountCredentials",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ServiceAccount, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(ServiceAccount)
+	rctx.Result = res
+
+	return ec._ServiceAccount(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ServiceAccountCredentials_secret(ctx context.Context, field graphql.CollectedField, obj *ServiceAccountCredentials) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ServiceAccountCredentials",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Secret, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+var serviceAccountSecretImplementors = []string{"ServiceAccountSecret"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _ServiceAccountSecret(ctx context.Context, sel ast.SelectionSet, obj *ServiceAccountSecret) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, serviceAccountSecretImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ServiceAccountSecret")
+		case "id":
+			out.Values[i] = ec._ServiceAccountSecret_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "created":
+			out.Values[i] = ec._ServiceAccountSecret_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "lastUsed":
+			out.Values[i] = ec._ServiceAccountSecret_lastUsed(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ServiceAccountSecret_id(ctx context.Context, field graphql.CollectedField, obj *ServiceAccountSecret) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ServiceAccountSecret",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ServiceAccountSecret_created(ctx context.Context, field graphql.CollectedField, obj *ServiceAccountSecret) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ServiceAccountSecret",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ServiceAccountSecret_lastUsed(ctx context.Context, field graphql.CollectedField, obj *ServiceAccountSecret) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ServiceAccountSecret",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastUsed, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return MarshalTime(*res)
+}
+
+var sessionImplementors = []string{"Session"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Session(ctx context.Context, sel ast.SelectionSet, obj *Session) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, sessionImplementors)
+
+	var wg sync.WaitGroup
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Session")
+		case "id":
+			out.Values[i] = ec._Session_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "userAgent":
+			out.Values[i] = ec._Session_userAgent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "ip":
+			out.Values[i] = ec._Session_ip(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "country":
+			out.Values[i] = ec._Session_country(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "created":
+			out.Values[i] = ec._Session_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "lastSeen":
+			out.Values[i] = ec._Session_lastSeen(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "current":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Session_current(ctx, field, obj)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	wg.Wait()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Session_id(ctx context.Context, field graphql.CollectedField, obj *Session) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Session",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Session_userAgent(ctx context.Context, field graphql.CollectedField, obj *Session) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Session",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UserAgent, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Session_ip(ctx context.Context, field graphql.CollectedField, obj *Session) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Session",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IP, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Session_country(ctx context.Context, field graphql.CollectedField, obj *Session) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Session",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Country, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Session_created(ctx context.Context, field graphql.CollectedField, obj *Session) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Session",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Session_lastSeen(ctx context.Context, field graphql.CollectedField, obj *Session) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Session",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastSeen, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Session_current(ctx context.Context, field graphql.CollectedField, obj *Session) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Session",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Session().Current(rctx, obj)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+var shortLinkImplementors = []string{"ShortLink"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _ShortLink(ctx context.Context, sel ast.SelectionSet, obj *ShortLink) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, shortLinkImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ShortLink")
+		case "id":
+			out.Values[i] = ec._ShortLink_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "slug":
+			out.Values[i] = ec._ShortLink_slug(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "path":
+			out.Values[i] = ec._ShortLink_path(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "url":
+			out.Values[i] = ec._ShortLink_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "clicks":
+			out.Values[i] = ec._ShortLink_clicks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "lastClick":
+			out.Values[i] = ec._ShortLink_lastClick(ctx, field, obj)
+		case "created":
+			out.Values[i] = ec._ShortLink_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ShortLink_id(ctx context.Context, field graphql.CollectedField, obj *ShortLink) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ShortLink",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ShortLink_slug(ctx context.Context, field graphql.CollectedField, obj *ShortLink) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ShortLink",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Slug, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ShortLink_path(ctx context.Context, field graphql.CollectedField, obj *ShortLink) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ShortLink",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Path(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ShortLink_url(ctx context.Context, field graphql.CollectedField, obj *ShortLink) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ShortLink",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ShortLink_clicks(ctx context.Context, field graphql.CollectedField, obj *ShortLink) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ShortLink",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Clicks, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ShortLink_lastClick(ctx context.Context, field graphql.CollectedField, obj *ShortLink) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ShortLink",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastClick, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return MarshalTime(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _ShortLink_created(ctx context.Context, field graphql.CollectedField, obj *ShortLink) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "ShortLink",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+var siteImplementors = []string{"Site"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Site(ctx context.Context, sel ast.SelectionSet, obj *Site) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, siteImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Site")
+		case "id":
+			out.Values[i] = ec._Site_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "host":
+			out.Values[i] = ec._Site_host(ctx, field, obj)
+		case "allowedUsers":
+			out.Values[i] = ec._Site_allowedUsers(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "created":
+			out.Values[i] = ec._Site_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Site_id(ctx context.Context, field graphql.CollectedField, obj *Site) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Site",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Site_host(ctx context.Context, field graphql.CollectedField, obj *Site) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Site",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Host, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Site_allowedUsers(ctx context.Context, field graphql.CollectedField, obj *Site) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Site",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AllowedUsers, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+
+	for idx1 := range res {
+		arr1[idx1] = func() graphql.Marshaler {
+			return graphql.MarshalString(res[idx1])
+		}()
+	}
+
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Site_created(ctx context.Context, field graphql.CollectedField, obj *Site) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Site",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+var siteSettingsImplementors = []string{"SiteSettings"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _SiteSettings(ctx context.Context, sel ast.SelectionSet, obj *SiteSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, siteSettingsImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SiteSettings")
+		case "title":
+			out.Values[i] = ec._SiteSettings_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "description":
+			out.Values[i] = ec._SiteSettings_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "url":
+			out.Values[i] = ec._SiteSettings_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "footerText":
+			out.Values[i] = ec._SiteSettings_footerText(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "postsPerPage":
+			out.Values[i] = ec._SiteSettings_postsPerPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "socialLinks":
+			out.Values[i] = ec._SiteSettings_socialLinks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "license":
+			out.Values[i] = ec._SiteSettings_license(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _SiteSettings_title(ctx context.Context, field graphql.CollectedField, obj *SiteSettings) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "SiteSettings",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Title, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _SiteSettings_description(ctx context.Context, field graphql.CollectedField, obj *SiteSettings) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "SiteSettings",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _SiteSettings_url(ctx context.Context, field graphql.CollectedField, obj *SiteSettings) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "SiteSettings",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _SiteSettings_footerText(ctx context.Context, field graphql.CollectedField, obj *SiteSettings) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "SiteSettings",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FooterText, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _SiteSettings_postsPerPage(ctx context.Context, field graphql.CollectedField, obj *SiteSettings) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "SiteSettings",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PostsPerPage, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _SiteSettings_socialLinks(ctx context.Context, field graphql.CollectedField, obj *SiteSettings) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "SiteSettings",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SocialLinks, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]SocialLink)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec._SocialLink(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _SiteSettings_license(ctx context.Context, field graphql.CollectedField, obj *SiteSettings) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "SiteSettings",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.License, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*License)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec._License(ctx, field.Selections, res)
+}
+
+var socialLinkImplementors = []string{"SocialLink"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _SocialLink(ctx context.Context, sel ast.SelectionSet, obj *SocialLink) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, socialLinkImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SocialLink")
+		case "name":
+			out.Values[i] = ec._SocialLink_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "url":
+			out.Values[i] = ec._SocialLink_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _SocialLink_name(ctx context.Context, field graphql.CollectedField, obj *SocialLink) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "SocialLink",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _SocialLink_url(ctx context.Context, field graphql.CollectedField, obj *SocialLink) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "SocialLink",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+var statImplementors = []string{"Stat"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Stat(ctx context.Context, sel ast.SelectionSet, obj *Stat) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, statImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Stat")
+		case "key":
+			out.Values[i] = ec._Stat_key(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "value":
+			out.Values[i] = ec._Stat_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Stat_key(ctx context.Context, field graphql.CollectedField, obj *Stat) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Stat",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Key, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Stat_value(ctx context.Context, field graphql.CollectedField, obj *Stat) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Stat",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+var tagCountImplementors = []string{"TagCount"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _TagCount(ctx context.Context, sel ast.SelectionSet, obj *TagCount) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, tagCountImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TagCount")
+		case "tag":
+			out.Values[i] = ec._TagCount_tag(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "count":
+			out.Values[i] = ec._TagCount_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _TagCount_tag(ctx context.Context, field graphql.CollectedField, obj *TagCount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "TagCount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Tag, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _TagCount_count(ctx context.Context, field graphql.CollectedField, obj *TagCount) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "TagCount",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Count, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+var themeImplementors = []string{"Theme"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Theme(ctx context.Context, sel ast.SelectionSet, obj *Theme) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, themeImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Theme")
+		case "accentColor":
+			out.Values[i] = ec._Theme_accentColor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "lightImage":
+			out.Values[i] = ec._Theme_lightImage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "darkImage":
+			out.Values[i] = ec._Theme_darkImage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "navItems":
+			out.Values[i] = ec._Theme_navItems(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Theme_accentColor(ctx context.Context, field graphql.CollectedField, obj *Theme) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Theme",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AccentColor, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Theme_lightImage(ctx context.Context, field graphql.CollectedField, obj *Theme) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Theme",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LightImage, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Theme_darkImage(ctx context.Context, field graphql.CollectedField, obj *Theme) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Theme",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DarkImage, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Theme_navItems(ctx context.Context, field graphql.CollectedField, obj *Theme) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Theme",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NavItems, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]NavItem)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec._NavItem(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+var userImplementors = []string{"User"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj *User) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, userImplementors)
+
+	var wg sync.WaitGroup
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("User")
+		case "id":
+			out.Values[i] = ec._User_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "role":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._User_role(ctx, field, obj)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "created":
+			out.Values[i] = ec._User_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "modified":
+			out.Values[i] = ec._User_modified(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "deactivated":
+			out.Values[i] = ec._User_deactivated(ctx, field, obj)
+		case "notificationSettings":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._User_notificationSettings(ctx, field, obj)
+				wg.Done()
+			}(i, field)
+		case "identities":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._User_identities(ctx, field, obj)
+				wg.Done()
+			}(i, field)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	wg.Wait()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *User) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "User",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _User_role(ctx context.Context, field graphql.CollectedField, obj *User) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "User",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.User().Role(rctx, obj)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Role)
+	rctx.Result = res
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _User_created(ctx context.Context, field graphql.CollectedField, obj *User) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "User",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _User_modified(ctx context.Context, field graphql.CollectedField, obj *User) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "User",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Modified, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _User_deactivated(ctx context.Context, field graphql.CollectedField, obj *User) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "User",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Deactivated, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return MarshalTime(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _User_notificationSettings(ctx context.Context, field graphql.CollectedField, obj *User) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "User",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.User().NotificationSettings(rctx, obj)
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*NotificationSettings)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec._NotificationSettings(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _User_identities(ctx context.Context, field graphql.CollectedField, obj *User) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "User",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.User().Identities(rctx, obj)
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]Identity)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec._Identity(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+var webhookImplementors = []string{"Webhook"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Webhook(ctx context.Context, sel ast.SelectionSet, obj *Webhook) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, webhookImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Webhook")
+		case "id":
+			out.Values[i] = ec._Webhook_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "url":
+			out.Values[i] = ec._Webhook_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "events":
+			out.Values[i] = ec._Webhook_events(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "created":
+			out.Values[i] = ec._Webhook_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "modified":
+			out.Values[i] = ec._Webhook_modified(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Webhook_id(ctx context.Context, field graphql.CollectedField, obj *Webhook) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Webhook",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Webhook_url(ctx context.Context, field graphql.CollectedField, obj *Webhook) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Webhook",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Webhook_events(ctx context.Context, field graphql.CollectedField, obj *Webhook) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Webhook",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Events, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+
+	for idx1 := range res {
+		arr1[idx1] = func() graphql.Marshaler {
+			return graphql.MarshalString(res[idx1])
+		}()
+	}
+
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Webhook_created(ctx context.Context, field graphql.CollectedField, obj *Webhook) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Webhook",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Webhook_modified(ctx context.Context, field graphql.CollectedField, obj *Webhook) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "Webhook",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Modified, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+var webhookCredentialsImplementors = []string{"WebhookCredentials"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _WebhookCredentials(ctx context.Context, sel ast.SelectionSet, obj *WebhookCredentials) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, webhookCredentialsImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("WebhookCredentials")
+		case "webhook":
+			out.Values[i] = ec._WebhookCredentials_webhook(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "secret":
+			out.Values[i] = ec._WebhookCredentials_secret(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _WebhookCredentials_webhook(ctx context.Context, field graphql.CollectedField, obj *WebhookCredentials) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "WebhookCredentials",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Webhook, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Webhook)
+	rctx.Result = res
+
+	return ec._Webhook(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _WebhookCredentials_secret(ctx context.Context, field graphql.CollectedField, obj *WebhookCredentials) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "WebhookCredentials",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Secret, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+var webhookDeliveryImplementors = []string{"WebhookDelivery"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _WebhookDelivery(ctx context.Context, sel ast.SelectionSet, obj *WebhookDelivery) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, webhookDeliveryImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("WebhookDelivery")
+		case "id":
+			out.Values[i] = ec._WebhookDelivery_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "webhookId":
+			out.Values[i] = ec._WebhookDelivery_webhookId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "event":
+			out.Values[i] = ec._WebhookDelivery_event(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "payload":
+			out.Values[i] = ec._WebhookDelivery_payload(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "requestId":
+			out.Values[i] = ec._WebhookDelivery_requestId(ctx, field, obj)
+		case "attempts":
+			out.Values[i] = ec._WebhookDelivery_attempts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "statusCode":
+			out.Values[i] = ec._WebhookDelivery_statusCode(ctx, field, obj)
+		case "error":
+			out.Values[i] = ec._WebhookDelivery_error(ctx, field, obj)
+		case "nextAttempt":
+			out.Values[i] = ec._WebhookDelivery_nextAttempt(ctx, field, obj)
+		case "delivered":
+			out.Values[i] = ec._WebhookDelivery_delivered(ctx, field, obj)
+		case "created":
+			out.Values[i] = ec._WebhookDelivery_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _WebhookDelivery_id(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "WebhookDelivery",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _WebhookDelivery_webhookId(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "WebhookDelivery",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.WebhookID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _WebhookDelivery_event(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "WebhookDelivery",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Event, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _WebhookDelivery_payload(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "WebhookDelivery",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Payload, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _WebhookDelivery_requestId(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "WebhookDelivery",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RequestID, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _WebhookDelivery_attempts(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "WebhookDelivery",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Attempts, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _WebhookDelivery_statusCode(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "WebhookDelivery",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StatusCode, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalInt(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _WebhookDelivery_error(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "WebhookDelivery",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Error, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _WebhookDelivery_nextAttempt(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "WebhookDelivery",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NextAttempt, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return MarshalTime(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _WebhookDelivery_delivered(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "WebhookDelivery",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Delivered, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return MarshalTime(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _WebhookDelivery_created(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "WebhookDelivery",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	return MarshalTime(res)
+}
+
+var __DirectiveImplementors = []string{"__Directive"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, __DirectiveImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "description":
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Directive",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Directive",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Directive",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+
+	for idx1 := range res {
+		arr1[idx1] = func() graphql.Marshaler {
+			return graphql.MarshalString(res[idx1])
+		}()
+	}
+
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Directive",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec.___InputValue(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+var __EnumValueImplementors = []string{"__EnumValue"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, __EnumValueImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__EnumValue",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__EnumValue",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__EnumValue",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__EnumValue",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+var __FieldImplementors = []string{"__Field"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, __FieldImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Field",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Field",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Field",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec.___InputValue(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Field",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	rctx.Result = res
+
+	if res == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+
+	return ec.___Type(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Field",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Field",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+var __InputValueImplementors = []string{"__InputValue"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, __InputValueImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__InputValue")
+		case "name":
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__InputValue",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__InputValue",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__InputValue",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	rctx.Result = res
+
+	if res == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+
+	return ec.___Type(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__InputValue",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultValue, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+var __SchemaImplementors = []string{"__Schema"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, __SchemaImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Schema",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Types(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec.___Type(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Schema",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QueryType(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	rctx.Result = res
+
+	if res == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+
+	return ec.___Type(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Schema",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MutationType(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec.___Type(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Schema",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscriptionType(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec.___Type(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Schema",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Directives(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Directive)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec.___Directive(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+var __TypeImplementors = []string{"__Type"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, __TypeImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "name":
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Type",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Type",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Type",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field___Type_fields_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "__Type",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields(args["includeDeprecated"].(bool)), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Field)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec.___Field(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
 	rctx := &graphql.ResolverContext{
-		Object: "__InputValue",
+		Object: "__Type",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interfaces(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec.___Type(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Type",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PossibleTypes(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec.___Type(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field___Type_enumValues_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "__Type",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.EnumValue)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec.___EnumValue(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Type",
 		Args:   nil,
 		Field:  field,
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InputFields(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	rctx.Result = res
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec.___InputValue(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+	rctx := &graphql.ResolverContext{
+		Object: "__Type",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OfType(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	rctx.Result = res
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec.___Type(ctx, field.Selections, res)
+}
+
+func UnmarshalAutomationRuleInput(v interface{}) (AutomationRuleInput, error) {
+	var it AutomationRuleInput
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+			it.Name, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "event":
+			var err error
+			it.Event, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "condition":
+			var err error
+			it.Condition, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "actions":
+			var err error
+			var rawIf1 []interface{}
+			if v != nil {
+				if tmp1, ok := v.([]interface{}); ok {
+					rawIf1 = tmp1
+				} else {
+					rawIf1 = []interface{}{v}
+				}
+			}
+			it.Actions = make([]string, len(rawIf1))
+			for idx1 := range rawIf1 {
+				it.Actions[idx1], err = graphql.UnmarshalString(rawIf1[idx1])
+			}
+			if err != nil {
+				return it, err
+			}
+		case "enabled":
+			var err error
+			var ptr1 bool
+			if v != nil {
+				ptr1, err = graphql.UnmarshalBoolean(v)
+				it.Enabled = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalDateRange(v interface{}) (DateRange, error) {
+	var it DateRange
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "from":
+			var err error
+			it.From, err = UnmarshalTime(v)
+			if err != nil {
+				return it, err
+			}
+		case "to":
+			var err error
+			it.To, err = UnmarshalTime(v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalGeoInput(v interface{}) (GeoInput, error) {
+	var it GeoInput
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "lat":
+			var err error
+			it.Lat, err = graphql.UnmarshalFloat(v)
+			if err != nil {
+				return it, err
+			}
+		case "long":
+			var err error
+			it.Long, err = graphql.UnmarshalFloat(v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalLicenseInput(v interface{}) (LicenseInput, error) {
+	var it LicenseInput
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+			var ptr1 LicenseId
+			if v != nil {
+				err = (&ptr1).UnmarshalGQL(v)
+				it.ID = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "text":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.Text = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalLinkFilter(v interface{}) (LinkFilter, error) {
+	var it LinkFilter
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "tag":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.Tag = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "dead":
+			var err error
+			var ptr1 bool
+			if v != nil {
+				ptr1, err = graphql.UnmarshalBoolean(v)
+				it.Dead = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "search":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.Search = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalMetadataInput(v interface{}) (MetadataInput, error) {
+	var it MetadataInput
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "key":
+			var err error
+			it.Key, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "value":
+			var err error
+			it.Value, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalNavItemInput(v interface{}) (NavItemInput, error) {
+	var it NavItemInput
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "label":
+			var err error
+			it.Label, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "url":
+			var err error
+			it.URL, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalNewBook(v interface{}) (NewBook, error) {
+	var it NewBook
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "title":
+			var err error
+			it.Title, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "author":
+			var err error
+			it.Author, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "isbn":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.Isbn = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "shelf":
+			var err error
+			var ptr1 Shelf
+			if v != nil {
+				err = (&ptr1).UnmarshalGQL(v)
+				it.Shelf = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "pages":
+			var err error
+			var ptr1 int
+			if v != nil {
+				ptr1, err = graphql.UnmarshalInt(v)
+				it.Pages = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "started":
+			var err error
+			var ptr1 time.Time
+			if v != nil {
+				ptr1, err = UnmarshalTime(v)
+				it.Started = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalNewHighlight(v interface{}) (NewHighlight, error) {
+	var it NewHighlight
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "postId":
+			var err error
+			it.PostID, err = graphql.UnmarshalID(v)
+			if err != nil {
+				return it, err
+			}
+		case "start":
+			var err error
+			it.Start, err = graphql.UnmarshalInt(v)
+			if err != nil {
+				return it, err
+			}
+		case "end":
+			var err error
+			it.End, err = graphql.UnmarshalInt(v)
+			if err != nil {
+				return it, err
+			}
+		case "note":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.Note = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "public":
+			var err error
+			var ptr1 bool
+			if v != nil {
+				ptr1, err = graphql.UnmarshalBoolean(v)
+				it.Public = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalNewLink(v interface{}) (NewLink, error) {
+	var it NewLink
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "title":
+			var err error
+			it.Title, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "uri":
+			var err error
+			it.URI, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "description":
+			var err error
+			it.Description, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "tags":
+			var err error
+			var rawIf1 []interface{}
+			if v != nil {
+				if tmp1, ok := v.([]interface{}); ok {
+					rawIf1 = tmp1
+				} else {
+					rawIf1 = []interface{}{v}
+				}
+			}
+			it.Tags = make([]*string, len(rawIf1))
+			for idx1 := range rawIf1 {
+				var ptr2 string
+				if rawIf1[idx1] != nil {
+					ptr2, err = graphql.UnmarshalString(rawIf1[idx1])
+					it.Tags[idx1] = &ptr2
+				}
+			}
+			if err != nil {
+				return it, err
+			}
+		case "created":
+			var err error
+			it.Created, err = UnmarshalTime(v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalNewLog(v interface{}) (NewLog, error) {
+	var it NewLog
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "content":
+			var err error
+			it.Content, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "location":
+			var err error
+			var ptr1 GeoInput
+			if v != nil {
+				ptr1, err = UnmarshalGeoInput(v)
+				it.Location = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "datetime":
+			var err error
+			var ptr1 time.Time
+			if v != nil {
+				ptr1, err = UnmarshalTime(v)
+				it.Datetime = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "groupId":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalID(v)
+				it.GroupID = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalNewOIDCClient(v interface{}) (NewOIDCClient, error) {
+	var it NewOIDCClient
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+			it.Name, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "redirectURIs":
+			var err error
+			var rawIf1 []interface{}
+			if v != nil {
+				if tmp1, ok := v.([]interface{}); ok {
+					rawIf1 = tmp1
+				} else {
+					rawIf1 = []interface{}{v}
+				}
+			}
+			it.RedirectURIs = make([]string, len(rawIf1))
+			for idx1 := range rawIf1 {
+				it.RedirectURIs[idx1], err = graphql.UnmarshalString(rawIf1[idx1])
+			}
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalNewPost(v interface{}) (NewPost, error) {
+	var it NewPost
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "content":
+			var err error
+			it.Content, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "title":
+			var err error
+			it.Title, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "datetime":
+			var err error
+			it.Datetime, err = UnmarshalTime(v)
+			if err != nil {
+				return it, err
+			}
+		case "draft":
+			var err error
+			it.Draft, err = graphql.UnmarshalBoolean(v)
+			if err != nil {
+				return it, err
+			}
+		case "canonicalURL":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.CanonicalURL = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "originallyPublishedAt":
+			var err error
+			var ptr1 time.Time
+			if v != nil {
+				ptr1, err = UnmarshalTime(v)
+				it.OriginallyPublishedAt = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "license":
+			var err error
+			var ptr1 LicenseInput
+			if v != nil {
+				ptr1, err = UnmarshalLicenseInput(v)
+				it.License = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalNewRedirect(v interface{}) (NewRedirect, error) {
+	var it NewRedirect
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "from":
+			var err error
+			it.From, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "to":
+			var err error
+			it.To, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "status":
+			var err error
+			var ptr1 int
+			if v != nil {
+				ptr1, err = graphql.UnmarshalInt(v)
+				it.Status = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalNewServiceAccount(v interface{}) (NewServiceAccount, error) {
+	var it NewServiceAccount
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+			it.Name, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "scopes":
+			var err error
+			var rawIf1 []interface{}
+			if v != nil {
+				if tmp1, ok := v.([]interface{}); ok {
+					rawIf1 = tmp1
+				} else {
+					rawIf1 = []interface{}{v}
+				}
+			}
+			it.Scopes = make([]string, len(rawIf1))
+			for idx1 := range rawIf1 {
+				it.Scopes[idx1], err = graphql.UnmarshalString(rawIf1[idx1])
+			}
+			if err != nil {
+				return it, err
+			}
+		case "expires":
+			var err error
+			var ptr1 time.Time
+			if v != nil {
+				ptr1, err = UnmarshalTime(v)
+				it.Expires = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalNewStat(v interface{}) (NewStat, error) {
+	var it NewStat
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "key":
+			var err error
+			it.Key, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "value":
+			var err error
+			it.Value, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalNewUser(v interface{}) (NewUser, error) {
+	var it NewUser
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+			it.ID, err = graphql.UnmarshalID(v)
+			if err != nil {
+				return it, err
+			}
+		case "role":
+			var err error
+			err = (&it.Role).UnmarshalGQL(v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalNewWebhook(v interface{}) (NewWebhook, error) {
+	var it NewWebhook
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "url":
+			var err error
+			it.URL, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "events":
+			var err error
+			var rawIf1 []interface{}
+			if v != nil {
+				if tmp1, ok := v.([]interface{}); ok {
+					rawIf1 = tmp1
+				} else {
+					rawIf1 = []interface{}{v}
+				}
+			}
+			it.Events = make([]string, len(rawIf1))
+			for idx1 := range rawIf1 {
+				it.Events[idx1], err = graphql.UnmarshalString(rawIf1[idx1])
+			}
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalNotificationSettingsInput(v interface{}) (NotificationSettingsInput, error) {
+	var it NotificationSettingsInput
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "email":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.Email = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "comments":
+			var err error
+			var ptr1 bool
+			if v != nil {
+				ptr1, err = graphql.UnmarshalBoolean(v)
+				it.Comments = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "digest":
+			var err error
+			var ptr1 bool
+			if v != nil {
+				ptr1, err = graphql.UnmarshalBoolean(v)
+				it.Digest = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "authAlerts":
+			var err error
+			var ptr1 bool
+			if v != nil {
+				ptr1, err = graphql.UnmarshalBoolean(v)
+				it.AuthAlerts = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "integrityAlerts":
+			var err error
+			var ptr1 bool
+			if v != nil {
+				ptr1, err = graphql.UnmarshalBoolean(v)
+				it.IntegrityAlerts = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "commentsDelivery":
+			var err error
+			var ptr1 NotificationDelivery
+			if v != nil {
+				err = (&ptr1).UnmarshalGQL(v)
+				it.CommentsDelivery = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "authAlertsDelivery":
+			var err error
+			var ptr1 NotificationDelivery
+			if v != nil {
+				err = (&ptr1).UnmarshalGQL(v)
+				it.AuthAlertsDelivery = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "timezone":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.Timezone = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "quietStart":
+			var err error
+			var ptr1 int
+			if v != nil {
+				ptr1, err = graphql.UnmarshalInt(v)
+				it.QuietStart = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "quietEnd":
+			var err error
+			var ptr1 int
+			if v != nil {
+				ptr1, err = graphql.UnmarshalInt(v)
+				it.QuietEnd = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalSiteSettingsInput(v interface{}) (SiteSettingsInput, error) {
+	var it SiteSettingsInput
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "title":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.Title = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "description":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.Description = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "url":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.URL = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "footerText":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.FooterText = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "postsPerPage":
+			var err error
+			var ptr1 int
+			if v != nil {
+				ptr1, err = graphql.UnmarshalInt(v)
+				it.PostsPerPage = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "socialLinks":
+			var err error
+			var rawIf1 []interface{}
+			if v != nil {
+				if tmp1, ok := v.([]interface{}); ok {
+					rawIf1 = tmp1
+				} else {
+					rawIf1 = []interface{}{v}
+				}
+			}
+			it.SocialLinks = make([]SocialLinkInput, len(rawIf1))
+			for idx1 := range rawIf1 {
+				it.SocialLinks[idx1], err = UnmarshalSocialLinkInput(rawIf1[idx1])
+			}
+			if err != nil {
+				return it, err
+			}
+		case "license":
+			var err error
+			var ptr1 LicenseInput
+			if v != nil {
+				ptr1, err = UnmarshalLicenseInput(v)
+				it.License = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalSocialLinkInput(v interface{}) (SocialLinkInput, error) {
+	var it SocialLinkInput
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+			it.Name, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "url":
+			var err error
+			it.URL, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalThemeInput(v interface{}) (ThemeInput, error) {
+	var it ThemeInput
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "accentColor":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.AccentColor = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "lightImage":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.LightImage = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "darkImage":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.DarkImage = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "navItems":
+			var err error
+			var rawIf1 []interface{}
+			if v != nil {
+				if tmp1, ok := v.([]interface{}); ok {
+					rawIf1 = tmp1
+				} else {
+					rawIf1 = []interface{}{v}
+				}
+			}
+			it.NavItems = make([]NavItemInput, len(rawIf1))
+			for idx1 := range rawIf1 {
+				it.NavItems[idx1], err = UnmarshalNavItemInput(rawIf1[idx1])
+			}
+			if err != nil {
+				return it, err
+			}
 		}
-		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
-	rctx.Result = res
 
-	if res == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	return it, nil
+}
+
+func UnmarshalUserFilter(v interface{}) (UserFilter, error) {
+	var it UserFilter
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "role":
+			var err error
+			var ptr1 Role
+			if v != nil {
+				err = (&ptr1).UnmarshalGQL(v)
+				it.Role = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "active":
+			var err error
+			var ptr1 bool
+			if v != nil {
+				ptr1, err = graphql.UnmarshalBoolean(v)
+				it.Active = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
 		}
-		return graphql.Null
 	}
 
-	return ec.___Type(ctx, field.Selections, res)
+	return it, nil
 }
 
-// nolint: vetshadow
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__InputValue",
-		Args:   nil,
-		Field:  field,
+func (ec *executionContext) FieldMiddleware(ctx context.Context, obj interface{}, next graphql.Resolver) (ret interface{}) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	rctx := graphql.GetResolverContext(ctx)
+	for _, d := range rctx.Field.Definition.Directives {
+		switch d.Name {
+		case "constraint":
+			if ec.directives.Constraint != nil {
+				rawArgs := d.ArgumentMap(ec.Variables)
+				args, err := dir_constraint_args(rawArgs)
+				if err != nil {
+					ec.Error(ctx, err)
+					return nil
+				}
+				n := next
+				next = func(ctx context.Context) (interface{}, error) {
+					return ec.directives.Constraint(ctx, obj, n, args["minLength"].(*int), args["maxLength"].(*int), args["pattern"].(*string), args["format"].(*string), args["min"].(*float64), args["max"].(*float64))
+				}
+			}
+		case "hasRole":
+			if ec.directives.HasRole != nil {
+				rawArgs := d.ArgumentMap(ec.Variables)
+				args, err := dir_hasRole_args(rawArgs)
+				if err != nil {
+					ec.Error(ctx, err)
+					return nil
+				}
+				n := next
+				next = func(ctx context.Context) (interface{}, error) {
+					return ec.directives.HasRole(ctx, obj, n, args["role"].(Role))
+				}
+			}
+		}
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
-	})
-	if resTmp == nil {
-		return graphql.Null
+	res, err := ec.ResolverMiddleware(ctx, next)
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
 	}
-	res := resTmp.(*string)
-	rctx.Result = res
+	return res
+}
 
-	if res == nil {
-		return graphql.Null
-	}
-	return graphql.MarshalString(*res)
+func (ec *executionContext) introspectSchema() *introspection.Schema {
+	return introspection.WrapSchema(parsedSchema)
+}
+
+func (ec *executionContext) introspectType(name string) *introspection.Type {
+	return introspection.WrapTypeFromDef(parsedSchema, parsedSchema.Types[name])
+}
+
+var parsedSchema = gqlparser.MustLoadSchema(
+	&ast.Source{Name: "schema.graphql", Input: `schema {
+  query: Query
+}
+
+"""
+The query type, represents all of the entry points into our object graph.
+"""
+type Query {
+  "Returns an array of all posts ever, ordered by reverse chronological order."
+  allPosts(): [Post]! @deprecated(reason: "Use posts, which is paginated. Will be removed after 2019-04-15.")
+
+  "Returns an array of inprogress posts."
+  drafts(): [Post]! @hasRole(role: admin)
+
+  "Returns an array of all posts, ordered by reverse chronological order, using provided limit and offset. Limit defaults to the postsPerPage site setting, and is at most 100."
+  posts(limit: Int, offset: Int): [Post]!
+
+  "Returns a single post by ID. Protected posts need an unlock token from unlockPost, unless the reader is an admin."
+  post(id: ID!, unlock: String): Post
+
+  "Returns published posts that a missing path probably meant, best match first, for did you mean links on 404 pages. Limit defaults to 5, and can be at most 20."
+  suggestPosts(path: String!, limit: Int): [Post]!
+
+  "Returns post id for the next post chronologically."
+  nextPost(id: ID!): Post
+
+  "Returns post id for the previous post chronologically."
+  prevPost(id: ID!): Post
+
+  "Returns the tags on published posts, most used first."
+  tags(): [TagCount]!
+
+  "Returns the published posts that belong in the sitemap, newest first. Posts cross-posted from another site are left out."
+  sitemapPosts(): [Post]!
+
+  "Returns the keys posts can have metadata for, by name."
+  metadataKeys(): [MetadataKey!]!
+
+  "Returns all links ever, in reverse chronological order."
+  allLinks(): [Link]! @deprecated(reason: "Use links, which is paginated. Will be removed after 2019-04-15.")
+
+  "Returns a subset of all links ever matching filter, in reverse chronological order, using provided limit and offset. Limit defaults to 50, and is at most 100."
+  links(filter: LinkFilter, limit: Int, offset: Int): [Link]!
+
+  "Returns a single link by id."
+  link(id: ID!): Link
+
+  "Returns a number of stats, ordered by most recently updated."
+  stats(count: Int): [Stat]!
+
+  "Returns a unified diff of the content of a post between two revisions."
+  postDiff(id: ID!, from: Int!, to: Int!): String! @hasRole(role: admin)
+
+  "Returns all service accounts, ordered by name."
+  serviceAccounts(): [ServiceAccount]! @hasRole(role: admin)
+
+  "Returns all OpenID Connect clients, ordered by name."
+  oidcClients(): [OIDCClient]! @hasRole(role: admin)
+
+  "Returns users matching filter, oldest first, using provided limit and offset."
+  users(filter: UserFilter, limit: Int, offset: Int): [User]! @hasRole(role: admin)
+
+  "Returns all invites, newest first."
+  invites(): [Invite]! @hasRole(role: admin)
+
+  "Returns the most recent jobs, newest first, optionally only those with a status or started by a request."
+  jobs(limit: Int, status: JobStatus, requestId: String): [Job]! @hasRole(role: admin)
+  job(id: ID!): Job @hasRole(role: admin)
+
+  "Returns every site this server serves, the default site first."
+  sites(): [Site!]! @hasRole(role: admin)
+
+  "Returns the site's presentation settings."
+  siteSettings(): SiteSettings!
+
+  "Returns the frontend's theme."
+  theme(): Theme!
+
+  "Returns all redirects, ordered by path."
+  redirects(): [Redirect]! @hasRole(role: admin)
+
+  "Returns all short links, most clicked first."
+  shortLinks(): [ShortLink]! @hasRole(role: admin)
+
+  "Returns the short link for slug, with its click stats."
+  shortLink(slug: String!): ShortLink @hasRole(role: admin)
+
+  "Returns the logged in user's logs, newest first. Range defaults to the last 30 days."
+  logs(range: DateRange): [Log]!
+
+  "Returns the logs other members of the logged in user's groups have shared with them, newest first. Range defaults to the last 30 days."
+  sharedWithMe(range: DateRange): [Log]!
+
+  "Returns the groups the logged in user is a member of, ordered by name."
+  groups(): [Group]!
+
+  "Returns the logged in user's active sessions, most recently used first."
+  mySessions(): [Session]!
+
+  "Returns the logged in user's read later queue, newest first unless order says otherwise."
+  readLater(order: ReadLaterOrder, limit: Int, offset: Int): [ReadLaterItem]!
+
+  "Returns how far through a post the logged in user has read, or null if they haven't started it."
+  readingProgress(postId: ID!): ReadingProgress
+
+  "Returns the logged in user's highlights on a post, in the order they are in the post, and other readers' public ones if includePublic is true."
+  highlights(postId: ID!, includePublic: Boolean): [Highlight]!
+
+  "Returns all of the logged in user's highlights and their notes as Markdown, with a section for each post."
+  exportHighlights(): String!
+
+  "Returns counts of posts, users and recent failures for the admin dashboard."
+  adminStats(): AdminStats! @hasRole(role: admin)
+
+  "Returns how many goroutines are running, grouped by their labels, most first."
+  goroutines(): [GoroutineCount]! @hasRole(role: admin)
+
+  "Returns what build is running, for how long, and how its memory and database connections are doing."
+  debugInfo(): DebugInfo! @hasRole(role: admin)
+
+  "Returns all webhooks, oldest first."
+  webhooks(): [Webhook]! @hasRole(role: admin)
+
+  "Returns recent webhook deliveries, newest first. Failed only returns deliveries that have been tried but not delivered, and requestId only those caused by a request. Limit defaults to 50."
+  webhookDeliveries(webhookId: ID, failed: Boolean, requestId: String, limit: Int): [WebhookDelivery]! @hasRole(role: admin)
+
+  "Returns the site's automation rules, oldest first."
+  automationRules(): [AutomationRule]! @hasRole(role: admin)
+
+  "Returns the issues found by the latest integrity check."
+  integrityIssues(): [IntegrityIssue]! @hasRole(role: admin)
+
+  "Returns page views for path, or the whole site if path is null, from /beacon. Range defaults to the last 30 days."
+  pageViews(path: String, range: DateRange): PageViewStats! @hasRole(role: admin)
+
+  "Returns books, most recently finished or added first. Year only returns books finished that year. Limit defaults to 50."
+  books(shelf: Shelf, year: Int, limit: Int, offset: Int): [Book]!
+
+  "Returns a book by ID."
+  book(id: ID!): Book
+
+  "Returns the books being read, most recently started first."
+  currentlyReading(): [Book]!
+
+  "Returns stats on the books finished in year."
+  readingStats(year: Int!): ReadingStats!
+
+  "Returns the page at slug. Drafts are only returned to admins."
+  page(slug: String!): Page
+
+  "Returns pages, ordered by slug. Drafts are only returned to admins."
+  pages(): [Page]!
+}
+
+"""
+A page is content that isn't dated, like an about page, served at /{slug}.
+"""
+type Page {
+  slug: String!
+  title: String!
+  content: String!
+
+  "html is the content of the page rendered from Markdown."
+  html: String!
+
+  "draft pages are only visible to admins."
+  draft: Boolean!
+  created: Time!
+  modified: Time!
+
+  "version counts the page's saves. upsertPage needs it to edit the page."
+  version: Int!
+}
+
+"""
+A post is an individual post in the blog.
+"""
+type Post {
+  id: ID!
+  title: String!
+  content: String!
+  summary: String!
+  readtime: Int!
+
+  "html is the content of the post rendered from Markdown."
+  html: String!
+
+  "datetime is the published time of an article."
+  datetime: Time!
+  created: Time!
+  modified: Time!
+  draft: Boolean!
+  tags: [String!]!
+
+  "protected posts have a password, and are left out of lists of posts."
+  protected: Boolean!
+
+  "links are the links referenced in a post."
+  links: [Link]!
+
+  "revisions are the previous versions of a post, newest first."
+  revisions(limit: Int, offset: Int): [Revision]! @hasRole(role: admin)
+
+  "syndicationUrls are copies of the post on other sites, for rel=syndication links."
+  syndicationUrls: [String!]!
+
+  "metadata are one-off fields of the post, like the video of a talk, by key."
+  metadata: [MetadataEntry!]!
+
+  "canonicalURL is where a cross-posted post was first published, for rel=canonical links."
+  canonicalURL: String
+
+  "originallyPublishedAt is when a cross-posted post was first published, at its canonical URL."
+  originallyPublishedAt: Time
+
+  "license is the post's license, or the site's if it doesn't have one."
+  license: License
+
+  "version counts the post's saves. editPost needs it to edit the post."
+  version: Int!
+}
+
+"""
+A license says how a post can be reused. Licenses with an SPDX identifier are
+machine readable: link to their url with rel=license in pages, and put their
+name in feeds.
+"""
+type License {
+  id: LicenseId!
+
+  "spdx is the license's SPDX identifier, like CC-BY-4.0, if it has one."
+  spdx: String
+  name: String!
+
+  "url is the text of the license."
+  url: String
+
+  "text is the terms of a custom license."
+  text: String
+
+  "html is a rel=license link to the license, to put in rendered pages."
+  html: String!
+}
+
+enum LicenseId {
+  all_rights_reserved
+  cc0_1_0
+  cc_by_4_0
+  cc_by_sa_4_0
+  cc_by_nd_4_0
+  cc_by_nc_4_0
+  cc_by_nc_sa_4_0
+  cc_by_nc_nd_4_0
+  mit
+  apache_2_0
+  custom
+}
+
+"""
+A null id removes a license. Only custom licenses have text, which they need.
+"""
+input LicenseInput {
+  id: LicenseId
+  text: String
+}
+
+"""
+A metadata entry is a key and value in a post's metadata. Values are in the
+canonical form of the key's type: RFC 3339 UTC times, and true or false for
+bools.
+"""
+type MetadataEntry {
+  key: String!
+  value: String!
+}
+
+"""
+A metadata key is a key posts can have metadata for, and the type of its
+values.
+"""
+type MetadataKey {
+  name: String!
+  type: MetadataType!
+  description: String!
 }
 
-var __SchemaImplementors = []string{"__Schema"}
-
-// nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, __SchemaImplementors)
-
-	out := graphql.NewOrderedMap(len(fields))
-	invalid := false
-	for i, field := range fields {
-		out.Keys[i] = field.Alias
+enum MetadataType {
+  string
+  url
+  int
+  bool
+  time
+}
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "types":
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "queryType":
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "mutationType":
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-		case "subscriptionType":
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-		case "directives":
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
+"""
+A tag count is how many published posts have a tag.
+"""
+type TagCount {
+  tag: String!
+  count: Int!
+}
 
-	if invalid {
-		return graphql.Null
-	}
-	return out
+"""
+A revision is a snapshot of a post taken before it was edited.
+"""
+type Revision {
+  id: ID!
+  revision: Int!
+  title: String!
+  content: String!
+  datetime: Time!
+  draft: Boolean!
+  created: Time!
 }
 
-// nolint: vetshadow
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Schema",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.Type)
-	rctx.Result = res
+"""
+A link is a link I have save on pinboard or a link in a post.
+"""
+type Link {
+  id: ID!
+  title: String!
+  uri: URI!
+  created: Time!
+  modified: Time!
+  description: String!
 
-	arr1 := make(graphql.Array, len(res))
-	var wg sync.WaitGroup
+  "screenshot is the page's Open Graph image, if it has one."
+  screenshot: URI!
+  tags: [String!]!
 
-	isLen1 := len(res) == 1
-	if !isLen1 {
-		wg.Add(len(res))
-	}
+  "checked is the last time the link was checked to see if it still loads."
+  checked: Time
 
-	for idx1 := range res {
-		idx1 := idx1
-		rctx := &graphql.ResolverContext{
-			Index:  &idx1,
-			Result: &res[idx1],
-		}
-		ctx := graphql.WithResolverContext(ctx, rctx)
-		f := func(idx1 int) {
-			if !isLen1 {
-				defer wg.Done()
-			}
-			arr1[idx1] = func() graphql.Marshaler {
+  "statusCode is the HTTP status the link responded with when checked."
+  statusCode: Int
 
-				return ec.___Type(ctx, field.Selections, &res[idx1])
-			}()
-		}
-		if isLen1 {
-			f(idx1)
-		} else {
-			go f(idx1)
-		}
+  "dead is true if the link failed to load when checked."
+  dead: Boolean!
+}
 
-	}
-	wg.Wait()
-	return arr1
+"""
+A stat is a key value pair of two interesting strings.
+"""
+type Stat {
+  key: String!
+  value: String!
 }
 
-// nolint: vetshadow
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Schema",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Type)
-	rctx.Result = res
+"""
+A user is a person who has logged in with Google, or been provisioned by an
+admin.
+"""
+type User {
+  id: ID!
+  role: Role!
+  created: Time!
+  modified: Time!
 
-	if res == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
+  "deactivated is when an admin deactivated the user. Null means active."
+  deactivated: Time
 
-	return ec.___Type(ctx, field.Selections, res)
+  "notificationSettings are only visible to the user and admins."
+  notificationSettings: NotificationSettings
+
+  "identities are the other accounts linked to the user at /link. They are only visible to the user and admins."
+  identities: [Identity!]
 }
 
-// nolint: vetshadow
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Schema",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Type)
-	rctx.Result = res
+"""
+An identity is an account with a login provider, like google, that logs in as
+the user it is linked to.
+"""
+type Identity {
+  provider: String!
+  providerUserId: String!
+  created: Time!
+}
 
-	if res == nil {
-		return graphql.Null
-	}
+"""
+A session is a browser logged in as a user. Revoking it logs that browser out.
+"""
+type Session {
+  id: ID!
+  userAgent: String!
 
-	return ec.___Type(ctx, field.Selections, res)
-}
+  "ip is the address the session was last used from."
+  ip: String!
 
-// nolint: vetshadow
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Schema",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Type)
-	rctx.Result = res
+  "country is where the session logged in from. It is empty if the server doesn't know."
+  country: String!
+  created: Time!
+  lastSeen: Time!
 
-	if res == nil {
-		return graphql.Null
-	}
+  "current is true for the session making the request."
+  current: Boolean!
+}
 
-	return ec.___Type(ctx, field.Selections, res)
+"""
+A read later item is a URL or a post that a user saved to read later. Exactly
+one of url and post is set. progress is how far through the post the user has
+read.
+"""
+type ReadLaterItem {
+  id: ID!
+  url: String
+  post: Post
+  progress: Int
+  created: Time!
 }
 
-// nolint: vetshadow
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Schema",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.Directive)
-	rctx.Result = res
+"""
+A highlight is a range of a post's content that a reader marked, with an
+optional note. It is anchored by its quote and the text either side of it, so
+it survives small edits to the post. start and end are where the quote is in
+the post's current content, in characters, and are null if it isn't there
+anymore.
+"""
+type Highlight {
+  id: ID!
+  postId: ID!
+  quote: String!
+  start: Int
+  end: Int
+  note: String
 
-	arr1 := make(graphql.Array, len(res))
-	var wg sync.WaitGroup
+  "public highlights are shown to other readers of the post."
+  public: Boolean!
 
-	isLen1 := len(res) == 1
-	if !isLen1 {
-		wg.Add(len(res))
-	}
+  "mine is true for the logged in user's own highlights."
+  mine: Boolean!
+  created: Time!
+  modified: Time!
+}
 
-	for idx1 := range res {
-		idx1 := idx1
-		rctx := &graphql.ResolverContext{
-			Index:  &idx1,
-			Result: &res[idx1],
-		}
-		ctx := graphql.WithResolverContext(ctx, rctx)
-		f := func(idx1 int) {
-			if !isLen1 {
-				defer wg.Done()
-			}
-			arr1[idx1] = func() graphql.Marshaler {
+"""
+start and end are character offsets into the post's content. public defaults
+to false.
+"""
+input NewHighlight {
+  postId: ID!
+  start: Int! @constraint(min: 0)
+  end: Int! @constraint(min: 0)
+  note: String @constraint(maxLength: 5000)
+  public: Boolean
+}
 
-				return ec.___Directive(ctx, field.Selections, &res[idx1])
-			}()
-		}
-		if isLen1 {
-			f(idx1)
-		} else {
-			go f(idx1)
-		}
+enum ReadLaterOrder {
+  newest
+  oldest
+}
 
-	}
-	wg.Wait()
-	return arr1
+"""
+Reading progress is how far through a post, from 0 to 100 percent, a user has
+read, so any of their devices can pick up where they left off.
+"""
+type ReadingProgress {
+  postId: ID!
+  percent: Int!
+  modified: Time!
 }
 
-var __TypeImplementors = []string{"__Type"}
+"""
+Notification settings control what email a user gets. Admins also get comment
+notifications, authentication failure alerts and integrity alerts.
+"""
+type NotificationSettings {
+  "email is where notifications go. It defaults to the email the user logged in with."
+  email: String
+  comments: Boolean!
 
-// nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, __TypeImplementors)
+  "digest is a weekly email of saved links, and links from recent posts."
+  digest: Boolean!
+  authAlerts: Boolean!
 
-	out := graphql.NewOrderedMap(len(fields))
-	invalid := false
-	for i, field := range fields {
-		out.Keys[i] = field.Alias
+  "integrityAlerts are about new issues found by the integrity check. They are delivered like authentication alerts."
+  integrityAlerts: Boolean!
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "name":
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-		case "fields":
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-		case "interfaces":
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-		case "possibleTypes":
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-		case "enumValues":
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-		case "inputFields":
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-		case "ofType":
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
+  "commentsDelivery is how often comment notifications are sent."
+  commentsDelivery: NotificationDelivery!
 
-	if invalid {
-		return graphql.Null
-	}
-	return out
+  "authAlertsDelivery is how often authentication alerts are sent."
+  authAlertsDelivery: NotificationDelivery!
+
+  "timezone is an IANA time zone, like America/New_York, that quiet hours are in."
+  timezone: String!
+
+  "quietStart and quietEnd are the hours, from 0 to 23, between which no notifications are sent. They are null if there are no quiet hours."
+  quietStart: Int
+  quietEnd: Int
 }
 
-// nolint: vetshadow
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Type",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
+"""
+NotificationDelivery is how often a type of notification is sent. Hourly and
+daily notifications are batched into one email.
+"""
+enum NotificationDelivery {
+  immediate
+  hourly
+  daily
 }
 
-// nolint: vetshadow
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Type",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	rctx.Result = res
+"""
+An invite is a single use code that lets someone sign up when signups are
+invite only. Send people to /login?invite=code.
+"""
+type Invite {
+  code: String!
+  created: Time!
+  used: Time
+  usedBy: ID
+}
 
-	if res == nil {
-		return graphql.Null
-	}
-	return graphql.MarshalString(*res)
+"""
+A service account is a non-user principal, used by other services to talk to
+this one. Authenticate as one by sending a secret as a Bearer token.
+"""
+type ServiceAccount {
+  id: ID!
+  name: String!
+
+  "scopes are the roles this service account is granted."
+  scopes: [String!]!
+
+  "expires is when all secrets for this account stop working. Null means never."
+  expires: Time
+
+  "lastUsed is the last time any secret for this account was used."
+  lastUsed: Time
+  created: Time!
+  secrets: [ServiceAccountSecret]!
 }
 
-// nolint: vetshadow
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Type",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	return graphql.MarshalString(res)
+"""
+A service account secret is a credential for a service account. The secret
+itself is only returned once, when it is created.
+"""
+type ServiceAccountSecret {
+  id: ID!
+  created: Time!
+  lastUsed: Time
 }
 
-// nolint: vetshadow
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field___Type_fields_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx := &graphql.ResolverContext{
-		Object: "__Type",
-		Args:   args,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(args["includeDeprecated"].(bool)), nil
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.Field)
-	rctx.Result = res
+"""
+Service account credentials are returned when a new secret is created. The
+secret can not be retrieved again.
+"""
+type ServiceAccountCredentials {
+  serviceAccount: ServiceAccount!
+  secret: String!
+}
 
-	arr1 := make(graphql.Array, len(res))
-	var wg sync.WaitGroup
+"""
+An OIDC client is an application that logs users in with this server, using
+OpenID Connect.
+"""
+type OIDCClient {
+  id: ID!
+  name: String!
 
-	isLen1 := len(res) == 1
-	if !isLen1 {
-		wg.Add(len(res))
-	}
+  "redirectURIs are the only URIs users can be sent back to after logging in."
+  redirectURIs: [String!]!
+  created: Time!
+}
 
-	for idx1 := range res {
-		idx1 := idx1
-		rctx := &graphql.ResolverContext{
-			Index:  &idx1,
-			Result: &res[idx1],
-		}
-		ctx := graphql.WithResolverContext(ctx, rctx)
-		f := func(idx1 int) {
-			if !isLen1 {
-				defer wg.Done()
-			}
-			arr1[idx1] = func() graphql.Marshaler {
+"""
+OIDC client credentials are returned when a client is created. The secret can
+not be retrieved again.
+"""
+type OIDCClientCredentials {
+  client: OIDCClient!
+  secret: String!
+}
 
-				return ec.___Field(ctx, field.Selections, &res[idx1])
-			}()
-		}
-		if isLen1 {
-			f(idx1)
-		} else {
-			go f(idx1)
-		}
+"""
+A site is a domain served by this server, chosen by the Host header of each
+request. Posts, pages, settings and the theme belong to a site. The default
+site has no host, and serves every host that isn't another site's.
+"""
+type Site {
+  id: ID!
+  host: String
 
-	}
-	wg.Wait()
-	return arr1
+  "Email addresses of the only users who can log in on the site, besides admins. Anyone can if it's empty."
+  allowedUsers: [String!]!
+  created: Time!
 }
 
-// nolint: vetshadow
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Type",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.Type)
-	rctx.Result = res
-
-	arr1 := make(graphql.Array, len(res))
-	var wg sync.WaitGroup
+"""
+Site settings are presentation settings for the site, which admins can change
+without a deploy.
+"""
+type SiteSettings {
+  title: String!
+  description: String!
 
-	isLen1 := len(res) == 1
-	if !isLen1 {
-		wg.Add(len(res))
-	}
+  "url is the public URL of the site."
+  url: String!
+  footerText: String!
 
-	for idx1 := range res {
-		idx1 := idx1
-		rctx := &graphql.ResolverContext{
-			Index:  &idx1,
-			Result: &res[idx1],
-		}
-		ctx := graphql.WithResolverContext(ctx, rctx)
-		f := func(idx1 int) {
-			if !isLen1 {
-				defer wg.Done()
-			}
-			arr1[idx1] = func() graphql.Marshaler {
+  "postsPerPage is the default number of posts returned by posts."
+  postsPerPage: Int!
+  socialLinks: [SocialLink!]!
 
-				return ec.___Type(ctx, field.Selections, &res[idx1])
-			}()
-		}
-		if isLen1 {
-			f(idx1)
-		} else {
-			go f(idx1)
-		}
+  "license is the default license of posts."
+  license: License
+}
 
-	}
-	wg.Wait()
-	return arr1
+"""
+A social link is a link to the site owner somewhere else, like Twitter.
+"""
+type SocialLink {
+  name: String!
+  url: String!
 }
 
-// nolint: vetshadow
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Type",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.Type)
-	rctx.Result = res
-
-	arr1 := make(graphql.Array, len(res))
-	var wg sync.WaitGroup
+"""
+Admin stats summarize the site for the admin dashboard. Comments aren't
+stored, so there are none to moderate.
+"""
+type AdminStats {
+  posts: Int!
+  drafts: Int!
 
-	isLen1 := len(res) == 1
-	if !isLen1 {
-		wg.Add(len(res))
-	}
+  "users counts active users by role."
+  users: [RoleCount!]!
+  deactivatedUsers: Int!
 
-	for idx1 := range res {
-		idx1 := idx1
-		rctx := &graphql.ResolverContext{
-			Index:  &idx1,
-			Result: &res[idx1],
-		}
-		ctx := graphql.WithResolverContext(ctx, rctx)
-		f := func(idx1 int) {
-			if !isLen1 {
-				defer wg.Done()
-			}
-			arr1[idx1] = func() graphql.Marshaler {
+  "failedWebhookDeliveries have been tried but not delivered."
+  failedWebhookDeliveries: Int!
 
-				return ec.___Type(ctx, field.Selections, &res[idx1])
-			}()
-		}
-		if isLen1 {
-			f(idx1)
-		} else {
-			go f(idx1)
-		}
+  "recentErrors are the newest background errors from the last day, from jobs, webhooks, syndication and ActivityPub."
+  recentErrors: [AdminError!]!
 
-	}
-	wg.Wait()
-	return arr1
+  "cronTasks are the scheduled tasks, and how their last runs went."
+  cronTasks: [CronTaskStatus!]!
 }
 
-// nolint: vetshadow
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field___Type_enumValues_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx := &graphql.ResolverContext{
-		Object: "__Type",
-		Args:   args,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.EnumValue)
-	rctx.Result = res
+"""
+A cron task status is a scheduled task's schedule and its last run. next is
+null if the task is off. lastError is null if the last run succeeded.
+"""
+type CronTaskStatus {
+  name: String!
+  schedule: String!
+  next: Time
+  lastStarted: Time
+  lastFinished: Time
+  lastError: String
+}
 
-	arr1 := make(graphql.Array, len(res))
-	var wg sync.WaitGroup
+type RoleCount {
+  role: Role!
+  count: Int!
+}
 
-	isLen1 := len(res) == 1
-	if !isLen1 {
-		wg.Add(len(res))
-	}
+type AdminError {
+  "source is what failed, like job merge_users or webhook post.published."
+  source: String!
+  message: String!
+  time: Time!
+}
 
-	for idx1 := range res {
-		idx1 := idx1
-		rctx := &graphql.ResolverContext{
-			Index:  &idx1,
-			Result: &res[idx1],
-		}
-		ctx := graphql.WithResolverContext(ctx, rctx)
-		f := func(idx1 int) {
-			if !isLen1 {
-				defer wg.Done()
-			}
-			arr1[idx1] = func() graphql.Marshaler {
+"""
+Page view stats summarize views recorded by /beacon. Visitors are counted once
+per day and path, from a daily hash of their IP address.
+"""
+type PageViewStats {
+  views: Int!
+  visitors: Int!
 
-				return ec.___EnumValue(ctx, field.Selections, &res[idx1])
-			}()
-		}
-		if isLen1 {
-			f(idx1)
-		} else {
-			go f(idx1)
-		}
+  "referrers are the sites that sent the most views, most first."
+  referrers: [ReferrerCount!]!
 
-	}
-	wg.Wait()
-	return arr1
+  "days are the totals for each day with views, in UTC."
+  days: [DayCount!]!
 }
 
-// nolint: vetshadow
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Type",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.InputValue)
-	rctx.Result = res
+type ReferrerCount {
+  referrer: String!
+  views: Int!
+}
 
-	arr1 := make(graphql.Array, len(res))
-	var wg sync.WaitGroup
+type DayCount {
+  day: Time!
+  views: Int!
+  visitors: Int!
+}
 
-	isLen1 := len(res) == 1
-	if !isLen1 {
-		wg.Add(len(res))
-	}
+"""
+A theme is the visual appearance of the frontend, which admins can change
+without a frontend deploy.
+"""
+type Theme {
+  "accentColor is a hex color, like #001b44."
+  accentColor: String!
 
-	for idx1 := range res {
-		idx1 := idx1
-		rctx := &graphql.ResolverContext{
-			Index:  &idx1,
-			Result: &res[idx1],
-		}
-		ctx := graphql.WithResolverContext(ctx, rctx)
-		f := func(idx1 int) {
-			if !isLen1 {
-				defer wg.Done()
-			}
-			arr1[idx1] = func() graphql.Marshaler {
+  "lightImage is the header image for light mode. Empty means none."
+  lightImage: String!
 
-				return ec.___InputValue(ctx, field.Selections, &res[idx1])
-			}()
-		}
-		if isLen1 {
-			f(idx1)
-		} else {
-			go f(idx1)
-		}
+  "darkImage is the header image for dark mode. Empty means none."
+  darkImage: String!
+  navItems: [NavItem!]!
+}
 
-	}
-	wg.Wait()
-	return arr1
+"""
+A nav item is a link in the site navigation.
+"""
+type NavItem {
+  label: String!
+  url: String!
 }
 
-// nolint: vetshadow
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
-	rctx := &graphql.ResolverContext{
-		Object: "__Type",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.OfType(), nil
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Type)
-	rctx.Result = res
+"""
+A redirect sends requests for a path on this server somewhere else. Redirects
+are checked before returning a 404.
+"""
+type Redirect {
+  id: ID!
 
-	if res == nil {
-		return graphql.Null
-	}
+  "from is the path to redirect, like /old."
+  from: String!
 
-	return ec.___Type(ctx, field.Selections, res)
+  "to is a path or URL to redirect to."
+  to: String!
+
+  "status is the HTTP status code, one of 301, 302, 307 or 308."
+  status: Int!
+  hits: Int!
+  lastHit: Time
+  created: Time!
+  modified: Time!
 }
 
-func UnmarshalNewLink(v interface{}) (NewLink, error) {
-	var it NewLink
-	var asMap = v.(map[string]interface{})
+"""
+A book on one of the reading shelves. Books are added with addBook, or
+imported from a Goodreads CSV export with importGoodreads.
+"""
+type Book {
+  id: ID!
+  title: String!
+  author: String!
+  isbn: String
+  goodreadsId: String
+  shelf: Shelf!
+
+  "rating is from 1 to 5, or null if the book hasn't been rated."
+  rating: Int
+  pages: Int
+  started: Time
+  finished: Time
+  created: Time!
+  modified: Time!
+}
 
-	for k, v := range asMap {
-		switch k {
-		case "title":
-			var err error
-			it.Title, err = graphql.UnmarshalString(v)
-			if err != nil {
-				return it, err
-			}
-		case "uri":
-			var err error
-			it.URI, err = graphql.UnmarshalString(v)
-			if err != nil {
-				return it, err
-			}
-		case "description":
-			var err error
-			it.Description, err = graphql.UnmarshalString(v)
-			if err != nil {
-				return it, err
-			}
-		case "tags":
-			var err error
-			var rawIf1 []interface{}
-			if v != nil {
-				if tmp1, ok := v.([]interface{}); ok {
-					rawIf1 = tmp1
-				} else {
-					rawIf1 = []interface{}{v}
-				}
-			}
-			it.Tags = make([]*string, len(rawIf1))
-			for idx1 := range rawIf1 {
-				var ptr2 string
-				if rawIf1[idx1] != nil {
-					ptr2, err = graphql.UnmarshalString(rawIf1[idx1])
-					it.Tags[idx1] = &ptr2
-				}
-			}
-			if err != nil {
-				return it, err
-			}
-		case "created":
-			var err error
-			it.Created, err = graphql.UnmarshalTime(v)
-			if err != nil {
-				return it, err
-			}
-		}
-	}
+"""
+A job is work running in the background: either a bulk admin operation, or a
+queued job. done counts up to total as a bulk operation runs. Queued jobs have
+a payload, and are retried with backoff until they succeed or are dead.
+"""
+type Job {
+  id: ID!
+  kind: String!
+  status: JobStatus!
+  total: Int!
+  done: Int!
 
-	return it, nil
+  "error is why a failed job stopped, or why a queued job's last attempt failed."
+  error: String
+
+  "payload is the JSON a queued job was enqueued with."
+  payload: String
+
+  "report is JSON describing what a finished job did, for jobs that have one, like imports."
+  report: String
+  attempts: Int!
+
+  "runAt is when a queued job runs next."
+  runAt: Time
+
+  "requestId is the ID of the request that started the job, from its X-Request-Id header."
+  requestId: String
+  created: Time!
+  finished: Time
 }
 
-func UnmarshalNewPost(v interface{}) (NewPost, error) {
-	var it NewPost
-	var asMap = v.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "content":
-			var err error
-			it.Content, err = graphql.UnmarshalString(v)
-			if err != nil {
-				return it, err
-			}
-		case "title":
-			var err error
-			it.Title, err = graphql.UnmarshalString(v)
-			if err != nil {
-				return it, err
-			}
-		case "datetime":
-			var err error
-			it.Datetime, err = graphql.UnmarshalTime(v)
-			if err != nil {
-				return it, err
-			}
-		case "draft":
-			var err error
-			it.Draft, err = graphql.UnmarshalBoolean(v)
-			if err != nil {
-				return it, err
-			}
-		}
-	}
+"""
+Queued jobs are queued until they run, and dead once they have failed too many
+times. Dead jobs can be requeued.
+"""
+enum JobStatus {
+  queued
+  running
+  succeeded
+  failed
+  dead
+}
 
-	return it, nil
+enum Shelf {
+  to_read
+  reading
+  read
 }
 
-func UnmarshalNewStat(v interface{}) (NewStat, error) {
-	var it NewStat
-	var asMap = v.(map[string]interface{})
+"""
+Reading stats summarize the books finished in a year.
+"""
+type ReadingStats {
+  year: Int!
+  books: Int!
+  pages: Int!
 
-	for k, v := range asMap {
-		switch k {
-		case "key":
-			var err error
-			it.Key, err = graphql.UnmarshalString(v)
-			if err != nil {
-				return it, err
-			}
-		case "value":
-			var err error
-			it.Value, err = graphql.UnmarshalString(v)
-			if err != nil {
-				return it, err
-			}
-		}
-	}
+  "averageRating is the mean rating of the rated books, or null if none were rated."
+  averageRating: Float
 
-	return it, nil
+  "months has an entry for every month of the year, from January."
+  months: [MonthCount!]!
 }
 
-func (ec *executionContext) FieldMiddleware(ctx context.Context, obj interface{}, next graphql.Resolver) (ret interface{}) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
-		}
-	}()
-	rctx := graphql.GetResolverContext(ctx)
-	for _, d := range rctx.Field.Definition.Directives {
-		switch d.Name {
-		case "hasRole":
-			if ec.directives.HasRole != nil {
-				rawArgs := d.ArgumentMap(ec.Variables)
-				args, err := dir_hasRole_args(rawArgs)
-				if err != nil {
-					ec.Error(ctx, err)
-					return nil
-				}
-				n := next
-				next = func(ctx context.Context) (interface{}, error) {
-					return ec.directives.HasRole(ctx, obj, n, args["role"].(Role))
-				}
-			}
-		}
-	}
-	res, err := ec.ResolverMiddleware(ctx, next)
-	if err != nil {
-		ec.Error(ctx, err)
-		return nil
-	}
-	return res
+type MonthCount {
+  month: Int!
+  books: Int!
+  pages: Int!
 }
 
-func (ec *executionContext) introspectSchema() *introspection.Schema {
-	return introspection.WrapSchema(parsedSchema)
+"""
+A short link is a short URL on this server, /s/{slug}, that redirects to a
+longer one.
+"""
+type ShortLink {
+  id: ID!
+  slug: String!
+
+  "path is where the short link is served, like /s/abc123."
+  path: String!
+  url: String!
+  clicks: Int!
+  lastClick: Time
+  created: Time!
 }
 
-func (ec *executionContext) introspectType(name string) *introspection.Type {
-	return introspection.WrapTypeFromDef(parsedSchema, parsedSchema.Types[name])
+"""
+A webhook is a URL that is sent a JSON payload when events happen. Events are
+post.published, comment.created and user.created. Payloads are signed with
+the webhook's secret, see the X-Webhook-Signature header.
+"""
+type Webhook {
+  id: ID!
+  url: String!
+  events: [String!]!
+  created: Time!
+  modified: Time!
 }
 
-var parsedSchema = gqlparser.MustLoadSchema(
-	&ast.Source{Name: "schema.graphql", Input: `schema {
-  query: Query
+"""
+Webhook credentials are returned when a webhook is created. The secret can not
+be retrieved again.
+"""
+type WebhookCredentials {
+  webhook: Webhook!
+  secret: String!
 }
 
 """
-The query type, represents all of the entry points into our object graph.
+An automation rule runs when an event happens, and if its condition is true,
+its actions change what happens next. The only event is post.published.
+Conditions compare variables, like post.tags and post.readtime, with ==, !=,
+<, <=, >, >=, in, contains and matches, and combine them with and, or and
+not, like ` + "`" + `"photo" in post.tags and not post.protected` + "`" + `. Actions are
+syndicate_to:<service>, which limits syndication to the services it names,
+skip_syndication and skip_federation.
 """
-type Query {
-  "Returns an array of all posts ever, ordered by reverse chronological order."
-  allPosts(): [Post]!
+type AutomationRule {
+  id: ID!
+  name: String!
+  event: String!
+  condition: String!
+  actions: [String!]!
+  enabled: Boolean!
+
+  "runs are the rule's latest runs, newest first. Limit defaults to 20."
+  runs(limit: Int): [AutomationRuleRun]!
+  created: Time!
+  modified: Time!
+}
 
-  "Returns an array of inprogress posts."
-  drafts(): [Post]! @hasRole(role: admin)
+"""
+An integrity issue is an inconsistency in the database, found by the integrity
+check. check is the kind, like orphaned_revisions, and subject is what has
+the issue, like revision 12. repaired says whether it was fixed when it was
+found. Some issues, like posts with invalid licenses, are never repaired
+automatically.
+"""
+type IntegrityIssue {
+  id: ID!
+  check: String!
+  subject: String!
+  message: String!
+  repaired: Boolean!
+  created: Time!
+}
 
-  "Returns an array of all posts, ordered by reverse chronological order, using provided limit and offset."
-  posts(limit: Int, offset: Int): [Post]!
+"An automation rule run is the log of a rule running on a subject, like post 12."
+type AutomationRuleRun {
+  id: ID!
+  ruleId: ID!
+  subject: String!
+  matched: Boolean!
 
-  "Returns a single post by ID."
-  post(id: ID!): Post
+  "actions are the actions run, which are none if the rule didn't match."
+  actions: [String!]!
 
-  "Returns post id for the next post chronologically."
-  nextPost(id: ID!): Post
+  "error is why the condition couldn't be evaluated."
+  error: String
+  created: Time!
+}
 
-  "Returns post id for the previous post chronologically."
-  prevPost(id: ID!): Post
+"""
+A webhook delivery is an event being sent to a webhook. Failed deliveries are
+retried with exponential backoff.
+"""
+type WebhookDelivery {
+  id: ID!
+  webhookId: ID!
+  event: String!
+  payload: String!
 
-  "Returns all links ever, in reverse chronological order."
-  allLinks(): [Link]!
+  "requestId is the ID of the request that caused the event, also sent in the X-Request-Id header."
+  requestId: String
+  attempts: Int!
 
-  "Returns a subset of all links ever, in reverse chronological order, using provided limit and offset."
-  links(limit: Int, offset: Int): [Link]!
+  "statusCode is the HTTP status of the last attempt, if there was a response."
+  statusCode: Int
 
-  "Returns a single link by id."
-  link(id: ID!): Link
+  "error is why the last attempt failed."
+  error: String
 
-  "Returns a number of stats, ordered by most recently updated."
-  stats(count: Int): [Stat]!
+  "nextAttempt is when the delivery will be tried again. Null means it won't be."
+  nextAttempt: Time
+  delivered: Time
+  created: Time!
 }
 
 """
-A post is an individual post in the blog.
+A data export is a tar.gz archive of the site's content, users and settings,
+for backups. Import it with importData.
 """
-type Post {
+type DataExport {
+  filename: String!
+
+  "archive is the base64 encoded tar.gz."
+  archive: String!
+  created: Time!
+}
+
+"""
+A log is a short, private journal entry, optionally with where it was
+written. Logs are only visible to the user that wrote them, and the members of
+the group they are shared with. /logs.geojson exports them for mapping.
+"""
+type Log {
   id: ID!
-  title: String!
-  content: String!
-  summary: String!
-  readtime: Int!
+  userId: ID!
 
-  "datetime is the published time of an article."
+  "groupId is the group the log is shared with. Null means private."
+  groupId: ID
+  content: String!
+  location: Geo
   datetime: Time!
   created: Time!
   modified: Time!
-  draft: Boolean!
-  tags: [String!]!
-
-  "links are the links referenced in a post."
-  links: [Link]!
 }
 
 """
-A link is a link I have save on pinboard or a link in a post.
+A group is a set of users, like a household, who can share logs with each
+other. Only its owner can invite members.
 """
-type Link {
+type Group {
   id: ID!
-  title: String!
-  uri: URI!
+  name: String!
+  ownerId: ID!
+  members: [ID!]!
   created: Time!
-  description: String!
-  screenshot: URI!
-  tags: [String!]!
+  modified: Time!
 }
 
 """
-A stat is a key value pair of two interesting strings.
+A geo is a point on Earth, in degrees.
 """
-type Stat {
-  key: String!
-  value: String!
+type Geo {
+  lat: Float!
+  long: Float!
+}
+
+"""
+Debug info is what build of the server is running, and how it is doing.
+version and gitSHA are empty if the build didn't set them. uptime is in
+seconds, and heapAlloc in bytes.
+"""
+type DebugInfo {
+  version: String!
+  gitSHA: String!
+  goVersion: String!
+  started: Time!
+  uptime: Int!
+  goroutines: Int!
+  heapAlloc: Int!
+  heapObjects: Int!
+  numGC: Int!
+  database: DBPoolStats!
+
+  "replica is null if there is no read replica."
+  replica: DBPoolStats
+}
+
+"""
+DB pool stats are the stats of a database connection pool. maxOpen is 0 if
+it is unlimited, and waitDuration is the total time spent waiting for a
+connection, in milliseconds.
+"""
+type DBPoolStats {
+  maxOpen: Int!
+  open: Int!
+  inUse: Int!
+  idle: Int!
+  waitCount: Int!
+  waitDuration: Float!
+}
+
+"""
+A goroutine count is how many goroutines are running with a set of labels.
+Background workers are labelled like worker=digest, other background tasks
+like task=purge, and requests, and the goroutines they start, like
+http=/graphql. Goroutines without labels have empty labels.
+"""
+type GoroutineCount {
+  labels: String!
+  count: Int!
+}
+
+"""
+An import result is how many rows were imported into a table. Rows that
+already existed are not counted.
+"""
+type ImportResult {
+  table: String!
+  imported: Int!
 }
 
 """
-Time is a datetime scalar with timezone.
+Time is an RFC 3339 datetime, like 2019-01-02T15:04:05Z. Inputs must include
+an offset. Times are always returned in UTC.
 """
 scalar Time
 
@@ -3897,21 +25856,178 @@ type Comment {
   id: ID!
 }
 
+"""
+When editing, a null canonicalURL, originallyPublishedAt or license keeps the
+post's current value, and an empty canonicalURL removes both.
+"""
 input NewPost {
   content: String!
-  title: String!
+  title: String! @constraint(maxLength: 280)
   datetime: Time!
   draft: Boolean!
+  canonicalURL: String @constraint(format: "url")
+  originallyPublishedAt: Time
+  license: LicenseInput
+}
+
+"""
+An empty value removes the key from the post's metadata.
+"""
+input MetadataInput {
+  key: String! @constraint(pattern: "^[a-z][a-z0-9_]*$")
+  value: String!
 }
 
 input NewLink {
-  title: String!
+  title: String! @constraint(maxLength: 280)
   uri: URI!
   description: String!
-  tags: [String]!
+  tags: [String]! @constraint(maxLength: 64)
   created: Time!
 }
 
+input NewServiceAccount {
+  name: String!
+  scopes: [String!]!
+  expires: Time
+}
+
+input NewOIDCClient {
+  name: String!
+  redirectURIs: [String!]!
+}
+
+input NewUser {
+  "id is the user's Google profile ID."
+  id: ID!
+  role: Role!
+}
+
+"""
+Search matches the title, description and URL of links.
+"""
+input LinkFilter {
+  tag: String
+  dead: Boolean
+  search: String
+}
+
+input UserFilter {
+  role: Role
+  active: Boolean
+}
+
+"""
+Shelf defaults to to_read. Books added to the reading shelf are started now,
+unless started is set.
+"""
+input NewBook {
+  title: String!
+  author: String!
+  isbn: String
+  shelf: Shelf
+  pages: Int
+  started: Time
+}
+
+"""
+Content can be at most 500 characters. Datetime defaults to now.
+"""
+input NewLog {
+  content: String! @constraint(minLength: 1, maxLength: 500)
+  location: GeoInput
+  datetime: Time
+
+  "groupId shares the log with a group the user is a member of."
+  groupId: ID
+}
+
+input GeoInput {
+  lat: Float! @constraint(min: -90, max: 90)
+  long: Float! @constraint(min: -180, max: 180)
+}
+
+"""
+A date range includes both the from and to days, in the request's time zone.
+"""
+input DateRange {
+  from: Time!
+  to: Time!
+}
+
+"""
+Fields left out of notification settings input are not changed. Setting
+quietStart and quietEnd to the same hour turns quiet hours off.
+"""
+input NotificationSettingsInput {
+  email: String @constraint(format: "email")
+  comments: Boolean
+  digest: Boolean
+  authAlerts: Boolean
+  integrityAlerts: Boolean
+  commentsDelivery: NotificationDelivery
+  authAlertsDelivery: NotificationDelivery
+  timezone: String
+  quietStart: Int @constraint(min: 0, max: 23)
+  quietEnd: Int @constraint(min: 0, max: 23)
+}
+
+"""
+Fields left out of site settings input are not changed.
+"""
+input SiteSettingsInput {
+  title: String @constraint(minLength: 1, maxLength: 280)
+  description: String
+  url: String @constraint(format: "url")
+  footerText: String
+  postsPerPage: Int @constraint(min: 1, max: 100)
+  socialLinks: [SocialLinkInput!]
+  license: LicenseInput
+}
+
+input SocialLinkInput {
+  name: String!
+  url: String!
+}
+
+"""
+Fields left out of theme input are not changed.
+"""
+input ThemeInput {
+  accentColor: String @constraint(pattern: "^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$")
+  lightImage: String
+  darkImage: String
+  navItems: [NavItemInput!]
+}
+
+input NavItemInput {
+  label: String!
+  url: String!
+}
+
+input NewRedirect {
+  from: String! @constraint(pattern: "^/")
+  to: String! @constraint(minLength: 1)
+
+  "status defaults to 301."
+  status: Int
+}
+
+input NewWebhook {
+  url: String! @constraint(format: "url")
+  events: [String!]!
+}
+
+input AutomationRuleInput {
+  name: String! @constraint(minLength: 1, maxLength: 280)
+  event: String!
+  condition: String! @constraint(maxLength: 1000)
+  actions: [String!]!
+
+  "enabled defaults to true."
+  enabled: Boolean
+}
+
 input NewStat {
   key: String!
   value: String!
@@ -3919,13 +26035,169 @@ input NewStat {
 
 type Mutation {
   createPost(input: NewPost!): Post! @hasRole(role: admin)
-  editPost(Id: ID!, input: NewPost!): Post! @hasRole(role: admin)
+  "editPost saves the post if it is still at version, the version the edit was made to. Otherwise it fails with a CONFLICT error, whose version extension is the post's current version."
+  editPost(Id: ID!, input: NewPost!, version: Int!): Post! @hasRole(role: admin)
   createLink(input: NewLink!): Link! @hasRole(role: admin)
+
+  "saveLink fetches a page and saves it as a link, with its title, description and image."
+  saveLink(url: String! @constraint(format: "url"), tags: [String!] @constraint(maxLength: 64)): Link! @hasRole(role: admin)
   upsertStat(input: NewStat!): Stat! @hasRole(role: admin)
+  "revertPost restores a revision of the post. If version is set, it fails with a CONFLICT error unless the post is still at that version."
+  revertPost(id: ID!, revision: Int!, version: Int): Post! @hasRole(role: admin)
+
+  "setPostMetadata sets keys of a post's metadata, leaving its other keys alone. Nothing is changed unless every value is valid for its key."
+  setPostMetadata(id: ID!, metadata: [MetadataInput!]!): Post! @hasRole(role: admin)
+
+  "setPostPassword protects a post with a password, or removes its protection if password is null."
+  setPostPassword(id: ID!, password: String): Post! @hasRole(role: admin)
+
+  "unlockPost returns a token for reading a protected post, which is valid for a week. Wrong passwords are rate limited."
+  unlockPost(id: ID!, password: String!): String!
+  createServiceAccount(input: NewServiceAccount!): ServiceAccountCredentials! @hasRole(role: admin)
+  rotateServiceAccountSecret(id: ID!): ServiceAccountCredentials! @hasRole(role: admin)
+  revokeServiceAccountSecret(id: ID!): Boolean! @hasRole(role: admin)
+  deleteServiceAccount(id: ID!): ServiceAccount! @hasRole(role: admin)
+  createOIDCClient(input: NewOIDCClient!): OIDCClientCredentials! @hasRole(role: admin)
+  deleteOIDCClient(id: ID!): OIDCClient! @hasRole(role: admin)
+  provisionUsers(input: [NewUser!]!): [User]! @hasRole(role: admin)
+  deactivateUsers(ids: [ID!]!): [User]! @hasRole(role: admin)
+  reactivateUsers(ids: [ID!]!): [User]! @hasRole(role: admin)
+
+  "deactivateUsersMatching deactivates every active user matching filter, except the caller, in a background job."
+  deactivateUsersMatching(filter: UserFilter!): Job! @hasRole(role: admin)
+
+  "reassignUserContent moves the logs of the user from to the user to, in a background job."
+  reassignUserContent(from: ID!, to: ID!): Job! @hasRole(role: admin)
+
+  "mergeUsers moves everything of the duplicate user from to the user into, then deactivates from, in a background job."
+  mergeUsers(from: ID!, into: ID!): Job! @hasRole(role: admin)
+
+  "Queues a dead job to run again, with its attempts reset."
+  requeueJob(id: ID!): Job! @hasRole(role: admin)
+  createInvite(): Invite! @hasRole(role: admin)
+
+  "createSite adds a site for another domain. It has the default settings, and no posts or pages, until an admin on its domain adds some."
+  createSite(host: String!, allowedUsers: [String!]): Site! @hasRole(role: admin)
+
+  "updateSite changes a site's host or allowed users. Fields left out are not changed."
+  updateSite(id: ID!, host: String, allowedUsers: [String!]): Site! @hasRole(role: admin)
+  updateSiteSettings(input: SiteSettingsInput!): SiteSettings! @hasRole(role: admin)
+  updateTheme(input: ThemeInput!): Theme! @hasRole(role: admin)
+  resetTheme(): Theme! @hasRole(role: admin)
+  createRedirect(input: NewRedirect!): Redirect! @hasRole(role: admin)
+  updateRedirect(id: ID!, input: NewRedirect!): Redirect! @hasRole(role: admin)
+  deleteRedirect(id: ID!): Redirect! @hasRole(role: admin)
+
+  "createShortLink shortens url. A random slug is generated if slug is null."
+  createShortLink(url: String! @constraint(format: "url"), slug: String @constraint(maxLength: 64)): ShortLink! @hasRole(role: admin)
+
+  addBook(input: NewBook!): Book! @hasRole(role: admin)
+
+  "upsertPage creates or updates the page at slug. Title and draft are kept if null, or are empty and false for a new page. Updating a page needs the version the edit was made to, and fails with a CONFLICT error, whose version extension is the page's current version, if it has changed since."
+  upsertPage(slug: String! @constraint(pattern: "^[a-z0-9]+(-[a-z0-9]+)*$"), content: String!, title: String, draft: Boolean, version: Int): Page! @hasRole(role: admin)
+
+  "markRead moves a book to the read shelf. Finished defaults to now, and rating, from 1 to 5, is kept if null."
+  markRead(id: ID!, finished: Time, rating: Int @constraint(min: 1, max: 5)): Book! @hasRole(role: admin)
+
+  "importGoodreads adds or updates books from the CSV of a Goodreads library export. It returns the imported books."
+  importGoodreads(csv: String!): [Book]! @hasRole(role: admin)
+  createWebhook(input: NewWebhook!): WebhookCredentials! @hasRole(role: admin)
+  deleteWebhook(id: ID!): Webhook! @hasRole(role: admin)
+  createAutomationRule(input: AutomationRuleInput!): AutomationRule! @hasRole(role: admin)
+  updateAutomationRule(id: ID!, input: AutomationRuleInput!): AutomationRule! @hasRole(role: admin)
+  deleteAutomationRule(id: ID!): AutomationRule! @hasRole(role: admin)
+
+  "testAutomationRule returns whether condition is true for a post, without running any actions."
+  testAutomationRule(condition: String!, postId: ID!): Boolean! @hasRole(role: admin)
+
+  "checkIntegrity runs the integrity check now, and returns the issues it found. If repair is true, issues that can be repaired without losing anything are."
+  checkIntegrity(repair: Boolean): [IntegrityIssue]! @hasRole(role: admin)
+  exportData(): DataExport! @hasRole(role: admin)
+
+  """
+  exportHugo returns the site as a Hugo site, to build as static files if
+  this server is ever gone. If media is true, the images posts use are
+  downloaded into it.
+  """
+  exportHugo(media: Boolean): DataExport! @hasRole(role: admin)
+
+  "archive is a base64 encoded tar.gz from exportData."
+  importData(archive: String!): [ImportResult]! @hasRole(role: admin)
+
+  """
+  importWordPress imports the posts and pages in file, a base64 encoded
+  WordPress export (WXR) file, in a job, and redirects their old permalinks to
+  them. baseURL is where the blog was, and defaults to the one in the export.
+  The job's report has what was imported.
+  """
+  importWordPress(file: String!, baseURL: String): Job! @hasRole(role: admin)
+
+  """
+  importStaticSite imports the posts in archive, a base64 encoded tar.gz or zip
+  of a Jekyll or Hugo site's source, in a job, and redirects their old
+  permalinks to them. baseURL is where the site was, and permalink is the
+  pattern of its post URLs, like /:year/:month/:title/, if it isn't the
+  default. The job's report has what was imported.
+  """
+  importStaticSite(archive: String!, baseURL: String, permalink: String): Job! @hasRole(role: admin)
+
+  "purgeCache removes paths, like /post/1, from the CDN cache. It returns the URLs purged."
+  purgeCache(paths: [String!]!): [String!]! @hasRole(role: admin)
+
+  "insertLog writes a log for the logged in user."
+  insertLog(input: NewLog!): Log!
+
+  "shareLog shares one of the logged in user's logs with a group they are a member of, or makes it private if groupId is null."
+  shareLog(id: ID!, groupId: ID): Log!
+  createGroup(name: String! @constraint(minLength: 1, maxLength: 280)): Group!
+
+  "createGroupInvite returns a single use code for joinGroup. Only the group's owner can invite."
+  createGroupInvite(groupId: ID!): String!
+  joinGroup(code: String!): Group!
+
+  "leaveGroup removes the logged in user from a group, and makes their logs shared with it private."
+  leaveGroup(id: ID!): Group!
+
+  "deleteGroup deletes a group the logged in user owns. Logs shared with it become private."
+  deleteGroup(id: ID!): Group!
+
+  "revokeSession logs out one of the logged in user's sessions."
+  revokeSession(id: ID!): Boolean!
+
+  "revokeAllOtherSessions logs out all of the logged in user's sessions except the current one, and returns how many were logged out."
+  revokeAllOtherSessions(): Int!
+
+  "readLater saves a URL or a post, given by exactly one of url and postId, to the logged in user's read later queue. Saving something already in the queue returns it."
+  readLater(url: String, postId: ID): ReadLaterItem!
+
+  "removeReadLater takes an item out of the logged in user's read later queue."
+  removeReadLater(id: ID!): Boolean!
+
+  "createHighlight highlights a range of a post's content for the logged in user."
+  createHighlight(input: NewHighlight!): Highlight!
+
+  "updateHighlight changes the note or visibility of one of the logged in user's highlights. An empty note removes it."
+  updateHighlight(id: ID!, note: String, public: Boolean): Highlight!
+  deleteHighlight(id: ID!): Boolean!
+
+  "readingProgress records how far through a post, from 0 to 100 percent, the logged in user has read. The latest save wins."
+  readingProgress(postId: ID!, percent: Int! @constraint(min: 0, max: 100)): ReadingProgress!
+
+  "updateNotificationSettings changes the logged in user's notification settings."
+  updateNotificationSettings(input: NotificationSettingsInput!): NotificationSettings!
 }
 
 directive @hasRole(role: Role!) on FIELD_DEFINITION
 
+"""
+constraint limits the values of mutation arguments and input fields. Every
+argument is checked before the mutation runs, and each one that breaks a
+constraint is its own error, with a VALIDATION code and the field in the field
+extension. Lengths are in characters. Format is url, for absolute http or
+https URLs, or email.
+"""
+directive @constraint(minLength: Int, maxLength: Int, pattern: String, format: String, min: Float, max: Float) on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION
+
 enum Role {
   admin
   normal
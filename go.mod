@@ -1,53 +1,102 @@
 module github.com/icco/graphql
 
+go 1.27.1
+
 require (
-	cloud.google.com/go v0.29.0 // indirect
+	cloud.google.com/go v0.29.0
 	contrib.go.opencensus.io/exporter/stackdriver v0.6.0
 	github.com/99designs/gqlgen v0.6.0
 	github.com/GuiaBolso/darwin v0.0.0-20170210191649-86919dfcf808
+	github.com/alecthomas/chroma/v2 v2.2.0
+	github.com/basvanbeek/ocsql v0.1.0
+	github.com/go-chi/chi v3.3.3+incompatible
+	github.com/go-chi/cors v1.0.0
+	github.com/gofrs/uuid v3.1.0+incompatible
+	github.com/golang/protobuf v1.2.0
+	github.com/gorilla/sessions v1.1.3
+	github.com/hashicorp/golang-lru v0.5.0
+	github.com/lib/pq v1.0.0
+	github.com/microcosm-cc/bluemonday v1.0.21
+	github.com/pmezard/go-difflib v1.0.0
+	github.com/vektah/gqlparser v0.0.0-20181002002754-f119686bf1d4
+	github.com/yuin/goldmark v1.4.13
+	go.etcd.io/bbolt v1.3.6
+	go.opencensus.io v0.17.0
+	golang.org/x/crypto v0.0.0-20220722155217-630584e8d5aa
+	golang.org/x/net v0.0.0-20221002022538-bcab6841153b
+	golang.org/x/oauth2 v0.0.0-20181003184128-c57b0facaced
+	golang.org/x/sync v0.1.0
+	google.golang.org/api v0.0.0-20181003000758-f5c49d98d21c
+	google.golang.org/grpc v1.15.0
+	gopkg.in/square/go-jose.v2 v2.1.9
+	gopkg.in/unrolled/render.v1 v1.0.0-20180914162206-b9786414de4d
+	gopkg.in/unrolled/secure.v1 v1.0.0-20181005190816-ff9db2ff917f
+)
+
+require (
+	git.apache.org/thrift.git v0.0.0-20180902110319-2566ecd5d999 // indirect
 	github.com/agnivade/levenshtein v1.0.1 // indirect
+	github.com/alecthomas/repr v0.0.0-20220113201626-b1b626ac65ae // indirect
 	github.com/andreyvit/diff v0.0.0-20170406064948-c7f18ee00883 // indirect
 	github.com/aws/aws-sdk-go v1.15.49 // indirect
-	github.com/basvanbeek/ocsql v0.1.0
+	github.com/aymerick/douceur v0.2.0 // indirect
+	github.com/beorn7/perks v0.0.0-20180321164747-3a771d992973 // indirect
+	github.com/client9/misspell v0.3.4 // indirect
 	github.com/codegangsta/negroni v1.0.0 // indirect
+	github.com/cznic/b v0.0.0-20180115125044-35e9bbe41f07 // indirect
+	github.com/cznic/fileutil v0.0.0-20180108211300-6a051e75936f // indirect
+	github.com/cznic/golex v0.0.0-20170803123110-4ab7c5e190e4 // indirect
+	github.com/cznic/internal v0.0.0-20180608152220-f44710a21d00 // indirect
+	github.com/cznic/lldb v1.1.0 // indirect
+	github.com/cznic/mathutil v0.0.0-20180504122225-ca4c9f2c1369 // indirect
 	github.com/cznic/ql v1.2.0 // indirect
+	github.com/cznic/sortutil v0.0.0-20150617083342-4c7342852e65 // indirect
+	github.com/cznic/strutil v0.0.0-20171016134553-529a34b1c186 // indirect
+	github.com/cznic/zappy v0.0.0-20160723133515-2533cb5b45cc // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/dlclark/regexp2 v1.4.0 // indirect
+	github.com/edsrzf/mmap-go v0.0.0-20170320065105-0bce6a688712 // indirect
 	github.com/eknkc/amber v0.0.0-20171010120322-cdade1c07385 // indirect
-	github.com/go-chi/chi v3.3.3+incompatible
-	github.com/go-chi/cors v1.0.0
 	github.com/go-ini/ini v1.38.3 // indirect
-	github.com/gofrs/uuid v3.1.0+incompatible
+	github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b // indirect
+	github.com/golang/lint v0.0.0-20180702182130-06c8688daad7 // indirect
+	github.com/golang/mock v1.1.1 // indirect
+	github.com/golang/snappy v0.0.0-20180518054509-2e65f85255db // indirect
 	github.com/google/go-cmp v0.2.0 // indirect
 	github.com/googleapis/gax-go v2.0.0+incompatible // indirect
 	github.com/gopherjs/gopherjs v0.0.0-20181004151105-1babbf986f6f // indirect
-	github.com/gorilla/sessions v1.1.3
+	github.com/gorilla/context v1.1.1 // indirect
+	github.com/gorilla/css v1.0.0 // indirect
+	github.com/gorilla/securecookie v1.1.1 // indirect
 	github.com/gorilla/websocket v1.4.0 // indirect
-	github.com/hashicorp/golang-lru v0.5.0 // indirect
 	github.com/jmespath/go-jmespath v0.0.0-20180206201540-c2b33e8439af // indirect
 	github.com/jtolds/gls v4.2.1+incompatible // indirect
+	github.com/kisielk/gotool v1.0.0 // indirect
 	github.com/kr/pretty v0.1.0 // indirect
-	github.com/lib/pq v1.0.0
-	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/kr/pty v1.1.1 // indirect
+	github.com/kr/text v0.1.0 // indirect
+	github.com/matttproud/golang_protobuf_extensions v1.0.1 // indirect
+	github.com/openzipkin/zipkin-go v0.1.1 // indirect
+	github.com/prometheus/client_golang v0.8.0 // indirect
+	github.com/prometheus/client_model v0.0.0-20180712105110-5c3871d89910 // indirect
+	github.com/prometheus/common v0.0.0-20180801064454-c7de2306084e // indirect
 	github.com/prometheus/procfs v0.0.0-20181005140218-185b4288413d // indirect
-	github.com/russross/blackfriday v2.0.0+incompatible
 	github.com/sergi/go-diff v1.0.0 // indirect
-	github.com/shurcooL/sanitized_anchor_name v0.0.0-20170918181015-86672fcb3f95 // indirect
 	github.com/smartystreets/assertions v0.0.0-20180927180507-b2de0cb4f26d // indirect
 	github.com/smartystreets/goconvey v0.0.0-20180222194500-ef6db91d284a // indirect
-	github.com/stretchr/testify v1.2.2 // indirect
-	github.com/vektah/gqlparser v0.0.0-20181002002754-f119686bf1d4
-	go.opencensus.io v0.17.0
-	golang.org/x/net v0.0.0-20181005035420-146acd28ed58 // indirect
-	golang.org/x/oauth2 v0.0.0-20181003184128-c57b0facaced
-	golang.org/x/sys v0.0.0-20181005133103-4497e2df6f9e // indirect
-	google.golang.org/api v0.0.0-20181003000758-f5c49d98d21c
+	github.com/stretchr/objx v0.1.0 // indirect
+	github.com/stretchr/testify v1.7.0 // indirect
+	golang.org/x/lint v0.0.0-20180702182130-06c8688daad7 // indirect
+	golang.org/x/sys v0.0.0-20220728004956-3c1f35247d10 // indirect
+	golang.org/x/term v0.0.0-20210927222741-03fcf44c2211 // indirect
+	golang.org/x/text v0.3.7 // indirect
+	golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e // indirect
 	google.golang.org/appengine v1.2.0 // indirect
 	google.golang.org/genproto v0.0.0-20181004005441-af9cb2a35e7f // indirect
-	google.golang.org/grpc v1.15.0 // indirect
 	gopkg.in/DATA-DOG/go-sqlmock.v1 v1.3.0 // indirect
 	gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127 // indirect
 	gopkg.in/ini.v1 v1.38.3 // indirect
-	gopkg.in/unrolled/render.v1 v1.0.0-20180914162206-b9786414de4d
-	gopkg.in/unrolled/secure.v1 v1.0.0-20181005190816-ff9db2ff917f
-	gopkg.in/yaml.v2 v2.2.1 // indirect
+	gopkg.in/yaml.v2 v2.2.1
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
+	honnef.co/go/tools v0.0.0-20180728063816-88497007e858 // indirect
 )
@@ -0,0 +1,104 @@
+package graphql
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"runtime/pprof"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GoroutineCount is how many goroutines are running with a set of pprof
+// labels, like worker=digest. Goroutines without labels have empty Labels.
+type GoroutineCount struct {
+	Labels string `json:"labels"`
+	Count  int    `json:"count"`
+}
+
+// GoTask runs f in a new goroutine labelled task=name, so it can be told
+// apart in Goroutines and in goroutine profiles.
+func GoTask(name string, f func()) {
+	go pprof.Do(context.Background(), pprof.Labels("task", name), func(context.Context) {
+		f()
+	})
+}
+
+// Goroutines counts the running goroutines by their pprof labels, most first.
+// Goroutines inherit the labels of the goroutine that started them, so
+// growth in a count points at what is leaking.
+func Goroutines() ([]*GoroutineCount, error) {
+	var buf bytes.Buffer
+	if err := pprof.Lookup("goroutine").WriteTo(&buf, 1); err != nil {
+		return nil, err
+	}
+
+	return parseGoroutineProfile(&buf)
+}
+
+// parseGoroutineProfile reads a goroutine profile written with debug=1, where
+// each group of identical stacks starts with its count, like "3 @ 0x1 0x2",
+// and is followed by its labels, if it has any, like
+// `# labels: {"worker":"digest"}`.
+func parseGoroutineProfile(buf *bytes.Buffer) ([]*GoroutineCount, error) {
+	counts := map[string]int{}
+	count, labels := 0, ""
+	flush := func() {
+		if count > 0 {
+			counts[labels] += count
+		}
+		count, labels = 0, ""
+	}
+
+	s := bufio.NewScanner(buf)
+	s.Buffer(make([]byte, 64*1024), 1024*1024)
+	for s.Scan() {
+		line := s.Text()
+		switch {
+		case strings.HasPrefix(line, "# labels: "):
+			labels = formatLabels(strings.TrimPrefix(line, "# labels: "))
+		case strings.Contains(line, " @ "):
+			flush()
+			n, err := strconv.Atoi(line[:strings.Index(line, " @ ")])
+			if err != nil {
+				return nil, err
+			}
+			count = n
+		}
+	}
+	flush()
+
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	ret := make([]*GoroutineCount, 0, len(counts))
+	for l, n := range counts {
+		ret = append(ret, &GoroutineCount{Labels: l, Count: n})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Count != ret[j].Count {
+			return ret[i].Count > ret[j].Count
+		}
+		return ret[i].Labels < ret[j].Labels
+	})
+
+	return ret, nil
+}
+
+// formatLabels turns pprof's {"a":"b", "c":"d"} into a=b,c=d.
+func formatLabels(s string) string {
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "{"), "}")
+	if s == "" {
+		return ""
+	}
+
+	pairs := strings.Split(s, ", ")
+	for i, p := range pairs {
+		pairs[i] = strings.Replace(strings.Replace(p, `"`, "", -1), ":", "=", 1)
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
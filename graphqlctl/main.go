@@ -0,0 +1,217 @@
+// Command graphqlctl administers a graphql server's database with the same
+// functions the server uses, so nothing needs hand-written SQL.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/icco/graphql"
+)
+
+const usage = `Usage: graphqlctl <command> [args]
+
+Commands:
+  user create-admin <id>        create a user, or make an existing one, an admin
+  token rotate <user id>        revoke a user's API tokens and print a new one
+  serviceaccount rotate <id>    add a secret to a service account and print it
+  migrate up|down [steps]|status
+  export [file]                 write a backup archive, to stdout if file is -
+  export-hugo [file]            write the site as a Hugo site, with its images`
+
+func main() {
+	log.SetFlags(0)
+	if len(os.Args) < 2 {
+		log.Fatal(usage)
+	}
+	cmd, args := os.Args[1], os.Args[2:]
+
+	dbURL := os.Getenv("DATABASE_URL")
+	if dbURL == "" {
+		log.Fatal("DATABASE_URL is empty!")
+	}
+	graphql.InitDB(dbURL)
+
+	// Only migrate may run against a database that isn't up to date.
+	if cmd != "migrate" {
+		if err := graphql.CheckMigrations(); err != nil {
+			log.Fatalf("%v. Run graphqlctl migrate up.", err)
+		}
+	}
+
+	ctx := context.Background()
+	switch cmd {
+	case "user":
+		user(ctx, args)
+	case "token":
+		token(ctx, args)
+	case "serviceaccount":
+		serviceAccount(ctx, args)
+	case "migrate":
+		migrate(ctx, args)
+	case "export":
+		export(ctx, args)
+	case "export-hugo":
+		exportHugo(ctx, args)
+	default:
+		log.Fatalf("Unknown command %q\n\n%s", cmd, usage)
+	}
+}
+
+func user(ctx context.Context, args []string) {
+	if len(args) != 2 || args[0] != "create-admin" {
+		log.Fatal("Usage: graphqlctl user create-admin <id>")
+	}
+
+	u, err := graphql.ProvisionUser(ctx, args[1], graphql.RoleAdmin)
+	if err != nil {
+		log.Fatalf("Failed to create admin: %v", err)
+	}
+
+	log.Printf("%s is an admin", u.ID)
+}
+
+func token(ctx context.Context, args []string) {
+	if len(args) != 2 || args[0] != "rotate" {
+		log.Fatal("Usage: graphqlctl token rotate <user id>")
+	}
+
+	u, err := graphql.FindUser(ctx, args[1])
+	if err != nil {
+		log.Fatalf("Failed to find user %s: %v", args[1], err)
+	}
+
+	t, err := u.RotateTokens(ctx)
+	if err != nil {
+		log.Fatalf("Failed to rotate tokens: %v", err)
+	}
+
+	fmt.Println(t.Token)
+}
+
+func serviceAccount(ctx context.Context, args []string) {
+	if len(args) != 2 || args[0] != "rotate" {
+		log.Fatal("Usage: graphqlctl serviceaccount rotate <id>")
+	}
+
+	s, err := graphql.GetServiceAccount(ctx, args[1])
+	if err != nil {
+		log.Fatalf("Failed to find service account %s: %v", args[1], err)
+	}
+
+	secret, err := s.RotateSecret(ctx)
+	if err != nil {
+		log.Fatalf("Failed to rotate secret: %v", err)
+	}
+
+	fmt.Println(secret)
+}
+
+func migrate(ctx context.Context, args []string) {
+	if len(args) == 0 {
+		log.Fatal("Usage: graphqlctl migrate up|down [steps]|status")
+	}
+
+	switch args[0] {
+	case "up":
+		if err := graphql.MigrateUp(); err != nil {
+			log.Fatalf("Failed to migrate up: %v", err)
+		}
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			var err error
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps <= 0 {
+				log.Fatalf("Steps must be a positive number, not %q", args[1])
+			}
+		}
+
+		if err := graphql.MigrateDown(ctx, steps); err != nil {
+			log.Fatalf("Failed to migrate down: %v", err)
+		}
+	case "status":
+		infos, err := graphql.Migrations()
+		if err != nil {
+			log.Fatalf("Failed to get migration status: %v", err)
+		}
+
+		tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "VERSION\tDESCRIPTION\tAPPLIED")
+		for _, info := range infos {
+			applied := "pending"
+			if info.Applied != nil {
+				applied = info.Applied.Format(time.RFC3339)
+			}
+			fmt.Fprintf(tw, "%d\t%s\t%s\n", info.Version, info.Description, applied)
+		}
+		tw.Flush()
+		return
+	default:
+		log.Fatalf("Unknown migrate command %q, expected up, down or status", args[0])
+	}
+
+	log.Printf("Migrated the database")
+}
+
+func export(ctx context.Context, args []string) {
+	name := graphql.ExportFilename(time.Now())
+	if len(args) > 0 {
+		name = args[0]
+	}
+
+	if name == "-" {
+		if err := graphql.Export(ctx, os.Stdout); err != nil {
+			log.Fatalf("Failed to export: %v", err)
+		}
+		return
+	}
+
+	f, err := os.Create(name)
+	if err != nil {
+		log.Fatalf("Failed to create %s: %v", name, err)
+	}
+	if err := graphql.Export(ctx, f); err != nil {
+		f.Close()
+		log.Fatalf("Failed to export: %v", err)
+	}
+	if err := f.Close(); err != nil {
+		log.Fatalf("Failed to write %s: %v", name, err)
+	}
+
+	log.Printf("Exported to %s", name)
+}
+
+func exportHugo(ctx context.Context, args []string) {
+	name := graphql.HugoExportFilename(time.Now())
+	if len(args) > 0 {
+		name = args[0]
+	}
+	opts := graphql.HugoOptions{Media: true}
+
+	if name == "-" {
+		if err := graphql.ExportHugo(ctx, os.Stdout, opts); err != nil {
+			log.Fatalf("Failed to export: %v", err)
+		}
+		return
+	}
+
+	f, err := os.Create(name)
+	if err != nil {
+		log.Fatalf("Failed to create %s: %v", name, err)
+	}
+	if err := graphql.ExportHugo(ctx, f, opts); err != nil {
+		f.Close()
+		log.Fatalf("Failed to export: %v", err)
+	}
+	if err := f.Close(); err != nil {
+		log.Fatalf("Failed to write %s: %v", name, err)
+	}
+
+	log.Printf("Exported to %s", name)
+}
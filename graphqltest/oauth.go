@@ -0,0 +1,125 @@
+package graphqltest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// Profile is a Google account to log in with.
+type Profile struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+// FakeOAuth stands in for Google as the server's OAuth provider. It logs
+// everyone in as the profile last passed to LoginAs without asking, and
+// serves that profile from the Google+ people API.
+type FakeOAuth struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	profile Profile
+	codes   map[string]Profile
+	tokens  map[string]Profile
+}
+
+// NewFakeOAuth starts a fake OAuth provider. Its authorization and token
+// endpoints are /auth and /token, and the Google+ API is at /plus/v1/.
+func NewFakeOAuth() *FakeOAuth {
+	f := &FakeOAuth{
+		codes:  map[string]Profile{},
+		tokens: map[string]Profile{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/auth", f.authHandler)
+	mux.HandleFunc("/token", f.tokenHandler)
+	mux.HandleFunc("/plus/v1/people/me", f.profileHandler)
+	f.Server = httptest.NewServer(mux)
+
+	return f
+}
+
+// LoginAs makes the next logins log in as p.
+func (f *FakeOAuth) LoginAs(p Profile) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.profile = p
+}
+
+// authHandler sends the browser straight back to the server with a code
+// for the current profile, like Google does once someone has agreed.
+func (f *FakeOAuth) authHandler(w http.ResponseWriter, r *http.Request) {
+	redirect, err := url.Parse(r.FormValue("redirect_uri"))
+	if err != nil || redirect.Host == "" {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+
+	code := randomHex(16)
+	f.mu.Lock()
+	f.codes[code] = f.profile
+	f.mu.Unlock()
+
+	q := redirect.Query()
+	q.Set("code", code)
+	q.Set("state", r.FormValue("state"))
+	redirect.RawQuery = q.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+// tokenHandler exchanges a code for an access token. Codes only work once.
+func (f *FakeOAuth) tokenHandler(w http.ResponseWriter, r *http.Request) {
+	code := r.FormValue("code")
+
+	f.mu.Lock()
+	p, ok := f.codes[code]
+	delete(f.codes, code)
+	token := randomHex(16)
+	if ok {
+		f.tokens[token] = p
+	}
+	f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": token,
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+	})
+}
+
+// profileHandler responds with the profile an access token is for, shaped
+// like a Google+ person.
+func (f *FakeOAuth) profileHandler(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+	f.mu.Lock()
+	p, ok := f.tokens[token]
+	f.mu.Unlock()
+
+	if !ok {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"id":          p.ID,
+		"displayName": p.Name,
+		"emails": []map[string]string{
+			{"type": "account", "value": p.Email},
+		},
+	})
+}
@@ -0,0 +1,175 @@
+// Package graphqltest runs the server's handlers end to end for tests,
+// against a throwaway Postgres database and a fake OAuth provider instead of
+// Google, so everything from logging in through the session middleware to
+// the resolvers runs like in production.
+//
+//	s, err := graphqltest.StartServer(ctx, graphqltest.Fixtures{
+//		Admins: []graphqltest.Profile{{ID: "1", Email: "admin@example.com"}},
+//	}, func(s *graphqltest.Server) http.Handler {
+//		// Point the OAuth config at s.OAuth, and return the router.
+//	})
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer s.Close()
+//
+//	c, err := s.Login(ctx, graphqltest.Profile{ID: "1", Email: "admin@example.com"})
+//
+// Postgres comes from TEST_DATABASE_URL if it is set, and otherwise from a
+// container started with docker.
+package graphqltest
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// postgresImage is the image of the Postgres container, unless
+// TEST_POSTGRES_IMAGE is set.
+const postgresImage = "postgres:13"
+
+// Postgres is an empty database that only exists until it is closed.
+type Postgres struct {
+	// URL is the database's connection string.
+	URL string
+
+	server    string
+	name      string
+	container string
+}
+
+// StartPostgres creates an empty database. It is created on the server at
+// TEST_DATABASE_URL if that is set, and otherwise in a new Postgres
+// container, which is removed when the database is closed.
+func StartPostgres(ctx context.Context) (*Postgres, error) {
+	p := &Postgres{server: os.Getenv("TEST_DATABASE_URL")}
+	if p.server == "" {
+		if err := p.startContainer(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	conn, err := waitForPostgres(ctx, p.server)
+	if err != nil {
+		p.Close()
+		return nil, err
+	}
+	defer conn.Close()
+
+	p.name = "graphqltest_" + randomHex(8)
+	if _, err := conn.ExecContext(ctx, "CREATE DATABASE "+pq.QuoteIdentifier(p.name)); err != nil {
+		p.name = ""
+		p.Close()
+		return nil, fmt.Errorf("could not create database: %v", err)
+	}
+
+	u, err := url.Parse(p.server)
+	if err != nil {
+		p.Close()
+		return nil, err
+	}
+	u.Path = "/" + p.name
+	p.URL = u.String()
+
+	return p, nil
+}
+
+// Close drops the database, and removes its container if it has one.
+func (p *Postgres) Close() error {
+	if p.container != "" {
+		return exec.Command("docker", "rm", "-f", "-v", p.container).Run()
+	}
+	if p.name == "" {
+		return nil
+	}
+
+	conn, err := sql.Open("postgres", p.server)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Exec("DROP DATABASE IF EXISTS " + pq.QuoteIdentifier(p.name))
+	return err
+}
+
+func (p *Postgres) startContainer(ctx context.Context) error {
+	image := os.Getenv("TEST_POSTGRES_IMAGE")
+	if image == "" {
+		image = postgresImage
+	}
+
+	password := randomHex(16)
+	out, err := exec.CommandContext(ctx, "docker", "run", "-d", "--rm",
+		"-e", "POSTGRES_PASSWORD="+password,
+		"-p", "127.0.0.1::5432",
+		image).Output()
+	if err != nil {
+		return fmt.Errorf("could not start Postgres with docker, set TEST_DATABASE_URL to use another server: %v", commandError(err))
+	}
+	p.container = strings.TrimSpace(string(out))
+
+	out, err = exec.CommandContext(ctx, "docker", "port", p.container, "5432/tcp").Output()
+	if err != nil {
+		p.Close()
+		return fmt.Errorf("could not find Postgres's port: %v", commandError(err))
+	}
+	// docker port prints a line per address, like 127.0.0.1:49153.
+	addr := strings.TrimSpace(strings.SplitN(string(out), "\n", 2)[0])
+
+	p.server = fmt.Sprintf("postgres://postgres:%s@%s/postgres?sslmode=disable", password, addr)
+	return nil
+}
+
+// waitForPostgres connects to the server at dsn, waiting up to a minute for
+// it to start accepting connections.
+func waitForPostgres(ctx context.Context, dsn string) (*sql.DB, error) {
+	conn, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	for {
+		err := conn.PingContext(ctx)
+		if err == nil {
+			return conn, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			conn.Close()
+			return nil, fmt.Errorf("Postgres did not start: %v", err)
+		case <-time.After(250 * time.Millisecond):
+		}
+	}
+}
+
+// commandError adds what a command wrote to stderr to err.
+func commandError(err error) error {
+	if ee, ok := err.(*exec.ExitError); ok && len(ee.Stderr) > 0 {
+		return fmt.Errorf("%v: %s", err, strings.TrimSpace(string(ee.Stderr)))
+	}
+
+	return err
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+
+	return hex.EncodeToString(b)
+}
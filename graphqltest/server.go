@@ -0,0 +1,169 @@
+package graphqltest
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+
+	"github.com/icco/graphql"
+	"github.com/icco/graphql/client"
+)
+
+// Fixtures are what the database has in it when the server starts. Users
+// are created with the same functions the server uses, and their IDs are
+// their profiles' IDs, so logging in with a profile logs in as its user.
+type Fixtures struct {
+	Admins []Profile
+	Users  []Profile
+	Posts  []*graphql.Post
+
+	// SQL is run after everything else, for anything without a function.
+	SQL []string
+}
+
+// Server serves a handler from the server package, against its own database
+// and a fake OAuth provider.
+type Server struct {
+	// URL is where the server listens, like http://127.0.0.1:41235.
+	URL   string
+	DB    *Postgres
+	OAuth *FakeOAuth
+
+	conn    *sql.DB
+	http    *httptest.Server
+	handler http.Handler
+}
+
+// StartServer creates a database with fixtures in it, which the graphql
+// package is connected to, and a fake OAuth provider, and then serves the
+// handler returned by newHandler. newHandler should point the server's
+// OAuth config at s.OAuth, with s.URL + "/callback" as the callback. The
+// server has to be closed.
+func StartServer(ctx context.Context, fixtures Fixtures, newHandler func(s *Server) http.Handler) (*Server, error) {
+	s := &Server{OAuth: NewFakeOAuth()}
+
+	var err error
+	if s.DB, err = StartPostgres(ctx); err != nil {
+		s.Close()
+		return nil, err
+	}
+	if s.conn, err = fixtures.seed(ctx, s.DB.URL); err != nil {
+		s.Close()
+		return nil, fmt.Errorf("could not seed fixtures: %v", err)
+	}
+
+	// The handler needs the server's URL, which isn't known until it is
+	// listening.
+	s.http = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.handler.ServeHTTP(w, r)
+	}))
+	s.URL = s.http.URL
+	s.handler = newHandler(s)
+
+	return s, nil
+}
+
+// Close stops the server, and removes its database and fake OAuth provider.
+func (s *Server) Close() error {
+	if s.http != nil {
+		s.http.Close()
+	}
+	if s.OAuth != nil {
+		s.OAuth.Close()
+	}
+	if s.conn != nil {
+		s.conn.Close()
+	}
+	if s.DB != nil {
+		return s.DB.Close()
+	}
+
+	return nil
+}
+
+// Client returns a client for the server's GraphQL endpoint that isn't
+// logged in. It keeps cookies, like a browser.
+func (s *Server) Client() *client.Client {
+	jar, _ := cookiejar.New(nil)
+	c := client.New(s.URL + "/graphql")
+	c.HTTPClient = &http.Client{Jar: jar}
+	return c
+}
+
+// Login logs in through /login and the fake OAuth provider as p, like a
+// browser, and returns a client with the session cookie. Its HTTPClient can
+// also be used for pages other than /graphql.
+func (s *Server) Login(ctx context.Context, p Profile) (*client.Client, error) {
+	c := s.Client()
+	s.OAuth.LoginAs(p)
+
+	req, err := http.NewRequest(http.MethodGet, s.URL+"/login?redirect=/healthz", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.HTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || resp.Request.URL.Path != "/healthz" {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return nil, fmt.Errorf("login as %s ended at %s with %s: %s", p.ID, resp.Request.URL, resp.Status, bytes.TrimSpace(body))
+	}
+
+	return c, nil
+}
+
+// seed connects the graphql package to the database at dsn, migrates it and
+// adds the fixtures to it. The connection is left open for the server.
+func (f Fixtures) seed(ctx context.Context, dsn string) (*sql.DB, error) {
+	conn := graphql.InitDB(dsn)
+
+	if err := graphql.MigrateUp(); err != nil {
+		return conn, err
+	}
+
+	for _, users := range []struct {
+		role     graphql.Role
+		profiles []Profile
+	}{
+		{graphql.RoleAdmin, f.Admins},
+		{graphql.RoleNormal, f.Users},
+	} {
+		for _, p := range users.profiles {
+			if _, err := graphql.ProvisionUser(ctx, p.ID, users.role); err != nil {
+				return conn, err
+			}
+			if p.Email == "" {
+				continue
+			}
+			if err := graphql.RememberEmail(ctx, p.ID, p.Email); err != nil {
+				return conn, err
+			}
+		}
+	}
+
+	for _, p := range f.Posts {
+		if err := p.Save(ctx); err != nil {
+			return conn, err
+		}
+	}
+
+	return conn, execSQL(ctx, conn, f.SQL)
+}
+
+func execSQL(ctx context.Context, conn *sql.DB, statements []string) error {
+	for _, stmt := range statements {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("%q failed: %v", stmt, err)
+		}
+	}
+
+	return nil
+}
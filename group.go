@@ -0,0 +1,210 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Group is a set of users, like a household, who can share logs with each
+// other. Only its owner can invite members.
+type Group struct {
+	ID       string    `json:"id"`
+	Name     string    `json:"name"`
+	OwnerID  string    `json:"owner_id"`
+	Created  time.Time `json:"created"`
+	Modified time.Time `json:"modified"`
+}
+
+const groupColumns = "id, name, owner_id, created_at, modified_at"
+
+func scanGroup(row interface {
+	Scan(dest ...interface{}) error
+}) (*Group, error) {
+	g := new(Group)
+	if err := row.Scan(&g.ID, &g.Name, &g.OwnerID, &g.Created, &g.Modified); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// CreateGroup creates a group owned by the user, with them as its first
+// member.
+func CreateGroup(ctx context.Context, u *User, name string) (*Group, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, Validation("name", "Name is required")
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	row := tx.QueryRowContext(ctx, "INSERT INTO groups (name, owner_id, created_at, modified_at) VALUES ($1, $2, $3, $3) RETURNING "+groupColumns, name, u.ID, now)
+	g, err := scanGroup(row)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, "INSERT INTO group_members (group_id, user_id, created_at) VALUES ($1, $2, $3)", g.ID, u.ID, now); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// GetGroup returns a group the user is a member of.
+func GetGroup(ctx context.Context, u *User, id string) (*Group, error) {
+	row := db.QueryRowContext(ctx, "SELECT "+groupColumns+" FROM groups WHERE id = $1 AND id IN (SELECT group_id FROM group_members WHERE user_id = $2)", id, u.ID)
+	g, err := scanGroup(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, NotFound("No group with id %s", id)
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return g, nil
+	}
+}
+
+// UserGroups returns the groups the user is a member of, ordered by name.
+func UserGroups(ctx context.Context, u *User) ([]*Group, error) {
+	rows, err := db.QueryContext(ctx, "SELECT "+groupColumns+" FROM groups WHERE id IN (SELECT group_id FROM group_members WHERE user_id = $1) ORDER BY name", u.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	groups := make([]*Group, 0)
+	for rows.Next() {
+		g, err := scanGroup(rows)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// checkGroupMember returns an error unless the user is a member of the group.
+func checkGroupMember(ctx context.Context, groupID string, u *User) error {
+	_, err := GetGroup(ctx, u, groupID)
+	return err
+}
+
+// MemberIDs returns the IDs of the group's members, in the order they joined.
+func (g *Group) MemberIDs(ctx context.Context) ([]string, error) {
+	rows, err := db.QueryContext(ctx, "SELECT user_id FROM group_members WHERE group_id = $1 ORDER BY created_at", g.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// NewInvite creates a single use code that adds whoever redeems it with
+// JoinGroup to the group. Only the owner can invite.
+func (g *Group) NewInvite(ctx context.Context, u *User) (string, error) {
+	if g.OwnerID != u.ID {
+		return "", ErrForbidden
+	}
+
+	code, err := randomHex(8)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := db.ExecContext(ctx, "INSERT INTO group_invites (code, group_id, created_by, created_at) VALUES ($1, $2, $3, $4)", code, g.ID, u.ID, time.Now()); err != nil {
+		return "", err
+	}
+
+	return code, nil
+}
+
+// JoinGroup redeems a group invite code, adding the user to its group.
+func JoinGroup(ctx context.Context, u *User, code string) (*Group, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	var groupID string
+	err = tx.QueryRowContext(ctx, "UPDATE group_invites SET used_by = $2, used_at = $3 WHERE code = $1 AND used_by IS NULL RETURNING group_id", code, u.ID, now).Scan(&groupID)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, fmt.Errorf("Invalid invite code")
+	case err != nil:
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, "INSERT INTO group_members (group_id, user_id, created_at) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING", groupID, u.ID, now); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return GetGroup(ctx, u, groupID)
+}
+
+// Leave removes the user from the group, and stops sharing their logs with
+// it. The owner can't leave, but can delete the group.
+func (g *Group) Leave(ctx context.Context, u *User) error {
+	if g.OwnerID == u.ID {
+		return fmt.Errorf("The owner can't leave a group, delete it instead")
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM group_members WHERE group_id = $1 AND user_id = $2", g.ID, u.ID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE logs SET group_id = NULL, modified_at = $3 WHERE group_id = $1 AND user_id = $2", g.ID, u.ID, time.Now()); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Delete deletes the group. Logs shared with it become private again. Only
+// the owner can delete a group.
+func (g *Group) Delete(ctx context.Context, u *User) error {
+	if g.OwnerID != u.ID {
+		return ErrForbidden
+	}
+
+	_, err := db.ExecContext(ctx, "DELETE FROM groups WHERE id = $1", g.ID)
+	return err
+}
@@ -0,0 +1,369 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxHighlightLength is the most characters a highlight can quote.
+	MaxHighlightLength = 5000
+
+	// MaxHighlightNoteLength is the most characters a highlight's note can
+	// have.
+	MaxHighlightNoteLength = 5000
+
+	// highlightContext is how many characters either side of a highlight
+	// are kept, to find it again after the post is edited.
+	highlightContext = 32
+)
+
+// Highlight is a range of a post's content that a reader marked, with an
+// optional note. It is anchored by its quote, the text either side of it,
+// and where it was, so it can be found again after the post is edited. Start
+// and End are where it is in the post's current content, in characters, or
+// nil if the quote isn't there anymore.
+type Highlight struct {
+	ID       string    `json:"id"`
+	UserID   string    `json:"user_id"`
+	PostID   string    `json:"post_id"`
+	Quote    string    `json:"quote"`
+	Prefix   string    `json:"prefix"`
+	Suffix   string    `json:"suffix"`
+	Offset   int       `json:"offset"`
+	Start    *int      `json:"start"`
+	End      *int      `json:"end"`
+	Note     *string   `json:"note"`
+	Public   bool      `json:"public"`
+	Created  time.Time `json:"created"`
+	Modified time.Time `json:"modified"`
+}
+
+const highlightColumns = "id, user_id, post_id, quote, prefix, suffix, start_offset, note, public, created_at, modified_at"
+
+func scanHighlight(row interface {
+	Scan(dest ...interface{}) error
+}) (*Highlight, error) {
+	h := new(Highlight)
+	if err := row.Scan(&h.ID, &h.UserID, &h.PostID, &h.Quote, &h.Prefix, &h.Suffix, &h.Offset, &h.Note, &h.Public, &h.Created, &h.Modified); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// readablePost returns a post that the reader can read without an unlock
+// token. Highlights quote posts, so drafts and protected posts can't have
+// them.
+func readablePost(ctx context.Context, id string) (*Post, error) {
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, Validation("postId", "%q is not a post id", id)
+	}
+
+	return getReadablePost(ctx, n)
+}
+
+func validHighlightNote(note *string) error {
+	if note != nil && utf8.RuneCountInString(*note) > MaxHighlightNoteLength {
+		return Validation("note", "Notes can be at most %d characters", MaxHighlightNoteLength)
+	}
+
+	return nil
+}
+
+// CreateHighlight highlights the characters of a post's content from start
+// up to end, for u.
+func CreateHighlight(ctx context.Context, u *User, input NewHighlight) (*Highlight, error) {
+	p, err := readablePost(ctx, input.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	text := []rune(p.Content)
+	start, end := input.Start, input.End
+	if start < 0 || end > len(text) || start >= end {
+		return nil, Validation("start", "start and end must be a range of the post's content")
+	}
+	if end-start > MaxHighlightLength {
+		return nil, Validation("end", "Highlights can be at most %d characters", MaxHighlightLength)
+	}
+
+	note := input.Note
+	if note != nil && *note == "" {
+		note = nil
+	}
+	if err := validHighlightNote(note); err != nil {
+		return nil, err
+	}
+
+	h := &Highlight{
+		UserID: u.ID,
+		PostID: p.ID,
+		Quote:  string(text[start:end]),
+		Prefix: string(text[maxInt(0, start-highlightContext):start]),
+		Suffix: string(text[end:minInt(len(text), end+highlightContext)]),
+		Offset: start,
+		Start:  &start,
+		End:    &end,
+		Note:   note,
+		Public: input.Public != nil && *input.Public,
+	}
+	h.Created = time.Now()
+	h.Modified = h.Created
+
+	err = db.QueryRowContext(ctx, `
+INSERT INTO highlights (user_id, post_id, quote, prefix, suffix, start_offset, note, public, created_at, modified_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id
+`, h.UserID, p.ID, h.Quote, h.Prefix, h.Suffix, h.Offset, h.Note, h.Public, h.Created, h.Modified).Scan(&h.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// getOwnHighlight returns one of u's highlights.
+func getOwnHighlight(ctx context.Context, u *User, id string) (*Highlight, error) {
+	row := db.QueryRowContext(ctx, "SELECT "+highlightColumns+" FROM highlights WHERE id = $1 AND user_id = $2", id, u.ID)
+	h, err := scanHighlight(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, NotFound("No highlight with id %s", id)
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return h, nil
+	}
+}
+
+// UpdateHighlight changes the note or visibility of one of u's highlights. An
+// empty note removes it.
+func UpdateHighlight(ctx context.Context, u *User, id string, note *string, public *bool) (*Highlight, error) {
+	h, err := getOwnHighlight(ctx, u, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if note != nil {
+		h.Note = note
+		if *note == "" {
+			h.Note = nil
+		}
+	}
+	if public != nil {
+		h.Public = *public
+	}
+	if err := validHighlightNote(h.Note); err != nil {
+		return nil, err
+	}
+
+	h.Modified = time.Now()
+	if _, err := db.ExecContext(ctx, "UPDATE highlights SET note = $2, public = $3, modified_at = $4 WHERE id = $1", h.ID, h.Note, h.Public, h.Modified); err != nil {
+		return nil, err
+	}
+
+	if err := anchorHighlights(ctx, []*Highlight{h}); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// DeleteHighlight deletes one of u's highlights.
+func DeleteHighlight(ctx context.Context, u *User, id string) error {
+	if _, err := getOwnHighlight(ctx, u, id); err != nil {
+		return err
+	}
+
+	_, err := db.ExecContext(ctx, "DELETE FROM highlights WHERE id = $1", id)
+	return err
+}
+
+// PostHighlights returns u's highlights on a post, in the order they are in
+// the post, and everyone else's public ones if includePublic is true.
+func PostHighlights(ctx context.Context, u *User, postID string, includePublic bool) ([]*Highlight, error) {
+	p, err := readablePost(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	highlights, err := queryHighlights(ctx, "SELECT "+highlightColumns+" FROM highlights WHERE post_id = $1 AND (user_id = $2 OR ($3 AND public)) ORDER BY start_offset, id", p.ID, u.ID, includePublic)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, h := range highlights {
+		h.anchor(p.Content)
+	}
+	return highlights, nil
+}
+
+// UserHighlights returns all of u's highlights, by post, in the order they
+// are in each post.
+func UserHighlights(ctx context.Context, u *User) ([]*Highlight, error) {
+	highlights, err := queryHighlights(ctx, "SELECT "+highlightColumns+" FROM highlights WHERE user_id = $1 ORDER BY post_id, start_offset, id", u.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := anchorHighlights(ctx, highlights); err != nil {
+		return nil, err
+	}
+	return highlights, nil
+}
+
+// ExportHighlights returns all of u's highlights, with their notes, as
+// Markdown, with a section for each post.
+func ExportHighlights(ctx context.Context, u *User) (string, error) {
+	highlights, err := UserHighlights(ctx, u)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	postID := ""
+	for _, h := range highlights {
+		if h.PostID != postID {
+			postID = h.PostID
+			id, err := strconv.ParseInt(postID, 10, 64)
+			if err != nil {
+				return "", err
+			}
+
+			p, err := GetPost(ctx, id)
+			if err != nil {
+				return "", err
+			}
+
+			link, err := p.Permalink(ctx)
+			if err != nil {
+				return "", err
+			}
+			fmt.Fprintf(&b, "# [%s](%s)\n\n", p.Title, link)
+		}
+
+		fmt.Fprintf(&b, "> %s\n\n", strings.Replace(h.Quote, "\n", "\n> ", -1))
+		if h.Note != nil {
+			fmt.Fprintf(&b, "%s\n\n", *h.Note)
+		}
+	}
+
+	return b.String(), nil
+}
+
+func queryHighlights(ctx context.Context, query string, args ...interface{}) ([]*Highlight, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	highlights := make([]*Highlight, 0)
+	for rows.Next() {
+		h, err := scanHighlight(rows)
+		if err != nil {
+			return nil, err
+		}
+		highlights = append(highlights, h)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return highlights, nil
+}
+
+// anchorHighlights finds highlights in their posts' current content.
+func anchorHighlights(ctx context.Context, highlights []*Highlight) error {
+	posts := map[string]*Post{}
+	for _, h := range highlights {
+		p, ok := posts[h.PostID]
+		if !ok {
+			id, err := strconv.ParseInt(h.PostID, 10, 64)
+			if err != nil {
+				return err
+			}
+
+			p, err = GetPost(ctx, id)
+			if err != nil {
+				return err
+			}
+			posts[h.PostID] = p
+		}
+
+		h.anchor(p.Content)
+	}
+
+	return nil
+}
+
+// anchor sets Start and End to where the quote is in content. If it is
+// there more than once, the match with the most matching text either side
+// wins, then the one closest to where the highlight was made.
+func (h *Highlight) anchor(content string) {
+	text, quote := []rune(content), []rune(h.Quote)
+	prefix, suffix := []rune(h.Prefix), []rune(h.Suffix)
+
+	best, bestScore, bestDistance := -1, -1, 0
+	for i := 0; i+len(quote) <= len(text); i++ {
+		if commonPrefixLen(text[i:], quote) != len(quote) {
+			continue
+		}
+
+		score := commonSuffixLen(text[:i], prefix) + commonPrefixLen(text[i+len(quote):], suffix)
+		distance := i - h.Offset
+		if distance < 0 {
+			distance = -distance
+		}
+		if score > bestScore || (score == bestScore && distance < bestDistance) {
+			best, bestScore, bestDistance = i, score, distance
+		}
+	}
+
+	if best < 0 {
+		h.Start, h.End = nil, nil
+		return
+	}
+
+	start, end := best, best+len(quote)
+	h.Start, h.End = &start, &end
+}
+
+// commonPrefixLen returns how many runes a and b start with in common.
+func commonPrefixLen(a, b []rune) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+// commonSuffixLen returns how many runes a and b end with in common.
+func commonSuffixLen(a, b []rune) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[len(a)-1-n] == b[len(b)-1-n] {
+		n++
+	}
+	return n
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,426 @@
+package graphql
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// maxHugoMediaSize is the largest image downloaded into a Hugo export.
+const maxHugoMediaSize = 32 << 20
+
+var hugoMediaClient = &http.Client{Timeout: 30 * time.Second}
+
+// HugoOptions configure a Hugo export.
+type HugoOptions struct {
+	// Media downloads the images posts and the theme use into the site's
+	// static directory, and points them there. Images that can't be
+	// downloaded are left where they are.
+	Media bool
+}
+
+// hugoFrontMatter is the front matter of an exported post or page. Keys
+// Hugo doesn't know are in each page's .Params.
+type hugoFrontMatter struct {
+	Title               string            `yaml:"title"`
+	Date                string            `yaml:"date"`
+	Lastmod             string            `yaml:"lastmod"`
+	Draft               bool              `yaml:"draft,omitempty"`
+	Tags                []string          `yaml:"tags,omitempty"`
+	Aliases             []string          `yaml:"aliases,omitempty"`
+	CanonicalURL        string            `yaml:"canonicalURL,omitempty"`
+	OriginallyPublished string            `yaml:"originallyPublished,omitempty"`
+	License             *hugoLicense      `yaml:"license,omitempty"`
+	Metadata            map[string]string `yaml:"metadata,omitempty"`
+}
+
+type hugoLicense struct {
+	Name string `yaml:"name"`
+	SPDX string `yaml:"spdx,omitempty"`
+	URL  string `yaml:"url,omitempty"`
+	Text string `yaml:"text,omitempty"`
+}
+
+// hugoMenuItem is an entry in the config's main menu.
+type hugoMenuItem struct {
+	Name   string `yaml:"name"`
+	URL    string `yaml:"url"`
+	Weight int    `yaml:"weight"`
+}
+
+// HugoExportFilename is the name to save a Hugo export made at t as.
+func HugoExportFilename(t time.Time) string {
+	return fmt.Sprintf("graphql-hugo-%s.tar.gz", t.UTC().Format("20060102-150405"))
+}
+
+// ExportHugo writes the site the request is for to w as a tar.gz of a Hugo
+// site, so it can be built as static files if this server is ever gone.
+// Posts keep their /post/{id} URLs and pages their /{slug} ones, redirects
+// to them become aliases, and every redirect is in static/_redirects, in the
+// format Netlify and Cloudflare Pages read. Drafts and protected posts are
+// exported as drafts, which Hugo only builds with --buildDrafts. The site
+// has minimal layouts, so it builds without a theme.
+func ExportHugo(ctx context.Context, w io.Writer, opts HugoOptions) error {
+	site, err := GetSiteSettings(ctx)
+	if err != nil {
+		return err
+	}
+	theme, err := GetTheme(ctx)
+	if err != nil {
+		return err
+	}
+	posts, err := hugoPosts(ctx)
+	if err != nil {
+		return err
+	}
+	pages, err := Pages(ctx, true)
+	if err != nil {
+		return err
+	}
+	redirects, err := Redirects(ctx)
+	if err != nil {
+		return err
+	}
+	metadata, err := allPostMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+
+	add := func(name string, data []byte) error {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(data)), ModTime: now}); err != nil {
+			return err
+		}
+		_, err := tw.Write(data)
+		return err
+	}
+
+	media := map[string]string{}
+	localMedia := func(u string) string {
+		if !opts.Media {
+			return u
+		}
+		if local, ok := media[u]; ok {
+			return local
+		}
+
+		media[u] = u
+		data, name, err := downloadHugoMedia(ctx, u)
+		if err != nil {
+			log.Printf("hugo export: could not download %s: %v", u, err)
+			return u
+		}
+		if err := add(path.Join("static", name), data); err != nil {
+			log.Printf("hugo export: could not add %s: %v", u, err)
+			return u
+		}
+		media[u] = "/" + name
+		return media[u]
+	}
+	localContent := func(content string) string {
+		for _, m := range ImageRegex.FindAllStringSubmatch(content, -1) {
+			u := m[1]
+			if u == "" {
+				u = m[2]
+			}
+			if local := localMedia(u); local != u {
+				content = strings.Replace(content, u, local, -1)
+			}
+		}
+		return content
+	}
+
+	aliases := map[string][]string{}
+	var redirectsFile strings.Builder
+	base := strings.TrimRight(site.URL, "/")
+	for _, r := range redirects {
+		to := r.To
+		if base != "" {
+			to = strings.TrimPrefix(to, base)
+		}
+		if to == "" {
+			to = "/"
+		}
+		if r.Status == 301 || r.Status == 308 {
+			aliases[NormalizeRedirectPath(to)] = append(aliases[NormalizeRedirectPath(to)], r.From)
+		}
+		fmt.Fprintf(&redirectsFile, "%s %s %d\n", r.From, r.To, r.Status)
+	}
+
+	config, err := hugoConfig(site, theme, localMedia)
+	if err != nil {
+		return err
+	}
+	if err := add("config.yaml", config); err != nil {
+		return err
+	}
+	if err := add("static/_redirects", []byte(redirectsFile.String())); err != nil {
+		return err
+	}
+
+	for name, layout := range hugoLayouts {
+		if err := add(path.Join("layouts", name), []byte(layout)); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range posts {
+		tags, err := ParseTags(p.Content)
+		if err != nil {
+			return err
+		}
+
+		fm := hugoFrontMatter{
+			Title:    p.Title,
+			Date:     p.Datetime.Format(time.RFC3339),
+			Lastmod:  p.Modified.Format(time.RFC3339),
+			Draft:    p.Draft || p.Protected,
+			Tags:     tags,
+			Aliases:  aliases["/post/"+p.ID],
+			License:  newHugoLicense(p.licenseOn(site)),
+			Metadata: map[string]string{},
+		}
+		if p.CanonicalURL != nil {
+			fm.CanonicalURL = *p.CanonicalURL
+		}
+		if p.OriginallyPublishedAt != nil {
+			fm.OriginallyPublished = p.OriginallyPublishedAt.Format(time.RFC3339)
+		}
+		for _, e := range metadata[p.ID] {
+			fm.Metadata[e.Key] = e.Value
+		}
+
+		data, err := hugoMarkdown(fm, localContent(p.Content))
+		if err != nil {
+			return err
+		}
+		if err := add(fmt.Sprintf("content/posts/%s.md", p.ID), data); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range pages {
+		data, err := hugoMarkdown(hugoFrontMatter{
+			Title:   p.Title,
+			Date:    p.Created.Format(time.RFC3339),
+			Lastmod: p.Modified.Format(time.RFC3339),
+			Draft:   p.Draft,
+			Aliases: aliases["/"+p.Slug],
+		}, localContent(p.Content))
+		if err != nil {
+			return err
+		}
+		if err := add(fmt.Sprintf("content/%s.md", p.Slug), data); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// hugoPosts returns every post of the site the request is for, including
+// drafts and protected posts, oldest first.
+func hugoPosts(ctx context.Context) ([]*Post, error) {
+	rows, err := db.QueryContext(ctx, "SELECT id, title, content, date, created_at, modified_at, tags, draft, password_hash IS NOT NULL, canonical_url, originally_published_at, license, license_text, version FROM posts WHERE site_id = $1 ORDER BY id", siteID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	posts := make([]*Post, 0)
+	for rows.Next() {
+		post := new(Post)
+		err := rows.Scan(&post.ID, &post.Title, &post.Content, &post.Datetime, &post.Created, &post.Modified, pq.Array(&post.Tags), &post.Draft, &post.Protected, &post.CanonicalURL, &post.OriginallyPublishedAt, &post.LicenseID, &post.LicenseText, &post.Version)
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, post)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// hugoConfig returns the Hugo config of a site with settings site and
+// theme. Theme images are passed through localMedia.
+func hugoConfig(site *SiteSettings, theme *Theme, localMedia func(string) string) ([]byte, error) {
+	menu := []hugoMenuItem{}
+	for i, n := range theme.NavItems {
+		menu = append(menu, hugoMenuItem{Name: n.Label, URL: n.URL, Weight: i + 1})
+	}
+
+	params := map[string]interface{}{
+		"description": site.Description,
+		"footerText":  site.FooterText,
+		"socialLinks": site.SocialLinks,
+		"accentColor": theme.AccentColor,
+	}
+	if theme.LightImage != "" {
+		params["lightImage"] = localMedia(theme.LightImage)
+	}
+	if theme.DarkImage != "" {
+		params["darkImage"] = localMedia(theme.DarkImage)
+	}
+	if l := newHugoLicense(site.License); l != nil {
+		params["license"] = l
+	}
+
+	baseURL := strings.TrimRight(site.URL, "/") + "/"
+	return yaml.Marshal(map[string]interface{}{
+		"baseURL":     baseURL,
+		"title":       site.Title,
+		"paginate":    site.PostsPerPage,
+		"enableEmoji": true,
+		"permalinks":  map[string]string{"posts": "/post/:filename/"},
+		"taxonomies":  map[string]string{"tag": "tags"},
+		"markup":      map[string]interface{}{"goldmark": map[string]interface{}{"renderer": map[string]bool{"unsafe": true}}},
+		"menu":        map[string]interface{}{"main": menu},
+		"params":      params,
+	})
+}
+
+// newHugoLicense returns l for front matter, or nil if there is no license.
+func newHugoLicense(l *License) *hugoLicense {
+	if l == nil {
+		return nil
+	}
+
+	h := &hugoLicense{Name: l.Name()}
+	if s := l.SPDX(); s != nil {
+		h.SPDX = *s
+	}
+	if u := l.URL(); u != nil {
+		h.URL = *u
+	}
+	if l.Text != nil {
+		h.Text = *l.Text
+	}
+	return h
+}
+
+// hugoMarkdown returns content with fm as YAML front matter.
+func hugoMarkdown(fm hugoFrontMatter, content string) ([]byte, error) {
+	if len(fm.Metadata) == 0 {
+		fm.Metadata = nil
+	}
+
+	data, err := yaml.Marshal(fm)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte("---\n" + string(data) + "---\n\n" + strings.TrimSpace(content) + "\n"), nil
+}
+
+// downloadHugoMedia downloads the image at u, and returns it and its path in
+// the static directory, named by a hash of u so every URL gets its own file.
+func downloadHugoMedia(ctx context.Context, u string) ([]byte, string, error) {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return nil, "", err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, "", fmt.Errorf("not an http or https URL")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := hugoMediaClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("got %s", resp.Status)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxHugoMediaSize+1))
+	if err != nil {
+		return nil, "", err
+	}
+	if len(data) > maxHugoMediaSize {
+		return nil, "", fmt.Errorf("larger than %d bytes", maxHugoMediaSize)
+	}
+
+	sum := sha256.Sum256([]byte(u))
+	return data, "media/" + hex.EncodeToString(sum[:8]) + strings.ToLower(path.Ext(parsed.Path)), nil
+}
+
+// hugoLayouts are just enough templates for an exported site to build
+// without a theme. A theme's layouts override them.
+var hugoLayouts = map[string]string{
+	"_default/baseof.html": `<!DOCTYPE html>
+<html lang="{{ .Site.LanguageCode | default "en" }}">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{ if not .IsHome }}{{ .Title }} | {{ end }}{{ .Site.Title }}</title>
+{{ with .Params.canonicalURL }}<link rel="canonical" href="{{ . }}">{{ end }}
+<style>
+body { max-width: 40rem; margin: 0 auto; padding: 1rem; font-family: sans-serif; line-height: 1.5; }
+a { color: {{ .Site.Params.accentColor | safeCSS }}; }
+img { max-width: 100%; }
+nav a { margin-right: 1rem; }
+</style>
+</head>
+<body>
+<header>
+<h1><a href="{{ "/" | relURL }}">{{ .Site.Title }}</a></h1>
+<nav>{{ range .Site.Menus.main }}<a href="{{ .URL }}">{{ .Name }}</a>{{ end }}</nav>
+</header>
+<main>{{ block "main" . }}{{ end }}</main>
+<footer>
+{{ with .Site.Params.footerText }}<p>{{ . }}</p>{{ end }}
+{{ range .Site.Params.socialLinks }}<a href="{{ .url }}">{{ .name }}</a> {{ end }}
+</footer>
+</body>
+</html>
+`,
+	"_default/single.html": `{{ define "main" }}
+<article>
+<h1>{{ .Title }}</h1>
+{{ if eq .Section "posts" }}<time datetime="{{ .Date.Format "2006-01-02T15:04:05Z07:00" }}">{{ .Date.Format "January 2, 2006" }}</time>{{ end }}
+{{ .Content }}
+{{ with .Params.tags }}<p>{{ range . }}<a href="{{ "tags/" | relURL }}{{ . | urlize }}/">#{{ . }}</a> {{ end }}</p>{{ end }}
+{{ with .Params.license }}<p>{{ if .url }}<a rel="license" href="{{ .url }}">{{ .name }}</a>{{ else }}{{ .text | default .name }}{{ end }}</p>{{ end }}
+</article>
+{{ end }}
+`,
+	"_default/list.html": `{{ define "main" }}
+{{ if not .IsHome }}<h1>{{ .Title }}</h1>{{ end }}
+{{ $pages := .Pages }}
+{{ if .IsHome }}{{ $pages = where .Site.RegularPages "Section" "posts" }}{{ end }}
+{{ range (.Paginate $pages).Pages }}
+<article>
+<h2><a href="{{ .RelPermalink }}">{{ .Title | default (.Date.Format "January 2, 2006") }}</a></h2>
+{{ .Summary }}
+</article>
+{{ end }}
+{{ template "_internal/pagination.html" . }}
+{{ end }}
+`,
+}
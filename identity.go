@@ -0,0 +1,142 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// IdentityProviderGoogle is the provider of Google logins. Users who first
+// logged in with Google have their Google ID as their user ID.
+const IdentityProviderGoogle = "google"
+
+// ErrIdentityLinked is returned when linking an identity the user already
+// has.
+var ErrIdentityLinked = fmt.Errorf("Identity is already linked")
+
+// Identity is an account with a login provider that is linked to a user.
+type Identity struct {
+	Provider       string    `json:"provider"`
+	ProviderUserID string    `json:"provider_user_id"`
+	UserID         string    `json:"user_id"`
+	Created        time.Time `json:"created"`
+}
+
+// identityUserID returns the user ID for an identity that hasn't been
+// linked. Google identities keep their ID, so existing users are unchanged.
+// Other providers' are prefixed, like github:1234.
+func identityUserID(provider, providerUserID string) string {
+	if provider == IdentityProviderGoogle {
+		return providerUserID
+	}
+
+	return provider + ":" + providerUserID
+}
+
+// splitUserID is the inverse of identityUserID.
+func splitUserID(id string) (string, string) {
+	if i := strings.Index(id, ":"); i > 0 {
+		return id[:i], id[i+1:]
+	}
+
+	return IdentityProviderGoogle, id
+}
+
+// ResolveIdentity returns the ID of the user an identity logs in as.
+func ResolveIdentity(ctx context.Context, provider, providerUserID string) (string, error) {
+	var userID string
+	err := db.QueryRowContext(ctx, "SELECT user_id FROM identities WHERE provider = $1 AND provider_user_id = $2", provider, providerUserID).Scan(&userID)
+	switch {
+	case err == sql.ErrNoRows:
+		return identityUserID(provider, providerUserID), nil
+	case err != nil:
+		return "", Internalf("Error running get query: %+v", err)
+	default:
+		return userID, nil
+	}
+}
+
+// resolveUserID returns the user that id, a user ID, has been linked to, or
+// id if it hasn't.
+func resolveUserID(ctx context.Context, id string) (string, error) {
+	provider, providerUserID := splitUserID(id)
+	return ResolveIdentity(ctx, provider, providerUserID)
+}
+
+// LinkIdentity links an identity to u, so logging in with it logs in as u.
+// If the identity already has a user, that user is merged into u in a
+// background job, which is returned. Otherwise the returned job is nil.
+func LinkIdentity(ctx context.Context, u *User, provider, providerUserID string) (*Job, error) {
+	owner, err := ResolveIdentity(ctx, provider, providerUserID)
+	if err != nil {
+		return nil, err
+	}
+	if owner == u.ID {
+		return nil, ErrIdentityLinked
+	}
+
+	existing, err := FindUser(ctx, owner)
+	switch {
+	case err == ErrUserNotFound:
+		existing = nil
+	case err != nil:
+		return nil, err
+	case existing.Deactivated != nil:
+		return nil, ErrUserDeactivated
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// The owner's own identity moves to u too. Any others linked to it are
+	// moved by the merge.
+	ownerProvider, ownerProviderUserID := splitUserID(owner)
+	now := time.Now()
+	for _, id := range [][2]string{{provider, providerUserID}, {ownerProvider, ownerProviderUserID}} {
+		if _, err := tx.ExecContext(ctx, `
+INSERT INTO identities (provider, provider_user_id, user_id, created_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (provider, provider_user_id) DO UPDATE
+SET user_id = $3`, id[0], id[1], u.ID, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		return nil, nil
+	}
+	return MergeUsers(ctx, u, existing.ID, u.ID)
+}
+
+// Identities returns the identities linked to a user, oldest first. Every
+// user can also log in with the identity their ID came from.
+func Identities(ctx context.Context, u *User) ([]Identity, error) {
+	rows, err := db.QueryContext(ctx, "SELECT provider, provider_user_id, user_id, created_at FROM identities WHERE user_id = $1 ORDER BY created_at ASC", u.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]Identity, 0)
+	for rows.Next() {
+		var i Identity
+		if err := rows.Scan(&i.Provider, &i.ProviderUserID, &i.UserID, &i.Created); err != nil {
+			return nil, err
+		}
+		ids = append(ids, i)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
@@ -0,0 +1,369 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// ImportOptions configure an import from another blog.
+type ImportOptions struct {
+	// BaseURL is where the blog was, like https://example.com. Relative
+	// links and images are made absolute against it, and only old URLs on
+	// it get redirects. WordPress exports have their own.
+	BaseURL string
+
+	// Permalink is the pattern of old post URLs in Jekyll and Hugo sites,
+	// like /:year/:month/:day/:title.html, for posts without a permalink of
+	// their own. It defaults to Jekyll's and Hugo's defaults.
+	Permalink string
+}
+
+// ImportReport is what an import did. Skipped counts posts that had been
+// imported already. Posts that couldn't be imported are described in Errors,
+// and don't stop the rest. Media isn't stored by this
+// server, so imported posts keep using images where they are, which are
+// listed in Media, for copying somewhere permanent.
+type ImportReport struct {
+	Posts     int               `json:"posts"`
+	Pages     int               `json:"pages"`
+	Redirects int               `json:"redirects"`
+	Skipped   int               `json:"skipped"`
+	Media     []*MediaReference `json:"media"`
+	Errors    []string          `json:"errors"`
+}
+
+// importedPost is a post or page read from another blog, before it is saved.
+// Source says where it came from in the export, for errors.
+type importedPost struct {
+	Source  string
+	Title   string
+	Content string
+	Date    time.Time
+	Tags    []string
+	Draft   bool
+
+	// Pages are imported as pages at Slug instead of as posts.
+	Page bool
+	Slug string
+
+	// OldURLs are where the post was, as paths or URLs, which redirect to
+	// it once it is imported.
+	OldURLs []string
+}
+
+type importingKey struct{}
+
+// importing returns whether ctx is an import's.
+func importing(ctx context.Context) bool {
+	v, _ := ctx.Value(importingKey{}).(bool)
+	return v
+}
+
+// startImport imports posts in a background job of kind started by admin.
+// problems are what went wrong reading the export, and start the report's
+// errors.
+func startImport(ctx context.Context, admin *User, kind string, posts []*importedPost, problems []string, opts ImportOptions) (*Job, error) {
+	return startJob(ctx, admin, kind, func(ctx context.Context, j *Job) error {
+		ctx = context.WithValue(ctx, importingKey{}, true)
+		report := &ImportReport{Media: make([]*MediaReference, 0), Errors: append([]string{}, problems...)}
+
+		if err := j.progress(ctx, 0, len(posts)); err != nil {
+			return err
+		}
+		for i, p := range posts {
+			if err := importPost(ctx, p, opts, report); err != nil {
+				report.Errors = append(report.Errors, fmt.Sprintf("%s: %v", p.Source, err))
+			}
+			if err := j.progress(ctx, i+1, len(posts)); err != nil {
+				return err
+			}
+		}
+
+		return j.report(ctx, report)
+	})
+}
+
+// importPost saves p, and redirects its old URLs to it. Posts with an old
+// URL that already redirects somewhere were imported before, so are
+// skipped.
+func importPost(ctx context.Context, p *importedPost, opts ImportOptions, report *ImportReport) error {
+	from := []string{}
+	for _, u := range p.OldURLs {
+		if old, ok := oldPath(u, opts.BaseURL); ok {
+			from = append(from, old)
+		}
+	}
+
+	for _, old := range from {
+		var exists bool
+		if err := db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM redirects WHERE from_path = $1)", old).Scan(&exists); err != nil {
+			return Internalf("Error running get query: %+v", err)
+		}
+		if exists {
+			report.Skipped++
+			return nil
+		}
+	}
+
+	content := p.Content
+	if tags := importTags(p.Tags); tags != "" {
+		content += "\n\n" + tags
+	}
+
+	var to, id string
+	if p.Page {
+		if _, err := UpsertPage(ctx, p.Slug, content, &p.Title, &p.Draft, nil); err != nil {
+			return err
+		}
+		report.Pages++
+		to = "/" + p.Slug
+	} else {
+		post := GeneratePost(ctx, p.Title, content, p.Date, p.Tags, p.Draft)
+		if err := post.Save(ctx); err != nil {
+			return err
+		}
+		report.Posts++
+		to, id = "/post/"+post.ID, post.ID
+	}
+
+	for _, m := range ImageRegex.FindAllStringSubmatch(content, -1) {
+		u := m[1]
+		if u == "" {
+			u = m[2]
+		}
+		report.Media = append(report.Media, &MediaReference{PostID: id, URL: u})
+	}
+
+	for _, old := range from {
+		if old == to {
+			continue
+		}
+		if _, err := CreateRedirect(ctx, old, to, 301); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s: could not redirect %s: %v", p.Source, old, err))
+			continue
+		}
+		report.Redirects++
+	}
+
+	return nil
+}
+
+// oldPath returns the path of u, an old URL of an imported post, if it can
+// be redirected. Absolute URLs have to be on base, if it is set.
+func oldPath(u, base string) (string, bool) {
+	parsed, err := url.Parse(strings.TrimSpace(u))
+	if err != nil {
+		return "", false
+	}
+
+	if parsed.IsAbs() {
+		b, err := url.Parse(base)
+		if base != "" && (err != nil || !strings.EqualFold(b.Host, parsed.Host)) {
+			return "", false
+		}
+	}
+
+	p := NormalizeRedirectPath(path.Clean("/" + parsed.Path))
+	return p, p != "/"
+}
+
+// nonTagChars are what can't be in a hashtag.
+var nonTagChars = regexp.MustCompile(`\W+`)
+
+// importTags returns tags as hashtags, which is how posts are tagged.
+func importTags(tags []string) string {
+	seen := map[string]bool{}
+	hashtags := []string{}
+	for _, t := range tags {
+		t = strings.Trim(nonTagChars.ReplaceAllString(strings.ToLower(t), "_"), "_")
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		hashtags = append(hashtags, "#"+t)
+	}
+
+	return strings.Join(hashtags, " ")
+}
+
+// resolveURL makes ref absolute against base, if base is set.
+func resolveURL(base *url.URL, ref string) string {
+	if base == nil {
+		return ref
+	}
+
+	r, err := url.Parse(strings.TrimSpace(ref))
+	if err != nil {
+		return ref
+	}
+	return base.ResolveReference(r).String()
+}
+
+var (
+	// markdownImage matches the start of Markdown images, up to the end of
+	// their URL.
+	markdownImage = regexp.MustCompile(`(!\[[^\]]*\]\(<?)([^)\s>]+)`)
+
+	// blankLines matches where there are more blank lines than needed.
+	blankLines = regexp.MustCompile(`\n{3,}`)
+
+	// lineIndent matches the indentation of lines of HTML, which would
+	// make code blocks in Markdown.
+	lineIndent = regexp.MustCompile(`\n[ \t]+`)
+)
+
+// absoluteImages makes relative images in Markdown absolute against base.
+func absoluteImages(markdown string, base *url.URL) string {
+	if base == nil {
+		return markdown
+	}
+
+	return markdownImage.ReplaceAllStringFunc(markdown, func(m string) string {
+		parts := markdownImage.FindStringSubmatch(m)
+		return parts[1] + resolveURL(base, parts[2])
+	})
+}
+
+// htmlToMarkdown converts HTML from other blogs to Markdown, since raw HTML
+// isn't rendered. Tags without a Markdown equivalent are replaced by what's
+// in them, and scripts and styles are dropped. Links and images are made
+// absolute against base.
+func htmlToMarkdown(s string, base *url.URL) (string, error) {
+	nodes, err := html.ParseFragment(strings.NewReader(s), &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body})
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	for _, n := range nodes {
+		writeMarkdown(&b, n, base, "")
+	}
+
+	return strings.TrimSpace(blankLines.ReplaceAllString(b.String(), "\n\n")), nil
+}
+
+// writeMarkdown writes n as Markdown. Lines inside lists and quotes start
+// with indent.
+func writeMarkdown(b *strings.Builder, n *html.Node, base *url.URL, indent string) {
+	children := func(indent string) string {
+		var c strings.Builder
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			writeMarkdown(&c, child, base, indent)
+		}
+		return c.String()
+	}
+	block := func(s string) {
+		b.WriteString("\n\n" + indent + strings.TrimSpace(s) + "\n\n" + indent)
+	}
+
+	switch n.Type {
+	case html.TextNode:
+		text := lineIndent.ReplaceAllString(n.Data, "\n")
+		b.WriteString(strings.Replace(text, "\n", "\n"+indent, -1))
+		return
+	case html.ElementNode:
+	default:
+		return
+	}
+
+	switch n.DataAtom {
+	case atom.Script, atom.Style:
+	case atom.P, atom.Div, atom.Figure, atom.Figcaption, atom.Section, atom.Article:
+		block(children(indent))
+	case atom.Br:
+		b.WriteString("  \n" + indent)
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		level := int(n.Data[1] - '0')
+		block(strings.Repeat("#", level) + " " + strings.Join(strings.Fields(children(indent)), " "))
+	case atom.Strong, atom.B:
+		if c := strings.TrimSpace(children(indent)); c != "" {
+			b.WriteString("**" + c + "**")
+		}
+	case atom.Em, atom.I:
+		if c := strings.TrimSpace(children(indent)); c != "" {
+			b.WriteString("_" + c + "_")
+		}
+	case atom.A:
+		href := htmlAttr(n, "href")
+		if href == "" {
+			b.WriteString(children(indent))
+			break
+		}
+		b.WriteString("[" + strings.TrimSpace(children(indent)) + "](" + resolveURL(base, href) + ")")
+	case atom.Img:
+		if src := htmlAttr(n, "src"); src != "" {
+			b.WriteString("![" + htmlAttr(n, "alt") + "](" + resolveURL(base, src) + ")")
+		}
+	case atom.Iframe:
+		if src := htmlAttr(n, "src"); src != "" {
+			block("[" + src + "](" + resolveURL(base, src) + ")")
+		}
+	case atom.Ul, atom.Ol:
+		var items strings.Builder
+		i := 0
+		for li := n.FirstChild; li != nil; li = li.NextSibling {
+			if li.DataAtom != atom.Li {
+				continue
+			}
+			i++
+			marker := "* "
+			if n.DataAtom == atom.Ol {
+				marker = fmt.Sprintf("%d. ", i)
+			}
+
+			var item strings.Builder
+			for c := li.FirstChild; c != nil; c = c.NextSibling {
+				writeMarkdown(&item, c, base, indent+strings.Repeat(" ", len(marker)))
+			}
+			items.WriteString("\n" + indent + marker + strings.TrimSpace(blankLines.ReplaceAllString(item.String(), "\n\n")))
+		}
+		block(items.String())
+	case atom.Blockquote:
+		lines := strings.Split(strings.TrimSpace(blankLines.ReplaceAllString(children(""), "\n\n")), "\n")
+		for i, l := range lines {
+			lines[i] = strings.TrimRight("> "+l, " ")
+		}
+		block(strings.Join(lines, "\n"+indent))
+	case atom.Pre:
+		code := strings.Trim(htmlText(n), "\n")
+		block("```\n" + indent + strings.Replace(code, "\n", "\n"+indent, -1) + "\n" + indent + "```")
+	case atom.Code:
+		b.WriteString("`" + htmlText(n) + "`")
+	case atom.Hr:
+		block("---")
+	default:
+		b.WriteString(children(indent))
+	}
+}
+
+// htmlAttr returns the value of n's attribute key, or empty.
+func htmlAttr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+
+	return ""
+}
+
+// htmlText returns the text in n, without any tags.
+func htmlText(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+
+	var b strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		b.WriteString(htmlText(c))
+	}
+	return b.String()
+}
@@ -0,0 +1,80 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultIndieAuthTokenEndpoint is the token endpoint IndieAuth tokens are
+// verified with, unless another is configured.
+const DefaultIndieAuthTokenEndpoint = "https://tokens.indieauth.com/token"
+
+var indieAuthClient = &http.Client{Timeout: 10 * time.Second}
+
+// IndieAuthToken is what a token endpoint says about an IndieAuth access
+// token. See https://indieauth.spec.indieweb.org/#access-token-verification.
+type IndieAuthToken struct {
+	Me       string `json:"me"`
+	ClientID string `json:"client_id"`
+	Scope    string `json:"scope"`
+}
+
+// HasScope returns true if the token was granted scope.
+func (t *IndieAuthToken) HasScope(scope string) bool {
+	for _, s := range strings.Fields(t.Scope) {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// VerifyIndieAuthToken asks endpoint about token, and returns it if it is
+// valid and was issued for this site's URL.
+func VerifyIndieAuthToken(ctx context.Context, endpoint, token string) (*IndieAuthToken, error) {
+	site, err := GetSiteSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if site.URL == "" {
+		return nil, fmt.Errorf("The site URL must be set to verify IndieAuth tokens")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := indieAuthClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token endpoint returned %s", resp.Status)
+	}
+
+	t := new(IndieAuthToken)
+	if err := json.NewDecoder(resp.Body).Decode(t); err != nil {
+		return nil, fmt.Errorf("could not parse token endpoint response: %+v", err)
+	}
+
+	if !sameSite(t.Me, site.URL) {
+		return nil, fmt.Errorf("token is for %q, not %q", t.Me, site.URL)
+	}
+
+	return t, nil
+}
+
+// sameSite compares two profile URLs, ignoring case and trailing slashes.
+func sameSite(a, b string) bool {
+	return strings.EqualFold(strings.TrimRight(a, "/"), strings.TrimRight(b, "/"))
+}
@@ -0,0 +1,305 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// notificationIntegrityAlerts is the notification type for new integrity
+// issues. It is delivered like auth alerts.
+const notificationIntegrityAlerts = "integrity_alerts"
+
+// IntegrityIssue is an inconsistency in the database found by the integrity
+// checker, like a revision of a post that doesn't exist. Repaired says
+// whether it was fixed when it was found.
+type IntegrityIssue struct {
+	ID       string    `json:"id"`
+	Check    string    `json:"check"`
+	Subject  string    `json:"subject"`
+	Message  string    `json:"message"`
+	Repaired bool      `json:"repaired"`
+	Created  time.Time `json:"created"`
+}
+
+// integrityCheck finds one kind of inconsistency. Checks with a repair can
+// fix what they find without losing anything worth keeping; the rest are
+// only reported, for an admin to decide.
+type integrityCheck struct {
+	name   string
+	find   func(ctx context.Context) ([]*IntegrityIssue, error)
+	repair func(ctx context.Context) error
+}
+
+var integrityChecks = []integrityCheck{
+	{"orphaned_revisions", findOrphanedRevisions, repairOrphanedRevisions},
+	{"missing_creators", findMissingCreators, repairMissingCreators},
+	{"sequences", findLaggingSequences, repairLaggingSequences},
+	{"unknown_metadata", findUnknownMetadata, nil},
+	{"invalid_licenses", findInvalidLicenses, nil},
+}
+
+// CheckIntegrity runs every integrity check, repairs what can be safely
+// repaired if repair is true, and stores the issues as the latest report.
+// Admins are notified about issues that weren't in the previous report.
+func CheckIntegrity(ctx context.Context, repair bool) ([]*IntegrityIssue, error) {
+	previous, err := IntegrityIssues(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	for _, i := range previous {
+		seen[i.Check+" "+i.Subject] = true
+	}
+
+	now := time.Now()
+	issues := make([]*IntegrityIssue, 0)
+	for _, c := range integrityChecks {
+		found, err := c.find(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("integrity check %s failed: %+v", c.name, err)
+		}
+
+		if len(found) > 0 && repair && c.repair != nil {
+			if err := c.repair(ctx); err != nil {
+				return nil, fmt.Errorf("integrity repair %s failed: %+v", c.name, err)
+			}
+		}
+
+		for _, i := range found {
+			i.Check = c.name
+			i.Repaired = repair && c.repair != nil
+			i.Created = now
+		}
+		issues = append(issues, found...)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM integrity_issues"); err != nil {
+		return nil, err
+	}
+	for _, i := range issues {
+		if err := tx.QueryRowContext(ctx, "INSERT INTO integrity_issues (check_name, subject, message, repaired, created_at) VALUES ($1, $2, $3, $4, $5) RETURNING id", i.Check, i.Subject, i.Message, i.Repaired, i.Created).Scan(&i.ID); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	fresh := []*IntegrityIssue{}
+	for _, i := range issues {
+		if !seen[i.Check+" "+i.Subject] {
+			fresh = append(fresh, i)
+		}
+	}
+	if len(fresh) > 0 {
+		if err := notifyIntegrityIssues(ctx, fresh); err != nil {
+			log.Printf("could not notify admins of integrity issues: %+v", err)
+		}
+	}
+
+	return issues, nil
+}
+
+// IntegrityIssues returns the issues from the latest integrity check.
+func IntegrityIssues(ctx context.Context) ([]*IntegrityIssue, error) {
+	rows, err := db.QueryContext(ctx, "SELECT id, check_name, subject, message, repaired, created_at FROM integrity_issues ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	issues := make([]*IntegrityIssue, 0)
+	for rows.Next() {
+		i := new(IntegrityIssue)
+		if err := rows.Scan(&i.ID, &i.Check, &i.Subject, &i.Message, &i.Repaired, &i.Created); err != nil {
+			return nil, err
+		}
+		issues = append(issues, i)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
+
+// checkIntegrity is the integrity cron task. It only repairs issues if
+// INTEGRITY_AUTO_REPAIR is true.
+func checkIntegrity(ctx context.Context) error {
+	_, err := CheckIntegrity(ctx, os.Getenv("INTEGRITY_AUTO_REPAIR") == "true")
+	return err
+}
+
+func notifyIntegrityIssues(ctx context.Context, issues []*IntegrityIssue) error {
+	site, err := GetSiteSettings(ctx)
+	if err != nil {
+		return err
+	}
+
+	return notifyAdmins(ctx, notificationIntegrityAlerts, "integrity", map[string]interface{}{
+		"Site":   site,
+		"Issues": issues,
+	})
+}
+
+// integrityIssues runs query, which selects a subject and a message, and
+// returns an issue for each row.
+func integrityIssues(ctx context.Context, query string, args ...interface{}) ([]*IntegrityIssue, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	issues := make([]*IntegrityIssue, 0)
+	for rows.Next() {
+		i := new(IntegrityIssue)
+		if err := rows.Scan(&i.Subject, &i.Message); err != nil {
+			return nil, err
+		}
+		issues = append(issues, i)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
+
+// findOrphanedRevisions finds revisions of posts that don't exist anymore.
+// They can never be restored, so repairing deletes them.
+func findOrphanedRevisions(ctx context.Context) ([]*IntegrityIssue, error) {
+	return integrityIssues(ctx, `
+SELECT 'revision ' || r.id, 'Revision ' || r.revision || ' belongs to post ' || COALESCE(r.post_id::text, 'null') || ', which does not exist'
+FROM revisions r
+WHERE NOT EXISTS (SELECT 1 FROM posts p WHERE p.id = r.post_id)
+ORDER BY r.id`)
+}
+
+func repairOrphanedRevisions(ctx context.Context) error {
+	_, err := db.ExecContext(ctx, "DELETE FROM revisions r WHERE NOT EXISTS (SELECT 1 FROM posts p WHERE p.id = r.post_id)")
+	return err
+}
+
+// findMissingCreators finds jobs and group invites created by users that
+// don't exist. Who created them is only informational, so repairing forgets
+// it.
+func findMissingCreators(ctx context.Context) ([]*IntegrityIssue, error) {
+	return integrityIssues(ctx, `
+SELECT 'job ' || j.id, 'Created by user ' || j.created_by || ', who does not exist'
+FROM jobs j
+WHERE j.created_by IS NOT NULL AND NOT EXISTS (SELECT 1 FROM users u WHERE u.id = j.created_by)
+UNION ALL
+SELECT 'group invite ' || i.code, 'Created by user ' || i.created_by || ', who does not exist'
+FROM group_invites i
+WHERE i.created_by IS NOT NULL AND NOT EXISTS (SELECT 1 FROM users u WHERE u.id = i.created_by)`)
+}
+
+func repairMissingCreators(ctx context.Context) error {
+	for _, table := range []string{"jobs", "group_invites"} {
+		if _, err := db.ExecContext(ctx, fmt.Sprintf("UPDATE %s t SET created_by = NULL WHERE created_by IS NOT NULL AND NOT EXISTS (SELECT 1 FROM users u WHERE u.id = t.created_by)", table)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findLaggingSequences finds ID sequences that are behind the IDs in their
+// table, like after rows were restored by hand, so the next insert would
+// fail. Repairing moves them past the largest ID, like Import does.
+func findLaggingSequences(ctx context.Context) ([]*IntegrityIssue, error) {
+	issues := make([]*IntegrityIssue, 0)
+	for _, t := range exportTables {
+		if t.Sequence == "" {
+			continue
+		}
+
+		var next, max int64
+		if err := db.QueryRowContext(ctx, fmt.Sprintf("SELECT CASE WHEN is_called THEN last_value + 1 ELSE last_value END, (SELECT COALESCE(MAX(id), 0) FROM %s) FROM %s", t.Name, t.Sequence)).Scan(&next, &max); err != nil {
+			return nil, err
+		}
+		if next <= max {
+			issues = append(issues, &IntegrityIssue{
+				Subject: "sequence " + t.Sequence,
+				Message: fmt.Sprintf("The next ID is %d, but %s already has ID %d", next, t.Name, max),
+			})
+		}
+	}
+
+	return issues, nil
+}
+
+func repairLaggingSequences(ctx context.Context) error {
+	for _, t := range exportTables {
+		if t.Sequence == "" {
+			continue
+		}
+
+		if _, err := db.ExecContext(ctx, fmt.Sprintf("SELECT setval('%s', COALESCE((SELECT MAX(id) FROM %s), 0) + 1, false) WHERE (SELECT COALESCE(MAX(id), 0) FROM %s) >= (SELECT CASE WHEN is_called THEN last_value + 1 ELSE last_value END FROM %s)", t.Sequence, t.Name, t.Name, t.Sequence)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findUnknownMetadata finds post metadata whose key isn't registered. The
+// key may only be missing from this server's configuration, so it is left
+// alone.
+func findUnknownMetadata(ctx context.Context) ([]*IntegrityIssue, error) {
+	keys := make([]string, 0, len(metadataKeys))
+	for k := range metadataKeys {
+		keys = append(keys, k)
+	}
+
+	return integrityIssues(ctx, `
+SELECT 'post ' || post_id, 'Has metadata for ' || key || ', which is not a registered key'
+FROM post_metadata
+WHERE NOT (key = ANY($1))
+ORDER BY post_id, key`, pq.Array(keys))
+}
+
+// findInvalidLicenses finds posts with a license that doesn't exist, or a
+// custom license without any text. Only an admin can say what the license
+// should be.
+func findInvalidLicenses(ctx context.Context) ([]*IntegrityIssue, error) {
+	rows, err := db.QueryContext(ctx, "SELECT id, license, license_text FROM posts WHERE license IS NOT NULL ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	issues := make([]*IntegrityIssue, 0)
+	for rows.Next() {
+		var id int64
+		var license LicenseId
+		var text *string
+		if err := rows.Scan(&id, &license, &text); err != nil {
+			return nil, err
+		}
+
+		switch {
+		case !license.IsValid():
+			issues = append(issues, &IntegrityIssue{Subject: fmt.Sprintf("post %d", id), Message: fmt.Sprintf("Has license %s, which does not exist", license)})
+		case license == LicenseIdCustom && (text == nil || *text == ""):
+			issues = append(issues, &IntegrityIssue{Subject: fmt.Sprintf("post %d", id), Message: "Has a custom license without any text"})
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
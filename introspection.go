@@ -0,0 +1,29 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// IntrospectionMiddleware is a gqlgen resolver middleware that blocks the
+// __schema and __type introspection fields unless the request comes from an
+// admin. If allowAll is true, everyone can introspect.
+func IntrospectionMiddleware(allowAll bool) graphql.FieldMiddleware {
+	return func(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+		if allowAll {
+			return next(ctx)
+		}
+
+		rctx := graphql.GetResolverContext(ctx)
+		if rctx == nil || (rctx.Field.Name != "__schema" && rctx.Field.Name != "__type") {
+			return next(ctx)
+		}
+
+		if HasRole(ctx, RoleAdmin) {
+			return next(ctx)
+		}
+
+		return nil, Unauthorized("Introspection is disabled")
+	}
+}
@@ -0,0 +1,473 @@
+package graphql
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	// jekyllPermalinks are Jekyll's named permalink styles. date is the
+	// default.
+	jekyllPermalinks = map[string]string{
+		"date":   "/:categories/:year/:month/:day/:title:output_ext",
+		"pretty": "/:categories/:year/:month/:day/:title/",
+		"none":   "/:categories/:title:output_ext",
+	}
+
+	// hugoPermalink is Hugo's default permalink.
+	hugoPermalink = "/:section/:slug/"
+
+	// datedFilename matches Jekyll post filenames, like
+	// 2019-01-02-hello-world.
+	datedFilename = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2})-(.+)$`)
+
+	// frontMatterDateFormats are the date formats front matter is parsed
+	// with.
+	frontMatterDateFormats = []string{
+		time.RFC3339,
+		"2006-01-02 15:04:05 -0700",
+		"2006-01-02 15:04:05 -07:00",
+		"2006-01-02 15:04:05",
+		"2006-01-02T15:04:05",
+		"2006-01-02 15:04",
+		"2006-01-02",
+	}
+)
+
+// frontMatter is the part of a Jekyll or Hugo post's front matter that is
+// imported.
+type frontMatter struct {
+	Title        string     `yaml:"title"`
+	Date         string     `yaml:"date"`
+	Tags         stringList `yaml:"tags"`
+	Categories   stringList `yaml:"categories"`
+	Category     string     `yaml:"category"`
+	Draft        bool       `yaml:"draft"`
+	Published    *bool      `yaml:"published"`
+	Slug         string     `yaml:"slug"`
+	Permalink    string     `yaml:"permalink"`
+	URL          string     `yaml:"url"`
+	Aliases      stringList `yaml:"aliases"`
+	RedirectFrom stringList `yaml:"redirect_from"`
+}
+
+// stringList is a list of strings in front matter, which Jekyll also allows
+// as one string separated by spaces.
+type stringList []string
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (l *stringList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var list []string
+	if err := unmarshal(&list); err == nil {
+		*l = list
+		return nil
+	}
+
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	*l = strings.Fields(s)
+	return nil
+}
+
+// ImportStaticSite imports the posts in a Jekyll or Hugo site, uploaded as a
+// tar.gz or zip archive of its source, in a background job started by
+// admin, and redirects their old permalinks to them. Jekyll posts are the
+// files in _posts and _drafts, and Hugo posts are those in sections of
+// content. Hugo pages outside any section are imported as pages. Old
+// permalinks come from front matter, or opts.Permalink, which can also be
+// one of Jekyll's named styles, like pretty. Hugo aliases and Jekyll's
+// redirect_from redirect too. The job's report is an ImportReport.
+func ImportStaticSite(ctx context.Context, admin *User, data []byte, opts ImportOptions) (*Job, error) {
+	var base *url.URL
+	if opts.BaseURL != "" {
+		var err error
+		base, err = url.Parse(strings.TrimRight(opts.BaseURL, "/") + "/")
+		if err != nil || !base.IsAbs() {
+			return nil, Validation("baseURL", "Base URL must be an absolute URL, like https://example.com")
+		}
+	}
+	if p, ok := jekyllPermalinks[opts.Permalink]; ok {
+		opts.Permalink = p
+	}
+
+	files, err := archiveFiles(data)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := []*importedPost{}
+	problems := []string{}
+	for _, f := range files {
+		p, err := staticSitePost(f.name, f.data, base, opts.Permalink)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", f.name, err))
+			continue
+		}
+		if p != nil {
+			posts = append(posts, p)
+		}
+	}
+	if len(posts) == 0 && len(problems) == 0 {
+		return nil, Validation("archive", "Archive has no Jekyll or Hugo posts in it")
+	}
+
+	return startImport(ctx, admin, "import_static_site", posts, problems, opts)
+}
+
+type archiveFile struct {
+	name string
+	data []byte
+}
+
+// archiveFiles returns the Markdown and HTML files in a tar.gz or zip
+// archive, in the order they are in it.
+func archiveFiles(data []byte) ([]archiveFile, error) {
+	files := []archiveFile{}
+	add := func(name string, r io.Reader) error {
+		switch strings.ToLower(path.Ext(name)) {
+		case ".md", ".markdown", ".html", ".htm":
+		default:
+			return nil
+		}
+
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		files = append(files, archiveFile{name: path.Clean(name), data: b})
+		return nil
+	}
+
+	switch {
+	case bytes.HasPrefix(data, []byte{0x1f, 0x8b}):
+		gz, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, Validation("archive", "Archive is not a valid tar.gz: %v", err)
+		}
+
+		tr := tar.NewReader(gz)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, Validation("archive", "Archive is not a valid tar.gz: %v", err)
+			}
+			if hdr.Typeflag != tar.TypeReg {
+				continue
+			}
+			if err := add(hdr.Name, tr); err != nil {
+				return nil, err
+			}
+		}
+	case bytes.HasPrefix(data, []byte("PK\x03\x04")):
+		zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			return nil, Validation("archive", "Archive is not a valid zip: %v", err)
+		}
+
+		for _, f := range zr.File {
+			if f.FileInfo().IsDir() {
+				continue
+			}
+			r, err := f.Open()
+			if err != nil {
+				return nil, Validation("archive", "Archive is not a valid zip: %v", err)
+			}
+			err = add(f.Name, r)
+			r.Close()
+			if err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, Validation("archive", "Archive must be a tar.gz or zip")
+	}
+
+	return files, nil
+}
+
+// staticSitePost returns the post or page in the file at name, or nil if it
+// isn't one, like a layout.
+func staticSitePost(name string, data []byte, base *url.URL, permalink string) (*importedPost, error) {
+	dirs := strings.Split(path.Dir(name), "/")
+	file := path.Base(name)
+	ext := path.Ext(file)
+	stem := strings.TrimSuffix(file, ext)
+
+	var jekyll, draft, page bool
+	var section string
+	found := false
+	for i, d := range dirs {
+		if d == "_posts" || d == "_drafts" {
+			jekyll, draft, found = true, d == "_drafts", true
+			break
+		}
+		if d == "content" {
+			found = true
+			rest := dirs[i+1:]
+			// Hugo page bundles are named after their directory, and are
+			// pages if that is right in content.
+			if stem == "index" && len(rest) > 0 {
+				stem = rest[len(rest)-1]
+				rest = rest[:len(rest)-1]
+			}
+			if len(rest) == 0 {
+				page = true
+			} else {
+				section = rest[0]
+			}
+			break
+		}
+	}
+	if !found || stem == "_index" {
+		return nil, nil
+	}
+
+	fm, body, err := splitFrontMatter(data)
+	if err != nil {
+		return nil, err
+	}
+
+	slug := stem
+	var date time.Time
+	if m := datedFilename.FindStringSubmatch(stem); m != nil {
+		date, _ = time.Parse("2006-01-02", m[1])
+		slug = m[2]
+	}
+	if fm.Slug != "" {
+		slug = fm.Slug
+	}
+	if fm.Date != "" {
+		if date, err = parseFrontMatterDate(fm.Date); err != nil {
+			return nil, err
+		}
+	}
+
+	p := &importedPost{
+		Source: name,
+		Title:  fm.Title,
+		Date:   date,
+		Draft:  draft || fm.Draft || (fm.Published != nil && !*fm.Published),
+		Page:   page,
+	}
+	if p.Title == "" {
+		p.Title = strings.Title(strings.Replace(slug, "-", " ", -1))
+	}
+	if p.Date.IsZero() {
+		if !p.Draft && !p.Page {
+			return nil, fmt.Errorf("post has no date")
+		}
+		p.Date = time.Now()
+	}
+
+	categories := fm.Categories
+	if fm.Category != "" {
+		categories = append(stringList{fm.Category}, categories...)
+	}
+	p.Tags = append(append([]string{}, fm.Tags...), categories...)
+
+	switch strings.ToLower(ext) {
+	case ".html", ".htm":
+		if p.Content, err = htmlToMarkdown(body, base); err != nil {
+			return nil, fmt.Errorf("could not convert content: %v", err)
+		}
+	default:
+		p.Content = absoluteImages(strings.TrimSpace(body), base)
+	}
+
+	if page {
+		p.Slug = strings.ToLower(slug)
+		if !pageSlugRegex.MatchString(p.Slug) {
+			return nil, fmt.Errorf("page slug %q is not a valid slug", slug)
+		}
+	}
+
+	switch {
+	case fm.Permalink != "":
+		p.OldURLs = append(p.OldURLs, fm.Permalink)
+	case fm.URL != "":
+		p.OldURLs = append(p.OldURLs, fm.URL)
+	case page:
+		p.OldURLs = append(p.OldURLs, "/"+slug+"/")
+	default:
+		pattern := permalink
+		if pattern == "" && jekyll {
+			pattern = jekyllPermalinks["date"]
+		}
+		if pattern == "" {
+			pattern = hugoPermalink
+		}
+		p.OldURLs = append(p.OldURLs, expandPermalink(pattern, p.Date, slug, section, categories))
+	}
+	p.OldURLs = append(p.OldURLs, fm.Aliases...)
+	p.OldURLs = append(p.OldURLs, fm.RedirectFrom...)
+
+	return p, nil
+}
+
+// expandPermalink fills in a Jekyll or Hugo permalink pattern.
+func expandPermalink(pattern string, date time.Time, slug, section string, categories []string) string {
+	cats := make([]string, len(categories))
+	for i, c := range categories {
+		cats[i] = strings.ToLower(strings.Replace(c, " ", "-", -1))
+	}
+
+	r := strings.NewReplacer(
+		":categories", strings.Join(cats, "/"),
+		":output_ext", ".html",
+		":section", section,
+		":year", date.Format("2006"),
+		":month", date.Format("01"),
+		":day", date.Format("02"),
+		":title", slug,
+		":slug", slug,
+	)
+
+	p := r.Replace(pattern)
+	for strings.Contains(p, "//") {
+		p = strings.Replace(p, "//", "/", -1)
+	}
+	return p
+}
+
+// parseFrontMatterDate parses dates in any of the formats Jekyll and Hugo
+// allow.
+func parseFrontMatterDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, f := range frontMatterDateFormats {
+		if t, err := time.Parse(f, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("could not parse date %q", s)
+}
+
+// splitFrontMatter returns the YAML (between ---) or TOML (between +++)
+// front matter of a post, and what's after it.
+func splitFrontMatter(data []byte) (*frontMatter, string, error) {
+	s := strings.TrimPrefix(strings.Replace(string(data), "\r\n", "\n", -1), "\ufeff")
+	fm := &frontMatter{}
+
+	var delim string
+	switch {
+	case strings.HasPrefix(s, "---\n"):
+		delim = "---"
+	case strings.HasPrefix(s, "+++\n"):
+		delim = "+++"
+	default:
+		return nil, "", fmt.Errorf("post has no front matter")
+	}
+
+	rest := s[len(delim)+1:]
+	end := strings.Index("\n"+rest, "\n"+delim)
+	if end < 0 {
+		return nil, "", fmt.Errorf("post's front matter never ends")
+	}
+	raw, body := rest[:end], rest[end:]
+	body = strings.TrimPrefix(body, delim)
+
+	if delim == "+++" {
+		values, err := parseTOMLFrontMatter(raw)
+		if err != nil {
+			return nil, "", err
+		}
+		// The values are plain strings, bools and lists, which mean the
+		// same in YAML.
+		b, err := yaml.Marshal(values)
+		if err != nil {
+			return nil, "", err
+		}
+		raw = string(b)
+	}
+
+	if err := yaml.Unmarshal([]byte(raw), fm); err != nil {
+		return nil, "", fmt.Errorf("could not parse front matter: %v", err)
+	}
+	return fm, body, nil
+}
+
+// parseTOMLFrontMatter parses the top level keys of TOML front matter, which
+// is all imports need. Strings, booleans, dates and lists of strings are
+// understood, and everything else is kept as written.
+func parseTOMLFrontMatter(s string) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	sc := bufio.NewScanner(strings.NewReader(s))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// Tables, like [params], aren't needed.
+		if strings.HasPrefix(line, "[") {
+			break
+		}
+
+		i := strings.Index(line, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("could not parse front matter line %q", line)
+		}
+		key := strings.Trim(strings.TrimSpace(line[:i]), `"'`)
+		value := strings.TrimSpace(line[i+1:])
+
+		// Lists can be split over lines.
+		for strings.HasPrefix(value, "[") && !strings.HasSuffix(value, "]") && sc.Scan() {
+			value += " " + strings.TrimSpace(sc.Text())
+		}
+
+		if strings.HasPrefix(value, "[") {
+			list := []string{}
+			for _, item := range strings.Split(strings.Trim(value, "[]"), ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					list = append(list, tomlString(item))
+				}
+			}
+			values[key] = list
+			continue
+		}
+
+		switch value {
+		case "true":
+			values[key] = true
+		case "false":
+			values[key] = false
+		default:
+			values[key] = tomlString(value)
+		}
+	}
+
+	return values, sc.Err()
+}
+
+// tomlString unquotes a TOML string, or returns anything else as written.
+func tomlString(s string) string {
+	switch {
+	case strings.HasPrefix(s, `"`):
+		if u, err := strconv.Unquote(s); err == nil {
+			return u
+		}
+	case strings.HasPrefix(s, "'") && strings.HasSuffix(s, "'") && len(s) > 1:
+		return s[1 : len(s)-1]
+	}
+
+	return s
+}
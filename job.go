@@ -0,0 +1,296 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// MaxJobAttempts is how many times a queued job runs before it is dead,
+	// and has to be requeued by an admin.
+	MaxJobAttempts = 5
+
+	// jobBackoff is the wait after a queued job's first failure. It doubles
+	// after each failure.
+	jobBackoff = 30 * time.Second
+)
+
+// JobHandler runs a queued job, given the payload it was enqueued with.
+type JobHandler func(ctx context.Context, payload json.RawMessage) error
+
+var jobHandlers = map[string]JobHandler{}
+
+// HandleJobs sets what runs queued jobs of kind. Handlers should be set
+// before job workers start, in init.
+func HandleJobs(kind string, h JobHandler) {
+	jobHandlers[kind] = h
+}
+
+// Job is work running in the background: either a bulk admin operation, or
+// a queued job. Done counts up to Total as a bulk operation runs. Queued jobs
+// have a Payload, and are retried until they succeed or run out of Attempts.
+// RequestID is the request that started the job, if one did. Jobs like
+// imports leave a JSON Report of what they did.
+type Job struct {
+	ID        string     `json:"id"`
+	Kind      string     `json:"kind"`
+	Status    JobStatus  `json:"status"`
+	Total     int        `json:"total"`
+	Done      int        `json:"done"`
+	Error     *string    `json:"error"`
+	Payload   *string    `json:"payload"`
+	Report    *string    `json:"report"`
+	Attempts  int        `json:"attempts"`
+	RunAt     *time.Time `json:"run_at"`
+	RequestID *string    `json:"request_id"`
+	CreatedBy string     `json:"created_by"`
+	Created   time.Time  `json:"created"`
+	Finished  *time.Time `json:"finished"`
+}
+
+const jobColumns = "id, kind, status, total, done, error, payload, report, attempts, run_at, request_id, created_by, created_at, finished_at"
+
+func scanJob(row interface {
+	Scan(dest ...interface{}) error
+}) (*Job, error) {
+	j := new(Job)
+	var errMsg, payload, report, requestID, createdBy sql.NullString
+	var runAt, finished pq.NullTime
+	if err := row.Scan(&j.ID, &j.Kind, &j.Status, &j.Total, &j.Done, &errMsg, &payload, &report, &j.Attempts, &runAt, &requestID, &createdBy, &j.Created, &finished); err != nil {
+		return nil, err
+	}
+	if errMsg.Valid {
+		j.Error = &errMsg.String
+	}
+	if payload.Valid {
+		j.Payload = &payload.String
+	}
+	if report.Valid {
+		j.Report = &report.String
+	}
+	if requestID.Valid {
+		j.RequestID = &requestID.String
+	}
+	j.CreatedBy = createdBy.String
+	j.RunAt = nullTimePtr(runAt)
+	j.Finished = nullTimePtr(finished)
+
+	return j, nil
+}
+
+// startJob records a job started by u, and runs it in the background. The
+// job isn't tied to ctx, so it carries on after the request that started it
+// is done, but keeps its request ID. If the server stops, the job is left
+// running.
+func startJob(ctx context.Context, u *User, kind string, run func(ctx context.Context, j *Job) error) (*Job, error) {
+	row := db.QueryRowContext(ctx, "INSERT INTO jobs (kind, status, request_id, created_by, created_at) VALUES ($1, $2, NULLIF($3, ''), $4, $5) RETURNING "+jobColumns, kind, JobStatusRunning, RequestID(ctx), u.ID, time.Now())
+	j, err := scanJob(row)
+	if err != nil {
+		return nil, err
+	}
+
+	requestID := RequestID(ctx)
+	GoTask("job", func() {
+		ctx := WithRequestID(context.Background(), requestID)
+		status, errMsg := JobStatusSucceeded, sql.NullString{}
+		if err := run(ctx, j); err != nil {
+			log.Printf("job %s (%s) failed: %+v", j.ID, kind, err)
+			status, errMsg = JobStatusFailed, sql.NullString{String: err.Error(), Valid: true}
+		}
+
+		if _, err := db.ExecContext(ctx, "UPDATE jobs SET status = $2, error = $3, finished_at = $4 WHERE id = $1", j.ID, status, errMsg, time.Now()); err != nil {
+			log.Printf("could not finish job %s: %+v", j.ID, err)
+		}
+	})
+
+	return j, nil
+}
+
+// progress records how much of the job is done.
+func (j *Job) progress(ctx context.Context, done, total int) error {
+	j.Done, j.Total = done, total
+	_, err := db.ExecContext(ctx, "UPDATE jobs SET done = $2, total = $3 WHERE id = $1", j.ID, done, total)
+	return err
+}
+
+// report records what the job did, marshaled as JSON.
+func (j *Job) report(ctx context.Context, report interface{}) error {
+	b, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	s := string(b)
+	j.Report = &s
+	_, err = db.ExecContext(ctx, "UPDATE jobs SET report = $2 WHERE id = $1", j.ID, s)
+	return err
+}
+
+// GetJob returns a job by ID.
+func GetJob(ctx context.Context, id string) (*Job, error) {
+	row := db.QueryRowContext(ctx, "SELECT "+jobColumns+" FROM jobs WHERE id = $1", id)
+	j, err := scanJob(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, NotFound("No job with id %s", id)
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return j, nil
+	}
+}
+
+// Jobs returns the most recent jobs, newest first. If status or requestID
+// aren't nil, only jobs with the status, or started by the request, are
+// returned.
+func Jobs(ctx context.Context, limit int, status *JobStatus, requestID *string) ([]*Job, error) {
+	rows, err := db.QueryContext(ctx, "SELECT "+jobColumns+" FROM jobs WHERE ($2::text IS NULL OR status = $2) AND ($3::text IS NULL OR request_id = $3) ORDER BY created_at DESC LIMIT $1", limit, status, requestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	jobs := make([]*Job, 0)
+	for rows.Next() {
+		j, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, j)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// Enqueue queues a job of kind, with payload marshaled as JSON, for a job
+// worker to run with the kind's handler. Failed jobs are retried with
+// exponential backoff, and are dead after MaxJobAttempts, or as soon as they
+// fail with an error that isn't retryable. The job runs with the request ID
+// of ctx.
+func Enqueue(ctx context.Context, kind string, payload interface{}) (*Job, error) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var createdBy sql.NullString
+	if u := ForContext(ctx); u != nil {
+		createdBy = sql.NullString{String: u.ID, Valid: true}
+	}
+
+	now := time.Now()
+	row := db.QueryRowContext(ctx, "INSERT INTO jobs (kind, status, payload, request_id, created_by, created_at, run_at) VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6, $6) RETURNING "+jobColumns, kind, JobStatusQueued, string(b), RequestID(ctx), createdBy, now)
+	return scanJob(row)
+}
+
+// RequeueJob queues a dead job to run again, with its attempts reset.
+func RequeueJob(ctx context.Context, id string) (*Job, error) {
+	row := db.QueryRowContext(ctx, "UPDATE jobs SET status = $2, attempts = 0, error = NULL, run_at = $3, finished_at = NULL WHERE id = $1 AND status = $4 RETURNING "+jobColumns, id, JobStatusQueued, time.Now(), JobStatusDead)
+	j, err := scanJob(row)
+	switch {
+	case err == sql.ErrNoRows:
+		if _, err := GetJob(ctx, id); err != nil {
+			return nil, err
+		}
+		return nil, Validation("id", "Only dead jobs can be requeued")
+	case err != nil:
+		return nil, Internalf("Error running update query: %+v", err)
+	default:
+		return j, nil
+	}
+}
+
+// StartJobWorkers starts workers that run queued jobs, each checking every
+// interval until ctx is done. It is safe to run on several servers at once.
+func StartJobWorkers(ctx context.Context, workers int, interval time.Duration) {
+	for i := 0; i < workers; i++ {
+		startWorker(ctx, "jobs", interval, runNextJob)
+	}
+}
+
+// runNextJob runs one due queued job. It returns false when none are due.
+func runNextJob(ctx context.Context) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	row := tx.QueryRowContext(ctx, `
+SELECT `+jobColumns+`
+FROM jobs
+WHERE status = $1 AND run_at <= $2
+ORDER BY run_at
+LIMIT 1
+FOR UPDATE SKIP LOCKED
+`, JobStatusQueued, time.Now())
+	j, err := scanJob(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, Internalf("Error running get query: %+v", err)
+	}
+
+	j.Attempts++
+	status, errMsg, runAt, finished := JobStatusSucceeded, sql.NullString{}, j.RunAt, pq.NullTime{Time: time.Now(), Valid: true}
+	if err := runJob(ctx, j); err != nil {
+		log.Printf("job %s (%s) attempt %d failed: %+v", j.ID, j.Kind, j.Attempts, err)
+		errMsg = sql.NullString{String: err.Error(), Valid: true}
+		if j.Attempts < MaxJobAttempts && jobRetryable(err) {
+			next := time.Now().Add(jobBackoff << uint(j.Attempts-1))
+			status, runAt, finished = JobStatusQueued, &next, pq.NullTime{}
+		} else {
+			status = JobStatusDead
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE jobs SET status = $2, attempts = $3, error = $4, run_at = $5, finished_at = $6 WHERE id = $1", j.ID, status, j.Attempts, errMsg, runAt, finished); err != nil {
+		return true, err
+	}
+
+	return true, tx.Commit()
+}
+
+// jobRetryable returns whether a job that failed with err should run again.
+// Errors without a code, like from other services, are assumed to be
+// transient, but errors like NotFound or Validation won't go away by
+// retrying.
+func jobRetryable(err error) bool {
+	return ClassifyError(err, CodeUnavailable).Code.Retryable()
+}
+
+// runJob runs a queued job with its kind's handler, with the request ID of
+// the request that queued it. Panics are failures.
+func runJob(ctx context.Context, j *Job) (err error) {
+	h, ok := jobHandlers[j.Kind]
+	if !ok {
+		return fmt.Errorf("no handler for %s jobs", j.Kind)
+	}
+
+	if j.RequestID != nil {
+		ctx = WithRequestID(ctx, *j.RequestID)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("panic: %v", p)
+		}
+	}()
+
+	var payload json.RawMessage
+	if j.Payload != nil {
+		payload = json.RawMessage(*j.Payload)
+	}
+
+	return h(ctx, payload)
+}
@@ -0,0 +1,173 @@
+package graphql
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const (
+	// JWTExpiry is how long a minted JWT is valid for.
+	JWTExpiry = 15 * time.Minute
+
+	// ServiceAudience is the audience of JWTs minted for other services.
+	// They identify users to those services, but aren't credentials for
+	// this API.
+	ServiceAudience = "services"
+)
+
+var (
+	jwtIssuer string
+	jwtKey    *jose.JSONWebKey
+	jwtSigner jose.Signer
+)
+
+// UserClaims are the claims in JWTs minted for users.
+type UserClaims struct {
+	jwt.Claims
+	Roles []string `json:"roles"`
+}
+
+// ConfigureJWT loads a PEM encoded RSA private key for signing JWTs. Until it
+// is called, JWTs can not be minted.
+func ConfigureJWT(privateKeyPEM []byte, issuer string) error {
+	priv, err := parseRSAPrivateKey(privateKeyPEM)
+	if err != nil {
+		return err
+	}
+
+	key := &jose.JSONWebKey{Key: priv, Algorithm: string(jose.RS256), Use: "sig"}
+	thumb, err := key.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return err
+	}
+	key.KeyID = base64.RawURLEncoding.EncodeToString(thumb)
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key}, (&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		return err
+	}
+
+	jwtIssuer = issuer
+	jwtKey = key
+	jwtSigner = signer
+
+	return nil
+}
+
+// parseRSAPrivateKey parses a PEM encoded PKCS #1 or PKCS #8 RSA private key.
+func parseRSAPrivateKey(privateKeyPEM []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(privateKeyPEM)
+	if block == nil {
+		return nil, fmt.Errorf("No PEM data found in private key")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		priv, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("Private key is not an RSA key")
+		}
+		return priv, nil
+	default:
+		return nil, fmt.Errorf("Unknown private key type %q", block.Type)
+	}
+}
+
+// JWTEnabled returns true if ConfigureJWT has been called.
+func JWTEnabled() bool {
+	return jwtSigner != nil
+}
+
+// JWTIssuer returns the issuer minted JWTs are signed as.
+func JWTIssuer() string {
+	return jwtIssuer
+}
+
+// JWKS returns the public keys that minted JWTs can be verified with.
+func JWKS() jose.JSONWebKeySet {
+	set := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{}}
+	if jwtKey != nil {
+		set.Keys = append(set.Keys, jwtKey.Public())
+	}
+
+	return set
+}
+
+// JWT mints a short lived signed token that identifies the user to other
+// services.
+func (u *User) JWT() (string, error) {
+	if !JWTEnabled() {
+		return "", fmt.Errorf("JWTs are not configured")
+	}
+
+	now := time.Now()
+	claims := UserClaims{
+		Claims: jwt.Claims{
+			Issuer:   jwtIssuer,
+			Subject:  u.ID,
+			Audience: jwt.Audience{ServiceAudience},
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(now.Add(JWTExpiry)),
+		},
+		Roles: []string{u.Role},
+	}
+
+	return jwt.Signed(jwtSigner).Claims(claims).CompactSerialize()
+}
+
+// UserFromJWT verifies a JWT minted by User.JWT, for services built with this
+// package, and returns the user it was minted for. This server doesn't
+// accept them as credentials itself.
+func UserFromJWT(ctx context.Context, token string) (*User, error) {
+	claims, err := parseJWT(token)
+	if err != nil {
+		return nil, err
+	}
+
+	// ID tokens and OpenID Connect access tokens are only meant for the
+	// client they were issued to.
+	if len(claims.Audience) != 1 || claims.Audience[0] != ServiceAudience {
+		return nil, fmt.Errorf("JWT was minted for %v, not other services", claims.Audience)
+	}
+
+	return GetUser(ctx, claims.Subject)
+}
+
+// parseJWT verifies that a JWT was signed by this server and hasn't expired,
+// and returns its claims.
+func parseJWT(token string) (*UserClaims, error) {
+	if !JWTEnabled() {
+		return nil, fmt.Errorf("JWTs are not configured")
+	}
+
+	tok, err := jwt.ParseSigned(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims UserClaims
+	if err := tok.Claims(jwtKey.Public().Key, &claims); err != nil {
+		return nil, err
+	}
+
+	if err := claims.Validate(jwt.Expected{Issuer: jwtIssuer, Time: time.Now()}); err != nil {
+		return nil, err
+	}
+
+	return &claims, nil
+}
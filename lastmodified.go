@@ -0,0 +1,92 @@
+package graphql
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type lastModifiedCtxKeyType string
+
+const lastModifiedCtxKey lastModifiedCtxKeyType = "lastModified"
+
+// lastModified is the newest modification time of anything a query touched.
+type lastModified struct {
+	mu sync.Mutex
+	t  time.Time
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// WithLastModified starts tracking the newest entity resolved by queries run
+// with the returned context. Read it with LastModified.
+func WithLastModified(ctx context.Context) context.Context {
+	return context.WithValue(ctx, lastModifiedCtxKey, &lastModified{})
+}
+
+// LastModified returns the newest modification time of the entities resolved
+// with ctx, or the zero time if none had one. Requires WithLastModified.
+func LastModified(ctx context.Context) time.Time {
+	lm, ok := ctx.Value(lastModifiedCtxKey).(*lastModified)
+	if !ok {
+		return time.Time{}
+	}
+
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+	return lm.t
+}
+
+// LastModifiedMiddleware is a gqlgen resolver middleware that records the
+// Modified time of every entity resolved, like posts, pages and links, for
+// Last-Modified headers. It does nothing without WithLastModified.
+func LastModifiedMiddleware(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	res, err := next(ctx)
+
+	lm, ok := ctx.Value(lastModifiedCtxKey).(*lastModified)
+	if !ok || err != nil || res == nil {
+		return res, err
+	}
+
+	if t := newestModified(reflect.ValueOf(res)); !t.IsZero() {
+		lm.mu.Lock()
+		if t.After(lm.t) {
+			lm.t = t
+		}
+		lm.mu.Unlock()
+	}
+
+	return res, err
+}
+
+// newestModified returns the Modified field of v, or the newest of them if v
+// is a slice.
+func newestModified(v reflect.Value) time.Time {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return time.Time{}
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		f := v.FieldByName("Modified")
+		if f.IsValid() && f.Type() == timeType {
+			return f.Interface().(time.Time)
+		}
+	case reflect.Slice:
+		var newest time.Time
+		for i := 0; i < v.Len(); i++ {
+			if t := newestModified(v.Index(i)); t.After(newest) {
+				newest = t
+			}
+		}
+		return newest
+	}
+
+	return time.Time{}
+}
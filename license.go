@@ -0,0 +1,152 @@
+package graphql
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// licenseInfo is what is known about a license with an ID.
+type licenseInfo struct {
+	SPDX string
+	Name string
+	URL  string
+}
+
+// licenses are the licenses posts can have, other than custom ones.
+var licenses = map[LicenseId]licenseInfo{
+	LicenseIdAllRightsReserved: {Name: "All rights reserved"},
+	LicenseIdCc010:             {SPDX: "CC0-1.0", Name: "CC0 1.0 Universal", URL: "https://creativecommons.org/publicdomain/zero/1.0/"},
+	LicenseIdCcBy40:            {SPDX: "CC-BY-4.0", Name: "Creative Commons Attribution 4.0 International", URL: "https://creativecommons.org/licenses/by/4.0/"},
+	LicenseIdCcBySa40:          {SPDX: "CC-BY-SA-4.0", Name: "Creative Commons Attribution-ShareAlike 4.0 International", URL: "https://creativecommons.org/licenses/by-sa/4.0/"},
+	LicenseIdCcByNd40:          {SPDX: "CC-BY-ND-4.0", Name: "Creative Commons Attribution-NoDerivatives 4.0 International", URL: "https://creativecommons.org/licenses/by-nd/4.0/"},
+	LicenseIdCcByNc40:          {SPDX: "CC-BY-NC-4.0", Name: "Creative Commons Attribution-NonCommercial 4.0 International", URL: "https://creativecommons.org/licenses/by-nc/4.0/"},
+	LicenseIdCcByNcSa40:        {SPDX: "CC-BY-NC-SA-4.0", Name: "Creative Commons Attribution-NonCommercial-ShareAlike 4.0 International", URL: "https://creativecommons.org/licenses/by-nc-sa/4.0/"},
+	LicenseIdCcByNcNd40:        {SPDX: "CC-BY-NC-ND-4.0", Name: "Creative Commons Attribution-NonCommercial-NoDerivatives 4.0 International", URL: "https://creativecommons.org/licenses/by-nc-nd/4.0/"},
+	LicenseIdMit:               {SPDX: "MIT", Name: "MIT License", URL: "https://opensource.org/licenses/MIT"},
+	LicenseIdApache20:          {SPDX: "Apache-2.0", Name: "Apache License 2.0", URL: "https://www.apache.org/licenses/LICENSE-2.0"},
+	LicenseIdCustom:            {Name: "Custom license"},
+}
+
+// License is how a post can be reused. Only custom licenses have text.
+type License struct {
+	ID   LicenseId `json:"id"`
+	Text *string   `json:"text"`
+}
+
+// newLicense returns the license with id and text, or nil if there is no id.
+func newLicense(id *LicenseId, text *string) *License {
+	if id == nil {
+		return nil
+	}
+
+	return &License{ID: *id, Text: text}
+}
+
+// licenseFromInput returns the license input is for, or nil if it removes
+// the license.
+func licenseFromInput(input LicenseInput) (*License, error) {
+	l := newLicense(input.ID, input.Text)
+	if l == nil {
+		return nil, nil
+	}
+	if l.Text != nil && strings.TrimSpace(*l.Text) == "" {
+		l.Text = nil
+	}
+
+	if err := l.Validate(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Validate checks that the license is known, and that only custom licenses
+// have text.
+func (l *License) Validate() error {
+	if !l.ID.IsValid() {
+		return Validation("license", "%q is not a license", l.ID)
+	}
+
+	if l.ID == LicenseIdCustom && l.Text == nil {
+		return Validation("license", "Custom licenses need text")
+	}
+	if l.ID != LicenseIdCustom && l.Text != nil {
+		return Validation("license", "Only custom licenses have text")
+	}
+
+	return nil
+}
+
+// SPDX returns the license's SPDX identifier, if it has one.
+func (l *License) SPDX() *string {
+	if s := licenses[l.ID].SPDX; s != "" {
+		return &s
+	}
+
+	return nil
+}
+
+// Name returns the license's name.
+func (l *License) Name() string {
+	return licenses[l.ID].Name
+}
+
+// URL returns where the text of the license is, if anywhere.
+func (l *License) URL() *string {
+	if u := licenses[l.ID].URL; u != "" {
+		return &u
+	}
+
+	return nil
+}
+
+// HTML returns a rel=license link to the license, to put in rendered pages.
+// Licenses with nowhere to link to are just their name, or their text if
+// they are custom.
+func (l *License) HTML() string {
+	u := l.URL()
+	if u == nil {
+		name := l.Name()
+		if l.Text != nil {
+			name = *l.Text
+		}
+		return fmt.Sprintf(`<span class="license">%s</span>`, html.EscapeString(name))
+	}
+
+	return fmt.Sprintf(`<a rel="license" href="%s">%s</a>`, html.EscapeString(*u), html.EscapeString(l.Name()))
+}
+
+// ownLicense returns the post's license, ignoring the site's default.
+func (p *Post) ownLicense() *License {
+	return newLicense(p.LicenseID, p.LicenseText)
+}
+
+// setLicense changes the post's license. A nil input leaves it alone.
+func (p *Post) setLicense(input *LicenseInput) error {
+	if input == nil {
+		return nil
+	}
+
+	l, err := licenseFromInput(*input)
+	if err != nil {
+		return err
+	}
+
+	p.LicenseID, p.LicenseText = nil, nil
+	if l != nil {
+		p.LicenseID, p.LicenseText = &l.ID, l.Text
+	}
+
+	return nil
+}
+
+// licenseOn returns the post's license, or the site's default license if it
+// doesn't have one.
+func (p *Post) licenseOn(site *SiteSettings) *License {
+	if l := p.ownLicense(); l != nil {
+		return l
+	}
+
+	return site.License
+}
@@ -0,0 +1,348 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"golang.org/x/net/html"
+)
+
+const (
+	// LinkRecheckInterval is how often saved links are checked to see if
+	// they are dead.
+	LinkRecheckInterval = 7 * 24 * time.Hour
+
+	// maxLinkPageSize is how much of a page is read looking for metadata.
+	maxLinkPageSize = 1 << 20
+)
+
+var linkClient = &http.Client{Timeout: 15 * time.Second}
+
+// Link is a bookmark: a page on another site worth remembering.
+type Link struct {
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	URI         string     `json:"uri"`
+	Description string     `json:"description"`
+	Screenshot  string     `json:"screenshot"`
+	Tags        []string   `json:"tags"`
+	StatusCode  *int       `json:"status_code"`
+	Dead        bool       `json:"dead"`
+	Checked     *time.Time `json:"checked"`
+	Created     time.Time  `json:"created"`
+	Modified    time.Time  `json:"modified"`
+}
+
+// LinkMetadata is what we can learn about a page from its HTML.
+type LinkMetadata struct {
+	Title       string
+	Description string
+	Image       string
+}
+
+// FetchLinkMetadata fetches a page, and returns its title, description and
+// image, preferring Open Graph tags.
+func FetchLinkMetadata(ctx context.Context, rawurl string) (*LinkMetadata, error) {
+	req, err := http.NewRequest(http.MethodGet, rawurl, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/html")
+
+	resp, err := linkClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("%s responded with %s", rawurl, resp.Status)
+	}
+
+	m, err := parseLinkMetadata(io.LimitReader(resp.Body, maxLinkPageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	// Images are often relative to the page.
+	if m.Image != "" {
+		if img, err := resp.Request.URL.Parse(m.Image); err == nil {
+			m.Image = img.String()
+		}
+	}
+
+	return m, nil
+}
+
+// parseLinkMetadata reads metadata from the head of an HTML page.
+func parseLinkMetadata(r io.Reader) (*LinkMetadata, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var title string
+	meta := map[string]string{}
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			switch n.Data {
+			case "title":
+				if title == "" && n.FirstChild != nil {
+					title = n.FirstChild.Data
+				}
+			case "meta":
+				var key, content string
+				for _, a := range n.Attr {
+					switch strings.ToLower(a.Key) {
+					case "property", "name":
+						key = strings.ToLower(a.Val)
+					case "content":
+						content = a.Val
+					}
+				}
+				if _, ok := meta[key]; key != "" && !ok {
+					meta[key] = content
+				}
+			case "body":
+				return
+			}
+		}
+
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+
+	first := func(values ...string) string {
+		for _, v := range values {
+			if v = strings.TrimSpace(v); v != "" {
+				return v
+			}
+		}
+		return ""
+	}
+
+	return &LinkMetadata{
+		Title:       first(meta["og:title"], meta["twitter:title"], title),
+		Description: first(meta["og:description"], meta["twitter:description"], meta["description"]),
+		Image:       first(meta["og:image"], meta["twitter:image"]),
+	}, nil
+}
+
+// validLinkURL returns an error if rawurl isn't an http or https URL.
+func validLinkURL(rawurl string) error {
+	if u, err := url.Parse(rawurl); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("Links must be http or https URLs")
+	}
+
+	return nil
+}
+
+// SaveLink fetches a page and saves it as a link, with the page's metadata.
+// Saving a URL that is already saved refreshes its metadata and replaces its
+// tags.
+func SaveLink(ctx context.Context, rawurl string, tags []string) (*Link, error) {
+	if err := validLinkURL(rawurl); err != nil {
+		return nil, err
+	}
+
+	m, err := FetchLinkMetadata(ctx, rawurl)
+	if err != nil {
+		return nil, fmt.Errorf("Could not fetch %s: %+v", rawurl, err)
+	}
+
+	if m.Title == "" {
+		m.Title = rawurl
+	}
+
+	l := &Link{
+		URI:         rawurl,
+		Title:       m.Title,
+		Description: m.Description,
+		Screenshot:  m.Image,
+		Tags:        tags,
+		Created:     time.Now(),
+	}
+
+	return l, l.Save(ctx)
+}
+
+// Save upserts the link by URL.
+func (l *Link) Save(ctx context.Context) error {
+	if err := validLinkURL(l.URI); err != nil {
+		return err
+	}
+
+	if l.Tags == nil {
+		l.Tags = []string{}
+	}
+
+	if l.Created.IsZero() {
+		l.Created = time.Now()
+	}
+
+	row := db.QueryRowContext(ctx, `
+INSERT INTO links (url, title, description, screenshot, tags, created_at, modified_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (url) DO UPDATE
+SET (title, description, screenshot, tags, modified_at) = ($2, $3, $4, $5, $7)
+RETURNING id, url, title, description, screenshot, tags, status_code, dead, checked_at, created_at, modified_at
+`, l.URI, l.Title, l.Description, l.Screenshot, pq.Array(l.Tags), l.Created, time.Now())
+
+	saved, err := scanLink(row)
+	if err != nil {
+		return err
+	}
+
+	*l = *saved
+	return nil
+}
+
+func scanLink(row interface {
+	Scan(dest ...interface{}) error
+}) (*Link, error) {
+	l := new(Link)
+	var status sql.NullInt64
+	var checked pq.NullTime
+	if err := row.Scan(&l.ID, &l.URI, &l.Title, &l.Description, &l.Screenshot, pq.Array(&l.Tags), &status, &l.Dead, &checked, &l.Created, &l.Modified); err != nil {
+		return nil, err
+	}
+
+	if status.Valid {
+		code := int(status.Int64)
+		l.StatusCode = &code
+	}
+	l.Checked = nullTimePtr(checked)
+
+	return l, nil
+}
+
+// GetLink returns a link by ID.
+func GetLink(ctx context.Context, id string) (*Link, error) {
+	row := db.QueryRowContext(ctx, "SELECT id, url, title, description, screenshot, tags, status_code, dead, checked_at, created_at, modified_at FROM links WHERE id = $1", id)
+	l, err := scanLink(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, NotFound("No link with id %s", id)
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return l, nil
+	}
+}
+
+// Links returns links matching filter, newest first. A limit of zero means
+// no limit.
+func Links(ctx context.Context, filter LinkFilter, limit, offset int) ([]*Link, error) {
+	where := []string{"TRUE"}
+	args := []interface{}{}
+	if filter.Tag != nil {
+		args = append(args, *filter.Tag)
+		where = append(where, fmt.Sprintf("$%d = ANY(tags)", len(args)))
+	}
+	if filter.Dead != nil {
+		args = append(args, *filter.Dead)
+		where = append(where, fmt.Sprintf("dead = $%d", len(args)))
+	}
+	if filter.Search != nil {
+		args = append(args, "%"+*filter.Search+"%")
+		where = append(where, fmt.Sprintf("(title ILIKE $%d OR description ILIKE $%d OR url ILIKE $%d)", len(args), len(args), len(args)))
+	}
+
+	var lim interface{}
+	if limit > 0 {
+		lim = limit
+	}
+	args = append(args, lim, offset)
+
+	query := fmt.Sprintf("SELECT id, url, title, description, screenshot, tags, status_code, dead, checked_at, created_at, modified_at FROM links WHERE %s ORDER BY created_at DESC LIMIT $%d OFFSET $%d", strings.Join(where, " AND "), len(args)-1, len(args))
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	links := make([]*Link, 0)
+	for rows.Next() {
+		l, err := scanLink(rows)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, l)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
+// StartLinkWorker checks saved links every interval, until ctx is done,
+// marking links that no longer load as dead. Each link is checked every
+// LinkRecheckInterval. It is safe to run on several servers at once.
+func StartLinkWorker(ctx context.Context, interval time.Duration) {
+	startWorker(ctx, "link", interval, checkNextLink)
+}
+
+// checkNextLink checks one link that is due. It returns false when none are.
+func checkNextLink(ctx context.Context) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var id, rawurl string
+	err = tx.QueryRowContext(ctx, `
+SELECT id, url
+FROM links
+WHERE checked_at IS NULL OR checked_at <= $1
+ORDER BY checked_at NULLS FIRST
+LIMIT 1
+FOR UPDATE SKIP LOCKED
+`, time.Now().Add(-LinkRecheckInterval)).Scan(&id, &rawurl)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, Internalf("Error running get query: %+v", err)
+	}
+
+	var status interface{}
+	dead := true
+	if code, err := checkLink(ctx, rawurl); err == nil {
+		status = code
+		dead = code >= 400
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE links SET status_code = $2, dead = $3, checked_at = $4 WHERE id = $1", id, status, dead, time.Now()); err != nil {
+		return true, err
+	}
+
+	return true, tx.Commit()
+}
+
+// checkLink returns the status code of a link.
+func checkLink(ctx context.Context, rawurl string) (int, error) {
+	req, err := http.NewRequest(http.MethodGet, rawurl, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := linkClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,168 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Link check policies control what happens when a published post links to
+// something on this site that doesn't exist.
+const (
+	// LinkCheckOff doesn't check links.
+	LinkCheckOff = "off"
+
+	// LinkCheckWarn saves the post, and returns broken links as warnings.
+	LinkCheckWarn = "warn"
+
+	// LinkCheckStrict refuses to save the post.
+	LinkCheckStrict = "strict"
+)
+
+var (
+	linkCheckPolicy = LinkCheckWarn
+
+	// LinkRegex is a regex for finding link and image URLs in Markdown and
+	// HTML.
+	LinkRegex = regexp.MustCompile(`\]\(<?([^)\s>]+)|(?:href|src)=["']([^"']+)`)
+
+	postPathRegex = regexp.MustCompile(`^/post/(\d+)$`)
+
+	warningsMu sync.Mutex
+)
+
+// ConfigureLinkCheck sets the link check policy.
+func ConfigureLinkCheck(policy string) error {
+	switch policy {
+	case LinkCheckOff, LinkCheckWarn, LinkCheckStrict:
+		linkCheckPolicy = policy
+		return nil
+	default:
+		return fmt.Errorf("Unknown link check policy %q", policy)
+	}
+}
+
+// BrokenLinks returns the links in a post to pages on this site that don't
+// resolve to a published post, a tag or a redirect.
+func (p *Post) BrokenLinks(ctx context.Context) ([]string, error) {
+	site, err := GetSiteSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var siteHost string
+	if u, err := url.Parse(site.URL); err == nil {
+		siteHost = u.Host
+	}
+
+	broken := []string{}
+	seen := map[string]bool{}
+	for _, m := range LinkRegex.FindAllStringSubmatch(p.Content, -1) {
+		link := m[1]
+		if link == "" {
+			link = m[2]
+		}
+		if seen[link] {
+			continue
+		}
+		seen[link] = true
+
+		u, err := url.Parse(link)
+		if err != nil {
+			broken = append(broken, link)
+			continue
+		}
+
+		// Only check links to this site.
+		if (u.IsAbs() && u.Host != siteHost) || !strings.HasPrefix(u.Path, "/") {
+			continue
+		}
+
+		ok, err := p.resolves(ctx, u.Path)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			broken = append(broken, link)
+		}
+	}
+
+	return broken, nil
+}
+
+// resolves returns true if path is a page on this site.
+func (p *Post) resolves(ctx context.Context, path string) (bool, error) {
+	path = NormalizeRedirectPath(path)
+	if path == "/" || strings.HasPrefix(path, "/tags/") {
+		return true, nil
+	}
+
+	if m := postPathRegex.FindStringSubmatch(path); m != nil {
+		if m[1] == p.ID {
+			return true, nil
+		}
+
+		var exists bool
+		err := db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM posts WHERE id = $1 AND site_id = $2 AND draft = false)", m[1], siteID(ctx)).Scan(&exists)
+		if err != nil || exists {
+			return exists, err
+		}
+	}
+
+	var exists bool
+	err := db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM redirects WHERE from_path = $1)", path).Scan(&exists)
+	return exists, err
+}
+
+// checkLinks applies the link check policy to a post that is about to be
+// saved as published.
+func (p *Post) checkLinks(ctx context.Context) error {
+	if linkCheckPolicy == LinkCheckOff {
+		return nil
+	}
+
+	broken, err := p.BrokenLinks(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(broken) == 0 {
+		return nil
+	}
+
+	if linkCheckPolicy == LinkCheckStrict {
+		return fmt.Errorf("Post has broken internal links: %s", strings.Join(broken, ", "))
+	}
+
+	for _, link := range broken {
+		addWarning(ctx, fmt.Sprintf("Post %s has a broken internal link: %s", p.ID, link))
+	}
+
+	return nil
+}
+
+// addWarning adds a warning to the warnings extension of the GraphQL
+// response. Outside of GraphQL, warnings are logged.
+func addWarning(ctx context.Context, msg string) {
+	rctx := graphql.GetRequestContext(ctx)
+	if rctx == nil {
+		log.Printf("warning: %s", msg)
+		return
+	}
+
+	warningsMu.Lock()
+	defer warningsMu.Unlock()
+
+	warnings, _ := rctx.Extensions["warnings"].([]string)
+	if warnings == nil {
+		rctx.RegisterExtension("warnings", []string{msg})
+		return
+	}
+	rctx.Extensions["warnings"] = append(warnings, msg)
+}
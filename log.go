@@ -0,0 +1,191 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+)
+
+// MaxLogLength is the longest a log's content can be, in characters.
+const MaxLogLength = 500
+
+// Log is a short, private journal entry, optionally with where it was
+// written. Logs shared with a group are visible to its members.
+type Log struct {
+	ID       string    `json:"id"`
+	UserID   string    `json:"user_id"`
+	GroupID  *string   `json:"group_id"`
+	Content  string    `json:"content"`
+	Location *Geo      `json:"location"`
+	Datetime time.Time `json:"datetime"`
+	Created  time.Time `json:"created"`
+	Modified time.Time `json:"modified"`
+}
+
+// Geo is a point on Earth, in degrees.
+type Geo struct {
+	Lat  float64 `json:"lat"`
+	Long float64 `json:"long"`
+}
+
+const logColumns = "id, user_id, group_id, content, lat, long, datetime, created_at, modified_at"
+
+func scanLog(row interface {
+	Scan(dest ...interface{}) error
+}) (*Log, error) {
+	l := new(Log)
+	var groupID sql.NullString
+	var lat, long sql.NullFloat64
+	if err := row.Scan(&l.ID, &l.UserID, &groupID, &l.Content, &lat, &long, &l.Datetime, &l.Created, &l.Modified); err != nil {
+		return nil, err
+	}
+	if groupID.Valid {
+		l.GroupID = &groupID.String
+	}
+	if lat.Valid && long.Valid {
+		l.Location = &Geo{Lat: lat.Float64, Long: long.Float64}
+	}
+
+	return l, nil
+}
+
+// InsertLog stores a new log for the user. Datetime defaults to now. The log
+// is shared with input's group, if the user is a member.
+func InsertLog(ctx context.Context, u *User, input NewLog) (*Log, error) {
+	content := strings.TrimSpace(input.Content)
+	if content == "" {
+		return nil, Validation("content", "Content is required")
+	}
+	if len([]rune(content)) > MaxLogLength {
+		return nil, Validation("content", "Content must be at most %d characters", MaxLogLength)
+	}
+
+	var lat, long interface{}
+	if input.Location != nil {
+		if input.Location.Lat < -90 || input.Location.Lat > 90 || input.Location.Long < -180 || input.Location.Long > 180 {
+			return nil, Validation("location", "Latitude must be from -90 to 90, and longitude from -180 to 180")
+		}
+		lat, long = input.Location.Lat, input.Location.Long
+	}
+
+	if input.GroupID != nil {
+		if err := checkGroupMember(ctx, *input.GroupID, u); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	datetime := now
+	if input.Datetime != nil {
+		datetime = *input.Datetime
+	}
+
+	row := db.QueryRowContext(ctx, "INSERT INTO logs (user_id, group_id, content, lat, long, datetime, created_at, modified_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $7) RETURNING "+logColumns, u.ID, input.GroupID, content, lat, long, datetime, now)
+	return scanLog(row)
+}
+
+// GetLog returns one of the user's logs.
+func GetLog(ctx context.Context, u *User, id string) (*Log, error) {
+	row := db.QueryRowContext(ctx, "SELECT "+logColumns+" FROM logs WHERE id = $1 AND user_id = $2", id, u.ID)
+	l, err := scanLog(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, NotFound("No log with id %s", id)
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return l, nil
+	}
+}
+
+// Share shares the log with a group the user is a member of, or makes it
+// private again if groupID is nil.
+func (l *Log) Share(ctx context.Context, u *User, groupID *string) error {
+	if groupID != nil {
+		if err := checkGroupMember(ctx, *groupID, u); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	if _, err := db.ExecContext(ctx, "UPDATE logs SET group_id = $2, modified_at = $3 WHERE id = $1", l.ID, groupID, now); err != nil {
+		return err
+	}
+
+	l.GroupID, l.Modified = groupID, now
+	return nil
+}
+
+// UserLogs returns the user's logs written between from and to, newest first.
+func UserLogs(ctx context.Context, u *User, from, to time.Time) ([]*Log, error) {
+	rows, err := db.QueryContext(ctx, "SELECT "+logColumns+" FROM logs WHERE user_id = $1 AND datetime >= $2 AND datetime <= $3 ORDER BY datetime DESC", u.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectLogs(rows)
+}
+
+// SharedLogs returns the logs other members of the user's groups have shared
+// with them, written between from and to, newest first.
+func SharedLogs(ctx context.Context, u *User, from, to time.Time) ([]*Log, error) {
+	rows, err := db.QueryContext(ctx, `
+SELECT `+logColumns+` FROM logs
+WHERE group_id IN (SELECT group_id FROM group_members WHERE user_id = $1)
+AND user_id <> $1 AND datetime >= $2 AND datetime <= $3
+ORDER BY datetime DESC`, u.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectLogs(rows)
+}
+
+// collectLogs scans and closes rows of logs.
+func collectLogs(rows *sql.Rows) ([]*Log, error) {
+	defer rows.Close()
+
+	logs := make([]*Log, 0)
+	for rows.Next() {
+		l, err := scanLog(rows)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+// LogsGeoJSON returns the logs that have a location as a GeoJSON feature
+// collection of points, for mapping. See RFC 7946.
+func LogsGeoJSON(logs []*Log) map[string]interface{} {
+	features := make([]map[string]interface{}, 0, len(logs))
+	for _, l := range logs {
+		if l.Location == nil {
+			continue
+		}
+
+		features = append(features, map[string]interface{}{
+			"type": "Feature",
+			"id":   l.ID,
+			"geometry": map[string]interface{}{
+				"type":        "Point",
+				"coordinates": []float64{l.Location.Long, l.Location.Lat},
+			},
+			"properties": map[string]interface{}{
+				"content":  l.Content,
+				"datetime": l.Datetime,
+			},
+		})
+	}
+
+	return map[string]interface{}{
+		"type":     "FeatureCollection",
+		"features": features,
+	}
+}
@@ -1,11 +1,25 @@
 package graphql
 
 import (
+	"bytes"
+	"fmt"
 	"html/template"
+	"log"
 	"regexp"
 	"strings"
+	"sync"
 
-	"github.com/russross/blackfriday"
+	"github.com/alecthomas/chroma/v2"
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
 )
 
 var (
@@ -14,15 +28,104 @@ var (
 
 	// TwitterHandleRegex is a regex for finding @username in Markdown.
 	TwitterHandleRegex = regexp.MustCompile(`(\s)@([_A-Za-z0-9]+)`)
+
+	// ImageRegex is a regex for finding image URLs in Markdown and HTML.
+	ImageRegex = regexp.MustCompile(`!\[[^\]]*\]\(<?([^)\s>]+)|<img[^>]+src=["']([^"']+)`)
+
+	// DefaultMarkdownExtensions are the extensions enabled if
+	// ConfigureMarkdown is never called.
+	DefaultMarkdownExtensions = []string{"gfm", "footnote", "highlight", "typographer"}
+
+	markdownMu     sync.RWMutex
+	markdownEngine = newMarkdownEngine(DefaultMarkdownExtensions)
+	markdownCache  *lru.Cache
+
+	// sanitizer strips anything unsafe from rendered HTML, while keeping the
+	// classes and ids that highlighting and footnotes need.
+	sanitizer = func() *bluemonday.Policy {
+		p := bluemonday.UGCPolicy()
+		p.AllowAttrs("class").Matching(regexp.MustCompile(`^[\w\- ]+$`)).OnElements("span", "pre", "code", "div", "a", "sup", "hr", "li", "ol")
+		p.AllowAttrs("id").Matching(regexp.MustCompile(`^[\w\-:]+$`)).OnElements("sup", "li", "div")
+		p.AllowAttrs("role").Matching(regexp.MustCompile(`^doc-[a-z]+$`)).OnElements("a", "sup", "div", "section")
+		return p
+	}()
 )
 
+func init() {
+	var err error
+	markdownCache, err = lru.New(512)
+	if err != nil {
+		log.Panicf("Could not create markdown cache: %+v", err)
+	}
+}
+
+// ConfigureMarkdown sets which extensions are used when rendering Markdown.
+// Valid extensions are gfm, footnote, highlight and typographer. Changing the
+// extensions empties the render cache.
+func ConfigureMarkdown(extensions []string) error {
+	for _, e := range extensions {
+		switch e {
+		case "gfm", "footnote", "highlight", "typographer":
+		default:
+			return fmt.Errorf("Unknown markdown extension %q", e)
+		}
+	}
+
+	markdownMu.Lock()
+	defer markdownMu.Unlock()
+	markdownEngine = newMarkdownEngine(extensions)
+	markdownCache.Purge()
+
+	return nil
+}
+
+func newMarkdownEngine(extensions []string) goldmark.Markdown {
+	opts := []goldmark.Extender{}
+	for _, e := range extensions {
+		switch e {
+		case "gfm":
+			opts = append(opts, extension.GFM)
+		case "footnote":
+			opts = append(opts, extension.Footnote)
+		case "highlight":
+			opts = append(opts, &highlighter{style: "github"})
+		case "typographer":
+			opts = append(opts, extension.Typographer)
+		}
+	}
+
+	return goldmark.New(goldmark.WithExtensions(opts...))
+}
+
 // Markdown generator.
 func Markdown(str string) template.HTML {
 	inc := []byte(str)
 	inc = twitterHandleToMarkdown(inc)
 	inc = hashTagsToMarkdown(inc)
-	s := blackfriday.Run(inc)
-	return template.HTML(s)
+
+	markdownMu.RLock()
+	md := markdownEngine
+	markdownMu.RUnlock()
+
+	var buf bytes.Buffer
+	if err := md.Convert(inc, &buf); err != nil {
+		log.Printf("Error rendering markdown: %+v", err)
+		return template.HTML(template.HTMLEscapeString(str))
+	}
+
+	return template.HTML(renderHooks(string(sanitizer.SanitizeBytes(buf.Bytes()))))
+}
+
+// CachedMarkdown renders Markdown, reusing a previous render for the same key.
+// Keys should change whenever the content does.
+func CachedMarkdown(key string, str string) template.HTML {
+	if html, ok := markdownCache.Get(key); ok {
+		return html.(template.HTML)
+	}
+
+	html := Markdown(str)
+	markdownCache.Add(key, html)
+	return html
 }
 
 // SummarizeText takes a chunk of markdown and just returns the first paragraph.
@@ -38,3 +141,49 @@ func twitterHandleToMarkdown(in []byte) []byte {
 func hashTagsToMarkdown(in []byte) []byte {
 	return HashtagRegex.ReplaceAll(in, []byte("$1[#$2](/tags/$2)"))
 }
+
+// highlighter is a goldmark extension that syntax highlights fenced code
+// blocks with chroma, using CSS classes rather than inline styles.
+type highlighter struct {
+	style string
+}
+
+func (h *highlighter) Extend(m goldmark.Markdown) {
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(util.Prioritized(h, 100)))
+}
+
+func (h *highlighter) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindFencedCodeBlock, h.renderFencedCodeBlock)
+}
+
+func (h *highlighter) renderFencedCodeBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	n := node.(*ast.FencedCodeBlock)
+	var code bytes.Buffer
+	for i := 0; i < n.Lines().Len(); i++ {
+		line := n.Lines().At(i)
+		code.Write(line.Value(source))
+	}
+
+	lexer := lexers.Fallback
+	if lang := n.Language(source); lang != nil {
+		if l := lexers.Get(string(lang)); l != nil {
+			lexer = l
+		}
+	}
+
+	iterator, err := chroma.Coalesce(lexer).Tokenise(nil, code.String())
+	if err != nil {
+		return ast.WalkStop, err
+	}
+
+	formatter := chromahtml.New(chromahtml.WithClasses(true))
+	if err := formatter.Format(w, styles.Get(h.style), iterator); err != nil {
+		return ast.WalkStop, err
+	}
+
+	return ast.WalkSkipChildren, nil
+}
@@ -0,0 +1,192 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// metadataKeyRegex is what metadata key names look like.
+var metadataKeyRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// metadataKeys are the keys posts can have metadata for. Keys are registered
+// with RegisterMetadataKey, so new ones don't need a migration.
+var metadataKeys = map[string]MetadataKey{
+	"slides_url": {Name: "slides_url", Type: MetadataTypeUrl, Description: "The slides of a talk."},
+	"video_url":  {Name: "video_url", Type: MetadataTypeUrl, Description: "The video of a talk."},
+}
+
+// MetadataKey is a key posts can have metadata for, and the type of its
+// values.
+type MetadataKey struct {
+	Name        string       `json:"name"`
+	Type        MetadataType `json:"type"`
+	Description string       `json:"description"`
+}
+
+// MetadataEntry is a key and value in a post's metadata. Values are stored as
+// strings, in the canonical form of their key's type.
+type MetadataEntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// RegisterMetadataKey lets posts have metadata for a key, replacing any key
+// with the same name. It should be called before serving.
+func RegisterMetadataKey(name string, typ MetadataType, description string) error {
+	if !metadataKeyRegex.MatchString(name) {
+		return fmt.Errorf("%q is not a valid metadata key, which are lower case letters, numbers and underscores", name)
+	}
+
+	if !typ.IsValid() {
+		return fmt.Errorf("%q is not a valid metadata type", typ)
+	}
+
+	metadataKeys[name] = MetadataKey{Name: name, Type: typ, Description: description}
+	return nil
+}
+
+// MetadataKeys returns the keys posts can have metadata for, by name.
+func MetadataKeys() []MetadataKey {
+	keys := make([]MetadataKey, 0, len(metadataKeys))
+	for _, k := range metadataKeys {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name < keys[j].Name })
+
+	return keys
+}
+
+// normalizeMetadata checks that value is valid for the key's type, and
+// returns it in the type's canonical form.
+func normalizeMetadata(key, value string) (string, error) {
+	k, ok := metadataKeys[key]
+	if !ok {
+		return "", Validation("metadata", "%q is not a metadata key", key)
+	}
+
+	switch k.Type {
+	case MetadataTypeUrl:
+		if err := validLinkURL(value); err != nil {
+			return "", Validation("metadata", "%s must be an http or https URL", key)
+		}
+	case MetadataTypeInt:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return "", Validation("metadata", "%s must be a whole number", key)
+		}
+		value = strconv.FormatInt(i, 10)
+	case MetadataTypeBool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", Validation("metadata", "%s must be true or false", key)
+		}
+		value = strconv.FormatBool(b)
+	case MetadataTypeTime:
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return "", Validation("metadata", "%s must be an RFC 3339 time", key)
+		}
+		value = t.UTC().Format(time.RFC3339)
+	}
+
+	return value, nil
+}
+
+// MetadataEntries returns the post's metadata, by key.
+func (p *Post) MetadataEntries(ctx context.Context) ([]MetadataEntry, error) {
+	rows, err := db.QueryContext(ctx, "SELECT key, value FROM post_metadata WHERE post_id = $1 ORDER BY key", p.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]MetadataEntry, 0)
+	for rows.Next() {
+		var e MetadataEntry
+		if err := rows.Scan(&e.Key, &e.Value); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// SetMetadata sets keys of the post's metadata, leaving other keys alone.
+// Empty values remove their key. Nothing is changed unless every entry is
+// valid.
+func (p *Post) SetMetadata(ctx context.Context, entries []MetadataInput) error {
+	values := map[string]string{}
+	for _, e := range entries {
+		v := e.Value
+		if v != "" {
+			var err error
+			if v, err = normalizeMetadata(e.Key, v); err != nil {
+				return err
+			}
+		}
+		values[e.Key] = v
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for k, v := range values {
+		if v == "" {
+			_, err = tx.ExecContext(ctx, "DELETE FROM post_metadata WHERE post_id = $1 AND key = $2", p.ID, k)
+		} else {
+			_, err = tx.ExecContext(ctx, `
+INSERT INTO post_metadata (post_id, key, value)
+VALUES ($1, $2, $3)
+ON CONFLICT (post_id, key) DO UPDATE SET value = $3
+`, p.ID, k, v)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	if !p.Draft {
+		purgeCache(ctx, p.purgePaths()...)
+	}
+	return nil
+}
+
+// allPostMetadata returns the metadata of every post that has any, by post
+// ID.
+func allPostMetadata(ctx context.Context) (map[string][]MetadataEntry, error) {
+	rows, err := db.QueryContext(ctx, "SELECT post_id, key, value FROM post_metadata ORDER BY post_id, key")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	metadata := map[string][]MetadataEntry{}
+	for rows.Next() {
+		var id string
+		var e MetadataEntry
+		if err := rows.Scan(&id, &e.Key, &e.Value); err != nil {
+			return nil, err
+		}
+		metadata[id] = append(metadata[id], e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
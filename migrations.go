@@ -0,0 +1,225 @@
+package graphql
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GuiaBolso/darwin"
+)
+
+// Migrations live in migrations/ as NNNN_description.up.sql and
+// NNNN_description.down.sql, with dashes for spaces in the description.
+// darwin checksums the up scripts, so never edit one that has been applied,
+// not even its whitespace. Add a new migration instead.
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migration is a darwin migration plus the script that undoes it.
+type migration struct {
+	darwin.Migration
+	Down string
+}
+
+// MigrationInfo describes one migration and whether it has been applied.
+type MigrationInfo struct {
+	Version     int
+	Description string
+	Applied     *time.Time
+}
+
+// loadMigrations reads the embedded migrations, ordered by version.
+func loadMigrations() ([]migration, error) {
+	names, err := migrationFiles.ReadDir("migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int]*migration{}
+	for _, f := range names {
+		name := f.Name()
+		var direction string
+		switch {
+		case strings.HasSuffix(name, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(name, ".down.sql"):
+			direction = "down"
+		default:
+			return nil, fmt.Errorf("Unexpected migration file %s", name)
+		}
+
+		parts := strings.SplitN(strings.TrimSuffix(name, "."+direction+".sql"), "_", 2)
+		version, err := strconv.Atoi(parts[0])
+		if err != nil || len(parts) != 2 {
+			return nil, fmt.Errorf("Migration file %s should be named NNNN_description.%s.sql", name, direction)
+		}
+
+		script, err := migrationFiles.ReadFile(path.Join("migrations", name))
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			desc := strings.Replace(parts[1], "-", " ", -1)
+			m = &migration{}
+			m.Version = float64(version)
+			m.Description = strings.ToUpper(desc[:1]) + desc[1:]
+			byVersion[version] = m
+		}
+
+		if direction == "up" {
+			m.Script = string(script)
+		} else {
+			m.Down = string(script)
+		}
+	}
+
+	migrations := make([]migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Script == "" || m.Down == "" {
+			return nil, fmt.Errorf("Migration %d needs both an up and a down script", int(m.Version))
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+func darwinMigrations(migrations []migration) []darwin.Migration {
+	dm := make([]darwin.Migration, len(migrations))
+	for i, m := range migrations {
+		dm[i] = m.Migration
+	}
+
+	return dm
+}
+
+// Migrations returns every migration and when it was applied.
+func Migrations() ([]*MigrationInfo, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	driver := darwin.NewGenericDriver(db.DB, darwin.PostgresDialect{})
+	if err := driver.Create(); err != nil {
+		return nil, err
+	}
+
+	records, err := driver.All()
+	if err != nil {
+		return nil, err
+	}
+
+	applied := map[float64]time.Time{}
+	for _, r := range records {
+		applied[r.Version] = r.AppliedAt
+	}
+
+	infos := make([]*MigrationInfo, 0, len(migrations))
+	for _, m := range migrations {
+		info := &MigrationInfo{Version: int(m.Version), Description: m.Description}
+		if t, ok := applied[m.Version]; ok {
+			info.Applied = &t
+		}
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
+// MigrateUp applies all pending migrations.
+func MigrateUp() error {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+
+	driver := darwin.NewGenericDriver(db.DB, darwin.PostgresDialect{})
+	return darwin.New(driver, darwinMigrations(migrations), nil).Migrate()
+}
+
+// MigrateDown undoes the last steps applied migrations, newest first.
+func MigrateDown(ctx context.Context, steps int) error {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+
+	infos, err := Migrations()
+	if err != nil {
+		return err
+	}
+
+	for i := len(migrations) - 1; i >= 0 && steps > 0; i-- {
+		if infos[i].Applied == nil {
+			continue
+		}
+
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, migrations[i].Down); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("Error undoing migration %d: %+v", infos[i].Version, err)
+		}
+
+		if _, err := tx.ExecContext(ctx, "DELETE FROM darwin_migrations WHERE version = $1", migrations[i].Version); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+		steps--
+	}
+
+	return nil
+}
+
+// CheckMigrations returns an error if the database has pending migrations,
+// or if an applied migration has been changed or removed.
+func CheckMigrations() error {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+
+	driver := darwin.NewGenericDriver(db.DB, darwin.PostgresDialect{})
+	if err := driver.Create(); err != nil {
+		return err
+	}
+
+	if err := darwin.Validate(driver, darwinMigrations(migrations)); err != nil {
+		return err
+	}
+
+	infos, err := Migrations()
+	if err != nil {
+		return err
+	}
+
+	pending := 0
+	for _, info := range infos {
+		if info.Applied == nil {
+			pending++
+		}
+	}
+
+	if pending > 0 {
+		return fmt.Errorf("Database is %d migrations behind", pending)
+	}
+
+	return nil
+}
@@ -0,0 +1,12 @@
+
+      CREATE TABLE posts (
+        id serial primary key,
+        title text,
+        content text,
+        date timestamp with time zone,
+        tags text[],
+        draft boolean,
+        created_at timestamp with time zone,
+        modified_at timestamp with time zone
+      );
+      
\ No newline at end of file
@@ -0,0 +1,9 @@
+
+      CREATE TABLE stats (
+        id serial primary key,
+        key text,
+        value text,
+        created_at timestamp with time zone,
+        modified_at timestamp with time zone
+      );
+      
\ No newline at end of file
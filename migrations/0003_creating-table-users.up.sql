@@ -0,0 +1,8 @@
+
+      CREATE TABLE users(
+        id serial primary key,
+        role text,
+        created_at timestamp with time zone,
+        modified_at timestamp with time zone
+      );
+      
\ No newline at end of file
@@ -0,0 +1,7 @@
+DROP TABLE users;
+CREATE TABLE users(
+  id serial primary key,
+  role text,
+  created_at timestamp with time zone,
+  modified_at timestamp with time zone
+);
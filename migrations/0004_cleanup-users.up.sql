@@ -0,0 +1,10 @@
+
+      DROP TABLE IF EXISTS users;
+      DROP TABLE IF EXISTS auth_identities;
+      CREATE TABLE users(
+        id text primary key,
+        role text,
+        created_at timestamp with time zone,
+        modified_at timestamp with time zone
+      );
+      
\ No newline at end of file
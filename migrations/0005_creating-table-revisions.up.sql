@@ -0,0 +1,14 @@
+
+      CREATE TABLE revisions(
+        id serial primary key,
+        post_id integer references posts(id),
+        revision integer,
+        title text,
+        content text,
+        date timestamp with time zone,
+        draft boolean,
+        tags text[],
+        created_at timestamp with time zone,
+        UNIQUE (post_id, revision)
+      );
+      
\ No newline at end of file
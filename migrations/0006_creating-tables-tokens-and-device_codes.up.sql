@@ -0,0 +1,14 @@
+
+      CREATE TABLE tokens(
+        token text primary key,
+        user_id text references users(id),
+        created_at timestamp with time zone
+      );
+      CREATE TABLE device_codes(
+        device_code text primary key,
+        user_code text unique,
+        user_id text references users(id),
+        expires_at timestamp with time zone,
+        created_at timestamp with time zone
+      );
+      
\ No newline at end of file
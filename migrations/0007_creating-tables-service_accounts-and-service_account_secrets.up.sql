@@ -0,0 +1,17 @@
+
+      CREATE TABLE service_accounts(
+        id serial primary key,
+        name text unique,
+        scopes text[],
+        expires_at timestamp with time zone,
+        created_at timestamp with time zone,
+        modified_at timestamp with time zone
+      );
+      CREATE TABLE service_account_secrets(
+        id serial primary key,
+        service_account_id integer references service_accounts(id),
+        secret_hash text unique,
+        last_used_at timestamp with time zone,
+        created_at timestamp with time zone
+      );
+      
\ No newline at end of file
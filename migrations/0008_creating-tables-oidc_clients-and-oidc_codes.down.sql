@@ -0,0 +1,2 @@
+DROP TABLE oidc_codes;
+DROP TABLE oidc_clients;
@@ -0,0 +1,18 @@
+
+      CREATE TABLE oidc_clients(
+        id text primary key,
+        name text,
+        redirect_uris text[],
+        secret_hash text,
+        created_at timestamp with time zone
+      );
+      CREATE TABLE oidc_codes(
+        code text primary key,
+        client_id text references oidc_clients(id),
+        user_id text references users(id),
+        redirect_uri text,
+        nonce text,
+        expires_at timestamp with time zone,
+        created_at timestamp with time zone
+      );
+      
\ No newline at end of file
@@ -0,0 +1,3 @@
+
+      ALTER TABLE users ADD COLUMN deactivated_at timestamp with time zone;
+      
\ No newline at end of file
@@ -0,0 +1,8 @@
+
+      CREATE TABLE invites(
+        code text primary key,
+        used_by text references users(id),
+        used_at timestamp with time zone,
+        created_at timestamp with time zone
+      );
+      
\ No newline at end of file
@@ -0,0 +1,7 @@
+
+      CREATE TABLE settings(
+        key text primary key,
+        value text,
+        modified_at timestamp with time zone
+      );
+      
\ No newline at end of file
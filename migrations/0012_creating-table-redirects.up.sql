@@ -0,0 +1,10 @@
+CREATE TABLE redirects(
+  id serial primary key,
+  from_path text unique,
+  to_url text,
+  status integer,
+  hits bigint default 0,
+  last_hit_at timestamp with time zone,
+  created_at timestamp with time zone,
+  modified_at timestamp with time zone
+);
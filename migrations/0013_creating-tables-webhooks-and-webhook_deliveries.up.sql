@@ -0,0 +1,21 @@
+CREATE TABLE webhooks(
+  id serial primary key,
+  url text,
+  events text[],
+  secret text,
+  created_at timestamp with time zone,
+  modified_at timestamp with time zone
+);
+CREATE TABLE webhook_deliveries(
+  id serial primary key,
+  webhook_id integer references webhooks(id) on delete cascade,
+  event text,
+  payload text,
+  attempts integer default 0,
+  status_code integer,
+  error text,
+  next_attempt_at timestamp with time zone,
+  delivered_at timestamp with time zone,
+  created_at timestamp with time zone
+);
+CREATE INDEX webhook_deliveries_pending ON webhook_deliveries (next_attempt_at) WHERE delivered_at IS NULL;
@@ -0,0 +1,12 @@
+CREATE TABLE syndications(
+  id serial primary key,
+  post_id integer references posts(id),
+  service text,
+  url text,
+  attempts integer default 0,
+  error text,
+  next_attempt_at timestamp with time zone,
+  syndicated_at timestamp with time zone,
+  created_at timestamp with time zone,
+  UNIQUE (post_id, service)
+);
@@ -0,0 +1,9 @@
+CREATE TABLE notification_settings(
+  user_id text primary key references users(id),
+  email text,
+  comments boolean default true,
+  digest boolean default false,
+  auth_alerts boolean default true,
+  digest_sent_at timestamp with time zone,
+  modified_at timestamp with time zone
+);
@@ -0,0 +1,2 @@
+DROP TABLE page_view_days;
+DROP TABLE page_views;
@@ -0,0 +1,18 @@
+CREATE TABLE page_views(
+  id bigserial primary key,
+  path text,
+  referrer text,
+  visitor text,
+  created_at timestamp with time zone
+);
+
+CREATE INDEX page_views_created_at_idx ON page_views (created_at);
+
+CREATE TABLE page_view_days(
+  day date,
+  path text,
+  referrer text,
+  views integer,
+  visitors integer,
+  PRIMARY KEY (day, path, referrer)
+);
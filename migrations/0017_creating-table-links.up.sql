@@ -0,0 +1,13 @@
+CREATE TABLE links(
+  id serial primary key,
+  url text unique,
+  title text,
+  description text,
+  screenshot text,
+  tags text[],
+  status_code integer,
+  dead boolean default false,
+  checked_at timestamp with time zone,
+  created_at timestamp with time zone,
+  modified_at timestamp with time zone
+);
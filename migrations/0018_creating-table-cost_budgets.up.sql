@@ -0,0 +1,5 @@
+CREATE TABLE cost_budgets(
+  key text primary key,
+  window_start timestamp with time zone,
+  spent integer
+);
@@ -0,0 +1,8 @@
+CREATE TABLE short_links(
+  id serial primary key,
+  slug text unique,
+  url text,
+  clicks bigint default 0,
+  last_click_at timestamp with time zone,
+  created_at timestamp with time zone
+);
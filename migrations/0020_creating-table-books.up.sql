@@ -0,0 +1,16 @@
+CREATE TABLE books(
+  id serial primary key,
+  title text,
+  author text,
+  isbn text,
+  goodreads_id text unique,
+  shelf text,
+  rating integer,
+  pages integer,
+  started_at timestamp with time zone,
+  finished_at timestamp with time zone,
+  created_at timestamp with time zone,
+  modified_at timestamp with time zone
+);
+
+CREATE INDEX books_shelf_idx ON books (shelf);
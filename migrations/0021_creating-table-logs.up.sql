@@ -0,0 +1,12 @@
+CREATE TABLE logs(
+  id serial primary key,
+  user_id text references users(id) on delete cascade,
+  content text,
+  lat double precision,
+  long double precision,
+  datetime timestamp with time zone,
+  created_at timestamp with time zone,
+  modified_at timestamp with time zone
+);
+
+CREATE INDEX logs_user_id_datetime_idx ON logs (user_id, datetime);
@@ -0,0 +1,8 @@
+CREATE TABLE pages(
+  slug text primary key,
+  title text,
+  content text,
+  draft boolean default false,
+  created_at timestamp with time zone,
+  modified_at timestamp with time zone
+);
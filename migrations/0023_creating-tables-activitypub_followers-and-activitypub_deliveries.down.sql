@@ -0,0 +1,2 @@
+DROP TABLE activitypub_deliveries;
+DROP TABLE activitypub_followers;
@@ -0,0 +1,18 @@
+CREATE TABLE activitypub_followers(
+  actor text primary key,
+  inbox text,
+  shared_inbox text,
+  created_at timestamp with time zone
+);
+CREATE TABLE activitypub_deliveries(
+  id serial primary key,
+  inbox text,
+  activity text,
+  attempts integer default 0,
+  status_code integer,
+  error text,
+  next_attempt_at timestamp with time zone,
+  delivered_at timestamp with time zone,
+  created_at timestamp with time zone
+);
+CREATE INDEX activitypub_deliveries_pending ON activitypub_deliveries (next_attempt_at) WHERE delivered_at IS NULL;
@@ -0,0 +1,11 @@
+CREATE TABLE jobs(
+  id serial primary key,
+  kind text,
+  status text,
+  total integer default 0,
+  done integer default 0,
+  error text,
+  created_by text,
+  created_at timestamp with time zone,
+  finished_at timestamp with time zone
+);
@@ -0,0 +1,8 @@
+CREATE TABLE identities(
+  provider text,
+  provider_user_id text,
+  user_id text references users(id),
+  created_at timestamp with time zone,
+  PRIMARY KEY (provider, provider_user_id)
+);
+CREATE INDEX identities_user_id ON identities (user_id);
@@ -0,0 +1,22 @@
+CREATE TABLE groups(
+  id serial primary key,
+  name text,
+  owner_id text references users(id),
+  created_at timestamp with time zone,
+  modified_at timestamp with time zone
+);
+CREATE TABLE group_members(
+  group_id integer references groups(id) on delete cascade,
+  user_id text references users(id) on delete cascade,
+  created_at timestamp with time zone,
+  PRIMARY KEY (group_id, user_id)
+);
+CREATE INDEX group_members_user_id ON group_members (user_id);
+CREATE TABLE group_invites(
+  code text primary key,
+  group_id integer references groups(id) on delete cascade,
+  created_by text,
+  created_at timestamp with time zone,
+  used_by text,
+  used_at timestamp with time zone
+);
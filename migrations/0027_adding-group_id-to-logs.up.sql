@@ -0,0 +1,2 @@
+ALTER TABLE logs ADD COLUMN group_id integer references groups(id) on delete set null;
+CREATE INDEX logs_group_id_datetime_idx ON logs (group_id, datetime) WHERE group_id IS NOT NULL;
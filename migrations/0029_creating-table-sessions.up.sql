@@ -0,0 +1,9 @@
+CREATE TABLE sessions(
+  id text primary key,
+  user_id text references users(id) on delete cascade,
+  user_agent text,
+  ip text,
+  created_at timestamp with time zone,
+  last_seen_at timestamp with time zone
+);
+CREATE INDEX sessions_user_id ON sessions (user_id);
@@ -0,0 +1,5 @@
+ALTER TABLE notification_settings DROP COLUMN quiet_end;
+ALTER TABLE notification_settings DROP COLUMN quiet_start;
+ALTER TABLE notification_settings DROP COLUMN timezone;
+ALTER TABLE notification_settings DROP COLUMN auth_alerts_delivery;
+ALTER TABLE notification_settings DROP COLUMN comments_delivery;
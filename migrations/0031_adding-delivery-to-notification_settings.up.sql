@@ -0,0 +1,5 @@
+ALTER TABLE notification_settings ADD COLUMN comments_delivery text NOT NULL DEFAULT 'immediate';
+ALTER TABLE notification_settings ADD COLUMN auth_alerts_delivery text NOT NULL DEFAULT 'immediate';
+ALTER TABLE notification_settings ADD COLUMN timezone text NOT NULL DEFAULT 'UTC';
+ALTER TABLE notification_settings ADD COLUMN quiet_start integer;
+ALTER TABLE notification_settings ADD COLUMN quiet_end integer;
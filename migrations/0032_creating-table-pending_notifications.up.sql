@@ -0,0 +1,9 @@
+CREATE TABLE pending_notifications(
+  id serial primary key,
+  user_id text references users(id) on delete cascade,
+  type text,
+  subject text,
+  body text,
+  created_at timestamp with time zone
+);
+CREATE INDEX pending_notifications_user_id ON pending_notifications (user_id);
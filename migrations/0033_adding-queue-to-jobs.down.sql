@@ -0,0 +1,4 @@
+DROP INDEX jobs_queued_run_at_idx;
+ALTER TABLE jobs DROP COLUMN run_at;
+ALTER TABLE jobs DROP COLUMN attempts;
+ALTER TABLE jobs DROP COLUMN payload;
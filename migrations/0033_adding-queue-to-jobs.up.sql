@@ -0,0 +1,4 @@
+ALTER TABLE jobs ADD COLUMN payload jsonb;
+ALTER TABLE jobs ADD COLUMN attempts integer NOT NULL DEFAULT 0;
+ALTER TABLE jobs ADD COLUMN run_at timestamp with time zone;
+CREATE INDEX jobs_queued_run_at_idx ON jobs (run_at) WHERE status = 'queued';
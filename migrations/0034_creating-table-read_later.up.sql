@@ -0,0 +1,9 @@
+CREATE TABLE read_later(
+  id serial primary key,
+  user_id text references users(id) on delete cascade,
+  url text,
+  post_id integer references posts(id) on delete cascade,
+  created_at timestamp with time zone
+);
+CREATE UNIQUE INDEX read_later_user_id_url ON read_later (user_id, url);
+CREATE UNIQUE INDEX read_later_user_id_post_id ON read_later (user_id, post_id);
@@ -0,0 +1 @@
+DROP TABLE reading_progress;
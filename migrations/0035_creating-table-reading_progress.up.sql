@@ -0,0 +1,7 @@
+CREATE TABLE reading_progress(
+  user_id text references users(id) on delete cascade,
+  post_id integer references posts(id) on delete cascade,
+  percent integer,
+  modified_at timestamp with time zone,
+  primary key (user_id, post_id)
+);
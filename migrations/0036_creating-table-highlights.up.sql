@@ -0,0 +1,15 @@
+CREATE TABLE highlights(
+  id serial primary key,
+  user_id text references users(id) on delete cascade,
+  post_id integer references posts(id) on delete cascade,
+  quote text,
+  prefix text,
+  suffix text,
+  start_offset integer,
+  note text,
+  public boolean default false,
+  created_at timestamp with time zone,
+  modified_at timestamp with time zone
+);
+CREATE INDEX highlights_post_id ON highlights (post_id);
+CREATE INDEX highlights_user_id ON highlights (user_id);
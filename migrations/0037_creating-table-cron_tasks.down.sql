@@ -0,0 +1 @@
+DROP TABLE cron_tasks;
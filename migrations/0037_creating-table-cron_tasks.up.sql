@@ -0,0 +1,6 @@
+CREATE TABLE cron_tasks(
+  name text primary key,
+  last_started_at timestamp with time zone,
+  last_finished_at timestamp with time zone,
+  last_error text
+);
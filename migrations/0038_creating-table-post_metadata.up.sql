@@ -0,0 +1,6 @@
+CREATE TABLE post_metadata(
+  post_id integer references posts(id) on delete cascade,
+  key text,
+  value text,
+  primary key (post_id, key)
+);
@@ -0,0 +1,14 @@
+DELETE FROM settings WHERE site_id <> 1;
+ALTER TABLE settings DROP CONSTRAINT settings_pkey;
+ALTER TABLE settings DROP COLUMN site_id;
+ALTER TABLE settings ADD PRIMARY KEY (key);
+
+DELETE FROM pages WHERE site_id <> 1;
+ALTER TABLE pages DROP CONSTRAINT pages_pkey;
+ALTER TABLE pages DROP COLUMN site_id;
+ALTER TABLE pages ADD PRIMARY KEY (slug);
+
+-- Posts of other sites are kept, on the one site that's left.
+ALTER TABLE posts DROP COLUMN site_id;
+
+DROP TABLE sites;
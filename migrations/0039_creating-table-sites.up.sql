@@ -0,0 +1,21 @@
+CREATE TABLE sites(
+  id serial primary key,
+  host text unique,
+  allowed_users text[] not null default '{}',
+  created_at timestamp with time zone
+);
+
+-- The default site has no host. It gets every request whose host isn't
+-- another site's, and everything from before there were sites.
+INSERT INTO sites (host, created_at) VALUES (NULL, now());
+
+ALTER TABLE posts ADD COLUMN site_id integer not null default 1 references sites(id);
+CREATE INDEX posts_site_id_date_idx ON posts (site_id, date);
+
+ALTER TABLE pages ADD COLUMN site_id integer not null default 1 references sites(id);
+ALTER TABLE pages DROP CONSTRAINT pages_pkey;
+ALTER TABLE pages ADD PRIMARY KEY (site_id, slug);
+
+ALTER TABLE settings ADD COLUMN site_id integer not null default 1 references sites(id);
+ALTER TABLE settings DROP CONSTRAINT settings_pkey;
+ALTER TABLE settings ADD PRIMARY KEY (site_id, key);
@@ -0,0 +1,3 @@
+INSERT INTO post_metadata (post_id, key, value) SELECT id, 'canonical_url', canonical_url FROM posts WHERE canonical_url IS NOT NULL;
+ALTER TABLE posts DROP COLUMN originally_published_at;
+ALTER TABLE posts DROP COLUMN canonical_url;
@@ -0,0 +1,6 @@
+ALTER TABLE posts ADD COLUMN canonical_url text;
+ALTER TABLE posts ADD COLUMN originally_published_at timestamp with time zone;
+
+-- Canonical URLs were metadata until they got their own column.
+UPDATE posts SET canonical_url = m.value FROM post_metadata m WHERE m.post_id = posts.id AND m.key = 'canonical_url';
+DELETE FROM post_metadata WHERE key = 'canonical_url';
@@ -0,0 +1,18 @@
+INSERT INTO post_metadata (post_id, key, value)
+SELECT id, 'license', CASE license
+    WHEN 'cc0_1_0' THEN 'CC0-1.0'
+    WHEN 'cc_by_4_0' THEN 'CC-BY-4.0'
+    WHEN 'cc_by_sa_4_0' THEN 'CC-BY-SA-4.0'
+    WHEN 'cc_by_nd_4_0' THEN 'CC-BY-ND-4.0'
+    WHEN 'cc_by_nc_4_0' THEN 'CC-BY-NC-4.0'
+    WHEN 'cc_by_nc_sa_4_0' THEN 'CC-BY-NC-SA-4.0'
+    WHEN 'cc_by_nc_nd_4_0' THEN 'CC-BY-NC-ND-4.0'
+    WHEN 'mit' THEN 'MIT'
+    WHEN 'apache_2_0' THEN 'Apache-2.0'
+    WHEN 'all_rights_reserved' THEN 'All rights reserved'
+    ELSE license_text
+  END
+FROM posts WHERE license IS NOT NULL;
+DELETE FROM settings WHERE key IN ('site_license', 'site_license_text');
+ALTER TABLE posts DROP COLUMN license_text;
+ALTER TABLE posts DROP COLUMN license;
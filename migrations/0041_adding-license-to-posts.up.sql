@@ -0,0 +1,32 @@
+ALTER TABLE posts ADD COLUMN license text;
+ALTER TABLE posts ADD COLUMN license_text text;
+
+-- Licenses were metadata until they got their own columns. Ones that aren't
+-- a known SPDX identifier become custom licenses.
+UPDATE posts SET
+  license = CASE upper(m.value)
+    WHEN 'CC0-1.0' THEN 'cc0_1_0'
+    WHEN 'CC-BY-4.0' THEN 'cc_by_4_0'
+    WHEN 'CC-BY-SA-4.0' THEN 'cc_by_sa_4_0'
+    WHEN 'CC-BY-ND-4.0' THEN 'cc_by_nd_4_0'
+    WHEN 'CC-BY-NC-4.0' THEN 'cc_by_nc_4_0'
+    WHEN 'CC-BY-NC-SA-4.0' THEN 'cc_by_nc_sa_4_0'
+    WHEN 'CC-BY-NC-ND-4.0' THEN 'cc_by_nc_nd_4_0'
+    WHEN 'MIT' THEN 'mit'
+    WHEN 'APACHE-2.0' THEN 'apache_2_0'
+    ELSE 'custom'
+  END,
+  license_text = CASE upper(m.value)
+    WHEN 'CC0-1.0' THEN NULL
+    WHEN 'CC-BY-4.0' THEN NULL
+    WHEN 'CC-BY-SA-4.0' THEN NULL
+    WHEN 'CC-BY-ND-4.0' THEN NULL
+    WHEN 'CC-BY-NC-4.0' THEN NULL
+    WHEN 'CC-BY-NC-SA-4.0' THEN NULL
+    WHEN 'CC-BY-NC-ND-4.0' THEN NULL
+    WHEN 'MIT' THEN NULL
+    WHEN 'APACHE-2.0' THEN NULL
+    ELSE m.value
+  END
+FROM post_metadata m WHERE m.post_id = posts.id AND m.key = 'license';
+DELETE FROM post_metadata WHERE key = 'license';
@@ -0,0 +1,2 @@
+DROP TABLE automation_rule_runs;
+DROP TABLE automation_rules;
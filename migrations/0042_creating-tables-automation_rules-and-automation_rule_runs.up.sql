@@ -0,0 +1,21 @@
+CREATE TABLE automation_rules(
+  id serial primary key,
+  site_id integer not null default 1 references sites(id),
+  name text,
+  event text,
+  condition text,
+  actions text[],
+  enabled boolean default true,
+  created_at timestamp with time zone,
+  modified_at timestamp with time zone
+);
+CREATE TABLE automation_rule_runs(
+  id serial primary key,
+  rule_id integer references automation_rules(id) on delete cascade,
+  subject text,
+  matched boolean,
+  actions text[],
+  error text,
+  created_at timestamp with time zone
+);
+CREATE INDEX automation_rule_runs_rule_id_created_at_idx ON automation_rule_runs (rule_id, created_at);
@@ -0,0 +1,2 @@
+ALTER TABLE webhook_deliveries DROP COLUMN request_id;
+ALTER TABLE jobs DROP COLUMN request_id;
@@ -0,0 +1,5 @@
+ALTER TABLE jobs ADD COLUMN request_id text;
+CREATE INDEX jobs_request_id_idx ON jobs (request_id) WHERE request_id IS NOT NULL;
+
+ALTER TABLE webhook_deliveries ADD COLUMN request_id text;
+CREATE INDEX webhook_deliveries_request_id_idx ON webhook_deliveries (request_id) WHERE request_id IS NOT NULL;
@@ -0,0 +1,9 @@
+CREATE TABLE audit_log(
+  id serial primary key,
+  user_id text references users(id) on delete set null,
+  action text,
+  detail text,
+  request_id text,
+  created_at timestamp with time zone
+);
+CREATE INDEX audit_log_created_at_idx ON audit_log (created_at);
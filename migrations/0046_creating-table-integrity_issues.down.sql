@@ -0,0 +1,3 @@
+ALTER TABLE notification_settings DROP COLUMN integrity_alerts;
+
+DROP TABLE integrity_issues;
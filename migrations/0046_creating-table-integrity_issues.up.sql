@@ -0,0 +1,10 @@
+CREATE TABLE integrity_issues(
+  id serial primary key,
+  check_name text,
+  subject text,
+  message text,
+  repaired boolean default false,
+  created_at timestamp with time zone
+);
+
+ALTER TABLE notification_settings ADD COLUMN integrity_alerts boolean NOT NULL DEFAULT true;
@@ -0,0 +1,3 @@
+-- Setup stays complete: a seeded setup_completed_at can't be told apart from
+-- one saved by /setup, and removing it would reopen setup.
+SELECT 1;
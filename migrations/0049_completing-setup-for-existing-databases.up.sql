@@ -0,0 +1,5 @@
+-- Databases from before setup already have users, so they don't need it.
+INSERT INTO settings (site_id, key, value, modified_at)
+  SELECT 1, 'setup_completed_at', now()::text, now()
+  WHERE EXISTS (SELECT 1 FROM users)
+  ON CONFLICT (site_id, key) DO NOTHING;
@@ -0,0 +1 @@
+ALTER TABLE device_codes DROP COLUMN last_polled_at, DROP COLUMN poll_interval;
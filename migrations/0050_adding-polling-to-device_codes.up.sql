@@ -0,0 +1 @@
+ALTER TABLE device_codes ADD COLUMN last_polled_at timestamp with time zone, ADD COLUMN poll_interval integer NOT NULL DEFAULT 5;
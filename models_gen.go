@@ -9,20 +9,81 @@ import (
 	time "time"
 )
 
+type AutomationRuleInput struct {
+	Name      string   `json:"name"`
+	Event     string   `json:"event"`
+	Condition string   `json:"condition"`
+	Actions   []string `json:"actions"`
+	Enabled   *bool    `json:"enabled"`
+}
+
 // Comment is an undefined type reserved for the future.
 type Comment struct {
 	ID string `json:"id"`
 }
 
-// A link is a link I have save on pinboard or a link in a post.
-type Link struct {
-	ID          string    `json:"id"`
-	Title       string    `json:"title"`
-	URI         string    `json:"uri"`
-	Created     time.Time `json:"created"`
-	Description string    `json:"description"`
-	Screenshot  string    `json:"screenshot"`
-	Tags        []string  `json:"tags"`
+// A data export is a tar.gz archive of the site's content, users and settings,
+// for backups. Import it with importData.
+type DataExport struct {
+	Filename string    `json:"filename"`
+	Archive  string    `json:"archive"`
+	Created  time.Time `json:"created"`
+}
+
+// A date range includes both the from and to days, in the request's time zone.
+type DateRange struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+type GeoInput struct {
+	Lat  float64 `json:"lat"`
+	Long float64 `json:"long"`
+}
+
+// A null id removes a license. Only custom licenses have text, which they need.
+type LicenseInput struct {
+	ID   *LicenseId `json:"id"`
+	Text *string    `json:"text"`
+}
+
+// Search matches the title, description and URL of links.
+type LinkFilter struct {
+	Tag    *string `json:"tag"`
+	Dead   *bool   `json:"dead"`
+	Search *string `json:"search"`
+}
+
+// An empty value removes the key from the post's metadata.
+type MetadataInput struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type NavItemInput struct {
+	Label string `json:"label"`
+	URL   string `json:"url"`
+}
+
+// Shelf defaults to to_read. Books added to the reading shelf are started now,
+// unless started is set.
+type NewBook struct {
+	Title   string     `json:"title"`
+	Author  string     `json:"author"`
+	Isbn    *string    `json:"isbn"`
+	Shelf   *Shelf     `json:"shelf"`
+	Pages   *int       `json:"pages"`
+	Started *time.Time `json:"started"`
+}
+
+// start and end are character offsets into the post's content. public defaults
+// to false.
+type NewHighlight struct {
+	PostID string  `json:"postId"`
+	Start  int     `json:"start"`
+	End    int     `json:"end"`
+	Note   *string `json:"note"`
+	Public *bool   `json:"public"`
 }
 
 type NewLink struct {
@@ -33,11 +94,41 @@ type NewLink struct {
 	Created     time.Time `json:"created"`
 }
 
+// Content can be at most 500 characters. Datetime defaults to now.
+type NewLog struct {
+	Content  string     `json:"content"`
+	Location *GeoInput  `json:"location"`
+	Datetime *time.Time `json:"datetime"`
+	GroupID  *string    `json:"groupId"`
+}
+
+type NewOIDCClient struct {
+	Name         string   `json:"name"`
+	RedirectURIs []string `json:"redirectURIs"`
+}
+
+// When editing, a null canonicalURL, originallyPublishedAt or license keeps the
+// post's current value, and an empty canonicalURL removes both.
 type NewPost struct {
-	Content  string    `json:"content"`
-	Title    string    `json:"title"`
-	Datetime time.Time `json:"datetime"`
-	Draft    bool      `json:"draft"`
+	Content               string        `json:"content"`
+	Title                 string        `json:"title"`
+	Datetime              time.Time     `json:"datetime"`
+	Draft                 bool          `json:"draft"`
+	CanonicalURL          *string       `json:"canonicalURL"`
+	OriginallyPublishedAt *time.Time    `json:"originallyPublishedAt"`
+	License               *LicenseInput `json:"license"`
+}
+
+type NewRedirect struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Status *int   `json:"status"`
+}
+
+type NewServiceAccount struct {
+	Name    string     `json:"name"`
+	Scopes  []string   `json:"scopes"`
+	Expires *time.Time `json:"expires"`
 }
 
 type NewStat struct {
@@ -45,12 +136,287 @@ type NewStat struct {
 	Value string `json:"value"`
 }
 
+type NewUser struct {
+	ID   string `json:"id"`
+	Role Role   `json:"role"`
+}
+
+type NewWebhook struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+}
+
+// Fields left out of notification settings input are not changed. Setting
+// quietStart and quietEnd to the same hour turns quiet hours off.
+type NotificationSettingsInput struct {
+	Email              *string               `json:"email"`
+	Comments           *bool                 `json:"comments"`
+	Digest             *bool                 `json:"digest"`
+	AuthAlerts         *bool                 `json:"authAlerts"`
+	IntegrityAlerts    *bool                 `json:"integrityAlerts"`
+	CommentsDelivery   *NotificationDelivery `json:"commentsDelivery"`
+	AuthAlertsDelivery *NotificationDelivery `json:"authAlertsDelivery"`
+	Timezone           *string               `json:"timezone"`
+	QuietStart         *int                  `json:"quietStart"`
+	QuietEnd           *int                  `json:"quietEnd"`
+}
+
+// OIDC client credentials are returned when a client is created. The secret can
+// not be retrieved again.
+type OIDCClientCredentials struct {
+	Client OIDCClient `json:"client"`
+	Secret string     `json:"secret"`
+}
+
+// Service account credentials are returned when a new secret is created. The
+// secret can not be retrieved again.
+type ServiceAccountCredentials struct {
+	ServiceAccount ServiceAccount `json:"serviceAccount"`
+	Secret         string         `json:"secret"`
+}
+
+// Fields left out of site settings input are not changed.
+type SiteSettingsInput struct {
+	Title        *string           `json:"title"`
+	Description  *string           `json:"description"`
+	URL          *string           `json:"url"`
+	FooterText   *string           `json:"footerText"`
+	PostsPerPage *int              `json:"postsPerPage"`
+	SocialLinks  []SocialLinkInput `json:"socialLinks"`
+	License      *LicenseInput     `json:"license"`
+}
+
+type SocialLinkInput struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
 // A stat is a key value pair of two interesting strings.
 type Stat struct {
 	Key   string `json:"key"`
 	Value string `json:"value"`
 }
 
+// Fields left out of theme input are not changed.
+type ThemeInput struct {
+	AccentColor *string        `json:"accentColor"`
+	LightImage  *string        `json:"lightImage"`
+	DarkImage   *string        `json:"darkImage"`
+	NavItems    []NavItemInput `json:"navItems"`
+}
+
+type UserFilter struct {
+	Role   *Role `json:"role"`
+	Active *bool `json:"active"`
+}
+
+// Webhook credentials are returned when a webhook is created. The secret can not
+// be retrieved again.
+type WebhookCredentials struct {
+	Webhook Webhook `json:"webhook"`
+	Secret  string  `json:"secret"`
+}
+
+// Queued jobs are queued until they run, and dead once they have failed too many
+// times. Dead jobs can be requeued.
+type JobStatus string
+
+const (
+	JobStatusQueued    JobStatus = "queued"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusDead      JobStatus = "dead"
+)
+
+func (e JobStatus) IsValid() bool {
+	switch e {
+	case JobStatusQueued, JobStatusRunning, JobStatusSucceeded, JobStatusFailed, JobStatusDead:
+		return true
+	}
+	return false
+}
+
+func (e JobStatus) String() string {
+	return string(e)
+}
+
+func (e *JobStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = JobStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid JobStatus", str)
+	}
+	return nil
+}
+
+func (e JobStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type LicenseId string
+
+const (
+	LicenseIdAllRightsReserved LicenseId = "all_rights_reserved"
+	LicenseIdCc010             LicenseId = "cc0_1_0"
+	LicenseIdCcBy40            LicenseId = "cc_by_4_0"
+	LicenseIdCcBySa40          LicenseId = "cc_by_sa_4_0"
+	LicenseIdCcByNd40          LicenseId = "cc_by_nd_4_0"
+	LicenseIdCcByNc40          LicenseId = "cc_by_nc_4_0"
+	LicenseIdCcByNcSa40        LicenseId = "cc_by_nc_sa_4_0"
+	LicenseIdCcByNcNd40        LicenseId = "cc_by_nc_nd_4_0"
+	LicenseIdMit               LicenseId = "mit"
+	LicenseIdApache20          LicenseId = "apache_2_0"
+	LicenseIdCustom            LicenseId = "custom"
+)
+
+func (e LicenseId) IsValid() bool {
+	switch e {
+	case LicenseIdAllRightsReserved, LicenseIdCc010, LicenseIdCcBy40, LicenseIdCcBySa40, LicenseIdCcByNd40, LicenseIdCcByNc40, LicenseIdCcByNcSa40, LicenseIdCcByNcNd40, LicenseIdMit, LicenseIdApache20, LicenseIdCustom:
+		return true
+	}
+	return false
+}
+
+func (e LicenseId) String() string {
+	return string(e)
+}
+
+func (e *LicenseId) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LicenseId(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LicenseId", str)
+	}
+	return nil
+}
+
+func (e LicenseId) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type MetadataType string
+
+const (
+	MetadataTypeString MetadataType = "string"
+	MetadataTypeUrl    MetadataType = "url"
+	MetadataTypeInt    MetadataType = "int"
+	MetadataTypeBool   MetadataType = "bool"
+	MetadataTypeTime   MetadataType = "time"
+)
+
+func (e MetadataType) IsValid() bool {
+	switch e {
+	case MetadataTypeString, MetadataTypeUrl, MetadataTypeInt, MetadataTypeBool, MetadataTypeTime:
+		return true
+	}
+	return false
+}
+
+func (e MetadataType) String() string {
+	return string(e)
+}
+
+func (e *MetadataType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MetadataType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MetadataType", str)
+	}
+	return nil
+}
+
+func (e MetadataType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// NotificationDelivery is how often a type of notification is sent. Hourly and
+// daily notifications are batched into one email.
+type NotificationDelivery string
+
+const (
+	NotificationDeliveryImmediate NotificationDelivery = "immediate"
+	NotificationDeliveryHourly    NotificationDelivery = "hourly"
+	NotificationDeliveryDaily     NotificationDelivery = "daily"
+)
+
+func (e NotificationDelivery) IsValid() bool {
+	switch e {
+	case NotificationDeliveryImmediate, NotificationDeliveryHourly, NotificationDeliveryDaily:
+		return true
+	}
+	return false
+}
+
+func (e NotificationDelivery) String() string {
+	return string(e)
+}
+
+func (e *NotificationDelivery) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationDelivery(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationDelivery", str)
+	}
+	return nil
+}
+
+func (e NotificationDelivery) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ReadLaterOrder string
+
+const (
+	ReadLaterOrderNewest ReadLaterOrder = "newest"
+	ReadLaterOrderOldest ReadLaterOrder = "oldest"
+)
+
+func (e ReadLaterOrder) IsValid() bool {
+	switch e {
+	case ReadLaterOrderNewest, ReadLaterOrderOldest:
+		return true
+	}
+	return false
+}
+
+func (e ReadLaterOrder) String() string {
+	return string(e)
+}
+
+func (e *ReadLaterOrder) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReadLaterOrder(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReadLaterOrder", str)
+	}
+	return nil
+}
+
+func (e ReadLaterOrder) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Role string
 
 const (
@@ -86,3 +452,40 @@ func (e *Role) UnmarshalGQL(v interface{}) error {
 func (e Role) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type Shelf string
+
+const (
+	ShelfToRead  Shelf = "to_read"
+	ShelfReading Shelf = "reading"
+	ShelfRead    Shelf = "read"
+)
+
+func (e Shelf) IsValid() bool {
+	switch e {
+	case ShelfToRead, ShelfReading, ShelfRead:
+		return true
+	}
+	return false
+}
+
+func (e Shelf) String() string {
+	return string(e)
+}
+
+func (e *Shelf) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Shelf(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Shelf", str)
+	}
+	return nil
+}
+
+func (e Shelf) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
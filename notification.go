@@ -0,0 +1,616 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// AuthFailureThreshold is how many failed authentications from one
+	// source, within AuthFailureWindow, alert admins.
+	AuthFailureThreshold = 10
+
+	// AuthFailureWindow is how far back failed authentications are counted.
+	AuthFailureWindow = 15 * time.Minute
+
+	// DigestInterval is how often digests are sent.
+	DigestInterval = 7 * 24 * time.Hour
+)
+
+// Notification types, which users choose a delivery for.
+const (
+	notificationComments   = "comments"
+	notificationAuthAlerts = "auth_alerts"
+)
+
+var (
+	authFailuresMu sync.Mutex
+	authFailures   = map[string][]time.Time{}
+	authAlerted    = map[string]time.Time{}
+)
+
+// NotificationSettings are what email a user gets, and where it goes.
+type NotificationSettings struct {
+	UserID             string               `json:"user_id"`
+	Email              *string              `json:"email"`
+	Comments           bool                 `json:"comments"`
+	CommentsDelivery   NotificationDelivery `json:"comments_delivery"`
+	Digest             bool                 `json:"digest"`
+	AuthAlerts         bool                 `json:"auth_alerts"`
+	AuthAlertsDelivery NotificationDelivery `json:"auth_alerts_delivery"`
+	IntegrityAlerts    bool                 `json:"integrity_alerts"`
+	Timezone           string               `json:"timezone"`
+	QuietStart         *int                 `json:"quiet_start"`
+	QuietEnd           *int                 `json:"quiet_end"`
+	DigestSent         *time.Time           `json:"digest_sent"`
+	Modified           time.Time            `json:"modified"`
+}
+
+// GetNotificationSettings returns the user's notification settings, or the
+// defaults if they have never been saved.
+func GetNotificationSettings(ctx context.Context, userID string) (*NotificationSettings, error) {
+	n := &NotificationSettings{
+		UserID:             userID,
+		Comments:           true,
+		CommentsDelivery:   NotificationDeliveryImmediate,
+		AuthAlerts:         true,
+		AuthAlertsDelivery: NotificationDeliveryImmediate,
+		IntegrityAlerts:    true,
+		Timezone:           "UTC",
+	}
+	var sent, modified pq.NullTime
+	var quietStart, quietEnd sql.NullInt64
+	err := db.QueryRowContext(ctx, "SELECT email, comments, comments_delivery, digest, auth_alerts, auth_alerts_delivery, integrity_alerts, timezone, quiet_start, quiet_end, digest_sent_at, modified_at FROM notification_settings WHERE user_id = $1", userID).Scan(&n.Email, &n.Comments, &n.CommentsDelivery, &n.Digest, &n.AuthAlerts, &n.AuthAlertsDelivery, &n.IntegrityAlerts, &n.Timezone, &quietStart, &quietEnd, &sent, &modified)
+	switch {
+	case err == sql.ErrNoRows:
+		return n, nil
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	}
+
+	if quietStart.Valid && quietEnd.Valid {
+		start, end := int(quietStart.Int64), int(quietEnd.Int64)
+		n.QuietStart, n.QuietEnd = &start, &end
+	}
+	n.DigestSent = nullTimePtr(sent)
+	n.Modified = modified.Time
+	return n, nil
+}
+
+// Validate checks that the settings make sense.
+func (n *NotificationSettings) Validate() error {
+	if n.Email != nil {
+		if _, err := mail.ParseAddress(*n.Email); err != nil {
+			return Validation("email", "Email is not a valid email address")
+		}
+	}
+
+	if !n.CommentsDelivery.IsValid() {
+		return Validation("commentsDelivery", "%q is not a valid delivery", n.CommentsDelivery)
+	}
+
+	if !n.AuthAlertsDelivery.IsValid() {
+		return Validation("authAlertsDelivery", "%q is not a valid delivery", n.AuthAlertsDelivery)
+	}
+
+	if _, err := time.LoadLocation(n.Timezone); err != nil || n.Timezone == "" {
+		return Validation("timezone", "%q is not a valid time zone", n.Timezone)
+	}
+
+	if (n.QuietStart == nil) != (n.QuietEnd == nil) {
+		return Validation("quietStart", "Quiet hours need a start and an end")
+	}
+
+	if n.QuietStart != nil && (*n.QuietStart < 0 || *n.QuietStart > 23) {
+		return Validation("quietStart", "Quiet hours start at an hour from 0 to 23")
+	}
+
+	if n.QuietEnd != nil && (*n.QuietEnd < 0 || *n.QuietEnd > 23) {
+		return Validation("quietEnd", "Quiet hours end at an hour from 0 to 23")
+	}
+
+	return nil
+}
+
+// delivery returns how notifications of type typ are delivered. Integrity
+// alerts are delivered like auth alerts.
+func (n *NotificationSettings) delivery(typ string) NotificationDelivery {
+	if typ == notificationComments {
+		return n.CommentsDelivery
+	}
+
+	return n.AuthAlertsDelivery
+}
+
+// quiet returns whether t is within the user's quiet hours, in their time
+// zone. Quiet hours can wrap past midnight, like 22 to 7.
+func (n *NotificationSettings) quiet(t time.Time) bool {
+	if n.QuietStart == nil || n.QuietEnd == nil || *n.QuietStart == *n.QuietEnd {
+		return false
+	}
+
+	if loc, err := time.LoadLocation(n.Timezone); err == nil {
+		t = t.In(loc)
+	}
+
+	h, start, end := t.Hour(), *n.QuietStart, *n.QuietEnd
+	if start < end {
+		return h >= start && h < end
+	}
+	return h >= start || h < end
+}
+
+// Save validates and stores the settings.
+func (n *NotificationSettings) Save(ctx context.Context) error {
+	if err := n.Validate(); err != nil {
+		return err
+	}
+
+	n.Modified = time.Now()
+	_, err := db.ExecContext(ctx, `
+INSERT INTO notification_settings (user_id, email, comments, comments_delivery, digest, auth_alerts, auth_alerts_delivery, integrity_alerts, timezone, quiet_start, quiet_end, modified_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+ON CONFLICT (user_id) DO UPDATE
+SET (email, comments, comments_delivery, digest, auth_alerts, auth_alerts_delivery, integrity_alerts, timezone, quiet_start, quiet_end, modified_at) = ($2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+`, n.UserID, n.Email, n.Comments, n.CommentsDelivery, n.Digest, n.AuthAlerts, n.AuthAlertsDelivery, n.IntegrityAlerts, n.Timezone, n.QuietStart, n.QuietEnd, n.Modified)
+	return err
+}
+
+// RememberEmail sets where a user's notifications go, unless they have
+// already chosen an address.
+func RememberEmail(ctx context.Context, userID, email string) error {
+	if email == "" {
+		return nil
+	}
+
+	_, err := db.ExecContext(ctx, `
+INSERT INTO notification_settings (user_id, email, modified_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id) DO UPDATE
+SET email = $2
+WHERE notification_settings.email IS NULL
+`, userID, email, time.Now())
+	return err
+}
+
+// notifiedAdmins returns the IDs of active admins who have turned on the
+// notification column.
+func notifiedAdmins(ctx context.Context, column string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(`
+SELECT n.user_id
+FROM notification_settings n
+JOIN users u ON u.id = n.user_id
+WHERE u.role = 'admin' AND u.deactivated_at IS NULL AND n.email IS NOT NULL AND n.%s
+`, column))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// notifyAdmins notifies every admin who gets notifications of type typ. It
+// tries them all, and returns the first error.
+func notifyAdmins(ctx context.Context, typ, name string, data interface{}) error {
+	ids, err := notifiedAdmins(ctx, typ)
+	if err != nil {
+		return err
+	}
+
+	var first error
+	for _, id := range ids {
+		n, err := GetNotificationSettings(ctx, id)
+		if err == nil {
+			err = notify(ctx, n, typ, name, data)
+		}
+		if err != nil && first == nil {
+			first = err
+		}
+	}
+
+	return first
+}
+
+// notify emails a user the named email, a notification of type typ. It is
+// sent now if the user wants typ immediately and it isn't their quiet hours.
+// Otherwise it waits in pending_notifications for the notification worker.
+func notify(ctx context.Context, n *NotificationSettings, typ, name string, data interface{}) error {
+	if mailer == nil || n.Email == nil {
+		return nil
+	}
+
+	now := time.Now()
+	if n.delivery(typ) == NotificationDeliveryImmediate && !n.quiet(now) {
+		return sendEmail(ctx, name, []string{*n.Email}, data)
+	}
+
+	e, err := RenderEmail(name, *n.Email, data)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecContext(ctx, "INSERT INTO pending_notifications (user_id, type, subject, body, created_at) VALUES ($1, $2, $3, $4, $5)", n.UserID, typ, e.Subject, e.Body, now)
+	return err
+}
+
+// NotifyComment emails admins about a new comment on a post. Posts don't
+// have authors, so admins get notified about every post.
+func NotifyComment(ctx context.Context, p *Post, author, text string) error {
+	link, err := p.Permalink(ctx)
+	if err != nil {
+		return err
+	}
+
+	return notifyAdmins(ctx, notificationComments, "comment", map[string]interface{}{
+		"Post":   p,
+		"Author": author,
+		"Text":   text,
+		"Link":   link,
+	})
+}
+
+// RecordAuthFailure counts a failed authentication from source, like an IP
+// address. Admins are emailed, at most once per window, when a source fails
+// AuthFailureThreshold times within AuthFailureWindow.
+func RecordAuthFailure(source string) {
+	now := time.Now()
+
+	authFailuresMu.Lock()
+	recent := []time.Time{now}
+	for _, t := range authFailures[source] {
+		if now.Sub(t) < AuthFailureWindow {
+			recent = append(recent, t)
+		}
+	}
+	authFailures[source] = recent
+
+	// Forget quiet sources, so the map doesn't grow forever.
+	for s, ts := range authFailures {
+		if now.Sub(ts[0]) >= AuthFailureWindow {
+			delete(authFailures, s)
+			delete(authAlerted, s)
+		}
+	}
+
+	alert := len(recent) >= AuthFailureThreshold && now.Sub(authAlerted[source]) >= AuthFailureWindow
+	if alert {
+		authAlerted[source] = now
+	}
+	authFailuresMu.Unlock()
+
+	if !alert {
+		return
+	}
+
+	GoTask("auth_alert", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		if _, err := Enqueue(ctx, "auth_alert", authAlert{Source: source, Failures: len(recent)}); err != nil {
+			log.Printf("could not queue auth alert: %+v", err)
+		}
+	})
+}
+
+// authAlert is the payload of auth_alert jobs.
+type authAlert struct {
+	Source   string `json:"source"`
+	Failures int    `json:"failures"`
+}
+
+func init() {
+	HandleJobs("auth_alert", func(ctx context.Context, payload json.RawMessage) error {
+		var a authAlert
+		if err := json.Unmarshal(payload, &a); err != nil {
+			return err
+		}
+
+		return sendAuthAlert(ctx, a.Source, a.Failures)
+	})
+}
+
+func sendAuthAlert(ctx context.Context, source string, failures int) error {
+	site, err := GetSiteSettings(ctx)
+	if err != nil {
+		return err
+	}
+
+	return notifyAdmins(ctx, notificationAuthAlerts, "auth_alert", map[string]interface{}{
+		"Site":     site,
+		"Source":   source,
+		"Failures": failures,
+		"Window":   AuthFailureWindow,
+	})
+}
+
+// digestPost is a post in a digest, with the links in it.
+type digestPost struct {
+	Title string
+	Links []string
+}
+
+// sendNextDigest sends one user their digest, if anyone is due one. It
+// returns false when nobody is.
+func sendNextDigest(ctx context.Context) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	var userID, email string
+	var sent pq.NullTime
+	err = tx.QueryRowContext(ctx, `
+SELECT n.user_id, n.email, n.digest_sent_at
+FROM notification_settings n
+JOIN users u ON u.id = n.user_id
+WHERE n.digest AND n.email IS NOT NULL AND u.deactivated_at IS NULL
+  AND (n.digest_sent_at IS NULL OR n.digest_sent_at <= $1)
+LIMIT 1
+FOR UPDATE OF n SKIP LOCKED
+`, now.Add(-DigestInterval)).Scan(&userID, &email, &sent)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, Internalf("Error running get query: %+v", err)
+	}
+
+	since := now.Add(-DigestInterval)
+	if sent.Valid && sent.Time.After(since) {
+		since = sent.Time
+	}
+
+	posts, err := digestPosts(ctx, since)
+	if err != nil {
+		return false, err
+	}
+
+	links, err := digestLinks(ctx, since)
+	if err != nil {
+		return false, err
+	}
+
+	// Quiet weeks don't get a digest.
+	if len(posts) > 0 || len(links) > 0 {
+		site, err := GetSiteSettings(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		if err := sendEmail(ctx, "digest", []string{email}, map[string]interface{}{
+			"Site":  site,
+			"Since": since,
+			"Posts": posts,
+			"Links": links,
+		}); err != nil {
+			return false, err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE notification_settings SET digest_sent_at = $2 WHERE user_id = $1", userID, now); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// digestPosts returns the posts published since, that link to something.
+func digestPosts(ctx context.Context, since time.Time) ([]*digestPost, error) {
+	rows, err := db.QueryContext(ctx, "SELECT title, content FROM posts WHERE site_id = $3 AND draft = false AND password_hash IS NULL AND date > $1 AND date <= $2 ORDER BY date", since, time.Now(), siteID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	posts := make([]*digestPost, 0)
+	for rows.Next() {
+		var title, content string
+		if err := rows.Scan(&title, &content); err != nil {
+			return nil, err
+		}
+
+		p := &digestPost{Title: title}
+		for _, m := range LinkRegex.FindAllStringSubmatch(content, -1) {
+			if strings.HasPrefix(m[1], "http") {
+				p.Links = append(p.Links, m[1])
+			}
+		}
+
+		if len(p.Links) > 0 {
+			posts = append(posts, p)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// digestLinks returns the links saved since.
+func digestLinks(ctx context.Context, since time.Time) ([]*Link, error) {
+	rows, err := db.QueryContext(ctx, "SELECT id, url, title, description, screenshot, tags, status_code, dead, checked_at, created_at, modified_at FROM links WHERE created_at > $1 ORDER BY created_at", since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	links := make([]*Link, 0)
+	for rows.Next() {
+		l, err := scanLink(rows)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, l)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
+// pendingNotification is a notification waiting to be sent in a batch.
+type pendingNotification struct {
+	ID      int64
+	Type    string
+	Subject string
+	Body    string
+	Created time.Time
+}
+
+// deliveryInterval returns how long notifications with the delivery wait to
+// be batched.
+func deliveryInterval(d NotificationDelivery) time.Duration {
+	switch d {
+	case NotificationDeliveryHourly:
+		return time.Hour
+	case NotificationDeliveryDaily:
+		return 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// StartNotificationWorker sends batches of pending notifications, checking
+// every interval until ctx is done. It is safe to run on several servers at
+// once.
+func StartNotificationWorker(ctx context.Context, interval time.Duration) {
+	startWorker(ctx, "notifications", interval, sendNextNotifications)
+}
+
+// sendNextNotifications sends one user their pending notifications, if any
+// are due and it isn't the user's quiet hours. A type of notification is due
+// once its oldest pending notification has waited out the type's delivery.
+// It returns false when nothing is due.
+func sendNextNotifications(ctx context.Context) (bool, error) {
+	if mailer == nil {
+		return false, nil
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	var userID string
+	err = tx.QueryRowContext(ctx, `
+SELECT p.user_id
+FROM pending_notifications p
+JOIN notification_settings n ON n.user_id = p.user_id
+WHERE n.email IS NOT NULL
+  AND p.created_at <= $1 - CASE CASE p.type WHEN 'comments' THEN n.comments_delivery ELSE n.auth_alerts_delivery END
+    WHEN 'hourly' THEN interval '1 hour'
+    WHEN 'daily' THEN interval '1 day'
+    ELSE interval '0'
+  END
+  AND NOT (n.quiet_start IS NOT NULL AND n.quiet_end IS NOT NULL AND CASE
+    WHEN n.quiet_start < n.quiet_end THEN EXTRACT(HOUR FROM $1 AT TIME ZONE n.timezone) >= n.quiet_start AND EXTRACT(HOUR FROM $1 AT TIME ZONE n.timezone) < n.quiet_end
+    WHEN n.quiet_start > n.quiet_end THEN EXTRACT(HOUR FROM $1 AT TIME ZONE n.timezone) >= n.quiet_start OR EXTRACT(HOUR FROM $1 AT TIME ZONE n.timezone) < n.quiet_end
+    ELSE false
+  END)
+LIMIT 1
+FOR UPDATE OF p SKIP LOCKED
+`, now).Scan(&userID)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, Internalf("Error running get query: %+v", err)
+	}
+
+	n, err := GetNotificationSettings(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := tx.QueryContext(ctx, "SELECT id, type, subject, body, created_at FROM pending_notifications WHERE user_id = $1 ORDER BY created_at FOR UPDATE", userID)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	pending := make([]*pendingNotification, 0)
+	for rows.Next() {
+		p := &pendingNotification{}
+		if err := rows.Scan(&p.ID, &p.Type, &p.Subject, &p.Body, &p.Created); err != nil {
+			return false, err
+		}
+		pending = append(pending, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return false, err
+	}
+
+	// Types are batched together once their oldest notification is due, so
+	// rows are oldest first.
+	due := map[string]bool{}
+	batch := make([]*pendingNotification, 0)
+	ids := make([]int64, 0)
+	for _, p := range pending {
+		if _, ok := due[p.Type]; !ok {
+			due[p.Type] = !p.Created.After(now.Add(-deliveryInterval(n.delivery(p.Type))))
+		}
+		if due[p.Type] {
+			batch = append(batch, p)
+			ids = append(ids, p.ID)
+		}
+	}
+
+	if len(batch) == 0 || n.Email == nil {
+		return false, nil
+	}
+
+	e := &Email{To: *n.Email, Subject: batch[0].Subject, Body: batch[0].Body}
+	if len(batch) > 1 {
+		site, err := GetSiteSettings(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		e, err = RenderEmail("notifications", *n.Email, map[string]interface{}{
+			"Site":          site,
+			"Notifications": batch,
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if err := mailer.Send(ctx, e); err != nil {
+		return false, fmt.Errorf("could not send notifications to %s: %+v", *n.Email, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM pending_notifications WHERE id = ANY($1)", pq.Array(ids)); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
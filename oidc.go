@@ -0,0 +1,236 @@
+package graphql
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// OIDCCodeExpiry is how long an authorization code can be exchanged for.
+const OIDCCodeExpiry = time.Minute
+
+// accessTokenAudiencePrefix starts the audience of access tokens issued to
+// OIDC clients. It is followed by the client's ID.
+const accessTokenAudiencePrefix = "oidc:"
+
+// OIDCClient is an application that can use this server as an OpenID Connect
+// provider to log users in.
+type OIDCClient struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	RedirectURIs []string  `json:"redirect_uris"`
+	Created      time.Time `json:"created"`
+
+	secretHash string
+}
+
+// IDTokenClaims are the claims in ID tokens given to OIDC clients.
+type IDTokenClaims struct {
+	jwt.Claims
+	Nonce    string   `json:"nonce,omitempty"`
+	AuthTime int64    `json:"auth_time"`
+	Roles    []string `json:"roles"`
+}
+
+// CreateOIDCClient stores a new client and returns it with its secret. The
+// secret is not stored and can not be retrieved again.
+func CreateOIDCClient(ctx context.Context, name string, redirectURIs []string) (*OIDCClient, string, error) {
+	id, err := randomHex(16)
+	if err != nil {
+		return nil, "", err
+	}
+
+	secret, err := randomHex(32)
+	if err != nil {
+		return nil, "", err
+	}
+
+	c := &OIDCClient{
+		ID:           id,
+		Name:         name,
+		RedirectURIs: redirectURIs,
+		Created:      time.Now(),
+		secretHash:   hashSecret(secret),
+	}
+
+	if _, err := db.ExecContext(ctx, "INSERT INTO oidc_clients (id, name, redirect_uris, secret_hash, created_at) VALUES ($1, $2, $3, $4, $5)", c.ID, c.Name, pq.Array(c.RedirectURIs), c.secretHash, c.Created); err != nil {
+		return nil, "", err
+	}
+
+	return c, secret, nil
+}
+
+// GetOIDCClient gets a client by ID from the database.
+func GetOIDCClient(ctx context.Context, id string) (*OIDCClient, error) {
+	var c OIDCClient
+	row := db.QueryRowContext(ctx, "SELECT id, name, redirect_uris, secret_hash, created_at FROM oidc_clients WHERE id = $1", id)
+	err := row.Scan(&c.ID, &c.Name, pq.Array(&c.RedirectURIs), &c.secretHash, &c.Created)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, NotFound("No client with id %s", id)
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return &c, nil
+	}
+}
+
+// OIDCClients returns all clients, ordered by name.
+func OIDCClients(ctx context.Context) ([]*OIDCClient, error) {
+	rows, err := db.QueryContext(ctx, "SELECT id, name, redirect_uris, secret_hash, created_at FROM oidc_clients ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	clients := make([]*OIDCClient, 0)
+	for rows.Next() {
+		c := new(OIDCClient)
+		err := rows.Scan(&c.ID, &c.Name, pq.Array(&c.RedirectURIs), &c.secretHash, &c.Created)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return clients, nil
+}
+
+// Delete removes a client and any outstanding authorization codes for it.
+func (c *OIDCClient) Delete(ctx context.Context) error {
+	if _, err := db.ExecContext(ctx, "DELETE FROM oidc_codes WHERE client_id = $1", c.ID); err != nil {
+		return err
+	}
+
+	_, err := db.ExecContext(ctx, "DELETE FROM oidc_clients WHERE id = $1", c.ID)
+	return err
+}
+
+// VerifySecret returns true if secret is the client's secret.
+func (c *OIDCClient) VerifySecret(secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(c.secretHash)) == 1
+}
+
+// AllowsRedirect returns true if uri is one of the client's registered
+// redirect URIs. URIs must match exactly.
+func (c *OIDCClient) AllowsRedirect(uri string) bool {
+	for _, r := range c.RedirectURIs {
+		if r == uri {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewCode creates an authorization code for a user logging in to the client.
+func (c *OIDCClient) NewCode(ctx context.Context, u *User, redirectURI, nonce string) (string, error) {
+	code, err := randomHex(32)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := db.ExecContext(ctx, "INSERT INTO oidc_codes (code, client_id, user_id, redirect_uri, nonce, expires_at, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)", code, c.ID, u.ID, redirectURI, nonce, time.Now().Add(OIDCCodeExpiry), time.Now()); err != nil {
+		return "", err
+	}
+
+	return code, nil
+}
+
+// ExchangeCode trades an authorization code for the user that approved it,
+// the nonce the client sent, and the time the code was created. Codes can
+// only be used once.
+func (c *OIDCClient) ExchangeCode(ctx context.Context, code, redirectURI string) (*User, string, time.Time, error) {
+	var userID, nonce string
+	var created time.Time
+	row := db.QueryRowContext(ctx, "DELETE FROM oidc_codes WHERE code = $1 AND client_id = $2 AND redirect_uri = $3 AND expires_at > $4 RETURNING user_id, nonce, created_at", code, c.ID, redirectURI, time.Now())
+	err := row.Scan(&userID, &nonce, &created)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, "", time.Time{}, fmt.Errorf("Invalid authorization code")
+	case err != nil:
+		return nil, "", time.Time{}, Internalf("Error running get query: %+v", err)
+	}
+
+	u, err := GetUser(ctx, userID)
+	if err != nil {
+		return nil, "", time.Time{}, err
+	}
+
+	return u, nonce, created, nil
+}
+
+// IDToken mints an OpenID Connect ID token for the user, for the client
+// clientID.
+func (u *User) IDToken(clientID, nonce string, authTime time.Time) (string, error) {
+	if !JWTEnabled() {
+		return "", fmt.Errorf("JWTs are not configured")
+	}
+
+	now := time.Now()
+	claims := IDTokenClaims{
+		Claims: jwt.Claims{
+			Issuer:   jwtIssuer,
+			Subject:  u.ID,
+			Audience: jwt.Audience{clientID},
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(now.Add(JWTExpiry)),
+		},
+		Nonce:    nonce,
+		AuthTime: authTime.Unix(),
+		Roles:    []string{u.Role},
+	}
+
+	return jwt.Signed(jwtSigner).Claims(claims).CompactSerialize()
+}
+
+// AccessToken mints an OpenID Connect access token for the user, for the
+// client clientID. Its audience is the client, so it is only good for the
+// userinfo endpoint, not as a credential for the API.
+func (u *User) AccessToken(clientID string) (string, error) {
+	if !JWTEnabled() {
+		return "", fmt.Errorf("JWTs are not configured")
+	}
+
+	now := time.Now()
+	claims := UserClaims{
+		Claims: jwt.Claims{
+			Issuer:   jwtIssuer,
+			Subject:  u.ID,
+			Audience: jwt.Audience{accessTokenAudiencePrefix + clientID},
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(now.Add(JWTExpiry)),
+		},
+		Roles: []string{u.Role},
+	}
+
+	return jwt.Signed(jwtSigner).Claims(claims).CompactSerialize()
+}
+
+// UserFromAccessToken verifies an access token minted by User.AccessToken for
+// a client that still exists, and returns the user it was minted for.
+func UserFromAccessToken(ctx context.Context, token string) (*User, error) {
+	claims, err := parseJWT(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(claims.Audience) != 1 || !strings.HasPrefix(claims.Audience[0], accessTokenAudiencePrefix) {
+		return nil, fmt.Errorf("JWT is not an OpenID Connect access token")
+	}
+
+	if _, err := GetOIDCClient(ctx, strings.TrimPrefix(claims.Audience[0], accessTokenAudiencePrefix)); err != nil {
+		return nil, err
+	}
+
+	return GetUser(ctx, claims.Subject)
+}
@@ -0,0 +1,115 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+var pageSlugRegex = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Page is content that isn't dated, like an about page, served at /{slug}.
+type Page struct {
+	Slug     string    `json:"slug"`
+	Title    string    `json:"title"`
+	Content  string    `json:"content"`
+	Draft    bool      `json:"draft"`
+	Created  time.Time `json:"created"`
+	Modified time.Time `json:"modified"`
+
+	// Version counts the page's saves. Edits are only saved if the page is
+	// still at the version they were made to.
+	Version int `json:"version"`
+}
+
+// HTML returns the page as rendered HTML. Renders are cached per revision of
+// the page.
+func (p *Page) HTML() string {
+	return string(CachedMarkdown(fmt.Sprintf("page:%s@%d", p.Slug, p.Modified.UnixNano()), p.Content))
+}
+
+func scanPage(row interface {
+	Scan(dest ...interface{}) error
+}) (*Page, error) {
+	p := new(Page)
+	if err := row.Scan(&p.Slug, &p.Title, &p.Content, &p.Draft, &p.Created, &p.Modified, &p.Version); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// UpsertPage creates or updates the page at slug. A nil title or draft keeps
+// the page's current value, or is empty and published for a new page.
+// Updating a page is a CONFLICT error unless it is still at version.
+func UpsertPage(ctx context.Context, slug, content string, title *string, draft *bool, version *int) (*Page, error) {
+	if !pageSlugRegex.MatchString(slug) {
+		return nil, Validation("slug", "Slug must be lowercase letters and numbers, separated by dashes, like about or uses-2019")
+	}
+
+	row := db.QueryRowContext(ctx, `
+    INSERT INTO pages (slug, title, content, draft, created_at, modified_at, site_id)
+    VALUES ($1, COALESCE($2, ''), $3, COALESCE($4, false), $5, $5, $6)
+    ON CONFLICT (site_id, slug) DO UPDATE
+    SET (title, content, draft, modified_at, version) = (COALESCE($2, pages.title), $3, COALESCE($4, pages.draft), $5, pages.version + 1)
+    WHERE pages.version = $7::integer
+    RETURNING slug, title, content, draft, created_at, modified_at, version`,
+		slug, title, content, draft, time.Now(), siteID(ctx), version)
+	p, err := scanPage(row)
+	if err == sql.ErrNoRows {
+		current, err := GetPage(ctx, slug)
+		if err != nil {
+			return nil, err
+		}
+		if version == nil {
+			return nil, Conflict(current.Version, "Page %s already exists, at version %d, which is needed to edit it", slug, current.Version)
+		}
+		return nil, Conflict(current.Version, "Page %s was changed since version %d, and is now at version %d", slug, *version, current.Version)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	purgeCache(ctx, "/"+p.Slug)
+	return p, nil
+}
+
+// GetPage returns the page at slug, including drafts.
+func GetPage(ctx context.Context, slug string) (*Page, error) {
+	row := db.QueryRowContext(ctx, "SELECT slug, title, content, draft, created_at, modified_at, version FROM pages WHERE site_id = $2 AND slug = $1", slug, siteID(ctx))
+	p, err := scanPage(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, NotFound("No page with slug %s", slug)
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return p, nil
+	}
+}
+
+// Pages returns pages ordered by slug. Drafts are only included if drafts is
+// true.
+func Pages(ctx context.Context, drafts bool) ([]*Page, error) {
+	rows, err := db.QueryContext(ctx, "SELECT slug, title, content, draft, created_at, modified_at, version FROM pages WHERE site_id = $2 AND ($1 OR NOT draft) ORDER BY slug", drafts, siteID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	pages := make([]*Page, 0)
+	for rows.Next() {
+		p, err := scanPage(rows)
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return pages, nil
+}
@@ -0,0 +1,117 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"log"
+)
+
+// Plugin is an integration that lives in its own package. It implements
+// whichever hooks it needs: PostPublishedHook, CommentCreatedHook, LoginHook
+// or RenderHook. Register plugins with RegisterPlugin.
+type Plugin interface {
+	// Name identifies the plugin in logs.
+	Name() string
+}
+
+// PostPublishedHook is called after a post is published for the first time.
+type PostPublishedHook interface {
+	PostPublished(ctx context.Context, p *Post) error
+}
+
+// CommentCreatedHook is called after a comment is created. Comments are
+// reserved for the future, so nothing calls it yet.
+type CommentCreatedHook interface {
+	CommentCreated(ctx context.Context, c *Comment) error
+}
+
+// LoginHook is called after a user logs in, with their new session.
+type LoginHook interface {
+	LoggedIn(ctx context.Context, u *User, s *Session) error
+}
+
+// RenderHook changes the HTML rendered from posts and pages. It is given
+// sanitized HTML, and what it returns isn't sanitized again. Renders are
+// cached, so it should only depend on the HTML.
+type RenderHook interface {
+	RenderHTML(html string) (string, error)
+}
+
+var plugins []Plugin
+
+// RegisterPlugin adds a plugin's hooks. It should be called before serving,
+// in init or main. Plugins are called in the order they are registered.
+func RegisterPlugin(p Plugin) error {
+	if p.Name() == "" {
+		return fmt.Errorf("Plugins need a name")
+	}
+
+	for _, existing := range plugins {
+		if existing.Name() == p.Name() {
+			return fmt.Errorf("A plugin named %q is already registered", p.Name())
+		}
+	}
+
+	switch p.(type) {
+	case PostPublishedHook, CommentCreatedHook, LoginHook, RenderHook:
+	default:
+		return fmt.Errorf("Plugin %q doesn't implement any hooks", p.Name())
+	}
+
+	plugins = append(plugins, p)
+	if _, ok := p.(RenderHook); ok {
+		markdownCache.Purge()
+	}
+
+	return nil
+}
+
+// runHook runs one plugin's hook. Hooks run in the request that triggered
+// them, so a hook failing or panicking is logged instead of failing it.
+func runHook(p Plugin, hook string, f func() error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("plugin %s panicked in its %s hook: %v", p.Name(), hook, r)
+		}
+	}()
+
+	if err := f(); err != nil {
+		log.Printf("plugin %s failed in its %s hook: %+v", p.Name(), hook, err)
+	}
+}
+
+// postPublishedHooks runs the PostPublished hook of every plugin.
+func postPublishedHooks(ctx context.Context, post *Post) {
+	for _, p := range plugins {
+		if h, ok := p.(PostPublishedHook); ok {
+			runHook(p, "PostPublished", func() error { return h.PostPublished(ctx, post) })
+		}
+	}
+}
+
+// loginHooks runs the LoggedIn hook of every plugin.
+func loginHooks(ctx context.Context, u *User, s *Session) {
+	for _, p := range plugins {
+		if h, ok := p.(LoginHook); ok {
+			runHook(p, "LoggedIn", func() error { return h.LoggedIn(ctx, u, s) })
+		}
+	}
+}
+
+// renderHooks runs the RenderHTML hook of every plugin over html. A hook
+// that fails leaves the HTML as it was.
+func renderHooks(html string) string {
+	for _, p := range plugins {
+		if h, ok := p.(RenderHook); ok {
+			runHook(p, "RenderHTML", func() error {
+				out, err := h.RenderHTML(html)
+				if err == nil {
+					html = out
+				}
+				return err
+			})
+		}
+	}
+
+	return html
+}
@@ -4,8 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"html/template"
+	"log"
 	"math"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -25,6 +26,24 @@ type Post struct {
 	Draft    bool      `json:"draft"`
 	Tags     []string  `json:"tags"`
 	Links    []*Link   `json:"links"`
+
+	// Protected posts have a password, and are unlisted. They are only
+	// readable with a token from Unlock.
+	Protected bool `json:"protected"`
+
+	// Cross-posts were first published somewhere else. CanonicalURL is
+	// where, for rel=canonical links, and OriginallyPublishedAt is when.
+	CanonicalURL          *string    `json:"canonical_url"`
+	OriginallyPublishedAt *time.Time `json:"originally_published_at"`
+
+	// LicenseID and LicenseText are the post's own license. Posts without
+	// one have the site's default license.
+	LicenseID   *LicenseId `json:"license"`
+	LicenseText *string    `json:"license_text"`
+
+	// Version counts the post's saves. Edits are only saved if the post is
+	// still at the version they were made to.
+	Version int `json:"version"`
 }
 
 // GeneratePost returns a fresh post that has not yet been saved to the
@@ -72,21 +91,21 @@ func GetMaxID(ctx context.Context) (int64, error) {
 // GetPost gets a post by ID from the database.
 func GetPost(ctx context.Context, id int64) (*Post, error) {
 	var post Post
-	row := db.QueryRowContext(ctx, "SELECT id, title, content, date, created_at, modified_at, tags, draft FROM posts WHERE id = $1", id)
-	err := row.Scan(&post.ID, &post.Title, &post.Content, &post.Datetime, &post.Created, &post.Modified, pq.Array(&post.Tags), &post.Draft)
+	row := db.QueryRowContext(ctx, "SELECT id, title, content, date, created_at, modified_at, tags, draft, password_hash IS NOT NULL, canonical_url, originally_published_at, license, license_text, version FROM posts WHERE id = $1 AND site_id = $2", id, siteID(ctx))
+	err := row.Scan(&post.ID, &post.Title, &post.Content, &post.Datetime, &post.Created, &post.Modified, pq.Array(&post.Tags), &post.Draft, &post.Protected, &post.CanonicalURL, &post.OriginallyPublishedAt, &post.LicenseID, &post.LicenseText, &post.Version)
 	switch {
 	case err == sql.ErrNoRows:
-		return nil, fmt.Errorf("No post with id %d", id)
+		return nil, NotFound("No post with id %d", id)
 	case err != nil:
-		return nil, fmt.Errorf("Error running get query: %+v", err)
+		return nil, Internalf("Error running get query: %+v", err)
 	default:
 		return &post, nil
 	}
 }
 
-// Posts returns all posts from the database.
+// Posts returns all drafts, or all published posts except protected ones.
 func Posts(ctx context.Context, isDraft bool) ([]*Post, error) {
-	rows, err := db.QueryContext(ctx, "SELECT id, title, content, date, created_at, modified_at, tags, draft FROM posts WHERE draft = $1 ORDER BY date DESC", isDraft)
+	rows, err := db.QueryContext(ctx, "SELECT id, title, content, date, created_at, modified_at, tags, draft, password_hash IS NOT NULL, canonical_url, originally_published_at, license, license_text, version FROM posts WHERE site_id = $2 AND draft = $1 AND ($1 OR password_hash IS NULL) ORDER BY date DESC", isDraft, siteID(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +114,7 @@ func Posts(ctx context.Context, isDraft bool) ([]*Post, error) {
 	posts := make([]*Post, 0)
 	for rows.Next() {
 		post := new(Post)
-		err := rows.Scan(&post.ID, &post.Title, &post.Content, &post.Datetime, &post.Created, &post.Modified, pq.Array(&post.Tags), &post.Draft)
+		err := rows.Scan(&post.ID, &post.Title, &post.Content, &post.Datetime, &post.Created, &post.Modified, pq.Array(&post.Tags), &post.Draft, &post.Protected, &post.CanonicalURL, &post.OriginallyPublishedAt, &post.LicenseID, &post.LicenseText, &post.Version)
 		if err != nil {
 			return nil, err
 		}
@@ -132,7 +151,10 @@ func ParseTags(text string) ([]string, error) {
 	return ret, nil
 }
 
-// Save insterts a post into the database.
+// Save insterts a post into the database. Saving an existing post is a
+// CONFLICT error unless it is still at p.Version, and bumps its version.
+// Posts saved by an import were published long ago, so their links aren't
+// checked and publishing them doesn't notify anyone.
 func (p *Post) Save(ctx context.Context) error {
 	if p.ID == "" {
 		maxID, err := GetMaxID(ctx)
@@ -143,14 +165,46 @@ func (p *Post) Save(ctx context.Context) error {
 		p.ID = fmt.Sprintf("%d", maxID+1)
 	}
 
-	if _, err := db.ExecContext(
+	// A post is published when it is saved as not a draft for the first time.
+	var wasDraft bool
+	var version int
+	err := db.QueryRowContext(ctx, "SELECT draft, version FROM posts WHERE id = $1 AND site_id = $2", p.ID, siteID(ctx)).Scan(&wasDraft, &version)
+	switch {
+	case err == sql.ErrNoRows:
+		wasDraft = true
+	case err != nil:
+		return Internalf("Error running get query: %+v", err)
+	case version != p.Version:
+		return p.conflict(version)
+	}
+
+	if err := p.validateCrossPost(); err != nil {
+		return err
+	}
+
+	if l := p.ownLicense(); l != nil {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if !p.Draft && !importing(ctx) {
+		if err := p.checkLinks(ctx); err != nil {
+			return err
+		}
+	}
+
+	// The version is checked again as the post is saved, in case it was
+	// saved by someone else since it was read above.
+	err = db.QueryRowContext(
 		ctx,
 		`
-INSERT INTO posts(id, title, content, date, draft, created_at, modified_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO posts(id, title, content, date, draft, created_at, modified_at, site_id, canonical_url, originally_published_at, license, license_text)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 ON CONFLICT (id) DO UPDATE
-SET (title, content, date, draft, modified_at) = ($2, $3, $4, $5, $7)
-WHERE posts.id = $1;
+SET (title, content, date, draft, modified_at, canonical_url, originally_published_at, license, license_text, version) = ($2, $3, $4, $5, $7, $9, $10, $11, $12, posts.version + 1)
+WHERE posts.id = $1 AND posts.site_id = $8 AND posts.version = $13
+RETURNING version;
 `,
 		p.ID,
 		p.Title,
@@ -158,26 +212,230 @@ WHERE posts.id = $1;
 		p.Datetime,
 		p.Draft,
 		p.Created,
-		time.Now()); err != nil {
+		time.Now(),
+		siteID(ctx),
+		p.CanonicalURL,
+		p.OriginallyPublishedAt,
+		p.LicenseID,
+		p.LicenseText,
+		p.Version).Scan(&p.Version)
+	switch {
+	case err == sql.ErrNoRows:
+		err := db.QueryRowContext(ctx, "SELECT version FROM posts WHERE id = $1 AND site_id = $2", p.ID, siteID(ctx)).Scan(&version)
+		switch {
+		case err == sql.ErrNoRows:
+			return NotFound("No post with id %s", p.ID)
+		case err != nil:
+			return Internalf("Error running get query: %+v", err)
+		}
+		return p.conflict(version)
+	case err != nil:
 		return err
 	}
 
-	_, err := strconv.ParseInt(p.ID, 10, 64)
+	_, err = strconv.ParseInt(p.ID, 10, 64)
 	if err != nil {
 		return err
 	}
 
+	if wasDraft && !p.Draft && !importing(ctx) {
+		p.published(ctx)
+	}
+
+	// Drafts aren't public, so they are only cached once published.
+	if !wasDraft || !p.Draft {
+		purgeCache(ctx, p.purgePaths()...)
+	}
+
+	return nil
+}
+
+// conflict returns the error for saving the post when it is at version.
+func (p *Post) conflict(version int) error {
+	return Conflict(version, "Post %s was changed since version %d, and is now at version %d", p.ID, p.Version, version)
+}
+
+// setCrossPost changes the post's canonical URL and original publication
+// time. Nil values are left alone, and an empty canonical URL removes both.
+func (p *Post) setCrossPost(canonicalURL *string, originallyPublishedAt *time.Time) {
+	if canonicalURL != nil {
+		p.CanonicalURL = canonicalURL
+		if *canonicalURL == "" {
+			p.CanonicalURL, p.OriginallyPublishedAt = nil, nil
+		}
+	}
+
+	if originallyPublishedAt != nil {
+		p.OriginallyPublishedAt = originallyPublishedAt
+	}
+}
+
+// validateCrossPost checks the post's canonical URL and original publication
+// time. A post can't have been published before somewhere else without
+// saying where.
+func (p *Post) validateCrossPost() error {
+	if p.CanonicalURL != nil {
+		if err := validLinkURL(*p.CanonicalURL); err != nil {
+			return Validation("canonicalURL", "Canonical URL must be an http or https URL")
+		}
+	}
+
+	if p.OriginallyPublishedAt != nil {
+		if p.CanonicalURL == nil {
+			return Validation("originallyPublishedAt", "Cross-posts need a canonical URL")
+		}
+		if p.OriginallyPublishedAt.After(time.Now()) {
+			return Validation("originallyPublishedAt", "Posts can't have been published in the future")
+		}
+	}
+
 	return nil
 }
 
+// CrossPosted returns whether the post's canonical URL is on a site other
+// than siteURL.
+func (p *Post) CrossPosted(siteURL string) bool {
+	if p.CanonicalURL == nil {
+		return false
+	}
+
+	canonical, err := url.Parse(*p.CanonicalURL)
+	if err != nil {
+		return false
+	}
+
+	site, err := url.Parse(siteURL)
+	return err != nil || !strings.EqualFold(canonical.Hostname(), site.Hostname())
+}
+
+// SitemapPosts returns the published posts that belong in the sitemap, newest
+// first. Cross-posts are left out, since search engines should index them
+// where they were first published.
+func SitemapPosts(ctx context.Context) ([]*Post, error) {
+	site, err := GetSiteSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := AllPosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return sitemapPosts(posts, site.URL), nil
+}
+
+// sitemapPosts returns the posts that aren't cross-posted from elsewhere.
+func sitemapPosts(posts []*Post, siteURL string) []*Post {
+	ret := make([]*Post, 0, len(posts))
+	for _, p := range posts {
+		if !p.CrossPosted(siteURL) {
+			ret = append(ret, p)
+		}
+	}
+
+	return ret
+}
+
+// published is called after a post is published for the first time.
+// Protected posts aren't syndicated or federated, and automation rules can
+// change where others are.
+func (p *Post) published(ctx context.Context) {
+	triggerWebhooks(ctx, EventPostPublished, p)
+	postPublishedHooks(ctx, p)
+	rules := runPostRules(ctx, p)
+
+	var protected bool
+	if err := db.QueryRowContext(ctx, "SELECT password_hash IS NOT NULL FROM posts WHERE id = $1", p.ID).Scan(&protected); err != nil || protected {
+		return
+	}
+
+	if !rules.skipSyndication {
+		if err := p.queueSyndication(ctx, rules.syndicateTo); err != nil {
+			log.Printf("could not queue syndication of post %s: %+v", p.ID, err)
+		}
+	}
+
+	if !rules.skipFederation {
+		if err := p.queueActivity(ctx); err != nil {
+			log.Printf("could not queue ActivityPub delivery of post %s: %+v", p.ID, err)
+		}
+	}
+}
+
+// PublishedPosts returns a page of published posts, newest first. Protected
+// posts are unlisted.
+func PublishedPosts(ctx context.Context, limit, offset int) ([]*Post, error) {
+	rows, err := db.QueryContext(ctx, "SELECT id, title, content, date, created_at, modified_at, tags, draft, canonical_url, originally_published_at, license, license_text, version FROM posts WHERE site_id = $3 AND draft = false AND password_hash IS NULL ORDER BY date DESC LIMIT $1 OFFSET $2", limit, offset, siteID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	posts := make([]*Post, 0)
+	for rows.Next() {
+		post := new(Post)
+		err := rows.Scan(&post.ID, &post.Title, &post.Content, &post.Datetime, &post.Created, &post.Modified, pq.Array(&post.Tags), &post.Draft, &post.CanonicalURL, &post.OriginallyPublishedAt, &post.LicenseID, &post.LicenseText, &post.Version)
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, post)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// TagCount is how many published posts have a tag.
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+// PublishedTags returns the tags on published posts, most used first.
+func PublishedTags(ctx context.Context) ([]*TagCount, error) {
+	rows, err := db.QueryContext(ctx, "SELECT tag, COUNT(*) FROM posts, unnest(tags) AS tag WHERE site_id = $1 AND draft = false AND password_hash IS NULL GROUP BY tag ORDER BY COUNT(*) DESC, tag", siteID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make([]*TagCount, 0)
+	for rows.Next() {
+		t := new(TagCount)
+		if err := rows.Scan(&t.Tag, &t.Count); err != nil {
+			return nil, err
+		}
+		tags = append(tags, t)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// Permalink returns the public URL of the post.
+func (p *Post) Permalink(ctx context.Context) (string, error) {
+	site, err := GetSiteSettings(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/post/%s", strings.TrimRight(site.URL, "/"), p.ID), nil
+}
+
 // Summary returns the first sentence of a post.
 func (p *Post) Summary() string {
 	return SummarizeText(p.Content)
 }
 
-// HTML returns the post as rendered HTML.
-func (p *Post) HTML() template.HTML {
-	return Markdown(p.Content)
+// HTML returns the post as rendered HTML. Renders are cached per revision of
+// the post.
+func (p *Post) HTML() string {
+	return string(CachedMarkdown(fmt.Sprintf("%s@%d", p.ID, p.Modified.UnixNano()), p.Content))
 }
 
 // ReadTime calculates the number of seconds it should take to read the post.
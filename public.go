@@ -0,0 +1,179 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/introspection"
+	"github.com/vektah/gqlparser/ast"
+)
+
+// PublicFields are the object types and fields in the public schema, served
+// to third parties at /public/graphql. Types reached through their fields
+// must be listed too, except scalars, enums and inputs, which are included
+// whole. There are no mutations.
+var PublicFields = map[string][]string{
+	"Query": {
+		"posts", "post", "nextPost", "prevPost", "tags", "sitemapPosts",
+		"links", "link",
+		"books", "book", "currentlyReading", "readingStats",
+		"pages", "page",
+		"siteSettings", "theme",
+	},
+	"Book":          {"id", "title", "author", "isbn", "shelf", "rating", "pages", "started", "finished"},
+	"License":       {"id", "spdx", "name", "url", "text", "html"},
+	"Link":          {"id", "title", "uri", "created", "modified", "description", "screenshot", "tags"},
+	"MetadataEntry": {"key", "value"},
+	"MonthCount":    {"month", "books", "pages"},
+	"NavItem":       {"label", "url"},
+	"Page":          {"slug", "title", "content", "html", "created", "modified"},
+	"Post":          {"id", "title", "content", "summary", "readtime", "html", "datetime", "created", "modified", "tags", "protected", "links", "syndicationUrls", "metadata", "canonicalURL", "originallyPublishedAt", "license"},
+	"ReadingStats":  {"year", "books", "pages", "averageRating", "months"},
+	"SiteSettings":  {"title", "description", "url", "footerText", "postsPerPage", "socialLinks", "license"},
+	"SocialLink":    {"name", "url"},
+	"TagCount":      {"tag", "count"},
+	"Theme":         {"accentColor", "lightImage", "darkImage", "navItems"},
+}
+
+// publicSchema serves a subset of another schema. Queries are validated
+// against the subset, so they can't reach anything else, then run by the
+// full schema.
+type publicSchema struct {
+	graphql.ExecutableSchema
+	schema *ast.Schema
+}
+
+// PublicSchema returns es restricted to PublicFields. It panics if
+// PublicFields names something es doesn't have.
+func PublicSchema(es graphql.ExecutableSchema) graphql.ExecutableSchema {
+	full := es.Schema()
+	s := &ast.Schema{
+		Types:         map[string]*ast.Definition{},
+		Directives:    map[string]*ast.DirectiveDefinition{},
+		PossibleTypes: map[string][]*ast.Definition{},
+		Implements:    map[string][]*ast.Definition{},
+	}
+
+	var include func(name string)
+	include = func(name string) {
+		if _, ok := s.Types[name]; ok {
+			return
+		}
+
+		def := full.Types[name]
+		if def == nil {
+			panic(fmt.Sprintf("public schema: no type %s", name))
+		}
+
+		// Introspection types, scalars, enums and inputs are copied whole.
+		if def.Kind != ast.Object || strings.HasPrefix(name, "__") {
+			s.Types[name] = def
+			for _, f := range def.Fields {
+				include(f.Type.Name())
+			}
+			return
+		}
+
+		names, ok := PublicFields[name]
+		if !ok {
+			panic(fmt.Sprintf("public schema: %s is reachable but not in PublicFields", name))
+		}
+
+		cp := *def
+		cp.Fields = nil
+		s.Types[name] = &cp
+		s.PossibleTypes[name] = []*ast.Definition{&cp}
+		for _, f := range def.Fields {
+			if !strings.HasPrefix(f.Name, "__") && !containsString(names, f.Name) {
+				continue
+			}
+
+			cp.Fields = append(cp.Fields, f)
+			include(f.Type.Name())
+			for _, a := range f.Arguments {
+				include(a.Type.Name())
+			}
+		}
+
+		if len(cp.Fields) != len(names)+introspectionFields(def) {
+			panic(fmt.Sprintf("public schema: %s is missing some of %v", name, names))
+		}
+	}
+
+	for _, name := range []string{"Boolean", "String", "Int", "Float", "ID"} {
+		include(name)
+	}
+	for name := range full.Types {
+		if strings.HasPrefix(name, "__") {
+			include(name)
+		}
+	}
+	include(full.Query.Name)
+	s.Query = s.Types[full.Query.Name]
+
+	// Directives like @hasRole refer to types that aren't public.
+	for name, d := range full.Directives {
+		public := true
+		for _, a := range d.Arguments {
+			if _, ok := s.Types[a.Type.Name()]; !ok {
+				public = false
+			}
+		}
+
+		if public {
+			s.Directives[name] = d
+		}
+	}
+
+	return &publicSchema{ExecutableSchema: es, schema: s}
+}
+
+// introspectionFields counts a definition's fields, like __schema, that
+// every query can use.
+func introspectionFields(def *ast.Definition) int {
+	n := 0
+	for _, f := range def.Fields {
+		if strings.HasPrefix(f.Name, "__") {
+			n++
+		}
+	}
+
+	return n
+}
+
+func (p *publicSchema) Schema() *ast.Schema {
+	return p.schema
+}
+
+func (p *publicSchema) Mutation(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+	return graphql.ErrorResponse(ctx, "Mutations are not available in the public API")
+}
+
+func (p *publicSchema) Subscription(ctx context.Context, op *ast.OperationDefinition) func() *graphql.Response {
+	return graphql.OneShot(graphql.ErrorResponse(ctx, "Subscriptions are not available in the public API"))
+}
+
+// PublicIntrospectionMiddleware is a gqlgen resolver middleware that answers
+// the __schema and __type introspection fields from the public schema es,
+// instead of the full schema it runs on.
+func PublicIntrospectionMiddleware(es graphql.ExecutableSchema) graphql.FieldMiddleware {
+	return func(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+		rctx := graphql.GetResolverContext(ctx)
+		if rctx == nil {
+			return next(ctx)
+		}
+
+		s := es.Schema()
+		switch rctx.Field.Name {
+		case "__schema":
+			return introspection.WrapSchema(s), nil
+		case "__type":
+			name, _ := rctx.Args["name"].(string)
+			return introspection.WrapTypeFromDef(s, s.Types[name]), nil
+		default:
+			return next(ctx)
+		}
+	}
+}
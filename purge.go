@@ -0,0 +1,195 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// cloudflareMaxFiles is the most URLs Cloudflare will purge in one request.
+	cloudflareMaxFiles = 30
+
+	// purgeConcurrency is the most purge requests sent to a CDN at once.
+	purgeConcurrency = 4
+)
+
+var (
+	purger      Purger
+	purgeClient = &http.Client{Timeout: 10 * time.Second}
+
+	// FeedPaths are pages that list posts, and so change whenever a published
+	// post does. Every site has its own.
+	FeedPaths = []string{"/", "/feed.rss", "/feed.atom", "/sitemap.xml"}
+)
+
+// Purger removes URLs from a CDN's cache.
+type Purger interface {
+	Purge(ctx context.Context, urls []string) error
+}
+
+// ConfigurePurger sets the CDN that content mutations purge. A nil purger
+// turns purging off.
+func ConfigurePurger(p Purger) {
+	purger = p
+}
+
+// Cloudflare purges URLs from a Cloudflare zone.
+type Cloudflare struct {
+	ZoneID string
+
+	// Token is an API token with the Zone.Cache Purge permission.
+	Token string
+}
+
+// Purge implements Purger.
+func (c *Cloudflare) Purge(ctx context.Context, urls []string) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(purgeConcurrency)
+	for len(urls) > 0 {
+		n := len(urls)
+		if n > cloudflareMaxFiles {
+			n = cloudflareMaxFiles
+		}
+
+		batch := urls[:n]
+		g.Go(func() error {
+			body, err := json.Marshal(map[string][]string{"files": batch})
+			if err != nil {
+				return err
+			}
+
+			req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("https://api.cloudflare.com/client/v4/zones/%s/purge_cache", url.PathEscape(c.ZoneID)), bytes.NewReader(body))
+			if err != nil {
+				return err
+			}
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+c.Token)
+
+			return doPurge(ctx, req)
+		})
+
+		urls = urls[n:]
+	}
+
+	return g.Wait()
+}
+
+// Fastly purges URLs from Fastly.
+type Fastly struct {
+	// Token is an API token with the purge_select scope.
+	Token string
+}
+
+// Purge implements Purger.
+func (f *Fastly) Purge(ctx context.Context, urls []string) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(purgeConcurrency)
+	for _, u := range urls {
+		u := u
+		g.Go(func() error {
+			parsed, err := url.Parse(u)
+			if err != nil {
+				return err
+			}
+
+			req, err := http.NewRequest(http.MethodPost, "https://api.fastly.com/purge/"+parsed.Host+parsed.RequestURI(), nil)
+			if err != nil {
+				return err
+			}
+			req.Header.Set("Fastly-Key", f.Token)
+
+			return doPurge(ctx, req)
+		})
+	}
+
+	return g.Wait()
+}
+
+func doPurge(ctx context.Context, req *http.Request) error {
+	resp, err := purgeClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s responded with %s", req.URL.Host, resp.Status)
+	}
+
+	return nil
+}
+
+// PurgeCache purges paths on the site the request is for from the CDN, and
+// returns the URLs that were purged. Nothing is purged if no CDN is configured.
+func PurgeCache(ctx context.Context, paths []string) ([]string, error) {
+	urls := make([]string, 0)
+	if purger == nil || len(paths) == 0 {
+		return urls, nil
+	}
+
+	site, err := GetSiteSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	base := strings.TrimRight(site.URL, "/")
+	if base == "" {
+		return nil, fmt.Errorf("The site URL must be set to purge the cache")
+	}
+
+	seen := map[string]bool{}
+	for _, p := range paths {
+		if !strings.HasPrefix(p, "/") {
+			return nil, fmt.Errorf("Paths to purge must start with /, got %q", p)
+		}
+
+		u := base + p
+		if !seen[u] {
+			seen[u] = true
+			urls = append(urls, u)
+		}
+	}
+
+	if err := purger.Purge(ctx, urls); err != nil {
+		return nil, err
+	}
+
+	return urls, nil
+}
+
+// purgeCache purges paths on the site the request is for in the background,
+// so mutations don't wait on the CDN. Errors are logged.
+func purgeCache(ctx context.Context, paths ...string) {
+	if purger == nil {
+		return
+	}
+
+	site := SiteForContext(ctx)
+	GoTask("purge", func() {
+		ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), SiteCtxKey, site), time.Minute)
+		defer cancel()
+
+		if _, err := PurgeCache(ctx, paths); err != nil {
+			log.Printf("could not purge %v: %+v", paths, err)
+		}
+	})
+}
+
+// purgePaths are the pages that show the post.
+func (p *Post) purgePaths() []string {
+	paths := append([]string{"/post/" + p.ID}, FeedPaths...)
+	for _, t := range p.Tags {
+		paths = append(paths, "/tags/"+url.PathEscape(t))
+	}
+
+	return paths
+}
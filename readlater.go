@@ -0,0 +1,214 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// ReadLaterItem is a URL or a post that a user saved to read later.
+type ReadLaterItem struct {
+	ID      string    `json:"id"`
+	UserID  string    `json:"user_id"`
+	URL     *string   `json:"url"`
+	PostID  *string   `json:"post_id"`
+	Created time.Time `json:"created"`
+}
+
+// ReadingProgress is how far through a post a user has read.
+type ReadingProgress struct {
+	UserID   string    `json:"user_id"`
+	PostID   string    `json:"post_id"`
+	Percent  int       `json:"percent"`
+	Modified time.Time `json:"modified"`
+}
+
+const readLaterColumns = "id, user_id, url, post_id, created_at"
+
+// readLaterOrders are the ORDER BY clauses of the read later orders.
+var readLaterOrders = map[ReadLaterOrder]string{
+	ReadLaterOrderNewest: "created_at DESC, id DESC",
+	ReadLaterOrderOldest: "created_at, id",
+}
+
+func scanReadLaterItem(row interface {
+	Scan(dest ...interface{}) error
+}) (*ReadLaterItem, error) {
+	i := new(ReadLaterItem)
+	var postID sql.NullInt64
+	if err := row.Scan(&i.ID, &i.UserID, &i.URL, &postID, &i.Created); err != nil {
+		return nil, err
+	}
+	if postID.Valid {
+		id := strconv.FormatInt(postID.Int64, 10)
+		i.PostID = &id
+	}
+
+	return i, nil
+}
+
+// parsePostID parses the ID of a post that the reader can read.
+func parsePostID(ctx context.Context, field, id string) (int64, error) {
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, Validation(field, "%q is not a post id", id)
+	}
+
+	if _, err := getReadablePost(ctx, n); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// getReadablePost gets a post that the reader can read without an unlock
+// token. Drafts are only readable by admins.
+func getReadablePost(ctx context.Context, id int64) (*Post, error) {
+	p, err := GetPost(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Draft && !HasRole(ctx, RoleAdmin) {
+		return nil, NotFound("No post with id %d", id)
+	}
+
+	if err := p.CanRead(ctx, ""); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// ReadLater saves a URL or a post, exactly one of which must be set, to the
+// user's read later queue. Saving something already in the queue returns the
+// item that is there.
+func ReadLater(ctx context.Context, u *User, rawurl, postID *string) (*ReadLaterItem, error) {
+	if (rawurl == nil) == (postID == nil) {
+		return nil, Validation("", "Exactly one of url and postId is required")
+	}
+
+	column, value := "url", interface{}(rawurl)
+	if rawurl != nil {
+		if err := validLinkURL(*rawurl); err != nil {
+			return nil, Validation("url", "%s", err.Error())
+		}
+	} else {
+		id, err := parsePostID(ctx, "postId", *postID)
+		if err != nil {
+			return nil, err
+		}
+		column, value = "post_id", id
+	}
+
+	// The no-op update makes RETURNING work for items already in the queue.
+	row := db.QueryRowContext(ctx, `
+INSERT INTO read_later (user_id, `+column+`, created_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, `+column+`) DO UPDATE SET created_at = read_later.created_at
+RETURNING `+readLaterColumns, u.ID, value, time.Now())
+	return scanReadLaterItem(row)
+}
+
+// RemoveReadLater takes an item out of the user's read later queue.
+func RemoveReadLater(ctx context.Context, u *User, id string) error {
+	res, err := db.ExecContext(ctx, "DELETE FROM read_later WHERE id = $1 AND user_id = $2", id, u.ID)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return NotFound("No read later item with id %s", id)
+	}
+
+	return nil
+}
+
+// ReadLaterQueue returns a page of the user's read later queue, in order,
+// which defaults to newest first.
+func ReadLaterQueue(ctx context.Context, u *User, order *ReadLaterOrder, limit, offset int) ([]*ReadLaterItem, error) {
+	by := readLaterOrders[ReadLaterOrderNewest]
+	if order != nil {
+		o, ok := readLaterOrders[*order]
+		if !ok {
+			return nil, Validation("order", "%q is not a valid order", *order)
+		}
+		by = o
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT "+readLaterColumns+" FROM read_later WHERE user_id = $1 ORDER BY "+by+" LIMIT $2 OFFSET $3", u.ID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]*ReadLaterItem, 0)
+	for rows.Next() {
+		i, err := scanReadLaterItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// GetReadingProgress returns how far through a post the user has read, or
+// nil if they haven't started it.
+func GetReadingProgress(ctx context.Context, u *User, postID string) (*ReadingProgress, error) {
+	id, err := strconv.ParseInt(postID, 10, 64)
+	if err != nil {
+		return nil, Validation("postId", "%q is not a post id", postID)
+	}
+
+	p := &ReadingProgress{UserID: u.ID, PostID: postID}
+	var modified pq.NullTime
+	err = db.QueryRowContext(ctx, "SELECT percent, modified_at FROM reading_progress WHERE user_id = $1 AND post_id = $2", u.ID, id).Scan(&p.Percent, &modified)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	}
+
+	p.Modified = modified.Time
+	return p, nil
+}
+
+// SaveReadingProgress records how far through a post, from 0 to 100 percent,
+// the user has read. The latest save wins, so any device can pick up where
+// another left off.
+func SaveReadingProgress(ctx context.Context, u *User, postID string, percent int) (*ReadingProgress, error) {
+	if percent < 0 || percent > 100 {
+		return nil, Validation("percent", "Percent must be from 0 to 100")
+	}
+
+	id, err := parsePostID(ctx, "postId", postID)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &ReadingProgress{UserID: u.ID, PostID: postID, Percent: percent, Modified: time.Now()}
+	_, err = db.ExecContext(ctx, `
+INSERT INTO reading_progress (user_id, post_id, percent, modified_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, post_id) DO UPDATE
+SET (percent, modified_at) = ($3, $4)
+`, u.ID, id, percent, p.Modified)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
@@ -0,0 +1,200 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// maxRedirectHops is the longest chain of redirects we allow.
+const maxRedirectHops = 10
+
+// ErrRedirectNotFound is returned by FollowRedirect when there is no redirect
+// for a path.
+var ErrRedirectNotFound = NotFound("No redirect for path")
+
+// Redirect sends requests for a path on this server somewhere else.
+type Redirect struct {
+	ID       string     `json:"id"`
+	From     string     `json:"from"`
+	To       string     `json:"to"`
+	Status   int        `json:"status"`
+	Hits     int        `json:"hits"`
+	LastHit  *time.Time `json:"last_hit"`
+	Created  time.Time  `json:"created"`
+	Modified time.Time  `json:"modified"`
+}
+
+// NormalizeRedirectPath cleans up a path so /foo and /foo/ match the same
+// redirect.
+func NormalizeRedirectPath(path string) string {
+	path = strings.TrimSpace(path)
+	if len(path) > 1 {
+		path = strings.TrimRight(path, "/")
+	}
+
+	return path
+}
+
+func scanRedirect(row interface {
+	Scan(dest ...interface{}) error
+}) (*Redirect, error) {
+	r := new(Redirect)
+	var lastHit pq.NullTime
+	if err := row.Scan(&r.ID, &r.From, &r.To, &r.Status, &r.Hits, &lastHit, &r.Created, &r.Modified); err != nil {
+		return nil, err
+	}
+	r.LastHit = nullTimePtr(lastHit)
+
+	return r, nil
+}
+
+// Validate checks the redirect, including that it doesn't create a loop with
+// existing redirects.
+func (r *Redirect) Validate(ctx context.Context) error {
+	r.From = NormalizeRedirectPath(r.From)
+	r.To = strings.TrimSpace(r.To)
+
+	if !strings.HasPrefix(r.From, "/") {
+		return Validation("from", "From must be a path, like /old")
+	}
+
+	if r.To == "" {
+		return Validation("to", "To is required")
+	}
+
+	switch r.Status {
+	case 301, 302, 307, 308:
+	default:
+		return Validation("status", "Status must be 301, 302, 307 or 308")
+	}
+
+	// Follow the chain of redirects from the destination, looking for our
+	// way back.
+	next := r.To
+	for i := 0; i < maxRedirectHops; i++ {
+		if !strings.HasPrefix(next, "/") {
+			return nil
+		}
+
+		next = NormalizeRedirectPath(next)
+		if next == r.From {
+			return fmt.Errorf("Redirecting %s to %s would create a loop", r.From, r.To)
+		}
+
+		err := db.QueryRowContext(ctx, "SELECT to_url FROM redirects WHERE from_path = $1", next).Scan(&next)
+		switch {
+		case err == sql.ErrNoRows:
+			return nil
+		case err != nil:
+			return Internalf("Error running get query: %+v", err)
+		}
+	}
+
+	return fmt.Errorf("Redirecting %s to %s would chain more than %d redirects", r.From, r.To, maxRedirectHops)
+}
+
+// CreateRedirect validates and stores a new redirect.
+func CreateRedirect(ctx context.Context, from, to string, status int) (*Redirect, error) {
+	r := &Redirect{From: from, To: to, Status: status}
+	if err := r.Validate(ctx); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	row := db.QueryRowContext(ctx, "INSERT INTO redirects (from_path, to_url, status, created_at, modified_at) VALUES ($1, $2, $3, $4, $4) RETURNING id, from_path, to_url, status, hits, last_hit_at, created_at, modified_at", r.From, r.To, r.Status, now)
+	r, err := scanRedirect(row)
+	if err != nil {
+		return nil, err
+	}
+
+	purgeCache(ctx, r.From)
+	return r, nil
+}
+
+// Save validates and updates an existing redirect.
+func (r *Redirect) Save(ctx context.Context) error {
+	if err := r.Validate(ctx); err != nil {
+		return err
+	}
+
+	// The old path stops redirecting if from_path changes.
+	var old string
+	now := time.Now()
+	err := db.QueryRowContext(ctx, "UPDATE redirects SET from_path = $2, to_url = $3, status = $4, modified_at = $5 FROM redirects AS old WHERE redirects.id = $1 AND old.id = redirects.id RETURNING old.from_path", r.ID, r.From, r.To, r.Status, now).Scan(&old)
+	switch {
+	case err == sql.ErrNoRows:
+		return NotFound("No redirect with id %s", r.ID)
+	case err != nil:
+		return err
+	}
+
+	r.Modified = now
+	purgeCache(ctx, old, r.From)
+	return nil
+}
+
+// Delete removes the redirect.
+func (r *Redirect) Delete(ctx context.Context) error {
+	if _, err := db.ExecContext(ctx, "DELETE FROM redirects WHERE id = $1", r.ID); err != nil {
+		return err
+	}
+
+	purgeCache(ctx, r.From)
+	return nil
+}
+
+// GetRedirect returns a redirect by ID.
+func GetRedirect(ctx context.Context, id string) (*Redirect, error) {
+	row := db.QueryRowContext(ctx, "SELECT id, from_path, to_url, status, hits, last_hit_at, created_at, modified_at FROM redirects WHERE id = $1", id)
+	r, err := scanRedirect(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, NotFound("No redirect with id %s", id)
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return r, nil
+	}
+}
+
+// Redirects returns all redirects, ordered by path.
+func Redirects(ctx context.Context) ([]*Redirect, error) {
+	rows, err := db.QueryContext(ctx, "SELECT id, from_path, to_url, status, hits, last_hit_at, created_at, modified_at FROM redirects ORDER BY from_path")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	redirects := make([]*Redirect, 0)
+	for rows.Next() {
+		r, err := scanRedirect(rows)
+		if err != nil {
+			return nil, err
+		}
+		redirects = append(redirects, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return redirects, nil
+}
+
+// FollowRedirect returns the redirect for a path, counting the hit.
+func FollowRedirect(ctx context.Context, path string) (*Redirect, error) {
+	row := db.QueryRowContext(ctx, "UPDATE redirects SET hits = hits + 1, last_hit_at = $2 WHERE from_path = $1 RETURNING id, from_path, to_url, status, hits, last_hit_at, created_at, modified_at", NormalizeRedirectPath(path), time.Now())
+	r, err := scanRedirect(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, ErrRedirectNotFound
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return r, nil
+	}
+}
@@ -0,0 +1,121 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/basvanbeek/ocsql"
+	"github.com/vektah/gqlparser/ast"
+)
+
+type replicaCtxKeyType int
+
+const replicaCtxKey replicaCtxKeyType = iota
+
+// database is the primary database, plus an optional read replica. Reads
+// from GraphQL queries go to the replica, everything else goes to the
+// primary.
+type database struct {
+	*sql.DB
+	replica *sql.DB
+
+	pinsMu sync.Mutex
+	pins   map[string]time.Time
+}
+
+// InitReplica connects to a read-only replica of the primary database. It
+// must be called after InitDB.
+func InitReplica(dataSourceName string) error {
+	wrappedDriver, err := ocsql.Register(driver, ocsql.WithAllTraceOptions())
+	if err != nil {
+		return fmt.Errorf("Failed to register the ocsql driver: %+v", err)
+	}
+
+	conn, _ := sql.Open(wrappedDriver, dataSourceName)
+	if err := conn.PingContext(context.Background()); err != nil {
+		return err
+	}
+
+	db.replica = conn
+	db.pins = map[string]time.Time{}
+	return nil
+}
+
+// reader returns the database to read from.
+func (d *database) reader(ctx context.Context) *sql.DB {
+	if d.replica == nil {
+		return d.DB
+	}
+
+	if ok, _ := ctx.Value(replicaCtxKey).(bool); !ok {
+		return d.DB
+	}
+
+	if u := ForContext(ctx); u != nil && d.pinned(u.ID) {
+		return d.DB
+	}
+
+	return d.replica
+}
+
+// pin sends the user's reads to the primary until the replica has caught up
+// with their writes.
+func (d *database) pin(userID string, until time.Time) {
+	d.pinsMu.Lock()
+	defer d.pinsMu.Unlock()
+
+	now := time.Now()
+	for id, t := range d.pins {
+		if now.After(t) {
+			delete(d.pins, id)
+		}
+	}
+	d.pins[userID] = until
+}
+
+func (d *database) pinned(userID string) bool {
+	d.pinsMu.Lock()
+	defer d.pinsMu.Unlock()
+
+	return time.Now().Before(d.pins[userID])
+}
+
+// QueryContext runs a query on the replica if the context allows it.
+func (d *database) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return d.reader(ctx).QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext runs a query on the replica if the context allows it.
+func (d *database) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return d.reader(ctx).QueryRowContext(ctx, query, args...)
+}
+
+// ReplicaMiddleware is a gqlgen request middleware that lets GraphQL queries
+// read from the replica. After a user runs a mutation, their reads go to the
+// primary for the lag duration, so they see their own writes.
+func ReplicaMiddleware(lag time.Duration) graphql.RequestMiddleware {
+	return func(ctx context.Context, next func(ctx context.Context) []byte) []byte {
+		rctx := graphql.GetRequestContext(ctx)
+		if db == nil || db.replica == nil || rctx == nil || rctx.Doc == nil {
+			return next(ctx)
+		}
+
+		// Documents can hold several operations, and we don't know which one
+		// is running, so only use the replica if they are all queries.
+		for _, op := range rctx.Doc.Operations {
+			if op.Operation != ast.Query {
+				res := next(ctx)
+				if u := ForContext(ctx); u != nil {
+					db.pin(u.ID, time.Now().Add(lag))
+				}
+				return res
+			}
+		}
+
+		return next(context.WithValue(ctx, replicaCtxKey, true))
+	}
+}
@@ -0,0 +1,25 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/go-chi/chi/middleware"
+)
+
+// RequestID returns the ID of the request ctx is for, or of the request that
+// caused the job or delivery ctx is running, or "" if there isn't one. Jobs,
+// webhook deliveries and emails carry it, so their logs can be traced back
+// to the API call that caused them.
+func RequestID(ctx context.Context) string {
+	return middleware.GetReqID(ctx)
+}
+
+// WithRequestID returns ctx with id as its request ID, for work done later on
+// behalf of a request. An empty id leaves ctx alone.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, middleware.RequestIDKey, id)
+}
@@ -3,21 +3,44 @@
 package graphql
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"encoding/base64"
 	"fmt"
+	"net"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/lib/pq"
 )
 
+// MaxPageSize is the most items the posts and links lists return at once.
+// Larger limits are lowered to it.
+const MaxPageSize = 100
+
 type key int
 
 const (
 	// UserCtxKey is a constant context key
 	UserCtxKey = iota
+
+	// ServiceAccountCtxKey is the context key for the current service account.
+	ServiceAccountCtxKey
+
+	// RemoteAddrCtxKey is the context key for the client's IP address.
+	RemoteAddrCtxKey
+
+	// SessionIDCtxKey is the context key for the current session's ID.
+	SessionIDCtxKey
+
+	// SiteCtxKey is the context key for the site the request is for.
+	SiteCtxKey
+
+	// TimezoneCtxKey is the context key for the request's X-Timezone header.
+	TimezoneCtxKey
 )
 
 // ForContext finds the user from the context. Requires
@@ -27,86 +50,1259 @@ func ForContext(ctx context.Context) *User {
 	return raw
 }
 
+// RemoteAddrForContext returns the client's IP address from the context,
+// without a port. Requires server.ContextMiddleware to have run.
+func RemoteAddrForContext(ctx context.Context) string {
+	raw, _ := ctx.Value(RemoteAddrCtxKey).(string)
+	if host, _, err := net.SplitHostPort(raw); err == nil {
+		return host
+	}
+	return raw
+}
+
+// SessionIDForContext returns the ID of the session the request was made
+// with, if any. Requires server.ContextMiddleware to have run.
+func SessionIDForContext(ctx context.Context) string {
+	raw, _ := ctx.Value(SessionIDCtxKey).(string)
+	return raw
+}
+
+// ServiceAccountForContext finds the service account from the context.
+// Requires server.ContextMiddleware to have run.
+func ServiceAccountForContext(ctx context.Context) *ServiceAccount {
+	raw, _ := ctx.Value(ServiceAccountCtxKey).(*ServiceAccount)
+	return raw
+}
+
+// HasRole returns true if the user or service account in the context has been
+// granted role.
+func HasRole(ctx context.Context, role Role) bool {
+	u := ForContext(ctx)
+	sa := ServiceAccountForContext(ctx)
+	return (u != nil && Role(u.Role) == role) || (sa != nil && sa.HasScope(string(role)))
+}
+
 // Resolver is the type that gqlgen expects to exist
 type Resolver struct{}
 
-// New returns a Config that has all of the proper settings for this graphql
-// server.
-func New() Config {
-	c := Config{
-		Resolvers: &Resolver{},
+// New returns a Config that has all of the proper settings for this graphql
+// server.
+func New() Config {
+	c := Config{
+		Resolvers: &Resolver{},
+	}
+
+	c.Directives.HasRole = func(ctx context.Context, _ interface{}, next graphql.Resolver, role Role) (interface{}, error) {
+		if !HasRole(ctx, role) {
+			// block calling the next resolver
+			return nil, ErrForbidden
+		}
+
+		// or let it pass through
+		return next(ctx)
+	}
+
+	// Lists cost what they return, for cost budgets.
+	c.Complexity.Query.Posts = func(childComplexity int, limit *int, offset *int) int {
+		return listComplexity(childComplexity, limit, 20)
+	}
+	c.Complexity.Query.Links = func(childComplexity int, filter *LinkFilter, limit *int, offset *int) int {
+		return listComplexity(childComplexity, limit, 50)
+	}
+	c.Complexity.Query.Users = func(childComplexity int, filter *UserFilter, limit *int, offset *int) int {
+		return listComplexity(childComplexity, limit, 50)
+	}
+	c.Complexity.Query.ReadLater = func(childComplexity int, order *ReadLaterOrder, limit *int, offset *int) int {
+		return listComplexity(childComplexity, limit, 20)
+	}
+	c.Complexity.Query.Jobs = func(childComplexity int, limit *int, status *JobStatus, requestID *string) int {
+		return listComplexity(childComplexity, limit, 20)
+	}
+	c.Complexity.Query.Stats = func(childComplexity int, count *int) int {
+		return listComplexity(childComplexity, count, 6)
+	}
+
+	return c
+}
+
+// AutomationRule returns the resolver for AutomationRule fields.
+func (r *Resolver) AutomationRule() AutomationRuleResolver {
+	return &automationRuleResolver{r}
+}
+
+// Group returns the resolver for Group fields.
+func (r *Resolver) Group() GroupResolver {
+	return &groupResolver{r}
+}
+
+// Highlight returns the resolver for Highlight fields.
+func (r *Resolver) Highlight() HighlightResolver {
+	return &highlightResolver{r}
+}
+
+// Mutation returns the resolver for Mutations.
+func (r *Resolver) Mutation() MutationResolver {
+	return &mutationResolver{r}
+}
+
+// Post returns the resolver for Post fields.
+func (r *Resolver) Post() PostResolver {
+	return &postResolver{r}
+}
+
+// Query returns the resolver for Queries.
+func (r *Resolver) Query() QueryResolver {
+	return &queryResolver{r}
+}
+
+// ReadLaterItem returns the resolver for ReadLaterItem fields.
+func (r *Resolver) ReadLaterItem() ReadLaterItemResolver {
+	return &readLaterItemResolver{r}
+}
+
+// ServiceAccount returns the resolver for ServiceAccount fields.
+func (r *Resolver) ServiceAccount() ServiceAccountResolver {
+	return &serviceAccountResolver{r}
+}
+
+// Session returns the resolver for Session fields.
+func (r *Resolver) Session() SessionResolver {
+	return &sessionResolver{r}
+}
+
+// User returns the resolver for User fields.
+func (r *Resolver) User() UserResolver {
+	return &userResolver{r}
+}
+
+type mutationResolver struct{ *Resolver }
+
+func (r *mutationResolver) CreatePost(ctx context.Context, input NewPost) (Post, error) {
+	p := &Post{}
+	maxID, err := GetMaxID(ctx)
+	if err != nil {
+		return Post{}, err
+	}
+	id := maxID + 1
+
+	p.ID = strconv.FormatInt(id, 10)
+	p.Title = input.Title
+	p.Content = input.Content
+	p.Datetime = input.Datetime
+	p.Draft = input.Draft
+	p.Created = time.Now()
+	p.setCrossPost(input.CanonicalURL, input.OriginallyPublishedAt)
+	if err := p.setLicense(input.License); err != nil {
+		return Post{}, err
+	}
+
+	err = p.Save(ctx)
+	if err != nil {
+		return Post{}, err
+	}
+
+	post, err := GetPost(ctx, id)
+	if err != nil {
+		return Post{}, err
+	}
+
+	return *post, nil
+}
+
+func (r *mutationResolver) EditPost(ctx context.Context, id string, input NewPost, version int) (Post, error) {
+	i, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return Post{}, err
+	}
+
+	p, err := GetPost(ctx, i)
+	if err != nil {
+		return Post{}, err
+	}
+
+	if p.Version != version {
+		return Post{}, Conflict(p.Version, "Post %s was changed since version %d, and is now at version %d", p.ID, version, p.Version)
+	}
+
+	if _, err = p.SaveRevision(ctx); err != nil {
+		return Post{}, err
+	}
+
+	p.Title = input.Title
+	p.Content = input.Content
+	p.Datetime = input.Datetime
+	p.Draft = input.Draft
+	p.setCrossPost(input.CanonicalURL, input.OriginallyPublishedAt)
+	if err := p.setLicense(input.License); err != nil {
+		return Post{}, err
+	}
+
+	err = p.Save(ctx)
+	if err != nil {
+		return Post{}, err
+	}
+
+	post, err := GetPost(ctx, i)
+	if err != nil {
+		return Post{}, err
+	}
+
+	return *post, nil
+}
+
+func (r *mutationResolver) RevertPost(ctx context.Context, id string, revision int, version *int) (Post, error) {
+	i, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return Post{}, err
+	}
+
+	p, err := GetPost(ctx, i)
+	if err != nil {
+		return Post{}, err
+	}
+
+	if version != nil && p.Version != *version {
+		return Post{}, Conflict(p.Version, "Post %s was changed since version %d, and is now at version %d", p.ID, *version, p.Version)
+	}
+
+	rev, err := GetRevision(ctx, p.ID, revision)
+	if err != nil {
+		return Post{}, err
+	}
+
+	if _, err = p.SaveRevision(ctx); err != nil {
+		return Post{}, err
+	}
+
+	p.Title = rev.Title
+	p.Content = rev.Content
+	p.Datetime = rev.Datetime
+	p.Draft = rev.Draft
+
+	err = p.Save(ctx)
+	if err != nil {
+		return Post{}, err
+	}
+
+	post, err := GetPost(ctx, i)
+	if err != nil {
+		return Post{}, err
+	}
+
+	return *post, nil
+}
+
+func (r *mutationResolver) SetPostMetadata(ctx context.Context, id string, metadata []MetadataInput) (Post, error) {
+	i, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return Post{}, err
+	}
+
+	p, err := GetPost(ctx, i)
+	if err != nil {
+		return Post{}, err
+	}
+
+	if err := p.SetMetadata(ctx, metadata); err != nil {
+		return Post{}, err
+	}
+
+	return *p, nil
+}
+
+func (r *mutationResolver) SetPostPassword(ctx context.Context, id string, password *string) (Post, error) {
+	i, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return Post{}, err
+	}
+
+	p, err := GetPost(ctx, i)
+	if err != nil {
+		return Post{}, err
+	}
+
+	pw := ""
+	if password != nil {
+		pw = *password
+	}
+	if err := p.SetPassword(ctx, pw); err != nil {
+		return Post{}, err
+	}
+
+	return *p, nil
+}
+
+func (r *mutationResolver) UnlockPost(ctx context.Context, id string, password string) (string, error) {
+	i, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return "", err
+	}
+
+	p, err := GetPost(ctx, i)
+	if err != nil {
+		return "", err
+	}
+
+	return p.Unlock(ctx, password, RemoteAddrForContext(ctx))
+}
+
+func (r *mutationResolver) CreateLink(ctx context.Context, input NewLink) (Link, error) {
+	l := &Link{
+		Title:       input.Title,
+		URI:         input.URI,
+		Description: input.Description,
+		Tags:        []string{},
+		Created:     input.Created,
+	}
+	for _, t := range input.Tags {
+		if t != nil {
+			l.Tags = append(l.Tags, *t)
+		}
+	}
+
+	if err := l.Save(ctx); err != nil {
+		return Link{}, err
+	}
+
+	return *l, nil
+}
+
+func (r *mutationResolver) SaveLink(ctx context.Context, url string, tags []string) (Link, error) {
+	l, err := SaveLink(ctx, url, tags)
+	if err != nil {
+		return Link{}, err
+	}
+
+	return *l, nil
+}
+
+func (r *mutationResolver) UpsertStat(ctx context.Context, input NewStat) (Stat, error) {
+	return Stat{}, fmt.Errorf("not implemented")
+}
+
+func (r *mutationResolver) CreateServiceAccount(ctx context.Context, input NewServiceAccount) (ServiceAccountCredentials, error) {
+	sa, secret, err := CreateServiceAccount(ctx, input.Name, input.Scopes, input.Expires)
+	if err != nil {
+		return ServiceAccountCredentials{}, err
+	}
+
+	return ServiceAccountCredentials{ServiceAccount: *sa, Secret: secret}, nil
+}
+
+func (r *mutationResolver) RotateServiceAccountSecret(ctx context.Context, id string) (ServiceAccountCredentials, error) {
+	sa, err := GetServiceAccount(ctx, id)
+	if err != nil {
+		return ServiceAccountCredentials{}, err
+	}
+
+	secret, err := sa.RotateSecret(ctx)
+	if err != nil {
+		return ServiceAccountCredentials{}, err
+	}
+
+	return ServiceAccountCredentials{ServiceAccount: *sa, Secret: secret}, nil
+}
+
+func (r *mutationResolver) RevokeServiceAccountSecret(ctx context.Context, id string) (bool, error) {
+	if err := RevokeServiceAccountSecret(ctx, id); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *mutationResolver) DeleteServiceAccount(ctx context.Context, id string) (ServiceAccount, error) {
+	sa, err := GetServiceAccount(ctx, id)
+	if err != nil {
+		return ServiceAccount{}, err
+	}
+
+	if err := sa.Delete(ctx); err != nil {
+		return ServiceAccount{}, err
+	}
+
+	return *sa, nil
+}
+
+func (r *mutationResolver) CreateOIDCClient(ctx context.Context, input NewOIDCClient) (OIDCClientCredentials, error) {
+	c, secret, err := CreateOIDCClient(ctx, input.Name, input.RedirectURIs)
+	if err != nil {
+		return OIDCClientCredentials{}, err
+	}
+
+	return OIDCClientCredentials{Client: *c, Secret: secret}, nil
+}
+
+func (r *mutationResolver) DeleteOIDCClient(ctx context.Context, id string) (OIDCClient, error) {
+	c, err := GetOIDCClient(ctx, id)
+	if err != nil {
+		return OIDCClient{}, err
+	}
+
+	if err := c.Delete(ctx); err != nil {
+		return OIDCClient{}, err
+	}
+
+	return *c, nil
+}
+
+func (r *mutationResolver) ProvisionUsers(ctx context.Context, input []NewUser) ([]*User, error) {
+	users := make([]*User, 0, len(input))
+	for _, in := range input {
+		u, err := ProvisionUser(ctx, in.ID, in.Role)
+		if err != nil {
+			return users, err
+		}
+		users = append(users, u)
+	}
+
+	return users, nil
+}
+
+func (r *mutationResolver) DeactivateUsers(ctx context.Context, ids []string) ([]*User, error) {
+	users := make([]*User, 0, len(ids))
+	for _, id := range ids {
+		u, err := FindUser(ctx, id)
+		if err != nil {
+			return users, err
+		}
+
+		if err := u.Deactivate(ctx); err != nil {
+			return users, err
+		}
+		users = append(users, u)
+	}
+
+	return users, nil
+}
+
+func (r *mutationResolver) DeactivateUsersMatching(ctx context.Context, filter UserFilter) (Job, error) {
+	j, err := DeactivateMatchingUsers(ctx, ForContext(ctx), filter)
+	if err != nil {
+		return Job{}, err
+	}
+
+	return *j, nil
+}
+
+func (r *mutationResolver) ReassignUserContent(ctx context.Context, from string, to string) (Job, error) {
+	j, err := ReassignUserContent(ctx, ForContext(ctx), from, to)
+	if err != nil {
+		return Job{}, err
+	}
+
+	return *j, nil
+}
+
+func (r *mutationResolver) MergeUsers(ctx context.Context, from string, into string) (Job, error) {
+	j, err := MergeUsers(ctx, ForContext(ctx), from, into)
+	if err != nil {
+		return Job{}, err
+	}
+
+	return *j, nil
+}
+
+func (r *mutationResolver) RequeueJob(ctx context.Context, id string) (Job, error) {
+	j, err := RequeueJob(ctx, id)
+	if err != nil {
+		return Job{}, err
+	}
+
+	return *j, nil
+}
+
+func (r *mutationResolver) ReactivateUsers(ctx context.Context, ids []string) ([]*User, error) {
+	users := make([]*User, 0, len(ids))
+	for _, id := range ids {
+		u, err := FindUser(ctx, id)
+		if err != nil {
+			return users, err
+		}
+
+		if err := u.Reactivate(ctx); err != nil {
+			return users, err
+		}
+		users = append(users, u)
+	}
+
+	return users, nil
+}
+
+func (r *mutationResolver) CreateInvite(ctx context.Context) (Invite, error) {
+	i, err := NewInvite(ctx)
+	if err != nil {
+		return Invite{}, err
+	}
+
+	return *i, nil
+}
+
+func (r *mutationResolver) CreateSite(ctx context.Context, host string, allowedUsers []string) (Site, error) {
+	s, err := CreateSite(ctx, host, allowedUsers)
+	if err != nil {
+		return Site{}, err
+	}
+
+	return *s, nil
+}
+
+func (r *mutationResolver) UpdateSite(ctx context.Context, id string, host *string, allowedUsers []string) (Site, error) {
+	s, err := UpdateSite(ctx, id, host, allowedUsers)
+	if err != nil {
+		return Site{}, err
+	}
+
+	return *s, nil
+}
+
+func (r *mutationResolver) UpdateSiteSettings(ctx context.Context, input SiteSettingsInput) (SiteSettings, error) {
+	current, err := GetSiteSettings(ctx)
+	if err != nil {
+		return SiteSettings{}, err
+	}
+
+	s := *current
+	if input.Title != nil {
+		s.Title = *input.Title
+	}
+	if input.Description != nil {
+		s.Description = *input.Description
+	}
+	if input.URL != nil {
+		s.URL = *input.URL
+	}
+	if input.FooterText != nil {
+		s.FooterText = *input.FooterText
+	}
+	if input.PostsPerPage != nil {
+		s.PostsPerPage = *input.PostsPerPage
+	}
+	if input.SocialLinks != nil {
+		s.SocialLinks = make([]SocialLink, len(input.SocialLinks))
+		for i, l := range input.SocialLinks {
+			s.SocialLinks[i] = SocialLink{Name: l.Name, URL: l.URL}
+		}
+	}
+	if input.License != nil {
+		l, err := licenseFromInput(*input.License)
+		if err != nil {
+			return SiteSettings{}, err
+		}
+		s.License = l
+	}
+
+	if err := UpdateSiteSettings(ctx, &s); err != nil {
+		return SiteSettings{}, err
+	}
+
+	return s, nil
+}
+
+func (r *mutationResolver) UpdateTheme(ctx context.Context, input ThemeInput) (Theme, error) {
+	current, err := GetTheme(ctx)
+	if err != nil {
+		return Theme{}, err
+	}
+
+	t := *current
+	if input.AccentColor != nil {
+		t.AccentColor = *input.AccentColor
+	}
+	if input.LightImage != nil {
+		t.LightImage = *input.LightImage
+	}
+	if input.DarkImage != nil {
+		t.DarkImage = *input.DarkImage
+	}
+	if input.NavItems != nil {
+		t.NavItems = make([]NavItem, len(input.NavItems))
+		for i, n := range input.NavItems {
+			t.NavItems[i] = NavItem{Label: n.Label, URL: n.URL}
+		}
+	}
+
+	if err := SaveTheme(ctx, &t); err != nil {
+		return Theme{}, err
+	}
+
+	return t, nil
+}
+
+func (r *mutationResolver) ResetTheme(ctx context.Context) (Theme, error) {
+	t, err := ResetTheme(ctx)
+	if err != nil {
+		return Theme{}, err
+	}
+
+	return *t, nil
+}
+
+func (r *mutationResolver) CreateRedirect(ctx context.Context, input NewRedirect) (Redirect, error) {
+	status := 301
+	if input.Status != nil {
+		status = *input.Status
+	}
+
+	redirect, err := CreateRedirect(ctx, input.From, input.To, status)
+	if err != nil {
+		return Redirect{}, err
+	}
+
+	return *redirect, nil
+}
+
+func (r *mutationResolver) UpdateRedirect(ctx context.Context, id string, input NewRedirect) (Redirect, error) {
+	redirect, err := GetRedirect(ctx, id)
+	if err != nil {
+		return Redirect{}, err
+	}
+
+	redirect.From = input.From
+	redirect.To = input.To
+	if input.Status != nil {
+		redirect.Status = *input.Status
+	}
+
+	if err := redirect.Save(ctx); err != nil {
+		return Redirect{}, err
+	}
+
+	return *redirect, nil
+}
+
+func (r *mutationResolver) DeleteRedirect(ctx context.Context, id string) (Redirect, error) {
+	redirect, err := GetRedirect(ctx, id)
+	if err != nil {
+		return Redirect{}, err
+	}
+
+	if err := redirect.Delete(ctx); err != nil {
+		return Redirect{}, err
+	}
+
+	return *redirect, nil
+}
+
+func (r *mutationResolver) CreateShortLink(ctx context.Context, url string, slug *string) (ShortLink, error) {
+	s := ""
+	if slug != nil {
+		s = *slug
+	}
+
+	link, err := CreateShortLink(ctx, url, s)
+	if err != nil {
+		return ShortLink{}, err
+	}
+
+	return *link, nil
+}
+
+func (r *mutationResolver) CreateWebhook(ctx context.Context, input NewWebhook) (WebhookCredentials, error) {
+	w, secret, err := CreateWebhook(ctx, input.URL, input.Events)
+	if err != nil {
+		return WebhookCredentials{}, err
+	}
+
+	return WebhookCredentials{Webhook: *w, Secret: secret}, nil
+}
+
+func (r *mutationResolver) DeleteWebhook(ctx context.Context, id string) (Webhook, error) {
+	w, err := GetWebhook(ctx, id)
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	if err := w.Delete(ctx); err != nil {
+		return Webhook{}, err
+	}
+
+	return *w, nil
+}
+
+func (r *mutationResolver) CreateAutomationRule(ctx context.Context, input AutomationRuleInput) (AutomationRule, error) {
+	rule, err := CreateAutomationRule(ctx, automationRuleFromInput(input))
+	if err != nil {
+		return AutomationRule{}, err
+	}
+
+	return *rule, nil
+}
+
+func (r *mutationResolver) UpdateAutomationRule(ctx context.Context, id string, input AutomationRuleInput) (AutomationRule, error) {
+	if _, err := GetAutomationRule(ctx, id); err != nil {
+		return AutomationRule{}, err
+	}
+
+	rule := automationRuleFromInput(input)
+	rule.ID = id
+	if err := rule.Save(ctx); err != nil {
+		return AutomationRule{}, err
+	}
+
+	saved, err := GetAutomationRule(ctx, id)
+	if err != nil {
+		return AutomationRule{}, err
+	}
+
+	return *saved, nil
+}
+
+func (r *mutationResolver) DeleteAutomationRule(ctx context.Context, id string) (AutomationRule, error) {
+	rule, err := GetAutomationRule(ctx, id)
+	if err != nil {
+		return AutomationRule{}, err
+	}
+
+	if err := rule.Delete(ctx); err != nil {
+		return AutomationRule{}, err
+	}
+
+	return *rule, nil
+}
+
+func (r *mutationResolver) TestAutomationRule(ctx context.Context, condition string, postID string) (bool, error) {
+	i, err := strconv.ParseInt(postID, 10, 64)
+	if err != nil {
+		return false, err
+	}
+
+	p, err := GetPost(ctx, i)
+	if err != nil {
+		return false, err
+	}
+
+	return TestAutomationRuleCondition(ctx, condition, p)
+}
+
+func (r *mutationResolver) CheckIntegrity(ctx context.Context, repair *bool) ([]*IntegrityIssue, error) {
+	return CheckIntegrity(ctx, repair != nil && *repair)
+}
+
+// automationRuleFromInput returns the rule input describes. Rules are
+// enabled unless input says otherwise.
+func automationRuleFromInput(input AutomationRuleInput) *AutomationRule {
+	return &AutomationRule{
+		Name:      input.Name,
+		Event:     input.Event,
+		Condition: input.Condition,
+		Actions:   input.Actions,
+		Enabled:   input.Enabled == nil || *input.Enabled,
+	}
+}
+
+func (r *mutationResolver) ExportData(ctx context.Context) (DataExport, error) {
+	var buf bytes.Buffer
+	if err := Export(ctx, &buf); err != nil {
+		return DataExport{}, err
+	}
+
+	now := time.Now()
+	return DataExport{
+		Filename: ExportFilename(now),
+		Archive:  base64.StdEncoding.EncodeToString(buf.Bytes()),
+		Created:  now,
+	}, nil
+}
+
+func (r *mutationResolver) ExportHugo(ctx context.Context, media *bool) (DataExport, error) {
+	var buf bytes.Buffer
+	if err := ExportHugo(ctx, &buf, HugoOptions{Media: media != nil && *media}); err != nil {
+		return DataExport{}, err
+	}
+
+	now := time.Now()
+	return DataExport{
+		Filename: HugoExportFilename(now),
+		Archive:  base64.StdEncoding.EncodeToString(buf.Bytes()),
+		Created:  now,
+	}, nil
+}
+
+func (r *mutationResolver) ImportData(ctx context.Context, archive string) ([]*ImportResult, error) {
+	data, err := base64.StdEncoding.DecodeString(archive)
+	if err != nil {
+		return nil, fmt.Errorf("Archive is not valid base64: %+v", err)
+	}
+
+	return Import(ctx, bytes.NewReader(data))
+}
+
+func (r *mutationResolver) ImportWordPress(ctx context.Context, file string, baseURL *string) (Job, error) {
+	data, err := base64.StdEncoding.DecodeString(file)
+	if err != nil {
+		return Job{}, Validation("file", "File is not valid base64")
+	}
+
+	j, err := ImportWordPress(ctx, ForContext(ctx), data, importOptions(baseURL, nil))
+	if err != nil {
+		return Job{}, err
+	}
+
+	return *j, nil
+}
+
+func (r *mutationResolver) ImportStaticSite(ctx context.Context, archive string, baseURL *string, permalink *string) (Job, error) {
+	data, err := base64.StdEncoding.DecodeString(archive)
+	if err != nil {
+		return Job{}, Validation("archive", "Archive is not valid base64")
+	}
+
+	j, err := ImportStaticSite(ctx, ForContext(ctx), data, importOptions(baseURL, permalink))
+	if err != nil {
+		return Job{}, err
+	}
+
+	return *j, nil
+}
+
+// importOptions returns the options of an import mutation.
+func importOptions(baseURL, permalink *string) ImportOptions {
+	var opts ImportOptions
+	if baseURL != nil {
+		opts.BaseURL = *baseURL
+	}
+	if permalink != nil {
+		opts.Permalink = *permalink
+	}
+
+	return opts
+}
+
+func (r *mutationResolver) PurgeCache(ctx context.Context, paths []string) ([]string, error) {
+	return PurgeCache(ctx, paths)
+}
+
+func (r *mutationResolver) UpsertPage(ctx context.Context, slug string, content string, title *string, draft *bool, version *int) (Page, error) {
+	p, err := UpsertPage(ctx, slug, content, title, draft, version)
+	if err != nil {
+		return Page{}, err
+	}
+
+	return *p, nil
+}
+
+func (r *mutationResolver) AddBook(ctx context.Context, input NewBook) (Book, error) {
+	b, err := AddBook(ctx, input)
+	if err != nil {
+		return Book{}, err
+	}
+
+	return *b, nil
+}
+
+func (r *mutationResolver) MarkRead(ctx context.Context, id string, finished *time.Time, rating *int) (Book, error) {
+	f := time.Now()
+	if finished != nil {
+		f = *finished
+	}
+
+	b, err := MarkRead(ctx, id, f, rating)
+	if err != nil {
+		return Book{}, err
+	}
+
+	return *b, nil
+}
+
+func (r *mutationResolver) ImportGoodreads(ctx context.Context, csv string) ([]*Book, error) {
+	return ImportGoodreads(ctx, strings.NewReader(csv))
+}
+
+func (r *mutationResolver) InsertLog(ctx context.Context, input NewLog) (Log, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return Log{}, ErrForbidden
+	}
+
+	l, err := InsertLog(ctx, u, input)
+	if err != nil {
+		return Log{}, err
+	}
+
+	return *l, nil
+}
+
+func (r *mutationResolver) ShareLog(ctx context.Context, id string, groupId *string) (Log, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return Log{}, ErrForbidden
+	}
+
+	l, err := GetLog(ctx, u, id)
+	if err != nil {
+		return Log{}, err
+	}
+
+	if err := l.Share(ctx, u, groupId); err != nil {
+		return Log{}, err
+	}
+
+	return *l, nil
+}
+
+func (r *mutationResolver) CreateGroup(ctx context.Context, name string) (Group, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return Group{}, ErrForbidden
+	}
+
+	g, err := CreateGroup(ctx, u, name)
+	if err != nil {
+		return Group{}, err
+	}
+
+	return *g, nil
+}
+
+func (r *mutationResolver) CreateGroupInvite(ctx context.Context, groupId string) (string, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return "", ErrForbidden
+	}
+
+	g, err := GetGroup(ctx, u, groupId)
+	if err != nil {
+		return "", err
+	}
+
+	return g.NewInvite(ctx, u)
+}
+
+func (r *mutationResolver) JoinGroup(ctx context.Context, code string) (Group, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return Group{}, ErrForbidden
+	}
+
+	g, err := JoinGroup(ctx, u, code)
+	if err != nil {
+		return Group{}, err
+	}
+
+	return *g, nil
+}
+
+func (r *mutationResolver) LeaveGroup(ctx context.Context, id string) (Group, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return Group{}, ErrForbidden
+	}
+
+	g, err := GetGroup(ctx, u, id)
+	if err != nil {
+		return Group{}, err
+	}
+
+	if err := g.Leave(ctx, u); err != nil {
+		return Group{}, err
+	}
+
+	return *g, nil
+}
+
+func (r *mutationResolver) DeleteGroup(ctx context.Context, id string) (Group, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return Group{}, ErrForbidden
+	}
+
+	g, err := GetGroup(ctx, u, id)
+	if err != nil {
+		return Group{}, err
+	}
+
+	if err := g.Delete(ctx, u); err != nil {
+		return Group{}, err
+	}
+
+	return *g, nil
+}
+
+func (r *mutationResolver) RevokeSession(ctx context.Context, id string) (bool, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return false, ErrForbidden
+	}
+
+	if err := RevokeSession(ctx, u, id); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *mutationResolver) RevokeAllOtherSessions(ctx context.Context) (int, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return 0, ErrForbidden
+	}
+
+	return RevokeOtherSessions(ctx, u, SessionIDForContext(ctx))
+}
+
+func (r *mutationResolver) ReadLater(ctx context.Context, url *string, postID *string) (ReadLaterItem, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return ReadLaterItem{}, ErrForbidden
+	}
+
+	i, err := ReadLater(ctx, u, url, postID)
+	if err != nil {
+		return ReadLaterItem{}, err
+	}
+
+	return *i, nil
+}
+
+func (r *mutationResolver) RemoveReadLater(ctx context.Context, id string) (bool, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return false, ErrForbidden
+	}
+
+	if err := RemoveReadLater(ctx, u, id); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *mutationResolver) CreateHighlight(ctx context.Context, input NewHighlight) (Highlight, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return Highlight{}, ErrForbidden
+	}
+
+	h, err := CreateHighlight(ctx, u, input)
+	if err != nil {
+		return Highlight{}, err
+	}
+
+	return *h, nil
+}
+
+func (r *mutationResolver) UpdateHighlight(ctx context.Context, id string, note *string, public *bool) (Highlight, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return Highlight{}, ErrForbidden
+	}
+
+	h, err := UpdateHighlight(ctx, u, id, note, public)
+	if err != nil {
+		return Highlight{}, err
+	}
+
+	return *h, nil
+}
+
+func (r *mutationResolver) DeleteHighlight(ctx context.Context, id string) (bool, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return false, ErrForbidden
+	}
+
+	if err := DeleteHighlight(ctx, u, id); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *mutationResolver) ReadingProgress(ctx context.Context, postID string, percent int) (ReadingProgress, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return ReadingProgress{}, ErrForbidden
+	}
+
+	p, err := SaveReadingProgress(ctx, u, postID, percent)
+	if err != nil {
+		return ReadingProgress{}, err
+	}
+
+	return *p, nil
+}
+
+func (r *mutationResolver) UpdateNotificationSettings(ctx context.Context, input NotificationSettingsInput) (NotificationSettings, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return NotificationSettings{}, ErrForbidden
+	}
+
+	n, err := GetNotificationSettings(ctx, u.ID)
+	if err != nil {
+		return NotificationSettings{}, err
+	}
+
+	if input.Email != nil {
+		n.Email = input.Email
+		if *input.Email == "" {
+			n.Email = nil
+		}
+	}
+	if input.Comments != nil {
+		n.Comments = *input.Comments
+	}
+	if input.Digest != nil {
+		n.Digest = *input.Digest
+	}
+	if input.AuthAlerts != nil {
+		n.AuthAlerts = *input.AuthAlerts
+	}
+	if input.IntegrityAlerts != nil {
+		n.IntegrityAlerts = *input.IntegrityAlerts
+	}
+	if input.CommentsDelivery != nil {
+		n.CommentsDelivery = *input.CommentsDelivery
+	}
+	if input.AuthAlertsDelivery != nil {
+		n.AuthAlertsDelivery = *input.AuthAlertsDelivery
+	}
+	if input.Timezone != nil {
+		n.Timezone = *input.Timezone
+	}
+	if input.QuietStart != nil {
+		n.QuietStart = input.QuietStart
+	}
+	if input.QuietEnd != nil {
+		n.QuietEnd = input.QuietEnd
+	}
+	if n.QuietStart != nil && n.QuietEnd != nil && *n.QuietStart == *n.QuietEnd {
+		n.QuietStart, n.QuietEnd = nil, nil
+	}
+
+	if err := n.Save(ctx); err != nil {
+		return NotificationSettings{}, err
+	}
+
+	return *n, nil
+}
+
+type automationRuleResolver struct{ *Resolver }
+
+func (r *automationRuleResolver) Runs(ctx context.Context, obj *AutomationRule, limit *int) ([]*AutomationRuleRun, error) {
+	l := 20
+	if limit != nil && *limit > 0 {
+		l = *limit
+	}
+
+	return obj.Runs(ctx, l)
+}
+
+type groupResolver struct{ *Resolver }
+
+func (r *groupResolver) Members(ctx context.Context, obj *Group) ([]string, error) {
+	return obj.MemberIDs(ctx)
+}
+
+type postResolver struct{ *Resolver }
+
+func (r *postResolver) Revisions(ctx context.Context, obj *Post, limit *int, offset *int) ([]*Revision, error) {
+	l := 50
+	if limit != nil && *limit > 0 {
+		l = *limit
 	}
 
-	c.Directives.HasRole = func(ctx context.Context, _ interface{}, next graphql.Resolver, role Role) (interface{}, error) {
-		u := ForContext(ctx)
-		if u == nil || Role(u.Role) != role {
-			// block calling the next resolver
-			return nil, fmt.Errorf("Forbidden")
-		}
+	o := 0
+	if offset != nil {
+		o = *offset
+	}
 
-		// or let it pass through
-		return next(ctx)
+	return Revisions(ctx, obj.ID, l, o)
+}
+
+func (r *postResolver) SyndicationUrls(ctx context.Context, obj *Post) ([]string, error) {
+	return obj.SyndicationURLs(ctx)
+}
+
+func (r *postResolver) Metadata(ctx context.Context, obj *Post) ([]MetadataEntry, error) {
+	return obj.MetadataEntries(ctx)
+}
+
+func (r *postResolver) License(ctx context.Context, obj *Post) (*License, error) {
+	site, err := GetSiteSettings(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return c
+	return obj.licenseOn(site), nil
 }
 
-// Mutation returns the resolver for Mutations.
-func (r *Resolver) Mutation() MutationResolver {
-	return &mutationResolver{r}
+type serviceAccountResolver struct{ *Resolver }
+
+func (r *serviceAccountResolver) Secrets(ctx context.Context, obj *ServiceAccount) ([]*ServiceAccountSecret, error) {
+	return obj.Secrets(ctx)
 }
 
-// Query returns the resolver for Queries.
-func (r *Resolver) Query() QueryResolver {
-	return &queryResolver{r}
+type highlightResolver struct{ *Resolver }
+
+func (r *highlightResolver) Mine(ctx context.Context, obj *Highlight) (bool, error) {
+	u := ForContext(ctx)
+	return u != nil && u.ID == obj.UserID, nil
 }
 
-type mutationResolver struct{ *Resolver }
+type readLaterItemResolver struct{ *Resolver }
 
-func (r *mutationResolver) CreatePost(ctx context.Context, input NewPost) (Post, error) {
-	p := &Post{}
-	maxID, err := GetMaxID(ctx)
+func (r *readLaterItemResolver) Post(ctx context.Context, obj *ReadLaterItem) (*Post, error) {
+	if obj.PostID == nil {
+		return nil, nil
+	}
+
+	id, err := strconv.ParseInt(*obj.PostID, 10, 64)
 	if err != nil {
-		return Post{}, err
+		return nil, err
 	}
-	id := maxID + 1
 
-	p.ID = strconv.FormatInt(id, 10)
-	p.Title = input.Title
-	p.Content = input.Content
-	p.Datetime = input.Datetime
-	p.Draft = input.Draft
-	p.Created = time.Now()
+	// The post may have become a draft or protected since it was saved.
+	return getReadablePost(ctx, id)
+}
 
-	err = p.Save(ctx)
-	if err != nil {
-		return Post{}, err
+func (r *readLaterItemResolver) Progress(ctx context.Context, obj *ReadLaterItem) (*int, error) {
+	u := ForContext(ctx)
+	if obj.PostID == nil || u == nil {
+		return nil, nil
 	}
 
-	post, err := GetPost(ctx, id)
-	if err != nil {
-		return Post{}, err
+	p, err := GetReadingProgress(ctx, u, *obj.PostID)
+	if err != nil || p == nil {
+		return nil, err
 	}
 
-	return *post, nil
+	return &p.Percent, nil
 }
 
-func (r *mutationResolver) EditPost(ctx context.Context, id string, input NewPost) (Post, error) {
-	return Post{}, fmt.Errorf("not implemented")
+type sessionResolver struct{ *Resolver }
+
+func (r *sessionResolver) Current(ctx context.Context, obj *Session) (bool, error) {
+	return obj.ID == SessionIDForContext(ctx), nil
 }
 
-func (r *mutationResolver) CreateLink(ctx context.Context, input NewLink) (Link, error) {
-	return Link{}, fmt.Errorf("not implemented")
+type userResolver struct{ *Resolver }
+
+func (r *userResolver) Role(ctx context.Context, obj *User) (Role, error) {
+	return Role(obj.Role), nil
 }
 
-func (r *mutationResolver) UpsertStat(ctx context.Context, input NewStat) (Stat, error) {
-	return Stat{}, fmt.Errorf("not implemented")
+func (r *userResolver) NotificationSettings(ctx context.Context, obj *User) (*NotificationSettings, error) {
+	if u := ForContext(ctx); (u == nil || u.ID != obj.ID) && !HasRole(ctx, RoleAdmin) {
+		return nil, nil
+	}
+
+	return GetNotificationSettings(ctx, obj.ID)
+}
+
+func (r *userResolver) Identities(ctx context.Context, obj *User) ([]Identity, error) {
+	if u := ForContext(ctx); (u == nil || u.ID != obj.ID) && !HasRole(ctx, RoleAdmin) {
+		return nil, nil
+	}
+
+	return Identities(ctx, obj)
 }
 
 type queryResolver struct{ *Resolver }
 
 func (r *queryResolver) AllPosts(ctx context.Context) ([]*Post, error) {
-	rows, err := db.QueryContext(ctx, "SELECT id, title, content, date, created_at, modified_at, tags, draft FROM posts WHERE draft = false ORDER BY date DESC")
+	rows, err := db.QueryContext(ctx, "SELECT id, title, content, date, created_at, modified_at, tags, draft, canonical_url, originally_published_at, license, license_text, version FROM posts WHERE site_id = $1 AND draft = false AND password_hash IS NULL ORDER BY date DESC", siteID(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +1311,7 @@ func (r *queryResolver) AllPosts(ctx context.Context) ([]*Post, error) {
 	posts := make([]*Post, 0)
 	for rows.Next() {
 		post := new(Post)
-		err := rows.Scan(&post.ID, &post.Title, &post.Content, &post.Datetime, &post.Created, &post.Modified, pq.Array(&post.Tags), &post.Draft)
+		err := rows.Scan(&post.ID, &post.Title, &post.Content, &post.Datetime, &post.Created, &post.Modified, pq.Array(&post.Tags), &post.Draft, &post.CanonicalURL, &post.OriginallyPublishedAt, &post.LicenseID, &post.LicenseText, &post.Version)
 		if err != nil {
 			return nil, err
 		}
@@ -129,51 +1325,67 @@ func (r *queryResolver) AllPosts(ctx context.Context) ([]*Post, error) {
 }
 
 func (r *queryResolver) Posts(ctx context.Context, limit *int, offset *int) ([]*Post, error) {
-	rows, err := db.QueryContext(ctx, "SELECT id, title, content, date, created_at, modified_at, tags, draft FROM posts WHERE draft = false ORDER BY date DESC LIMIT $1 OFFSET $2", limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	posts := make([]*Post, 0)
-	for rows.Next() {
-		post := new(Post)
-		err := rows.Scan(&post.ID, &post.Title, &post.Content, &post.Datetime, &post.Created, &post.Modified, pq.Array(&post.Tags), &post.Draft)
+	if limit == nil {
+		s, err := GetSiteSettings(ctx)
 		if err != nil {
 			return nil, err
 		}
-		posts = append(posts, post)
+		limit = &s.PostsPerPage
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, err
+	l := *limit
+	if l > MaxPageSize {
+		l = MaxPageSize
 	}
-	return posts, nil
+
+	o := 0
+	if offset != nil {
+		o = *offset
+	}
+
+	return PublishedPosts(ctx, l, o)
 }
 
-func (r *queryResolver) Post(ctx context.Context, id string) (*Post, error) {
+func (r *queryResolver) Post(ctx context.Context, id string, unlock *string) (*Post, error) {
 	var post Post
-	row := db.QueryRowContext(ctx, "SELECT id, title, content, date, created_at, modified_at, tags, draft FROM posts WHERE id = $1", id)
-	err := row.Scan(&post.ID, &post.Title, &post.Content, &post.Datetime, &post.Created, &post.Modified, pq.Array(&post.Tags), &post.Draft)
+	row := db.QueryRowContext(ctx, "SELECT id, title, content, date, created_at, modified_at, tags, draft, password_hash IS NOT NULL, canonical_url, originally_published_at, license, license_text, version FROM posts WHERE id = $1 AND site_id = $2", id, siteID(ctx))
+	err := row.Scan(&post.ID, &post.Title, &post.Content, &post.Datetime, &post.Created, &post.Modified, pq.Array(&post.Tags), &post.Draft, &post.Protected, &post.CanonicalURL, &post.OriginallyPublishedAt, &post.LicenseID, &post.LicenseText, &post.Version)
 	switch {
 	case err == sql.ErrNoRows:
-		return nil, fmt.Errorf("No post with id %s", id)
+		return nil, NotFound("No post with id %s", id)
 	case err != nil:
-		return nil, fmt.Errorf("Error running get query: %+v", err)
-	default:
-		return &post, nil
+		return nil, Internalf("Error running get query: %+v", err)
+	}
+
+	token := ""
+	if unlock != nil {
+		token = *unlock
+	}
+	if err := post.CanRead(ctx, token); err != nil {
+		return nil, err
+	}
+
+	return &post, nil
+}
+
+func (r *queryResolver) SuggestPosts(ctx context.Context, path string, limit *int) ([]*Post, error) {
+	l := 5
+	if limit != nil && *limit > 0 && *limit <= 20 {
+		l = *limit
 	}
+
+	return SuggestPosts(ctx, path, l)
 }
 
 func (r *queryResolver) NextPost(ctx context.Context, id string) (*Post, error) {
 	var postID string
-	row := db.QueryRowContext(ctx, "SELECT id FROM posts WHERE draft = false AND date > (SELECT date FROM posts WHERE id = $1) ORDER BY date ASC LIMIT 1", id)
+	row := db.QueryRowContext(ctx, "SELECT id FROM posts WHERE site_id = $2 AND draft = false AND password_hash IS NULL AND date > (SELECT date FROM posts WHERE id = $1) ORDER BY date ASC LIMIT 1", id, siteID(ctx))
 	err := row.Scan(&postID)
 	switch {
 	case err == sql.ErrNoRows:
 		return nil, sql.ErrNoRows
 	case err != nil:
-		return nil, fmt.Errorf("Error running get query: %+v", err)
+		return nil, Internalf("Error running get query: %+v", err)
 	default:
 		i, err := strconv.ParseInt(postID, 10, 64)
 		if err != nil {
@@ -185,13 +1397,13 @@ func (r *queryResolver) NextPost(ctx context.Context, id string) (*Post, error)
 
 func (r *queryResolver) PrevPost(ctx context.Context, id string) (*Post, error) {
 	var postID string
-	row := db.QueryRowContext(ctx, "SELECT id FROM posts WHERE draft = false AND date < (SELECT date FROM posts WHERE id = $1) ORDER BY date DESC LIMIT 1", id)
+	row := db.QueryRowContext(ctx, "SELECT id FROM posts WHERE site_id = $2 AND draft = false AND password_hash IS NULL AND date < (SELECT date FROM posts WHERE id = $1) ORDER BY date DESC LIMIT 1", id, siteID(ctx))
 	err := row.Scan(&postID)
 	switch {
 	case err == sql.ErrNoRows:
 		return nil, sql.ErrNoRows
 	case err != nil:
-		return nil, fmt.Errorf("Error running get query: %+v", err)
+		return nil, Internalf("Error running get query: %+v", err)
 	default:
 		i, err := strconv.ParseInt(postID, 10, 64)
 		if err != nil {
@@ -201,6 +1413,18 @@ func (r *queryResolver) PrevPost(ctx context.Context, id string) (*Post, error)
 	}
 }
 
+func (r *queryResolver) SitemapPosts(ctx context.Context) ([]*Post, error) {
+	return SitemapPosts(ctx)
+}
+
+func (r *queryResolver) Tags(ctx context.Context) ([]*TagCount, error) {
+	return PublishedTags(ctx)
+}
+
+func (r *queryResolver) MetadataKeys(ctx context.Context) ([]MetadataKey, error) {
+	return MetadataKeys(), nil
+}
+
 func (r *queryResolver) Drafts(ctx context.Context) ([]*Post, error) {
 	panic("not implemented")
 }
@@ -237,13 +1461,348 @@ func (r *queryResolver) Stats(ctx context.Context, count *int) ([]*Stat, error)
 }
 
 func (r *queryResolver) AllLinks(ctx context.Context) ([]*Link, error) {
-	return nil, fmt.Errorf("not implemented")
+	return Links(ctx, LinkFilter{}, 0, 0)
 }
 
-func (r *queryResolver) Links(ctx context.Context, limit *int, offset *int) ([]*Link, error) {
-	return nil, fmt.Errorf("not implemented")
+func (r *queryResolver) Links(ctx context.Context, filter *LinkFilter, limit *int, offset *int) ([]*Link, error) {
+	f := LinkFilter{}
+	if filter != nil {
+		f = *filter
+	}
+
+	l := 50
+	if limit != nil && *limit > 0 {
+		l = *limit
+	}
+	if l > MaxPageSize {
+		l = MaxPageSize
+	}
+
+	o := 0
+	if offset != nil && *offset > 0 {
+		o = *offset
+	}
+
+	return Links(ctx, f, l, o)
 }
 
 func (r *queryResolver) Link(ctx context.Context, id string) (*Link, error) {
-	return nil, fmt.Errorf("not implemented")
+	return GetLink(ctx, id)
+}
+
+func (r *queryResolver) PostDiff(ctx context.Context, id string, from int, to int) (string, error) {
+	i, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return "", err
+	}
+
+	// Only diff posts on this site.
+	p, err := GetPost(ctx, i)
+	if err != nil {
+		return "", err
+	}
+
+	a, err := GetRevision(ctx, p.ID, from)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := GetRevision(ctx, p.ID, to)
+	if err != nil {
+		return "", err
+	}
+
+	return DiffRevisions(a, b)
+}
+
+func (r *queryResolver) ServiceAccounts(ctx context.Context) ([]*ServiceAccount, error) {
+	return ServiceAccounts(ctx)
+}
+
+func (r *queryResolver) OidcClients(ctx context.Context) ([]*OIDCClient, error) {
+	return OIDCClients(ctx)
+}
+
+func (r *queryResolver) Users(ctx context.Context, filter *UserFilter, limit *int, offset *int) ([]*User, error) {
+	f := UserFilter{}
+	if filter != nil {
+		f = *filter
+	}
+
+	l := 50
+	if limit != nil && *limit > 0 {
+		l = *limit
+	}
+
+	o := 0
+	if offset != nil && *offset > 0 {
+		o = *offset
+	}
+
+	return Users(ctx, f, l, o)
+}
+
+func (r *queryResolver) Invites(ctx context.Context) ([]*Invite, error) {
+	return Invites(ctx)
+}
+
+func (r *queryResolver) Jobs(ctx context.Context, limit *int, status *JobStatus, requestID *string) ([]*Job, error) {
+	l := 20
+	if limit != nil {
+		l = *limit
+	}
+
+	return Jobs(ctx, l, status, requestID)
+}
+
+func (r *queryResolver) Job(ctx context.Context, id string) (*Job, error) {
+	return GetJob(ctx, id)
+}
+
+func (r *queryResolver) Sites(ctx context.Context) ([]Site, error) {
+	sites, err := Sites(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]Site, 0, len(sites))
+	for _, s := range sites {
+		ret = append(ret, *s)
+	}
+	return ret, nil
+}
+
+func (r *queryResolver) SiteSettings(ctx context.Context) (SiteSettings, error) {
+	s, err := GetSiteSettings(ctx)
+	if err != nil {
+		return SiteSettings{}, err
+	}
+
+	return *s, nil
+}
+
+func (r *queryResolver) Redirects(ctx context.Context) ([]*Redirect, error) {
+	return Redirects(ctx)
+}
+
+func (r *queryResolver) ShortLinks(ctx context.Context) ([]*ShortLink, error) {
+	return ShortLinks(ctx)
+}
+
+func (r *queryResolver) ShortLink(ctx context.Context, slug string) (*ShortLink, error) {
+	return GetShortLink(ctx, slug)
+}
+
+func (r *queryResolver) Logs(ctx context.Context, rangeArg *DateRange) ([]*Log, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return nil, ErrForbidden
+	}
+
+	from, to, err := logRange(ctx, rangeArg)
+	if err != nil {
+		return nil, err
+	}
+	return UserLogs(ctx, u, from, to)
+}
+
+func (r *queryResolver) SharedWithMe(ctx context.Context, rangeArg *DateRange) ([]*Log, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return nil, ErrForbidden
+	}
+
+	from, to, err := logRange(ctx, rangeArg)
+	if err != nil {
+		return nil, err
+	}
+	return SharedLogs(ctx, u, from, to)
+}
+
+// logRange returns the range of logs to query, which defaults to the last 30
+// days.
+func logRange(ctx context.Context, rangeArg *DateRange) (time.Time, time.Time, error) {
+	if rangeArg == nil {
+		to := time.Now()
+		return to.AddDate(0, 0, -30), to, nil
+	}
+
+	return dayRange(ctx, rangeArg.From, rangeArg.To)
+}
+
+func (r *queryResolver) Groups(ctx context.Context) ([]*Group, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return nil, ErrForbidden
+	}
+
+	return UserGroups(ctx, u)
+}
+
+func (r *queryResolver) MySessions(ctx context.Context) ([]*Session, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return nil, ErrForbidden
+	}
+
+	return UserSessions(ctx, u)
+}
+
+func (r *queryResolver) ReadLater(ctx context.Context, order *ReadLaterOrder, limit *int, offset *int) ([]*ReadLaterItem, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return nil, ErrForbidden
+	}
+
+	l, o := 20, 0
+	if limit != nil {
+		l = *limit
+	}
+	if offset != nil {
+		o = *offset
+	}
+
+	return ReadLaterQueue(ctx, u, order, l, o)
+}
+
+func (r *queryResolver) ReadingProgress(ctx context.Context, postID string) (*ReadingProgress, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return nil, ErrForbidden
+	}
+
+	return GetReadingProgress(ctx, u, postID)
+}
+
+func (r *queryResolver) Highlights(ctx context.Context, postID string, includePublic *bool) ([]*Highlight, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return nil, ErrForbidden
+	}
+
+	return PostHighlights(ctx, u, postID, includePublic != nil && *includePublic)
+}
+
+func (r *queryResolver) ExportHighlights(ctx context.Context) (string, error) {
+	u := ForContext(ctx)
+	if u == nil {
+		return "", ErrForbidden
+	}
+
+	return ExportHighlights(ctx, u)
+}
+
+func (r *queryResolver) AdminStats(ctx context.Context) (AdminStats, error) {
+	s, err := GetAdminStats(ctx)
+	if err != nil {
+		return AdminStats{}, err
+	}
+
+	return *s, nil
+}
+
+func (r *queryResolver) Goroutines(ctx context.Context) ([]*GoroutineCount, error) {
+	return Goroutines()
+}
+
+func (r *queryResolver) DebugInfo(ctx context.Context) (DebugInfo, error) {
+	return *GetDebugInfo(), nil
+}
+
+func (r *queryResolver) Webhooks(ctx context.Context) ([]*Webhook, error) {
+	return Webhooks(ctx)
+}
+
+func (r *queryResolver) AutomationRules(ctx context.Context) ([]*AutomationRule, error) {
+	return AutomationRules(ctx)
+}
+
+func (r *queryResolver) IntegrityIssues(ctx context.Context) ([]*IntegrityIssue, error) {
+	return IntegrityIssues(ctx)
+}
+
+func (r *queryResolver) WebhookDeliveries(ctx context.Context, webhookID *string, failed *bool, requestID *string, limit *int) ([]*WebhookDelivery, error) {
+	l := 50
+	if limit != nil && *limit > 0 {
+		l = *limit
+	}
+
+	return WebhookDeliveries(ctx, webhookID, requestID, failed != nil && *failed, l)
+}
+
+func (r *queryResolver) Theme(ctx context.Context) (Theme, error) {
+	t, err := GetTheme(ctx)
+	if err != nil {
+		return Theme{}, err
+	}
+
+	return *t, nil
+}
+
+func (r *queryResolver) PageViews(ctx context.Context, path *string, rangeArg *DateRange) (PageViewStats, error) {
+	to := time.Now()
+	from := to.AddDate(0, 0, -30)
+	if rangeArg != nil {
+		from, to = rangeArg.From, rangeArg.To
+	}
+
+	p := ""
+	if path != nil {
+		p = *path
+	}
+
+	stats, err := GetPageViewStats(ctx, p, from, to)
+	if err != nil {
+		return PageViewStats{}, err
+	}
+
+	return *stats, nil
+}
+
+func (r *queryResolver) Page(ctx context.Context, slug string) (*Page, error) {
+	p, err := GetPage(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Draft && !HasRole(ctx, RoleAdmin) {
+		return nil, NotFound("No page with slug %s", slug)
+	}
+
+	return p, nil
+}
+
+func (r *queryResolver) Pages(ctx context.Context) ([]*Page, error) {
+	return Pages(ctx, HasRole(ctx, RoleAdmin))
+}
+
+func (r *queryResolver) Books(ctx context.Context, shelf *Shelf, year *int, limit *int, offset *int) ([]*Book, error) {
+	l := 50
+	if limit != nil && *limit > 0 {
+		l = *limit
+	}
+
+	o := 0
+	if offset != nil {
+		o = *offset
+	}
+
+	return Books(ctx, shelf, year, l, o)
+}
+
+func (r *queryResolver) Book(ctx context.Context, id string) (*Book, error) {
+	return GetBook(ctx, id)
+}
+
+func (r *queryResolver) CurrentlyReading(ctx context.Context) ([]*Book, error) {
+	return CurrentlyReading(ctx)
+}
+
+func (r *queryResolver) ReadingStats(ctx context.Context, year int) (ReadingStats, error) {
+	stats, err := GetReadingStats(ctx, year)
+	if err != nil {
+		return ReadingStats{}, err
+	}
+
+	return *stats, nil
 }
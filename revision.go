@@ -0,0 +1,107 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// Revision is a snapshot of a post as it was before an edit.
+type Revision struct {
+	ID       string    `json:"id"`
+	PostID   string    `json:"post_id"`
+	Revision int       `json:"revision"`
+	Title    string    `json:"title"`
+	Content  string    `json:"content"`
+	Datetime time.Time `json:"datetime"`
+	Draft    bool      `json:"draft"`
+	Tags     []string  `json:"tags"`
+	Created  time.Time `json:"created"`
+}
+
+// SaveRevision stores the current state of the post as its next revision.
+func (p *Post) SaveRevision(ctx context.Context) (*Revision, error) {
+	r := &Revision{
+		PostID:   p.ID,
+		Title:    p.Title,
+		Content:  p.Content,
+		Datetime: p.Datetime,
+		Draft:    p.Draft,
+		Tags:     p.Tags,
+		Created:  time.Now(),
+	}
+
+	row := db.QueryRowContext(
+		ctx,
+		`
+INSERT INTO revisions(post_id, revision, title, content, date, draft, tags, created_at)
+VALUES ($1, (SELECT COALESCE(MAX(revision), 0) + 1 FROM revisions WHERE post_id = $1), $2, $3, $4, $5, $6, $7)
+RETURNING id, revision;
+`,
+		r.PostID,
+		r.Title,
+		r.Content,
+		r.Datetime,
+		r.Draft,
+		pq.Array(r.Tags),
+		r.Created)
+	if err := row.Scan(&r.ID, &r.Revision); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Revisions returns revisions of a post, newest first.
+func Revisions(ctx context.Context, postID string, limit, offset int) ([]*Revision, error) {
+	rows, err := db.QueryContext(ctx, "SELECT id, post_id, revision, title, content, date, draft, tags, created_at FROM revisions WHERE post_id = $1 ORDER BY revision DESC LIMIT $2 OFFSET $3", postID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revisions := make([]*Revision, 0)
+	for rows.Next() {
+		r := new(Revision)
+		err := rows.Scan(&r.ID, &r.PostID, &r.Revision, &r.Title, &r.Content, &r.Datetime, &r.Draft, pq.Array(&r.Tags), &r.Created)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
+
+// GetRevision gets a single revision of a post from the database.
+func GetRevision(ctx context.Context, postID string, revision int) (*Revision, error) {
+	var r Revision
+	row := db.QueryRowContext(ctx, "SELECT id, post_id, revision, title, content, date, draft, tags, created_at FROM revisions WHERE post_id = $1 AND revision = $2", postID, revision)
+	err := row.Scan(&r.ID, &r.PostID, &r.Revision, &r.Title, &r.Content, &r.Datetime, &r.Draft, pq.Array(&r.Tags), &r.Created)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, NotFound("No revision %d for post %s", revision, postID)
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return &r, nil
+	}
+}
+
+// DiffRevisions returns a unified diff of the content of two revisions.
+func DiffRevisions(from, to *Revision) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from.Content),
+		B:        difflib.SplitLines(to.Content),
+		FromFile: fmt.Sprintf("post/%s@%d", from.PostID, from.Revision),
+		ToFile:   fmt.Sprintf("post/%s@%d", to.PostID, to.Revision),
+		Context:  3,
+	})
+}
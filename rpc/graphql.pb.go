@@ -0,0 +1,1063 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: rpc/graphql.proto
+
+package rpc
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Post struct {
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// html is the content rendered from Markdown.
+	Html                 string               `protobuf:"bytes,4,opt,name=html,proto3" json:"html,omitempty"`
+	Tags                 []string             `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Draft                bool                 `protobuf:"varint,6,opt,name=draft,proto3" json:"draft,omitempty"`
+	Datetime             *timestamp.Timestamp `protobuf:"bytes,7,opt,name=datetime,proto3" json:"datetime,omitempty"`
+	Created              *timestamp.Timestamp `protobuf:"bytes,8,opt,name=created,proto3" json:"created,omitempty"`
+	Modified             *timestamp.Timestamp `protobuf:"bytes,9,opt,name=modified,proto3" json:"modified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Post) Reset()         { *m = Post{} }
+func (m *Post) String() string { return proto.CompactTextString(m) }
+func (*Post) ProtoMessage()    {}
+func (*Post) Descriptor() ([]byte, []int) {
+	return fileDescriptor_graphql_292ee9d2e457fedc, []int{0}
+}
+func (m *Post) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Post.Unmarshal(m, b)
+}
+func (m *Post) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Post.Marshal(b, m, deterministic)
+}
+func (dst *Post) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Post.Merge(dst, src)
+}
+func (m *Post) XXX_Size() int {
+	return xxx_messageInfo_Post.Size(m)
+}
+func (m *Post) XXX_DiscardUnknown() {
+	xxx_messageInfo_Post.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Post proto.InternalMessageInfo
+
+func (m *Post) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Post) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Post) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *Post) GetHtml() string {
+	if m != nil {
+		return m.Html
+	}
+	return ""
+}
+
+func (m *Post) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Post) GetDraft() bool {
+	if m != nil {
+		return m.Draft
+	}
+	return false
+}
+
+func (m *Post) GetDatetime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Datetime
+	}
+	return nil
+}
+
+func (m *Post) GetCreated() *timestamp.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *Post) GetModified() *timestamp.Timestamp {
+	if m != nil {
+		return m.Modified
+	}
+	return nil
+}
+
+type GetPostRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPostRequest) Reset()         { *m = GetPostRequest{} }
+func (m *GetPostRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPostRequest) ProtoMessage()    {}
+func (*GetPostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_graphql_292ee9d2e457fedc, []int{1}
+}
+func (m *GetPostRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPostRequest.Unmarshal(m, b)
+}
+func (m *GetPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPostRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetPostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPostRequest.Merge(dst, src)
+}
+func (m *GetPostRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPostRequest.Size(m)
+}
+func (m *GetPostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPostRequest proto.InternalMessageInfo
+
+func (m *GetPostRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// Limit defaults to the site's posts per page.
+type ListPostsRequest struct {
+	Limit                int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int32    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPostsRequest) Reset()         { *m = ListPostsRequest{} }
+func (m *ListPostsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPostsRequest) ProtoMessage()    {}
+func (*ListPostsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_graphql_292ee9d2e457fedc, []int{2}
+}
+func (m *ListPostsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPostsRequest.Unmarshal(m, b)
+}
+func (m *ListPostsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPostsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListPostsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPostsRequest.Merge(dst, src)
+}
+func (m *ListPostsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPostsRequest.Size(m)
+}
+func (m *ListPostsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPostsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPostsRequest proto.InternalMessageInfo
+
+func (m *ListPostsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListPostsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListPostsResponse struct {
+	Posts                []*Post  `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPostsResponse) Reset()         { *m = ListPostsResponse{} }
+func (m *ListPostsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPostsResponse) ProtoMessage()    {}
+func (*ListPostsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_graphql_292ee9d2e457fedc, []int{3}
+}
+func (m *ListPostsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPostsResponse.Unmarshal(m, b)
+}
+func (m *ListPostsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPostsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListPostsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPostsResponse.Merge(dst, src)
+}
+func (m *ListPostsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPostsResponse.Size(m)
+}
+func (m *ListPostsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPostsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPostsResponse proto.InternalMessageInfo
+
+func (m *ListPostsResponse) GetPosts() []*Post {
+	if m != nil {
+		return m.Posts
+	}
+	return nil
+}
+
+type Link struct {
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Url         string   `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Description string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Screenshot  string   `protobuf:"bytes,5,opt,name=screenshot,proto3" json:"screenshot,omitempty"`
+	Tags        []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// status_code is from the last check, or 0 if it hasn't been checked.
+	StatusCode           int32                `protobuf:"varint,7,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Dead                 bool                 `protobuf:"varint,8,opt,name=dead,proto3" json:"dead,omitempty"`
+	Checked              *timestamp.Timestamp `protobuf:"bytes,9,opt,name=checked,proto3" json:"checked,omitempty"`
+	Created              *timestamp.Timestamp `protobuf:"bytes,10,opt,name=created,proto3" json:"created,omitempty"`
+	Modified             *timestamp.Timestamp `protobuf:"bytes,11,opt,name=modified,proto3" json:"modified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Link) Reset()         { *m = Link{} }
+func (m *Link) String() string { return proto.CompactTextString(m) }
+func (*Link) ProtoMessage()    {}
+func (*Link) Descriptor() ([]byte, []int) {
+	return fileDescriptor_graphql_292ee9d2e457fedc, []int{4}
+}
+func (m *Link) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Link.Unmarshal(m, b)
+}
+func (m *Link) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Link.Marshal(b, m, deterministic)
+}
+func (dst *Link) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Link.Merge(dst, src)
+}
+func (m *Link) XXX_Size() int {
+	return xxx_messageInfo_Link.Size(m)
+}
+func (m *Link) XXX_DiscardUnknown() {
+	xxx_messageInfo_Link.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Link proto.InternalMessageInfo
+
+func (m *Link) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Link) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Link) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Link) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Link) GetScreenshot() string {
+	if m != nil {
+		return m.Screenshot
+	}
+	return ""
+}
+
+func (m *Link) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Link) GetStatusCode() int32 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
+func (m *Link) GetDead() bool {
+	if m != nil {
+		return m.Dead
+	}
+	return false
+}
+
+func (m *Link) GetChecked() *timestamp.Timestamp {
+	if m != nil {
+		return m.Checked
+	}
+	return nil
+}
+
+func (m *Link) GetCreated() *timestamp.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *Link) GetModified() *timestamp.Timestamp {
+	if m != nil {
+		return m.Modified
+	}
+	return nil
+}
+
+type GetLinkRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLinkRequest) Reset()         { *m = GetLinkRequest{} }
+func (m *GetLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLinkRequest) ProtoMessage()    {}
+func (*GetLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_graphql_292ee9d2e457fedc, []int{5}
+}
+func (m *GetLinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLinkRequest.Unmarshal(m, b)
+}
+func (m *GetLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLinkRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLinkRequest.Merge(dst, src)
+}
+func (m *GetLinkRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLinkRequest.Size(m)
+}
+func (m *GetLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLinkRequest proto.InternalMessageInfo
+
+func (m *GetLinkRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// Empty fields don't filter. Limit defaults to 50.
+type ListLinksRequest struct {
+	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Search               string   `protobuf:"bytes,2,opt,name=search,proto3" json:"search,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int32    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListLinksRequest) Reset()         { *m = ListLinksRequest{} }
+func (m *ListLinksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListLinksRequest) ProtoMessage()    {}
+func (*ListLinksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_graphql_292ee9d2e457fedc, []int{6}
+}
+func (m *ListLinksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLinksRequest.Unmarshal(m, b)
+}
+func (m *ListLinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLinksRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListLinksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLinksRequest.Merge(dst, src)
+}
+func (m *ListLinksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListLinksRequest.Size(m)
+}
+func (m *ListLinksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLinksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLinksRequest proto.InternalMessageInfo
+
+func (m *ListLinksRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *ListLinksRequest) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+func (m *ListLinksRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListLinksRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListLinksResponse struct {
+	Links                []*Link  `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListLinksResponse) Reset()         { *m = ListLinksResponse{} }
+func (m *ListLinksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListLinksResponse) ProtoMessage()    {}
+func (*ListLinksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_graphql_292ee9d2e457fedc, []int{7}
+}
+func (m *ListLinksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLinksResponse.Unmarshal(m, b)
+}
+func (m *ListLinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLinksResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListLinksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLinksResponse.Merge(dst, src)
+}
+func (m *ListLinksResponse) XXX_Size() int {
+	return xxx_messageInfo_ListLinksResponse.Size(m)
+}
+func (m *ListLinksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLinksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLinksResponse proto.InternalMessageInfo
+
+func (m *ListLinksResponse) GetLinks() []*Link {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+type User struct {
+	Id       string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role     string               `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Created  *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
+	Modified *timestamp.Timestamp `protobuf:"bytes,4,opt,name=modified,proto3" json:"modified,omitempty"`
+	// deactivated is unset for active users.
+	Deactivated          *timestamp.Timestamp `protobuf:"bytes,5,opt,name=deactivated,proto3" json:"deactivated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *User) Reset()         { *m = User{} }
+func (m *User) String() string { return proto.CompactTextString(m) }
+func (*User) ProtoMessage()    {}
+func (*User) Descriptor() ([]byte, []int) {
+	return fileDescriptor_graphql_292ee9d2e457fedc, []int{8}
+}
+func (m *User) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_User.Unmarshal(m, b)
+}
+func (m *User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_User.Marshal(b, m, deterministic)
+}
+func (dst *User) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_User.Merge(dst, src)
+}
+func (m *User) XXX_Size() int {
+	return xxx_messageInfo_User.Size(m)
+}
+func (m *User) XXX_DiscardUnknown() {
+	xxx_messageInfo_User.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_User proto.InternalMessageInfo
+
+func (m *User) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *User) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *User) GetCreated() *timestamp.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *User) GetModified() *timestamp.Timestamp {
+	if m != nil {
+		return m.Modified
+	}
+	return nil
+}
+
+func (m *User) GetDeactivated() *timestamp.Timestamp {
+	if m != nil {
+		return m.Deactivated
+	}
+	return nil
+}
+
+type GetUserRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetUserRequest) Reset()         { *m = GetUserRequest{} }
+func (m *GetUserRequest) String() string { return proto.CompactTextString(m) }
+func (*GetUserRequest) ProtoMessage()    {}
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_graphql_292ee9d2e457fedc, []int{9}
+}
+func (m *GetUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUserRequest.Unmarshal(m, b)
+}
+func (m *GetUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUserRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserRequest.Merge(dst, src)
+}
+func (m *GetUserRequest) XXX_Size() int {
+	return xxx_messageInfo_GetUserRequest.Size(m)
+}
+func (m *GetUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUserRequest proto.InternalMessageInfo
+
+func (m *GetUserRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// Limit defaults to 50.
+type ListUsersRequest struct {
+	Limit                int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int32    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListUsersRequest) Reset()         { *m = ListUsersRequest{} }
+func (m *ListUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUsersRequest) ProtoMessage()    {}
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_graphql_292ee9d2e457fedc, []int{10}
+}
+func (m *ListUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUsersRequest.Unmarshal(m, b)
+}
+func (m *ListUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUsersRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersRequest.Merge(dst, src)
+}
+func (m *ListUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListUsersRequest.Size(m)
+}
+func (m *ListUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUsersRequest proto.InternalMessageInfo
+
+func (m *ListUsersRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListUsersRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListUsersResponse struct {
+	Users                []*User  `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListUsersResponse) Reset()         { *m = ListUsersResponse{} }
+func (m *ListUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUsersResponse) ProtoMessage()    {}
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_graphql_292ee9d2e457fedc, []int{11}
+}
+func (m *ListUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUsersResponse.Unmarshal(m, b)
+}
+func (m *ListUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUsersResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersResponse.Merge(dst, src)
+}
+func (m *ListUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListUsersResponse.Size(m)
+}
+func (m *ListUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUsersResponse proto.InternalMessageInfo
+
+func (m *ListUsersResponse) GetUsers() []*User {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Post)(nil), "graphql.Post")
+	proto.RegisterType((*GetPostRequest)(nil), "graphql.GetPostRequest")
+	proto.RegisterType((*ListPostsRequest)(nil), "graphql.ListPostsRequest")
+	proto.RegisterType((*ListPostsResponse)(nil), "graphql.ListPostsResponse")
+	proto.RegisterType((*Link)(nil), "graphql.Link")
+	proto.RegisterType((*GetLinkRequest)(nil), "graphql.GetLinkRequest")
+	proto.RegisterType((*ListLinksRequest)(nil), "graphql.ListLinksRequest")
+	proto.RegisterType((*ListLinksResponse)(nil), "graphql.ListLinksResponse")
+	proto.RegisterType((*User)(nil), "graphql.User")
+	proto.RegisterType((*GetUserRequest)(nil), "graphql.GetUserRequest")
+	proto.RegisterType((*ListUsersRequest)(nil), "graphql.ListUsersRequest")
+	proto.RegisterType((*ListUsersResponse)(nil), "graphql.ListUsersResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PostsClient is the client API for Posts service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PostsClient interface {
+	GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*Post, error)
+	ListPosts(ctx context.Context, in *ListPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error)
+}
+
+type postsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPostsClient(cc *grpc.ClientConn) PostsClient {
+	return &postsClient{cc}
+}
+
+func (c *postsClient) GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*Post, error) {
+	out := new(Post)
+	err := c.cc.Invoke(ctx, "/graphql.Posts/GetPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) ListPosts(ctx context.Context, in *ListPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error) {
+	out := new(ListPostsResponse)
+	err := c.cc.Invoke(ctx, "/graphql.Posts/ListPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PostsServer is the server API for Posts service.
+type PostsServer interface {
+	GetPost(context.Context, *GetPostRequest) (*Post, error)
+	ListPosts(context.Context, *ListPostsRequest) (*ListPostsResponse, error)
+}
+
+func RegisterPostsServer(s *grpc.Server, srv PostsServer) {
+	s.RegisterService(&_Posts_serviceDesc, srv)
+}
+
+func _Posts_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).GetPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphql.Posts/GetPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).GetPost(ctx, req.(*GetPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_ListPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).ListPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphql.Posts/ListPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).ListPosts(ctx, req.(*ListPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Posts_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "graphql.Posts",
+	HandlerType: (*PostsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPost",
+			Handler:    _Posts_GetPost_Handler,
+		},
+		{
+			MethodName: "ListPosts",
+			Handler:    _Posts_ListPosts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc/graphql.proto",
+}
+
+// LinksClient is the client API for Links service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LinksClient interface {
+	GetLink(ctx context.Context, in *GetLinkRequest, opts ...grpc.CallOption) (*Link, error)
+	ListLinks(ctx context.Context, in *ListLinksRequest, opts ...grpc.CallOption) (*ListLinksResponse, error)
+}
+
+type linksClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLinksClient(cc *grpc.ClientConn) LinksClient {
+	return &linksClient{cc}
+}
+
+func (c *linksClient) GetLink(ctx context.Context, in *GetLinkRequest, opts ...grpc.CallOption) (*Link, error) {
+	out := new(Link)
+	err := c.cc.Invoke(ctx, "/graphql.Links/GetLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linksClient) ListLinks(ctx context.Context, in *ListLinksRequest, opts ...grpc.CallOption) (*ListLinksResponse, error) {
+	out := new(ListLinksResponse)
+	err := c.cc.Invoke(ctx, "/graphql.Links/ListLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LinksServer is the server API for Links service.
+type LinksServer interface {
+	GetLink(context.Context, *GetLinkRequest) (*Link, error)
+	ListLinks(context.Context, *ListLinksRequest) (*ListLinksResponse, error)
+}
+
+func RegisterLinksServer(s *grpc.Server, srv LinksServer) {
+	s.RegisterService(&_Links_serviceDesc, srv)
+}
+
+func _Links_GetLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinksServer).GetLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphql.Links/GetLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinksServer).GetLink(ctx, req.(*GetLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Links_ListLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinksServer).ListLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphql.Links/ListLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinksServer).ListLinks(ctx, req.(*ListLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Links_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "graphql.Links",
+	HandlerType: (*LinksServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLink",
+			Handler:    _Links_GetLink_Handler,
+		},
+		{
+			MethodName: "ListLinks",
+			Handler:    _Links_ListLinks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc/graphql.proto",
+}
+
+// UsersClient is the client API for Users service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type UsersClient interface {
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+}
+
+type usersClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewUsersClient(cc *grpc.ClientConn) UsersClient {
+	return &usersClient{cc}
+}
+
+func (c *usersClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/graphql.Users/GetUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, "/graphql.Users/ListUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UsersServer is the server API for Users service.
+type UsersServer interface {
+	GetUser(context.Context, *GetUserRequest) (*User, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+}
+
+func RegisterUsersServer(s *grpc.Server, srv UsersServer) {
+	s.RegisterService(&_Users_serviceDesc, srv)
+}
+
+func _Users_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphql.Users/GetUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphql.Users/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Users_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "graphql.Users",
+	HandlerType: (*UsersServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUser",
+			Handler:    _Users_GetUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _Users_ListUsers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc/graphql.proto",
+}
+
+func init() { proto.RegisterFile("rpc/graphql.proto", fileDescriptor_graphql_292ee9d2e457fedc) }
+
+var fileDescriptor_graphql_292ee9d2e457fedc = []byte{
+	// 632 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcb, 0x8e, 0xd3, 0x30,
+	0x14, 0x55, 0x9a, 0xa4, 0xed, 0xdc, 0x8a, 0xd1, 0x8c, 0x35, 0x02, 0x93, 0x05, 0x13, 0x85, 0x4d,
+	0x57, 0x1d, 0x51, 0x10, 0x62, 0xc1, 0x02, 0x0d, 0x0b, 0x36, 0xb3, 0x40, 0x11, 0x6c, 0xd8, 0xa0,
+	0x4c, 0xec, 0xb6, 0x66, 0xd2, 0x38, 0x63, 0xbb, 0x2c, 0xf9, 0x20, 0x3e, 0x88, 0xbf, 0xe0, 0x1f,
+	0x90, 0x1f, 0x49, 0x1d, 0xb5, 0xa8, 0x65, 0xd8, 0xdd, 0xc7, 0x71, 0xce, 0xcd, 0x3d, 0xc7, 0x86,
+	0x73, 0xd1, 0x94, 0x57, 0x4b, 0x51, 0x34, 0xab, 0xfb, 0x6a, 0xd6, 0x08, 0xae, 0x38, 0x1a, 0xb9,
+	0x34, 0xb9, 0x5c, 0x72, 0xbe, 0xac, 0xe8, 0x95, 0x29, 0xdf, 0x6e, 0x16, 0x57, 0x8a, 0xad, 0xa9,
+	0x54, 0xc5, 0xba, 0xb1, 0xc8, 0xec, 0xe7, 0x00, 0xa2, 0x8f, 0x5c, 0x2a, 0x74, 0x0a, 0x03, 0x46,
+	0x70, 0x90, 0x06, 0xd3, 0x93, 0x7c, 0xc0, 0x08, 0xba, 0x80, 0x58, 0x31, 0x55, 0x51, 0x3c, 0x30,
+	0x25, 0x9b, 0x20, 0x0c, 0xa3, 0x92, 0xd7, 0x8a, 0xd6, 0x0a, 0x87, 0xa6, 0xde, 0xa6, 0x08, 0x41,
+	0xb4, 0x52, 0xeb, 0x0a, 0x47, 0xa6, 0x6c, 0x62, 0x5d, 0x53, 0xc5, 0x52, 0xe2, 0x38, 0x0d, 0x75,
+	0x4d, 0xc7, 0xfa, 0xbb, 0x44, 0x14, 0x0b, 0x85, 0x87, 0x69, 0x30, 0x1d, 0xe7, 0x36, 0x41, 0xaf,
+	0x61, 0x4c, 0x0a, 0x45, 0xf5, 0x74, 0x78, 0x94, 0x06, 0xd3, 0xc9, 0x3c, 0x99, 0xd9, 0xd1, 0x67,
+	0xed, 0xe8, 0xb3, 0x4f, 0xed, 0xe8, 0x79, 0x87, 0x45, 0xaf, 0x60, 0x54, 0x0a, 0x5a, 0x28, 0x4a,
+	0xf0, 0xf8, 0xe0, 0xb1, 0x16, 0xaa, 0xd9, 0xd6, 0x9c, 0xb0, 0x05, 0xa3, 0x04, 0x9f, 0x1c, 0x66,
+	0x6b, 0xb1, 0x59, 0x0a, 0xa7, 0x1f, 0xa8, 0xd2, 0xeb, 0xca, 0xe9, 0xfd, 0x86, 0xee, 0x6e, 0x2d,
+	0x7b, 0x07, 0x67, 0x37, 0x4c, 0x1a, 0x88, 0x6c, 0x31, 0x17, 0x10, 0x57, 0x6c, 0xcd, 0x94, 0x81,
+	0xc5, 0xb9, 0x4d, 0xd0, 0x63, 0x18, 0xf2, 0xc5, 0x42, 0x52, 0x65, 0x16, 0x1c, 0xe7, 0x2e, 0xcb,
+	0xde, 0xc0, 0xb9, 0xf7, 0x05, 0xd9, 0xf0, 0x5a, 0x52, 0xf4, 0x1c, 0xe2, 0x46, 0x17, 0x70, 0x90,
+	0x86, 0xd3, 0xc9, 0xfc, 0xd1, 0xac, 0x95, 0xdb, 0xcc, 0x62, 0x7b, 0xd9, 0xef, 0x01, 0x44, 0x37,
+	0xac, 0xbe, 0x3b, 0x52, 0xca, 0x33, 0x08, 0x37, 0xa2, 0x72, 0x32, 0xea, 0x10, 0xa5, 0x30, 0x21,
+	0x54, 0x96, 0x82, 0x35, 0x8a, 0xf1, 0xda, 0x29, 0xe9, 0x97, 0xd0, 0x33, 0x00, 0x59, 0x0a, 0x4a,
+	0x6b, 0xb9, 0xe2, 0x0a, 0xc7, 0x06, 0xe0, 0x55, 0x3a, 0xc1, 0x87, 0x9e, 0xe0, 0x97, 0x30, 0x91,
+	0xaa, 0x50, 0x1b, 0xf9, 0xb5, 0xe4, 0xc4, 0xaa, 0x1b, 0xe7, 0x60, 0x4b, 0xef, 0x39, 0xa1, 0xfa,
+	0x10, 0xa1, 0x85, 0x15, 0x70, 0x9c, 0x9b, 0xd8, 0xe8, 0xba, 0xa2, 0xe5, 0xdd, 0x51, 0x02, 0xb5,
+	0x50, 0xdf, 0x0d, 0xf0, 0x30, 0x37, 0x4c, 0xfe, 0xd9, 0x0d, 0x7a, 0xe3, 0x7f, 0x73, 0xc3, 0x37,
+	0xeb, 0x06, 0x0d, 0xe9, 0xdc, 0x70, 0x06, 0xa1, 0x2a, 0x96, 0x0e, 0xa4, 0x43, 0xed, 0x04, 0x49,
+	0x0b, 0x51, 0xae, 0x9c, 0x3e, 0x2e, 0xdb, 0xfa, 0x26, 0xdc, 0xef, 0x9b, 0x68, 0x9f, 0x6f, 0x1c,
+	0xd7, 0xd6, 0x37, 0x95, 0x2e, 0xec, 0xf8, 0xc6, 0x4c, 0x6d, 0x7b, 0xd9, 0xaf, 0x00, 0xa2, 0xcf,
+	0x92, 0x8a, 0x1d, 0xdf, 0x20, 0x88, 0x04, 0xef, 0x6c, 0x63, 0x62, 0x7f, 0xc5, 0xe1, 0xc3, 0x56,
+	0x1c, 0x1d, 0xbf, 0x62, 0xf4, 0x56, 0x3b, 0xb2, 0x28, 0x15, 0xfb, 0x6e, 0x18, 0xe3, 0x83, 0x47,
+	0x7d, 0xb8, 0x13, 0x48, 0xff, 0xda, 0x81, 0xeb, 0xaa, 0x21, 0xff, 0x77, 0x5d, 0xdd, 0x17, 0xb6,
+	0x6b, 0xdf, 0xe8, 0xc2, 0xce, 0xda, 0xcd, 0x2c, 0xb6, 0x37, 0xff, 0x01, 0xb1, 0xb9, 0xe4, 0xe8,
+	0x05, 0x8c, 0xdc, 0xab, 0x82, 0x9e, 0x74, 0xc8, 0xfe, 0x3b, 0x93, 0xf4, 0x6f, 0x3c, 0xba, 0x86,
+	0x93, 0xee, 0x91, 0x40, 0x4f, 0x3d, 0x55, 0xfb, 0x4f, 0x4f, 0x92, 0xec, 0x6b, 0xd9, 0x21, 0x35,
+	0xbf, 0x31, 0x8b, 0xe3, 0xd7, 0x71, 0x9f, 0xdf, 0x73, 0x76, 0xd2, 0x77, 0x4e, 0xcb, 0x6f, 0xcf,
+	0xf7, 0xf9, 0x7d, 0xb3, 0x27, 0xc9, 0xbe, 0xd6, 0x96, 0xdf, 0x6c, 0xcd, 0xf1, 0xeb, 0xb8, 0xcf,
+	0xef, 0x09, 0x97, 0xf4, 0x57, 0xd8, 0xf2, 0xdb, 0xf3, 0x7d, 0x7e, 0x5f, 0xcb, 0x24, 0xd9, 0xd7,
+	0xb2, 0xfc, 0xd7, 0xf1, 0x97, 0x50, 0x34, 0xe5, 0xed, 0xd0, 0xb8, 0xe8, 0xe5, 0x9f, 0x01, 0x00,
+	0x48, 0xc1, 0xf3, 0x13, 0x46, 0x07, 0x00, 0x00,
+}
@@ -0,0 +1,310 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// RuleRunTTL is how long the log of a rule's runs is kept.
+const RuleRunTTL = 30 * 24 * time.Hour
+
+// Rule actions. syndicate_to takes a service, like syndicate_to:mastodon.
+const (
+	RuleActionSyndicateTo     = "syndicate_to"
+	RuleActionSkipSyndication = "skip_syndication"
+	RuleActionSkipFederation  = "skip_federation"
+)
+
+// ruleEvents are the events rules can run on, and the variables their
+// conditions can use.
+var ruleEvents = map[string]func(ctx context.Context, p *Post) map[string]interface{}{
+	EventPostPublished: postRuleVars,
+}
+
+// AutomationRule is an admin defined rule that runs when an event happens.
+// If its condition is true, its actions change what happens next, like which
+// services a published post is syndicated to.
+type AutomationRule struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Event     string    `json:"event"`
+	Condition string    `json:"condition"`
+	Actions   []string  `json:"actions"`
+	Enabled   bool      `json:"enabled"`
+	Created   time.Time `json:"created"`
+	Modified  time.Time `json:"modified"`
+}
+
+// AutomationRuleRun is the log of a rule running.
+type AutomationRuleRun struct {
+	ID      string    `json:"id"`
+	RuleID  string    `json:"rule_id"`
+	Subject string    `json:"subject"`
+	Matched bool      `json:"matched"`
+	Actions []string  `json:"actions"`
+	Error   *string   `json:"error"`
+	Created time.Time `json:"created"`
+}
+
+const automationRuleColumns = "id, name, event, condition, actions, enabled, created_at, modified_at"
+
+func scanAutomationRule(row interface {
+	Scan(dest ...interface{}) error
+}) (*AutomationRule, error) {
+	r := new(AutomationRule)
+	if err := row.Scan(&r.ID, &r.Name, &r.Event, &r.Condition, pq.Array(&r.Actions), &r.Enabled, &r.Created, &r.Modified); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// ruleOutcome is what the rules that matched an event decided.
+type ruleOutcome struct {
+	// syndicateTo limits syndication to these services, if it isn't nil.
+	syndicateTo     []string
+	skipSyndication bool
+	skipFederation  bool
+}
+
+// apply adds an action to the outcome.
+func (o *ruleOutcome) apply(action string) {
+	name, arg := action, ""
+	if i := strings.Index(action, ":"); i >= 0 {
+		name, arg = action[:i], action[i+1:]
+	}
+
+	switch name {
+	case RuleActionSyndicateTo:
+		if o.syndicateTo == nil {
+			o.syndicateTo = []string{}
+		}
+		o.syndicateTo = append(o.syndicateTo, arg)
+	case RuleActionSkipSyndication:
+		o.skipSyndication = true
+	case RuleActionSkipFederation:
+		o.skipFederation = true
+	}
+}
+
+// postRuleVars are the variables conditions of post.published rules can use.
+func postRuleVars(ctx context.Context, p *Post) map[string]interface{} {
+	license, canonicalURL := "", ""
+	if p.LicenseID != nil {
+		license = string(*p.LicenseID)
+	}
+	if p.CanonicalURL != nil {
+		canonicalURL = *p.CanonicalURL
+	}
+
+	tags := p.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return map[string]interface{}{
+		"post.id":            p.ID,
+		"post.title":         p.Title,
+		"post.content":       p.Content,
+		"post.tags":          tags,
+		"post.readtime":      float64(p.ReadTime()),
+		"post.protected":     p.Protected,
+		"post.license":       license,
+		"post.canonical_url": canonicalURL,
+	}
+}
+
+// Validate checks the rule's event, condition and actions. The condition is
+// tried on an empty subject, so unknown variables and type errors are caught
+// now rather than when the rule runs.
+func (r *AutomationRule) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return Validation("name", "Rules need a name")
+	}
+
+	vars, ok := ruleEvents[r.Event]
+	if !ok {
+		return Validation("event", "Rules can't run on %q", r.Event)
+	}
+
+	x, err := parseRuleCondition(r.Condition)
+	if err != nil {
+		return Validation("condition", "Invalid condition: %v", err)
+	}
+	if _, err := evalRuleBool(x, vars(context.Background(), &Post{})); err != nil {
+		return Validation("condition", "Invalid condition: %v", err)
+	}
+
+	if len(r.Actions) == 0 {
+		return Validation("actions", "Rules need at least one action")
+	}
+	for _, a := range r.Actions {
+		switch {
+		case a == RuleActionSkipSyndication, a == RuleActionSkipFederation:
+		case strings.HasPrefix(a, RuleActionSyndicateTo+":") && len(a) > len(RuleActionSyndicateTo)+1:
+		default:
+			return Validation("actions", "%q is not an action", a)
+		}
+	}
+
+	return nil
+}
+
+// AutomationRules returns the site's rules, oldest first.
+func AutomationRules(ctx context.Context) ([]*AutomationRule, error) {
+	return automationRules(ctx, "SELECT "+automationRuleColumns+" FROM automation_rules WHERE site_id = $1 ORDER BY id", siteID(ctx))
+}
+
+func automationRules(ctx context.Context, query string, args ...interface{}) ([]*AutomationRule, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]*AutomationRule, 0)
+	for rows.Next() {
+		r, err := scanAutomationRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// GetAutomationRule returns one of the site's rules by ID.
+func GetAutomationRule(ctx context.Context, id string) (*AutomationRule, error) {
+	row := db.QueryRowContext(ctx, "SELECT "+automationRuleColumns+" FROM automation_rules WHERE id = $1 AND site_id = $2", id, siteID(ctx))
+	r, err := scanAutomationRule(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, NotFound("No rule with id %s", id)
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return r, nil
+	}
+}
+
+// CreateAutomationRule validates and stores a new rule.
+func CreateAutomationRule(ctx context.Context, r *AutomationRule) (*AutomationRule, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	row := db.QueryRowContext(ctx, "INSERT INTO automation_rules (site_id, name, event, condition, actions, enabled, created_at, modified_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $7) RETURNING "+automationRuleColumns, siteID(ctx), r.Name, r.Event, r.Condition, pq.Array(r.Actions), r.Enabled, now)
+	return scanAutomationRule(row)
+}
+
+// Save validates and updates an existing rule.
+func (r *AutomationRule) Save(ctx context.Context) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	res, err := db.ExecContext(ctx, "UPDATE automation_rules SET name = $3, event = $4, condition = $5, actions = $6, enabled = $7, modified_at = $8 WHERE id = $1 AND site_id = $2", r.ID, siteID(ctx), r.Name, r.Event, r.Condition, pq.Array(r.Actions), r.Enabled, now)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return NotFound("No rule with id %s", r.ID)
+	}
+
+	r.Modified = now
+	return nil
+}
+
+// Delete removes the rule and its runs.
+func (r *AutomationRule) Delete(ctx context.Context) error {
+	_, err := db.ExecContext(ctx, "DELETE FROM automation_rules WHERE id = $1", r.ID)
+	return err
+}
+
+// Runs returns the newest runs of the rule, newest first.
+func (r *AutomationRule) Runs(ctx context.Context, limit int) ([]*AutomationRuleRun, error) {
+	rows, err := db.QueryContext(ctx, "SELECT id, rule_id, subject, matched, actions, error, created_at FROM automation_rule_runs WHERE rule_id = $1 ORDER BY created_at DESC LIMIT $2", r.ID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	runs := make([]*AutomationRuleRun, 0)
+	for rows.Next() {
+		run := new(AutomationRuleRun)
+		if err := rows.Scan(&run.ID, &run.RuleID, &run.Subject, &run.Matched, pq.Array(&run.Actions), &run.Error, &run.Created); err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+// TestAutomationRuleCondition returns whether condition is true for a post,
+// without running any actions.
+func TestAutomationRuleCondition(ctx context.Context, condition string, p *Post) (bool, error) {
+	x, err := parseRuleCondition(condition)
+	if err != nil {
+		return false, Validation("condition", "Invalid condition: %v", err)
+	}
+
+	matched, err := evalRuleBool(x, postRuleVars(ctx, p))
+	if err != nil {
+		return false, Validation("condition", "Invalid condition: %v", err)
+	}
+	return matched, nil
+}
+
+// runPostRules runs the site's enabled post.published rules on a post, logs
+// each run, and returns what the rules that matched decided. A rule that
+// fails is logged and skipped.
+func runPostRules(ctx context.Context, p *Post) ruleOutcome {
+	var outcome ruleOutcome
+	rules, err := automationRules(ctx, "SELECT "+automationRuleColumns+" FROM automation_rules WHERE site_id = $1 AND event = $2 AND enabled ORDER BY id", siteID(ctx), EventPostPublished)
+	if err != nil {
+		log.Printf("could not load rules for post %s: %+v", p.ID, err)
+		return outcome
+	}
+
+	vars := postRuleVars(ctx, p)
+	for _, r := range rules {
+		run := &AutomationRuleRun{RuleID: r.ID, Subject: "post " + p.ID, Actions: []string{}}
+
+		x, err := parseRuleCondition(r.Condition)
+		if err == nil {
+			run.Matched, err = evalRuleBool(x, vars)
+		}
+		if err != nil {
+			msg := err.Error()
+			run.Error = &msg
+		}
+
+		if run.Matched {
+			run.Actions = r.Actions
+			for _, a := range r.Actions {
+				outcome.apply(a)
+			}
+		}
+
+		if _, err := db.ExecContext(ctx, "INSERT INTO automation_rule_runs (rule_id, subject, matched, actions, error, created_at) VALUES ($1, $2, $3, $4, $5, $6)", run.RuleID, run.Subject, run.Matched, pq.Array(run.Actions), run.Error, time.Now()); err != nil {
+			log.Printf("could not log run of rule %s: %+v", r.ID, err)
+		}
+	}
+
+	return outcome
+}
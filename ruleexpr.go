@@ -0,0 +1,415 @@
+package graphql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// maxRuleConditionLength is the longest condition a rule can have. Conditions
+// have no loops or calls, so evaluating one takes time linear in its length.
+const maxRuleConditionLength = 1000
+
+// ruleExpr is a parsed rule condition.
+type ruleExpr interface {
+	eval(vars map[string]interface{}) (interface{}, error)
+}
+
+type ruleLiteral struct{ value interface{} }
+
+type ruleVar struct{ name string }
+
+type ruleList struct{ items []ruleExpr }
+
+type ruleNot struct{ x ruleExpr }
+
+type ruleBinary struct {
+	op   string
+	x, y ruleExpr
+}
+
+// ruleToken is a token of a condition: a string or number literal, an
+// identifier, or an operator or punctuation.
+type ruleToken struct {
+	kind  byte // 's'tring, 'n'umber, 'i'dentifier or 'o'perator
+	value string
+	pos   int
+}
+
+// parseRuleCondition parses a rule condition, like
+// `"photo" in post.tags and post.readtime < 60`. Conditions compare strings,
+// numbers, bools and lists of strings with ==, !=, <, <=, >, >=, in,
+// contains and matches (a regular expression), and combine them with and, or,
+// not and parentheses.
+func parseRuleCondition(condition string) (ruleExpr, error) {
+	if len(condition) > maxRuleConditionLength {
+		return nil, fmt.Errorf("conditions can be at most %d characters", maxRuleConditionLength)
+	}
+
+	tokens, err := lexRuleCondition(condition)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &ruleParser{tokens: tokens}
+	x, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t != nil {
+		return nil, fmt.Errorf("unexpected %q at %d", t.value, t.pos)
+	}
+
+	return x, nil
+}
+
+// ruleOperators are the operators and punctuation, longest first.
+var ruleOperators = []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")", "[", "]", ","}
+
+func lexRuleCondition(s string) ([]ruleToken, error) {
+	tokens := []ruleToken{}
+	for i := 0; i < len(s); {
+		c := rune(s[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '"' || c == '\'':
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(s) && rune(s[j]) != c; j++ {
+				if s[j] == '\\' && j+1 < len(s) {
+					j++
+				}
+				b.WriteByte(s[j])
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("unterminated string at %d", i)
+			}
+			tokens = append(tokens, ruleToken{'s', b.String(), i})
+			i = j + 1
+		case unicode.IsDigit(c):
+			j := i
+			for j < len(s) && (unicode.IsDigit(rune(s[j])) || s[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, ruleToken{'n', s[i:j], i})
+			i = j
+		case unicode.IsLetter(c) || c == '_':
+			j := i
+			for j < len(s) && (unicode.IsLetter(rune(s[j])) || unicode.IsDigit(rune(s[j])) || s[j] == '_' || s[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, ruleToken{'i', s[i:j], i})
+			i = j
+		default:
+			op := ""
+			for _, o := range ruleOperators {
+				if strings.HasPrefix(s[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected %q at %d", c, i)
+			}
+			tokens = append(tokens, ruleToken{'o', op, i})
+			i += len(op)
+		}
+	}
+
+	return tokens, nil
+}
+
+type ruleParser struct {
+	tokens []ruleToken
+	pos    int
+}
+
+func (p *ruleParser) peek() *ruleToken {
+	if p.pos >= len(p.tokens) {
+		return nil
+	}
+	return &p.tokens[p.pos]
+}
+
+// accept consumes the next token if it is one of values, which are operators
+// or keywords.
+func (p *ruleParser) accept(values ...string) string {
+	t := p.peek()
+	if t == nil || t.kind == 's' || t.kind == 'n' {
+		return ""
+	}
+
+	for _, v := range values {
+		if t.value == v {
+			p.pos++
+			return v
+		}
+	}
+	return ""
+}
+
+func (p *ruleParser) or() (ruleExpr, error) {
+	x, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept("or", "||") != "" {
+		y, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		x = &ruleBinary{"or", x, y}
+	}
+
+	return x, nil
+}
+
+func (p *ruleParser) and() (ruleExpr, error) {
+	x, err := p.not()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept("and", "&&") != "" {
+		y, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		x = &ruleBinary{"and", x, y}
+	}
+
+	return x, nil
+}
+
+func (p *ruleParser) not() (ruleExpr, error) {
+	if p.accept("not", "!") != "" {
+		x, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		return &ruleNot{x}, nil
+	}
+
+	return p.comparison()
+}
+
+func (p *ruleParser) comparison() (ruleExpr, error) {
+	x, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+
+	if op := p.accept("==", "!=", "<=", ">=", "<", ">", "in", "contains", "matches"); op != "" {
+		y, err := p.primary()
+		if err != nil {
+			return nil, err
+		}
+		if op == "matches" {
+			var s string
+			if lit, ok := y.(*ruleLiteral); ok {
+				s, ok = lit.value.(string)
+			}
+			if s == "" {
+				return nil, fmt.Errorf("matches needs a string regular expression")
+			}
+			re, err := regexp.Compile(s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid regular expression %q: %v", s, err)
+			}
+			y = &ruleLiteral{re}
+		}
+		return &ruleBinary{op, x, y}, nil
+	}
+
+	return x, nil
+}
+
+func (p *ruleParser) primary() (ruleExpr, error) {
+	t := p.peek()
+	if t == nil {
+		return nil, fmt.Errorf("unexpected end of condition")
+	}
+	p.pos++
+
+	switch t.kind {
+	case 's':
+		return &ruleLiteral{t.value}, nil
+	case 'n':
+		f, err := strconv.ParseFloat(t.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at %d", t.value, t.pos)
+		}
+		return &ruleLiteral{f}, nil
+	case 'i':
+		switch t.value {
+		case "true", "false":
+			return &ruleLiteral{t.value == "true"}, nil
+		case "and", "or", "not", "in", "contains", "matches":
+			return nil, fmt.Errorf("unexpected %q at %d", t.value, t.pos)
+		}
+		return &ruleVar{t.value}, nil
+	}
+
+	switch t.value {
+	case "(":
+		x, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if p.accept(")") == "" {
+			return nil, fmt.Errorf("missing ) for ( at %d", t.pos)
+		}
+		return x, nil
+	case "[":
+		l := &ruleList{}
+		for p.accept("]") == "" {
+			if len(l.items) > 0 && p.accept(",") == "" {
+				return nil, fmt.Errorf("missing , or ] in list at %d", t.pos)
+			}
+			x, err := p.primary()
+			if err != nil {
+				return nil, err
+			}
+			l.items = append(l.items, x)
+		}
+		return l, nil
+	}
+
+	return nil, fmt.Errorf("unexpected %q at %d", t.value, t.pos)
+}
+
+func (l *ruleLiteral) eval(vars map[string]interface{}) (interface{}, error) {
+	return l.value, nil
+}
+
+func (v *ruleVar) eval(vars map[string]interface{}) (interface{}, error) {
+	value, ok := vars[v.name]
+	if !ok {
+		return nil, fmt.Errorf("unknown variable %s", v.name)
+	}
+	return value, nil
+}
+
+func (l *ruleList) eval(vars map[string]interface{}) (interface{}, error) {
+	items := make([]string, len(l.items))
+	for i, x := range l.items {
+		v, err := x.eval(vars)
+		if err != nil {
+			return nil, err
+		}
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("lists can only have strings")
+		}
+		items[i] = s
+	}
+	return items, nil
+}
+
+func (n *ruleNot) eval(vars map[string]interface{}) (interface{}, error) {
+	b, err := evalRuleBool(n.x, vars)
+	return !b, err
+}
+
+// evalRuleBool evaluates x, which must be a bool.
+func evalRuleBool(x ruleExpr, vars map[string]interface{}) (bool, error) {
+	v, err := x.eval(vars)
+	if err != nil {
+		return false, err
+	}
+
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("expected true or false, got %v", v)
+	}
+	return b, nil
+}
+
+func (b *ruleBinary) eval(vars map[string]interface{}) (interface{}, error) {
+	switch b.op {
+	case "and", "or":
+		x, err := evalRuleBool(b.x, vars)
+		if err != nil || x == (b.op == "or") {
+			return x, err
+		}
+		return evalRuleBool(b.y, vars)
+	}
+
+	x, err := b.x.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	y, err := b.y.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	switch b.op {
+	case "in":
+		return ruleContains(y, x)
+	case "contains":
+		return ruleContains(x, y)
+	case "matches":
+		s, ok := x.(string)
+		if !ok {
+			return nil, fmt.Errorf("matches needs a string, got %v", x)
+		}
+		return y.(*regexp.Regexp).MatchString(s), nil
+	case "==", "!=":
+		eq := fmt.Sprint(x) == fmt.Sprint(y) && fmt.Sprintf("%T", x) == fmt.Sprintf("%T", y)
+		return eq == (b.op == "=="), nil
+	}
+
+	switch x := x.(type) {
+	case float64:
+		if y, ok := y.(float64); ok {
+			return compareRule(b.op, x < y, x == y), nil
+		}
+	case string:
+		if y, ok := y.(string); ok {
+			return compareRule(b.op, x < y, x == y), nil
+		}
+	}
+
+	return nil, fmt.Errorf("can't compare %v and %v with %s", x, y, b.op)
+}
+
+func compareRule(op string, less, equal bool) bool {
+	switch op {
+	case "<":
+		return less
+	case "<=":
+		return less || equal
+	case ">":
+		return !less && !equal
+	default:
+		return !less
+	}
+}
+
+// ruleContains returns whether haystack, a list or a string, contains needle.
+// Strings are compared case insensitively.
+func ruleContains(haystack, needle interface{}) (bool, error) {
+	n, ok := needle.(string)
+	if !ok {
+		return false, fmt.Errorf("can only look for strings, not %v", needle)
+	}
+
+	switch h := haystack.(type) {
+	case []string:
+		for _, s := range h {
+			if strings.EqualFold(s, n) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case string:
+		return strings.Contains(strings.ToLower(h), strings.ToLower(n)), nil
+	}
+
+	return false, fmt.Errorf("can only look in lists and strings, not %v", haystack)
+}
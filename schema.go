@@ -0,0 +1,93 @@
+package graphql
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vektah/gqlparser/ast"
+)
+
+// Deprecation is a field in the schema that is going away.
+type Deprecation struct {
+	// Field is written as Type.field, for example Query.allPosts.
+	Field  string    `json:"field"`
+	Reason string    `json:"reason"`
+	Since  time.Time `json:"since"`
+	Sunset time.Time `json:"sunset"`
+}
+
+// Deprecations is the registry of all deprecated fields in the schema, oldest
+// first. A field must be marked in schema.graphql with @deprecated and the
+// reason from DeprecationReason, and can not be removed before its sunset.
+var Deprecations = []Deprecation{
+	{
+		Field:  "Query.allPosts",
+		Reason: "Use posts, which is paginated.",
+		Since:  time.Date(2018, time.October, 15, 0, 0, 0, 0, time.UTC),
+		Sunset: time.Date(2019, time.April, 15, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Field:  "Query.allLinks",
+		Reason: "Use links, which is paginated.",
+		Since:  time.Date(2018, time.October, 15, 0, 0, 0, 0, time.UTC),
+		Sunset: time.Date(2019, time.April, 15, 0, 0, 0, 0, time.UTC),
+	},
+}
+
+// DeprecationReason is the reason that should be given to @deprecated in the
+// schema for this field.
+func (d Deprecation) DeprecationReason() string {
+	return fmt.Sprintf("%s Will be removed after %s.", d.Reason, d.Sunset.Format("2006-01-02"))
+}
+
+// SchemaSDL returns the schema definition language that the schema was built
+// from.
+func SchemaSDL(schema *ast.Schema) string {
+	return schema.Query.Position.Src.Input
+}
+
+// SchemaVersion returns a short hash of the schema, which changes whenever the
+// schema does.
+func SchemaVersion(schema *ast.Schema) string {
+	sum := sha256.Sum256([]byte(SchemaSDL(schema)))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// CheckDeprecations makes sure every field in Deprecations is either still in
+// the schema and marked deprecated, or past its sunset.
+func CheckDeprecations(schema *ast.Schema, now time.Time) error {
+	for _, d := range Deprecations {
+		parts := strings.SplitN(d.Field, ".", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("%s is not of the form Type.field", d.Field)
+		}
+		typeName, fieldName := parts[0], parts[1]
+
+		var field *ast.FieldDefinition
+		if def := schema.Types[typeName]; def != nil {
+			field = def.Fields.ForName(fieldName)
+		}
+
+		if field == nil {
+			if now.Before(d.Sunset) {
+				return fmt.Errorf("%s was removed before its sunset of %s", d.Field, d.Sunset.Format("2006-01-02"))
+			}
+			continue
+		}
+
+		dir := field.Directives.ForName("deprecated")
+		if dir == nil {
+			return fmt.Errorf("%s is in the deprecation registry but is not marked @deprecated", d.Field)
+		}
+
+		reason := dir.Arguments.ForName("reason")
+		if reason == nil || reason.Value.Raw != d.DeprecationReason() {
+			return fmt.Errorf("%s should be marked @deprecated(reason: %q)", d.Field, d.DeprecationReason())
+		}
+	}
+
+	return nil
+}
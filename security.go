@@ -0,0 +1,173 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"regexp"
+	"time"
+)
+
+const (
+	// AuthBackoffThreshold is how many failed authentications from one
+	// source, within AuthFailureWindow, before it has to wait between tries.
+	AuthBackoffThreshold = 5
+
+	// AuthBackoffBase is how long a source waits after AuthBackoffThreshold
+	// failures. It doubles with every failure after that, up to
+	// AuthFailureWindow.
+	AuthBackoffBase = 10 * time.Second
+)
+
+// Security event types.
+const (
+	// SecurityEventNewDevice is a login from a browser or device the user
+	// hasn't logged in with before.
+	SecurityEventNewDevice = "new_device"
+
+	// SecurityEventNewLocation is a login from a country the user hasn't
+	// logged in from before.
+	SecurityEventNewLocation = "new_location"
+)
+
+func init() {
+	HandleJobs("security_event", func(ctx context.Context, payload json.RawMessage) error {
+		var e SecurityEvent
+		if err := json.Unmarshal(payload, &e); err != nil {
+			return err
+		}
+
+		return sendSecurityEvent(ctx, e)
+	})
+}
+
+// deviceVersionRegex matches the version numbers in a user agent, so
+// browser updates aren't new devices.
+var deviceVersionRegex = regexp.MustCompile(`[0-9][0-9._]*`)
+
+// SecurityEvent is something that happened to a user's account that they
+// might want to know about, like a login from a new device.
+type SecurityEvent struct {
+	Type      string    `json:"type"`
+	UserID    string    `json:"user_id"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"user_agent"`
+	Country   string    `json:"country,omitempty"`
+	Time      time.Time `json:"time"`
+}
+
+// AuthBackoff returns how long source has to wait before authenticating
+// again, or zero if it can try now. Sources wait exponentially longer with
+// every failure past AuthBackoffThreshold.
+func AuthBackoff(source string) time.Duration {
+	now := time.Now()
+
+	authFailuresMu.Lock()
+	defer authFailuresMu.Unlock()
+
+	// Failures are newest first.
+	failures := 0
+	var last time.Time
+	for _, t := range authFailures[source] {
+		if now.Sub(t) < AuthFailureWindow {
+			if failures == 0 {
+				last = t
+			}
+			failures++
+		}
+	}
+	if failures < AuthBackoffThreshold {
+		return 0
+	}
+
+	wait := AuthFailureWindow
+	if shift := uint(failures - AuthBackoffThreshold); shift < 16 && AuthBackoffBase<<shift < wait {
+		wait = AuthBackoffBase << shift
+	}
+
+	if remaining := last.Add(wait).Sub(now); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// Login records a new session for a user who just logged in, runs plugins'
+// LoginHooks, and emits security events if it is from a new device or
+// country. country is empty if it isn't known.
+func Login(ctx context.Context, u *User, userAgent, ip, country string) (*Session, error) {
+	previous, err := UserSessions(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := NewSession(ctx, u, userAgent, ip, country)
+	if err != nil {
+		return nil, err
+	}
+	loginHooks(ctx, u, s)
+
+	// A user's first login isn't news to them.
+	if len(previous) == 0 {
+		return s, nil
+	}
+
+	knownDevice, knownCountry := false, country == ""
+	for _, p := range previous {
+		knownDevice = knownDevice || deviceName(p.UserAgent) == deviceName(userAgent)
+		knownCountry = knownCountry || p.Country == country
+	}
+
+	e := SecurityEvent{UserID: u.ID, IP: ip, UserAgent: userAgent, Country: country, Time: s.Created}
+	if !knownDevice {
+		e.Type = SecurityEventNewDevice
+		EmitSecurityEvent(e)
+	}
+	if !knownCountry {
+		e.Type = SecurityEventNewLocation
+		EmitSecurityEvent(e)
+	}
+
+	return s, nil
+}
+
+// deviceName strips the versions from a user agent.
+func deviceName(userAgent string) string {
+	return deviceVersionRegex.ReplaceAllString(userAgent, "")
+}
+
+// EmitSecurityEvent logs a security event as JSON, and queues a job to email
+// the user about it if they get authentication alerts.
+func EmitSecurityEvent(e SecurityEvent) {
+	if b, err := json.Marshal(e); err == nil {
+		log.Printf("security event: %s", b)
+	}
+
+	GoTask("security_event", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		if _, err := Enqueue(ctx, "security_event", e); err != nil {
+			log.Printf("could not queue security event: %+v", err)
+		}
+	})
+}
+
+func sendSecurityEvent(ctx context.Context, e SecurityEvent) error {
+	n, err := GetNotificationSettings(ctx, e.UserID)
+	if err != nil {
+		return err
+	}
+	if !n.AuthAlerts {
+		return nil
+	}
+
+	site, err := GetSiteSettings(ctx)
+	if err != nil {
+		return err
+	}
+
+	return notify(ctx, n, notificationAuthAlerts, "security_event", map[string]interface{}{
+		"Site":  site,
+		"Event": e,
+	})
+}
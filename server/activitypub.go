@@ -0,0 +1,133 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+	"github.com/icco/graphql"
+)
+
+// maxActivitySize is the largest activity the inbox accepts.
+const maxActivitySize = 1 << 20
+
+// activityRouter serves the blog's ActivityPub actor, so it can be followed
+// from Mastodon and other fediverse servers.
+func activityRouter() http.Handler {
+	r := chi.NewRouter()
+	r.Use(activityPubEnabled)
+
+	r.Get("/actor", func(w http.ResponseWriter, r *http.Request) {
+		actor, err := graphql.ActivityActor(r.Context())
+		if err != nil {
+			appErrorf(w, err, "could not build actor: %v", err)
+			return
+		}
+		activityJSON(w, graphql.ActivityContentType, actor)
+	})
+
+	r.Get("/outbox", func(w http.ResponseWriter, r *http.Request) {
+		outbox, err := graphql.ActivityOutbox(r.Context())
+		if err != nil {
+			appErrorf(w, err, "could not build outbox: %v", err)
+			return
+		}
+		activityJSON(w, graphql.ActivityContentType, outbox)
+	})
+
+	r.Get("/followers", func(w http.ResponseWriter, r *http.Request) {
+		followers, err := graphql.ActivityFollowers(r.Context())
+		if err != nil {
+			appErrorf(w, err, "could not build followers: %v", err)
+			return
+		}
+		activityJSON(w, graphql.ActivityContentType, followers)
+	})
+
+	r.Get("/posts/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			http.Error(w, http.StatusText(404), 404)
+			return
+		}
+
+		post, err := graphql.ActivityPost(r.Context(), id)
+		if err != nil {
+			http.Error(w, http.StatusText(404), 404)
+			return
+		}
+		activityJSON(w, graphql.ActivityContentType, post)
+	})
+
+	r.Post("/inbox", activityInboxHandler)
+
+	return r
+}
+
+// activityInboxHandler receives activities from other servers. Activities
+// must have a valid HTTP signature from their actor.
+func activityInboxHandler(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxActivitySize))
+	if err != nil {
+		http.Error(w, "activity is too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	actor, err := graphql.VerifyActivityRequest(r.Context(), r, body)
+	if err != nil {
+		log.Printf("activity signature error: %+v", err)
+		http.Error(w, http.StatusText(401), 401)
+		return
+	}
+
+	if err := graphql.HandleActivity(r.Context(), actor, body); err != nil {
+		log.Printf("could not handle activity from %s: %+v", actor.ID, err)
+		http.Error(w, http.StatusText(400), 400)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// webfingerHandler lets fediverse servers find the actor from a handle, like
+// @blog@example.com.
+func webfingerHandler(w http.ResponseWriter, r *http.Request) {
+	if !graphql.ActivityPubEnabled() {
+		http.Error(w, http.StatusText(404), 404)
+		return
+	}
+
+	doc, err := graphql.Webfinger(r.URL.Query().Get("resource"))
+	if err != nil {
+		http.Error(w, http.StatusText(404), 404)
+		return
+	}
+	activityJSON(w, "application/jrd+json", doc)
+}
+
+// activityPubEnabled 404s if ActivityPub isn't configured.
+func activityPubEnabled(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !graphql.ActivityPubEnabled() {
+			http.Error(w, http.StatusText(404), 404)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// activityJSON writes a JSON document with contentType.
+func activityJSON(w http.ResponseWriter, contentType string, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		appErrorf(w, err, "could not encode document: %v", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Write(data)
+}
@@ -1,6 +1,11 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"time"
@@ -34,7 +39,7 @@ func adminRouter() http.Handler {
 		})
 	})
 
-	r.Post("/post/new", func(w http.ResponseWriter, r *http.Request) {
+	r.With(limitBody(maxRequestSize)).Post("/post/new", func(w http.ResponseWriter, r *http.Request) {
 		var err error
 		r.ParseForm()
 
@@ -72,5 +77,155 @@ func adminRouter() http.Handler {
 		http.Redirect(w, r, "/admin/", http.StatusFound)
 	})
 
+	r.Mount("/debug", debugRouter())
+
+	r.Get("/export", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/gzip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", graphql.ExportFilename(time.Now())))
+
+		// Headers are already sent, so all we can do on failure is log and
+		// cut the archive short.
+		err := stream(w, func(sw io.Writer) error {
+			return graphql.Export(r.Context(), sw)
+		})
+		if err != nil {
+			log.Printf("export failed: %+v", err)
+		}
+	})
+
+	r.Get("/export/hugo", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/gzip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", graphql.HugoExportFilename(time.Now())))
+
+		opts := graphql.HugoOptions{Media: r.URL.Query().Get("media") == "true"}
+		err := stream(w, func(sw io.Writer) error {
+			return graphql.ExportHugo(r.Context(), sw, opts)
+		})
+		if err != nil {
+			log.Printf("hugo export failed: %+v", err)
+		}
+	})
+
+	// The archive is read straight from the request, so it is never held
+	// in memory or spooled to disk whole.
+	r.With(limitBody(maxUploadSize)).Post("/import", func(w http.ResponseWriter, r *http.Request) {
+		f, err := multipartFile(r, "archive")
+		if bodyTooLarge(err) {
+			Renderer.JSON(w, http.StatusRequestEntityTooLarge, map[string]string{"error": fmt.Sprintf("archive is larger than %d bytes", maxUploadSize)})
+			return
+		}
+		if err != nil {
+			http.Error(w, "archive is required", http.StatusBadRequest)
+			return
+		}
+
+		results, err := graphql.Import(r.Context(), f)
+		if bodyTooLarge(err) {
+			Renderer.JSON(w, http.StatusRequestEntityTooLarge, map[string]string{"error": fmt.Sprintf("archive is larger than %d bytes", maxUploadSize)})
+			return
+		}
+		if err != nil {
+			log.Printf("import failed: %+v", err)
+			Renderer.JSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+
+		Renderer.JSON(w, http.StatusOK, results)
+	})
+
+	r.With(limitBody(maxUploadSize)).Post("/import/wordpress", blogImportHandler("file", graphql.ImportWordPress))
+	r.With(limitBody(maxUploadSize)).Post("/import/site", blogImportHandler("archive", graphql.ImportStaticSite))
+
+	if graphql.SQLConsoleEnabled() {
+		r.With(limitBody(maxRequestSize)).Post("/sql", sqlConsoleHandler)
+	}
+
 	return r
 }
+
+// sqlConsoleHandler runs the read only statement in the JSON body's query,
+// like {"query": "SELECT count(*) FROM posts"}, and responds with its columns
+// and rows.
+func sqlConsoleHandler(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Query string `json:"query"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		Renderer.JSON(w, http.StatusBadRequest, map[string]string{"error": "json body could not be decoded"})
+		return
+	}
+
+	res, err := graphql.RunSQL(r.Context(), req.Query)
+	if err != nil {
+		e := graphql.ClassifyError(err, graphql.CodeInternal)
+		if e.Code == graphql.CodeInternal || e.Code == graphql.CodeUnavailable {
+			log.Printf("sql console failed: %+v", err)
+		}
+		Renderer.JSON(w, e.Code.HTTPStatus(), map[string]string{"error": e.ClientMessage()})
+		return
+	}
+
+	Renderer.JSON(w, http.StatusOK, res)
+}
+
+// blogImportHandler starts an import of the file uploaded in field with
+// start, and responds with its job. The base_url and permalink query
+// parameters are the import's options.
+func blogImportHandler(field string, start func(context.Context, *graphql.User, []byte, graphql.ImportOptions) (*graphql.Job, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		f, err := multipartFile(r, field)
+		if err != nil && !bodyTooLarge(err) {
+			Renderer.JSON(w, http.StatusBadRequest, map[string]string{"error": field + " is required"})
+			return
+		}
+
+		// The job outlives the request, so the file is read whole.
+		var data []byte
+		if err == nil {
+			data, err = ioutil.ReadAll(f)
+		}
+		if bodyTooLarge(err) {
+			Renderer.JSON(w, http.StatusRequestEntityTooLarge, map[string]string{"error": fmt.Sprintf("%s is larger than %d bytes", field, maxUploadSize)})
+			return
+		}
+		if err != nil {
+			appErrorf(w, err, "could not read %s: %v", field, err)
+			return
+		}
+
+		opts := graphql.ImportOptions{
+			BaseURL:   r.URL.Query().Get("base_url"),
+			Permalink: r.URL.Query().Get("permalink"),
+		}
+		job, err := start(r.Context(), graphql.ForContext(r.Context()), data, opts)
+		if err != nil {
+			e := graphql.ClassifyError(err, graphql.CodeInternal)
+			if e.Code == graphql.CodeInternal || e.Code == graphql.CodeUnavailable {
+				log.Printf("import failed: %+v", err)
+			}
+			Renderer.JSON(w, e.Code.HTTPStatus(), map[string]string{"error": e.ClientMessage()})
+			return
+		}
+
+		Renderer.JSON(w, http.StatusAccepted, job)
+	}
+}
+
+// multipartFile returns the file in a multipart request's field, as it is
+// read from the request. Fields before it are skipped.
+func multipartFile(r *http.Request, field string) (io.Reader, error) {
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		part, err := mr.NextPart()
+		if err != nil {
+			return nil, err
+		}
+		if part.FormName() == field && part.FileName() != "" {
+			return part, nil
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/icco/graphql"
+	"github.com/vektah/gqlparser/ast"
+	"github.com/vektah/gqlparser/parser"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+var (
+	shed = stats.Int64("graphql/shed", "Number of requests turned away because the server was saturated", stats.UnitDimensionless)
+
+	// shedLimit is which limit turned a request away: all, query or
+	// mutation.
+	shedLimit, _ = tag.NewKey("limit")
+)
+
+// semaphore limits how many of something run at once. Acquiring never
+// waits, so a saturated server answers straight away instead of queueing
+// work it can't get to.
+type semaphore chan struct{}
+
+func (s semaphore) tryAcquire() bool {
+	select {
+	case s <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s semaphore) release() {
+	<-s
+}
+
+// admission turns requests away with a 503 once too many are in flight,
+// both overall and per GraphQL operation type, so a burst of expensive
+// queries can't starve mutations, and the other way around.
+type admission struct {
+	all        semaphore
+	operations map[ast.Operation]semaphore
+	retryAfter time.Duration
+}
+
+// newAdmission returns admission control allowing all requests in flight at
+// once, and at most queries and mutations of each GraphQL operation type.
+// Rejected requests are told to retry after retryAfter.
+func newAdmission(all, queries, mutations int, retryAfter time.Duration) *admission {
+	if err := view.Register(&view.View{
+		Name:        shed.Name(),
+		Description: shed.Description(),
+		Measure:     shed,
+		TagKeys:     []tag.Key{shedLimit},
+		Aggregation: view.Count(),
+	}); err != nil {
+		log.Fatalf("Failed to register the shed view: %v", err)
+	}
+
+	return &admission{
+		all: make(semaphore, all),
+		operations: map[ast.Operation]semaphore{
+			ast.Query:    make(semaphore, queries),
+			ast.Mutation: make(semaphore, mutations),
+		},
+		retryAfter: retryAfter,
+	}
+}
+
+// limitAll is middleware that caps how many requests are in flight at once.
+func (a *admission) limitAll(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !a.all.tryAcquire() {
+			a.reject(w, r, "all")
+			return
+		}
+		defer a.all.release()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// limitOperations caps how many GraphQL operations of each type run at once.
+// It goes after batchHandler and getHandler, so each operation in a batch is
+// counted by its own type, and cached responses never count.
+func (a *admission) limitOperations(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		op := operationType(r)
+		sem, ok := a.operations[op]
+		if !ok {
+			// The schema has no subscriptions, so gqlgen rejects them
+			// without running anything, like a bad query.
+			op, sem = ast.Query, a.operations[ast.Query]
+		}
+		if !sem.tryAcquire() {
+			a.reject(w, r, string(op))
+			return
+		}
+		defer sem.release()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// reject responds with a 503 and a Retry-After header. GraphQL requests get
+// a GraphQL error with the UNAVAILABLE code.
+func (a *admission) reject(w http.ResponseWriter, r *http.Request, limit string) {
+	ctx, err := tag.New(r.Context(), tag.Upsert(shedLimit, limit))
+	if err != nil {
+		ctx = r.Context()
+	}
+	stats.Record(ctx, shed.M(1))
+
+	w.Header().Set("Retry-After", strconv.Itoa(int((a.retryAfter+time.Second-1)/time.Second)))
+	if !strings.HasSuffix(r.URL.Path, "/graphql") {
+		http.Error(w, "Server is busy, try again later", http.StatusServiceUnavailable)
+		return
+	}
+
+	Renderer.JSON(w, http.StatusServiceUnavailable, map[string]interface{}{
+		"errors": []map[string]interface{}{{
+			"message":    "Server is busy, try again later",
+			"extensions": (&graphql.Error{Code: graphql.CodeUnavailable}).Extensions(),
+		}},
+	})
+}
+
+// operationType returns the type of the GraphQL operation r runs, from its
+// query parameters or JSON body. Anything that can't be parsed counts as a
+// query, since gqlgen will reject it without running anything.
+func operationType(r *http.Request) ast.Operation {
+	var params struct {
+		Query         string `json:"query"`
+		OperationName string `json:"operationName"`
+	}
+
+	if r.Method == http.MethodGet {
+		params.Query = r.URL.Query().Get("query")
+		params.OperationName = r.URL.Query().Get("operationName")
+	} else if r.Body != nil {
+		body, err := ioutil.ReadAll(r.Body)
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		if err != nil || json.Unmarshal(body, &params) != nil {
+			return ast.Query
+		}
+	}
+
+	doc, err := parser.ParseQuery(&ast.Source{Input: params.Query})
+	if err != nil {
+		return ast.Query
+	}
+	for _, op := range doc.Operations {
+		if params.OperationName == "" || op.Name == params.OperationName {
+			return op.Operation
+		}
+	}
+
+	return ast.Query
+}
@@ -2,12 +2,15 @@ package main
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/gob"
 	"fmt"
 	"log"
+	"math"
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/gofrs/uuid"
@@ -21,8 +24,17 @@ import (
 const (
 	defaultSessionID        = "graphql.natwelch"
 	googleProfileSessionKey = "google_profile"
+	sessionIDSessionKey     = "session_id"
 	oauthTokenSessionKey    = "oauth_token"
 	oauthFlowRedirectKey    = "redirect"
+	oauthFlowInviteKey      = "invite"
+	oauthFlowLinkKey        = "link"
+	oauthFlowSeparateKey    = "separate"
+
+	// csrfSessionKey is where the session keeps the token that forms, like
+	// the device and merge forms, must send back as csrf_token, so other
+	// sites can't submit them for the user.
+	csrfSessionKey = "csrf"
 )
 
 var (
@@ -31,6 +43,14 @@ var (
 
 	// OAuthConfig is used to store and share the Oauth2 Config.
 	OAuthConfig *oauth2.Config
+
+	errInvalidState = graphql.Validation("state", "Invalid state parameter, try logging in again")
+
+	// oauthEndpoint is where users log in, and plusBasePath, if set, is
+	// where their profiles are fetched from instead of Google. Tests point
+	// them at graphqltest.FakeOAuth.
+	oauthEndpoint = google.Endpoint
+	plusBasePath  = ""
 )
 
 func init() {
@@ -39,11 +59,18 @@ func init() {
 	gob.Register(&graphql.User{})
 }
 
+// appErrorf logs err with a message, and responds with the HTTP status for
+// the error's code. Clients see the error's message unless it is internal.
 func appErrorf(w http.ResponseWriter, err error, msg string, args ...interface{}) {
-	message := fmt.Sprintf(msg, args...)
-	log.Printf("%s: %+v", message, err)
-	http.Error(w, message, http.StatusInternalServerError)
-	return
+	log.Printf("%s: %+v", fmt.Sprintf(msg, args...), err)
+
+	// Errors without a code are internal, so their details aren't shown.
+	e := &graphql.Error{Code: graphql.CodeInternal}
+	if err != nil {
+		e = graphql.ClassifyError(err, graphql.CodeInternal)
+	}
+
+	http.Error(w, e.ClientMessage(), e.Code.HTTPStatus())
 }
 
 func validateRedirectURL(path string) (string, error) {
@@ -54,10 +81,10 @@ func validateRedirectURL(path string) (string, error) {
 	// Ensure redirect URL is valid and not pointing to a different server.
 	parsedURL, err := url.Parse(path)
 	if err != nil {
-		return "/", err
+		return "/", graphql.Validation("redirect", "Redirect is not a valid URL")
 	}
 	if parsedURL.IsAbs() {
-		return "/", fmt.Errorf("URL must not be absolute")
+		return "/", graphql.Validation("redirect", "Redirect must not be an absolute URL")
 	}
 	return path, nil
 }
@@ -75,15 +102,41 @@ func configureOAuthClient(clientID, clientSecret, redirectURL string) *oauth2.Co
 			plus.UserinfoEmailScope,
 			plus.UserinfoProfileScope,
 		},
-		Endpoint: google.Endpoint,
+		Endpoint: oauthEndpoint,
+	}
+}
+
+// oauthConfig returns the OAuth config for the site the request is for. Sites
+// with a host get their callback on that host, so their session cookies are
+// set there. Each callback has to be allowed in the Google console.
+func oauthConfig(r *http.Request) *oauth2.Config {
+	site := graphql.SiteForContext(r.Context())
+	if site.Host == nil {
+		return OAuthConfig
+	}
+
+	u, err := url.Parse(OAuthConfig.RedirectURL)
+	if err != nil {
+		return OAuthConfig
 	}
+	u.Host = *site.Host
+
+	c := *OAuthConfig
+	c.RedirectURL = u.String()
+	return &c
 }
 
 func logoutHandler(w http.ResponseWriter, r *http.Request) {
 	// Nuke session
 	session, _ := SessionStore.Get(r, defaultSessionID)
+	if id, ok := session.Values[sessionIDSessionKey].(string); ok {
+		if err := graphql.DeleteSession(r.Context(), id); err != nil {
+			log.Printf("could not delete session: %+v", err)
+		}
+	}
 	session.Values[oauthTokenSessionKey] = nil
 	session.Values[googleProfileSessionKey] = nil
+	session.Values[sessionIDSessionKey] = nil
 	if err := session.Save(r, w); err != nil {
 		appErrorf(w, err, "could not save session: %v", err)
 		return
@@ -95,20 +148,24 @@ func logoutHandler(w http.ResponseWriter, r *http.Request) {
 func callbackHandler(w http.ResponseWriter, r *http.Request) {
 	oauthFlowSession, err := SessionStore.Get(r, r.FormValue("state"))
 	if err != nil {
-		appErrorf(w, err, "invalid state parameter. try logging in again.")
+		graphql.RecordAuthFailure(clientIP(r))
+		appErrorf(w, errInvalidState, "invalid state parameter: %v", err)
 		return
 	}
 
 	redirectURL, ok := oauthFlowSession.Values[oauthFlowRedirectKey].(string)
 	// Validate this callback request came from the app.
 	if !ok {
-		appErrorf(w, err, "invalid state parameter. try logging in again.")
+		graphql.RecordAuthFailure(clientIP(r))
+		appErrorf(w, errInvalidState, "invalid state parameter: %v", err)
 		return
 	}
 
 	code := r.FormValue("code")
-	tok, err := OAuthConfig.Exchange(context.Background(), code)
+	config := oauthConfig(r)
+	tok, err := config.Exchange(context.Background(), code)
 	if err != nil {
+		graphql.RecordAuthFailure(clientIP(r))
 		appErrorf(w, err, "could not get auth token: %v", err)
 		return
 	}
@@ -119,28 +176,94 @@ func callbackHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	client := oauth2.NewClient(r.Context(), OAuthConfig.TokenSource(r.Context(), tok))
+	client := oauth2.NewClient(r.Context(), config.TokenSource(r.Context(), tok))
 	plusService, err := plus.New(client)
 	if err != nil {
 		appErrorf(w, err, "could not get plus api: %v", err)
 		return
 	}
+	if plusBasePath != "" {
+		plusService.BasePath = plusBasePath
+	}
 	profile, err := plusService.People.Get("me").Do()
 	if err != nil {
 		appErrorf(w, err, "could not fetch Google profile: %v", err)
 		return
 	}
 
-	user, err := graphql.GetUser(r.Context(), profile.Id)
+	if linkID, ok := oauthFlowSession.Values[oauthFlowLinkKey].(string); ok && linkID != "" {
+		linkIdentity(w, r, linkID, profile.Id, redirectURL)
+		return
+	}
+
+	userID, err := graphql.ResolveIdentity(r.Context(), graphql.IdentityProviderGoogle, profile.Id)
+	if err != nil {
+		appErrorf(w, err, "could not resolve identity: %v", err)
+		return
+	}
+
+	// New users have to be allowed by the signup policy.
+	var user *graphql.User
+	_, err = graphql.FindUser(r.Context(), userID)
+	switch err {
+	case graphql.ErrUserNotFound:
+		if !graphql.SiteForContext(r.Context()).Allows(nil, accountEmail(profile)) {
+			err = graphql.ErrSignupNotAllowed
+			break
+		}
+
+		invite, _ := oauthFlowSession.Values[oauthFlowInviteKey].(string)
+
+		// Someone who already has a user with this email is offered a merge,
+		// unless they asked for a separate user.
+		separate, _ := oauthFlowSession.Values[oauthFlowSeparateKey].(bool)
+		if _, ferr := graphql.FindUserByEmail(r.Context(), accountEmail(profile)); ferr == nil && !separate {
+			startMerge(w, r, profile.Id, accountEmail(profile), invite)
+			return
+		}
+
+		user, err = graphql.Signup(r.Context(), userID, accountEmail(profile), invite)
+	case nil:
+		user, err = graphql.GetUser(r.Context(), userID)
+	}
+
+	if err == graphql.ErrSignupNotAllowed {
+		log.Printf("user %s is not allowed to signup", profile.Id)
+		http.Error(w, http.StatusText(403), 403)
+		return
+	}
+	if err == graphql.ErrUserDeactivated {
+		log.Printf("deactivated user %s tried to login", profile.Id)
+		http.Error(w, http.StatusText(403), 403)
+		return
+	}
 	if err != nil {
 		appErrorf(w, err, "could not upsert user: %v", err)
 		return
 	}
 	log.Printf("user: %+v", user)
 
+	// Sites can limit who logs in on them.
+	if !graphql.SiteForContext(r.Context()).Allows(user, accountEmail(profile)) {
+		log.Printf("user %s is not allowed on this site", user.ID)
+		http.Error(w, http.StatusText(403), 403)
+		return
+	}
+
+	if err := graphql.RememberEmail(r.Context(), user.ID, accountEmail(profile)); err != nil {
+		log.Printf("could not save email for user %s: %+v", user.ID, err)
+	}
+
+	s, err := graphql.Login(r.Context(), user, r.UserAgent(), clientIP(r), requestCountry(r))
+	if err != nil {
+		appErrorf(w, err, "could not create session: %v", err)
+		return
+	}
+
 	// Actually save something to session
 	session.Values[oauthTokenSessionKey] = tok
 	session.Values[googleProfileSessionKey] = user
+	session.Values[sessionIDSessionKey] = s.ID
 	if err := session.Save(r, w); err != nil {
 		appErrorf(w, err, "could not save session: %v", err)
 		return
@@ -149,7 +272,81 @@ func callbackHandler(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, redirectURL, http.StatusFound)
 }
 
+// accountEmail returns the Google account email address from a profile.
+func accountEmail(profile *plus.Person) string {
+	for _, e := range profile.Emails {
+		if e.Type == "account" {
+			return e.Value
+		}
+	}
+
+	return ""
+}
+
+// linkIdentity links the Google account that just logged in to the user who
+// started the link flow, then sends them back to redirectURL.
+func linkIdentity(w http.ResponseWriter, r *http.Request, userID, googleID, redirectURL string) {
+	user, err := graphql.GetUser(r.Context(), userID)
+	if err == graphql.ErrUserDeactivated {
+		http.Error(w, http.StatusText(403), 403)
+		return
+	}
+	if err != nil {
+		appErrorf(w, err, "could not get user: %v", err)
+		return
+	}
+
+	if !linkGoogleAccount(w, r, user, googleID) {
+		return
+	}
+
+	http.Redirect(w, r, redirectURL, http.StatusFound)
+}
+
+// linkGoogleAccount links a Google account to user. It writes an error and
+// returns false if it can't.
+func linkGoogleAccount(w http.ResponseWriter, r *http.Request, user *graphql.User, googleID string) bool {
+	job, err := graphql.LinkIdentity(r.Context(), user, graphql.IdentityProviderGoogle, googleID)
+	switch err {
+	case nil, graphql.ErrIdentityLinked:
+	case graphql.ErrUserDeactivated:
+		log.Printf("user %s tried to link deactivated identity %s", user.ID, googleID)
+		http.Error(w, http.StatusText(403), 403)
+		return false
+	default:
+		appErrorf(w, err, "could not link identity: %v", err)
+		return false
+	}
+
+	if job != nil {
+		log.Printf("linking identity %s merges its user into %s in job %s", googleID, user.ID, job.ID)
+	}
+
+	return true
+}
+
 func loginHandler(w http.ResponseWriter, r *http.Request) {
+	startOAuthFlow(w, r, "")
+}
+
+// linkHandler links another Google account to the logged in user. Logging in
+// with it afterwards logs in as the same user, and if it already had a user,
+// that user is merged in.
+func linkHandler(w http.ResponseWriter, r *http.Request) {
+	user := graphql.ForContext(r.Context())
+	if user == nil {
+		http.Redirect(w, r, "/login?redirect="+url.QueryEscape(r.URL.RequestURI()), http.StatusFound)
+		return
+	}
+
+	startOAuthFlow(w, r, user.ID)
+}
+
+// startOAuthFlow sends the browser to Google to log in. If linkID is set, the
+// account is linked to that user instead of being logged in. The form values
+// select_account=true always shows Google's account chooser, and
+// separate=true signs up a new user even if one has the same email.
+func startOAuthFlow(w http.ResponseWriter, r *http.Request, linkID string) {
 	sessionID := uuid.Must(uuid.NewV4()).String()
 
 	oauthFlowSession, err := SessionStore.New(r, sessionID)
@@ -165,43 +362,69 @@ func loginHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	oauthFlowSession.Values[oauthFlowRedirectKey] = redirectURL
+	oauthFlowSession.Values[oauthFlowInviteKey] = r.FormValue("invite")
+	oauthFlowSession.Values[oauthFlowLinkKey] = linkID
+	oauthFlowSession.Values[oauthFlowSeparateKey] = r.FormValue("separate") == "true"
 
 	if err := oauthFlowSession.Save(r, w); err != nil {
 		appErrorf(w, err, "could not save session: %v", err)
 		return
 	}
 
-	url := OAuthConfig.AuthCodeURL(sessionID, oauth2.ApprovalForce, oauth2.AccessTypeOnline)
+	opts := []oauth2.AuthCodeOption{oauth2.ApprovalForce, oauth2.AccessTypeOnline}
+	if linkID != "" || r.FormValue("select_account") == "true" {
+		// Let the user pick an account other than the one they're logged
+		// in to Google with.
+		opts = append(opts, oauth2.SetAuthURLParam("prompt", "select_account consent"))
+	}
+
+	url := oauthConfig(r).AuthCodeURL(sessionID, opts...)
 	http.Redirect(w, r, url, http.StatusFound)
 }
 
+// csrfToken returns the session's token for forms, making one if it doesn't
+// have one yet.
+func csrfToken(w http.ResponseWriter, r *http.Request) (string, error) {
+	session, err := SessionStore.Get(r, defaultSessionID)
+	if err != nil {
+		return "", err
+	}
+
+	if token, ok := session.Values[csrfSessionKey].(string); ok && token != "" {
+		return token, nil
+	}
+
+	token := uuid.Must(uuid.NewV4()).String()
+	session.Values[csrfSessionKey] = token
+	if err := session.Save(r, w); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// validCSRFToken returns true if the form sent back the session's token.
+func validCSRFToken(r *http.Request) bool {
+	session, err := SessionStore.Get(r, defaultSessionID)
+	if err != nil {
+		return false
+	}
+
+	token, ok := session.Values[csrfSessionKey].(string)
+	return ok && token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(r.FormValue("csrf_token"))) == 1
+}
+
 // AdminOnly is a middleware that makes sure the logged in user is an admin, or
 // 403.
 func AdminOnly(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		session, err := SessionStore.Get(r, defaultSessionID)
-		var user *graphql.User
-
-		// If error, we couldn't parse session.
-		allowed := false
+		user, _, err := sessionUser(w, r)
 		if err != nil {
-			log.Printf("session parsing error: %+v", err)
-		}
-
-		if session.Values[googleProfileSessionKey] != nil {
-			profile := session.Values[googleProfileSessionKey].(*graphql.User)
-			if profile.ID != "" {
-				user, err = graphql.GetUser(r.Context(), profile.ID)
-				if err != nil {
-					appErrorf(w, err, "could not upsert user: %v", err)
-					return
-				}
-
-				allowed = user.Role == "admin"
-			}
+			appErrorf(w, err, "could not upsert user: %v", err)
+			return
 		}
 
-		if !allowed {
+		if allowed := user != nil && user.Role == "admin"; !allowed {
 			log.Printf("User could not login: %+v", user)
 			http.Error(w, http.StatusText(403), 403)
 			return
@@ -211,32 +434,156 @@ func AdminOnly(next http.Handler) http.Handler {
 	})
 }
 
-// ContextMiddleware gets the current user in the session and stores in the
-// current context.
-func ContextMiddleware(next http.Handler) http.Handler {
+// authBackoff turns away clients that have failed to authenticate too often
+// recently, until their backoff is over.
+func authBackoff(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		session, err := SessionStore.Get(r, defaultSessionID)
-
-		// Allow unauthenticated users in
-		if err != nil || session == nil || session.Values[googleProfileSessionKey] == nil {
-			next.ServeHTTP(w, r)
+		if backedOff(w, r) {
 			return
 		}
 
-		// get the user from the database
-		profile := session.Values[googleProfileSessionKey].(*graphql.User)
-		if profile.ID != "" {
-			user, err := graphql.GetUser(r.Context(), profile.ID)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// backedOff responds with a 429 and returns true if the client has to wait
+// before authenticating again.
+func backedOff(w http.ResponseWriter, r *http.Request) bool {
+	wait := graphql.AuthBackoff(clientIP(r))
+	if wait <= 0 {
+		return false
+	}
+
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+	http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+	return true
+}
+
+// requestCountry returns the client's country from countryHeader, or empty
+// if it isn't configured or sent.
+func requestCountry(r *http.Request) string {
+	if countryHeader == "" {
+		return ""
+	}
+
+	return r.Header.Get(countryHeader)
+}
+
+// bearerContext returns ctx with the user or service account that token
+// belongs to. Tokens can be API tokens or service account secrets. JWTs are
+// refused.
+func bearerContext(ctx context.Context, token string) (context.Context, error) {
+	// JWTs have three dot separated parts. API tokens are hex. JWTs are
+	// minted for other services and OpenID Connect clients, not this API.
+	if strings.Count(token, ".") == 2 {
+		return nil, fmt.Errorf("jwts are not credentials for this api")
+	}
+
+	if user, err := graphql.GetUserByToken(ctx, token); err == nil {
+		return context.WithValue(ctx, graphql.UserCtxKey, user), nil
+	}
+
+	sa, err := graphql.GetServiceAccountBySecret(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("token lookup error: %+v", err)
+	}
+
+	return context.WithValue(ctx, graphql.ServiceAccountCtxKey, sa), nil
+}
+
+// ContextMiddleware gets the current user in the session, or from an API token
+// in the Authorization header, and stores it, the client's address and the
+// X-Timezone header in the current context.
+func ContextMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r = r.WithContext(context.WithValue(r.Context(), graphql.RemoteAddrCtxKey, clientIP(r)))
+		r = r.WithContext(context.WithValue(r.Context(), graphql.TimezoneCtxKey, r.Header.Get("X-Timezone")))
+
+		if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+			if backedOff(w, r) {
+				return
+			}
+
+			ctx, err := bearerContext(r.Context(), strings.TrimPrefix(auth, "Bearer "))
+			if err != nil && (r.URL.Path == micropubPath || r.URL.Path == oidcUserInfoPath) {
+				// Micropub clients send IndieAuth tokens, and OpenID
+				// Connect clients access tokens, which their handlers
+				// verify themselves.
+				next.ServeHTTP(w, r)
+				return
+			}
 			if err != nil {
-				appErrorf(w, err, "could not upsert user: %v", err)
+				log.Printf("%+v", err)
+				graphql.RecordAuthFailure(clientIP(r))
+				http.Error(w, http.StatusText(401), 401)
 				return
 			}
 
+			next.ServeHTTP(w, r.WithContext(ctx))
+			return
+		}
+
+		user, sessionID, err := sessionUser(w, r)
+		if err != nil {
+			appErrorf(w, err, "could not upsert user: %v", err)
+			return
+		}
+
+		// Allow unauthenticated users in
+		if user != nil {
 			// put it in context
 			ctx := context.WithValue(r.Context(), graphql.UserCtxKey, user)
+			ctx = context.WithValue(ctx, graphql.SessionIDCtxKey, sessionID)
 			r = r.WithContext(ctx)
 		}
 
 		next.ServeHTTP(w, r)
 	})
 }
+
+// sessionUser returns the user logged in with the session cookie, and the
+// session's ID. The user is nil if nobody is logged in, or the session was
+// revoked. Deactivated users are treated as logged out. Cookies from before
+// sessions were recorded are given a session.
+func sessionUser(w http.ResponseWriter, r *http.Request) (*graphql.User, string, error) {
+	session, err := SessionStore.Get(r, defaultSessionID)
+	if err != nil || session == nil || session.Values[googleProfileSessionKey] == nil {
+		return nil, "", nil
+	}
+
+	profile := session.Values[googleProfileSessionKey].(*graphql.User)
+	if profile.ID == "" {
+		return nil, "", nil
+	}
+
+	// get the user from the database
+	user, err := graphql.GetUser(r.Context(), profile.ID)
+	if err == graphql.ErrUserDeactivated {
+		return nil, "", nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	id, ok := session.Values[sessionIDSessionKey].(string)
+	if !ok {
+		s, err := graphql.NewSession(r.Context(), user, r.UserAgent(), clientIP(r), requestCountry(r))
+		if err != nil {
+			return nil, "", err
+		}
+
+		session.Values[sessionIDSessionKey] = s.ID
+		if err := session.Save(r, w); err != nil {
+			return nil, "", err
+		}
+		return user, s.ID, nil
+	}
+
+	if _, err := graphql.TouchSession(r.Context(), id, clientIP(r)); err == graphql.ErrSessionRevoked {
+		return nil, "", nil
+	} else if err != nil {
+		return nil, "", err
+	}
+
+	return user, id, nil
+}
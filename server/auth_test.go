@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/gorilla/sessions"
+	"github.com/icco/graphql/graphqltest"
+	"golang.org/x/oauth2"
+)
+
+var (
+	testAdmin = graphqltest.Profile{ID: "1", Email: "admin@example.com", Name: "Admin"}
+	testUser  = graphqltest.Profile{ID: "2", Email: "user@example.com", Name: "User"}
+)
+
+func okHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+// useTestSessions gives the test a session store with a key, and puts the
+// old one back afterwards.
+func useTestSessions(t *testing.T) {
+	store := SessionStore
+	SessionStore = sessions.NewCookieStore([]byte("graphqltest"))
+	t.Cleanup(func() { SessionStore = store })
+}
+
+// startServer serves the login and callback handlers, and /admin behind
+// AdminOnly, against a database with an admin and a normal user in it. It
+// skips the test if there is no Postgres to run against.
+func startServer(t *testing.T) *graphqltest.Server {
+	if os.Getenv("TEST_DATABASE_URL") == "" {
+		if _, err := exec.LookPath("docker"); err != nil {
+			t.Skip("needs TEST_DATABASE_URL or docker")
+		}
+	}
+	useTestSessions(t)
+
+	endpoint, basePath, config := oauthEndpoint, plusBasePath, OAuthConfig
+	t.Cleanup(func() { oauthEndpoint, plusBasePath, OAuthConfig = endpoint, basePath, config })
+
+	s, err := graphqltest.StartServer(context.Background(), graphqltest.Fixtures{
+		Admins: []graphqltest.Profile{testAdmin},
+		Users:  []graphqltest.Profile{testUser},
+	}, func(s *graphqltest.Server) http.Handler {
+		oauthEndpoint = oauth2.Endpoint{AuthURL: s.OAuth.URL + "/auth", TokenURL: s.OAuth.URL + "/token"}
+		plusBasePath = s.OAuth.URL + "/plus/v1/"
+		OAuthConfig = configureOAuthClient("graphqltest", "graphqltest", s.URL+"/callback")
+
+		r := chi.NewRouter()
+		r.Use(SiteMiddleware)
+		r.Use(ContextMiddleware)
+		r.Get("/healthz", okHandler)
+		r.HandleFunc("/login", loginHandler)
+		r.With(authBackoff).HandleFunc("/callback", callbackHandler)
+		r.With(AdminOnly).Get("/admin", okHandler)
+		return r
+	})
+	if err != nil {
+		t.Fatalf("could not start server: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	return s
+}
+
+func TestAdminOnlyAnonymous(t *testing.T) {
+	useTestSessions(t)
+
+	w := httptest.NewRecorder()
+	AdminOnly(http.HandlerFunc(okHandler)).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin", nil))
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("anonymous request got %d, want %d", w.Code, http.StatusForbidden)
+	}
+}
+
+func TestCallbackHandlerUnknownState(t *testing.T) {
+	useTestSessions(t)
+
+	w := httptest.NewRecorder()
+	callbackHandler(w, httptest.NewRequest(http.MethodGet, "/callback?state=bogus&code=bogus", nil))
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("callback with unknown state got %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestAdminOnlyLoggedIn(t *testing.T) {
+	s := startServer(t)
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name    string
+		profile *graphqltest.Profile
+		want    int
+	}{
+		{"admin", &testAdmin, http.StatusOK},
+		{"user", &testUser, http.StatusForbidden},
+		{"anonymous", nil, http.StatusForbidden},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := s.Client()
+			if tc.profile != nil {
+				var err error
+				if c, err = s.Login(ctx, *tc.profile); err != nil {
+					t.Fatalf("could not log in: %v", err)
+				}
+			}
+
+			resp, err := c.HTTPClient.Get(s.URL + "/admin")
+			if err != nil {
+				t.Fatalf("could not get /admin: %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tc.want {
+				t.Errorf("got %d, want %d", resp.StatusCode, tc.want)
+			}
+		})
+	}
+}
+
+func TestCallbackHandlerStateFromAnotherBrowser(t *testing.T) {
+	s := startServer(t)
+	s.OAuth.LoginAs(testAdmin)
+
+	// Start logging in, but don't follow the redirect to the provider, so
+	// the state is known but its cookie is left behind.
+	noRedirects := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := noRedirects.Get(s.URL + "/login")
+	if err != nil {
+		t.Fatalf("could not start login: %v", err)
+	}
+	resp.Body.Close()
+	u, err := resp.Location()
+	if err != nil {
+		t.Fatalf("login did not redirect: %v", err)
+	}
+
+	resp, err = s.Client().HTTPClient.Get(s.URL + "/callback?code=bogus&state=" + u.Query().Get("state"))
+	if err != nil {
+		t.Fatalf("could not get callback: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("got %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/icco/graphql"
+	"golang.org/x/sync/errgroup"
+)
+
+// bufferedResponseWriter collects a response in memory, so the result of one
+// operation in a batch can be added to the batch's response.
+type bufferedResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponseWriter) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponseWriter) Write(p []byte) (int, error) {
+	return b.body.Write(p)
+}
+
+func (b *bufferedResponseWriter) WriteHeader(status int) {
+	b.status = status
+}
+
+// batchHandler adds support for batched GraphQL requests, where the POST body
+// is a JSON array of operations. Each operation is run through next, with at
+// most maxConcurrent running at once, and the responses are returned as a
+// JSON array in the same order. Batches larger than maxSize are rejected.
+// Everything else is passed straight to next.
+func batchHandler(next http.Handler, maxSize, maxConcurrent int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "could not read body", http.StatusBadRequest)
+			return
+		}
+
+		trimmed := bytes.TrimSpace(body)
+		if len(trimmed) == 0 || trimmed[0] != '[' {
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var ops []json.RawMessage
+		if err := json.Unmarshal(trimmed, &ops); err != nil {
+			http.Error(w, "json body could not be decoded", http.StatusBadRequest)
+			return
+		}
+
+		if len(ops) == 0 || len(ops) > maxSize {
+			http.Error(w, fmt.Sprintf("batches must have between 1 and %d operations", maxSize), http.StatusBadRequest)
+			return
+		}
+
+		// Operations start no faster than maxConcurrent can run them, and stop
+		// starting once the client has gone away.
+		results := make([]json.RawMessage, len(ops))
+		statuses := make([]int, len(ops))
+		g, ctx := errgroup.WithContext(r.Context())
+		g.SetLimit(maxConcurrent)
+		for i, op := range ops {
+			if ctx.Err() != nil {
+				break
+			}
+
+			i, op := i, op
+			g.Go(func() error {
+				req := r.WithContext(ctx)
+				req.Body = ioutil.NopCloser(bytes.NewReader(op))
+				req.ContentLength = int64(len(op))
+
+				rw := &bufferedResponseWriter{header: http.Header{}, status: http.StatusOK}
+				next.ServeHTTP(rw, req)
+				results[i], statuses[i] = rw.body.Bytes(), rw.status
+				return nil
+			})
+		}
+		g.Wait()
+
+		// The client is gone, so there is no one to respond to.
+		if r.Context().Err() != nil {
+			return
+		}
+
+		// Operations that failed outside of GraphQL, like with a 400, may not
+		// have written JSON.
+		for i, res := range results {
+			if !json.Valid(res) {
+				results[i], _ = json.Marshal(map[string]interface{}{
+					"errors": []map[string]interface{}{{
+						"message":    string(bytes.TrimSpace(res)),
+						"extensions": (&graphql.Error{Code: graphql.CodeForHTTPStatus(statuses[i])}).Extensions(),
+					}},
+				})
+			}
+		}
+
+		Renderer.JSON(w, http.StatusOK, results)
+	})
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/icco/graphql"
+)
+
+// beaconHandler records a page view, sent by the frontend with
+// navigator.sendBeacon or as an image. The path and referrer are form values,
+// and the referrer falls back to the Referer header. Visitors with Do Not
+// Track on are not recorded.
+func beaconHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("DNT") == "1" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	referrer := r.FormValue("referrer")
+	if referrer == "" {
+		referrer = r.Referer()
+	}
+
+	if err := graphql.RecordPageView(r.Context(), r.FormValue("path"), referrer, clientIP(r)); err != nil {
+		log.Printf("could not record page view: %+v", err)
+		http.Error(w, http.StatusText(400), 400)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// beaconHeadHandler answers HEAD /beacon like beaconHandler, without
+// recording a page view.
+func beaconHeadHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNoContent)
+}
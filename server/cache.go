@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/icco/graphql"
+)
+
+// cachePolicy is how long an anonymous response is fresh for, and how long
+// after that it can still be served while it is refreshed in the background.
+type cachePolicy struct {
+	TTL   int `json:"ttl"`
+	Stale int `json:"stale"`
+}
+
+// cachedResponse is a response stored in the response cache.
+type cachedResponse struct {
+	header http.Header
+	body   []byte
+	stored time.Time
+	policy cachePolicy
+}
+
+// age returns how old the response is, in seconds.
+func (c *cachedResponse) age(now time.Time) int {
+	return int(now.Sub(c.stored) / time.Second)
+}
+
+// responseCache caches successful responses to anonymous GET queries, with
+// stale-while-revalidate: once a response is older than its TTL, but still
+// within its stale window, it is served right away and refreshed in the
+// background, so only the first request after the stale window waits on the
+// database.
+type responseCache struct {
+	entries  *lru.Cache
+	policy   cachePolicy
+	policies map[string]cachePolicy
+
+	mu         sync.Mutex
+	refreshing map[string]bool
+}
+
+// newResponseCache returns a cache holding at most size responses. Queries
+// use policy, unless their persisted query ID or operation name is in
+// policies.
+func newResponseCache(size int, policy cachePolicy, policies map[string]cachePolicy) (*responseCache, error) {
+	entries, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &responseCache{
+		entries:    entries,
+		policy:     policy,
+		policies:   policies,
+		refreshing: map[string]bool{},
+	}, nil
+}
+
+// loadCachePolicies reads a JSON object of persisted query IDs or operation
+// names to cache policies.
+func loadCachePolicies(path string) (map[string]cachePolicy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := map[string]cachePolicy{}
+	if err := json.Unmarshal(data, &policies); err != nil {
+		return nil, fmt.Errorf("could not parse %s: %v", path, err)
+	}
+
+	return policies, nil
+}
+
+// policyFor returns the cache policy for a request.
+func (c *responseCache) policyFor(r *http.Request) cachePolicy {
+	params := r.URL.Query()
+	for _, key := range []string{params.Get("id"), params.Get("operationName")} {
+		if p, ok := c.policies[key]; key != "" && ok {
+			return p
+		}
+	}
+
+	return c.policy
+}
+
+// cacheControl returns the Cache-Control header for a cacheable response.
+func (p cachePolicy) cacheControl() string {
+	if p.Stale > 0 {
+		return fmt.Sprintf("public, max-age=%d, stale-while-revalidate=%d", p.TTL, p.Stale)
+	}
+
+	return fmt.Sprintf("public, max-age=%d", p.TTL)
+}
+
+// serve writes a cached response for key, if there is one that is fresh or
+// stale, or a 304 if r already has it. Stale responses are refreshed in the
+// background with refresh.
+func (c *responseCache) serve(w http.ResponseWriter, r *http.Request, key string, refresh func(context.Context) *cachedResponse) bool {
+	v, ok := c.entries.Get(key)
+	if !ok {
+		return false
+	}
+	res := v.(*cachedResponse)
+
+	age := res.age(time.Now())
+	status := "HIT"
+	switch {
+	case age < res.policy.TTL:
+	case age < res.policy.TTL+res.policy.Stale:
+		status = "STALE"
+		c.revalidate(refreshContext(r.Context()), key, refresh)
+	default:
+		c.entries.Remove(key)
+		return false
+	}
+
+	for k, vs := range res.header {
+		w.Header()[k] = vs
+	}
+	w.Header().Set("X-Cache", status)
+	w.Header().Set("Age", fmt.Sprintf("%d", age))
+	if notModified(r, res.header) {
+		w.WriteHeader(http.StatusNotModified)
+		return true
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(res.body)
+	return true
+}
+
+// refreshContext returns a context for refreshing a response to a request
+// with ctx in the background: it has the request's site and time zone, but
+// isn't canceled when the request is done.
+func refreshContext(ctx context.Context) context.Context {
+	base := context.WithValue(context.Background(), graphql.SiteCtxKey, graphql.SiteForContext(ctx))
+	return context.WithValue(base, graphql.TimezoneCtxKey, ctx.Value(graphql.TimezoneCtxKey))
+}
+
+// revalidate refreshes key in the background with a context derived from
+// base, unless it is already being refreshed.
+func (c *responseCache) revalidate(base context.Context, key string, refresh func(context.Context) *cachedResponse) {
+	c.mu.Lock()
+	if c.refreshing[key] {
+		c.mu.Unlock()
+		return
+	}
+	c.refreshing[key] = true
+	c.mu.Unlock()
+
+	graphql.GoTask("revalidate", func() {
+		defer func() {
+			c.mu.Lock()
+			delete(c.refreshing, key)
+			c.mu.Unlock()
+		}()
+
+		ctx, cancel := context.WithTimeout(base, time.Minute)
+		defer cancel()
+
+		if res := refresh(ctx); res != nil {
+			c.entries.Add(key, res)
+		} else {
+			log.Printf("could not refresh cached response for %s", key)
+		}
+	})
+}
+
+// store caches a response for key.
+func (c *responseCache) store(key string, res *cachedResponse) {
+	if res.policy.TTL+res.policy.Stale > 0 {
+		c.entries.Add(key, res)
+	}
+}
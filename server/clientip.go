@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// trustedProxies are the networks of the load balancers and proxies in front
+// of the server. X-Forwarded-For is only believed when they send it.
+var trustedProxies []*net.IPNet
+
+// configureTrustedProxies sets trustedProxies from a comma separated list of
+// CIDRs or IP addresses, like 35.191.0.0/16,130.211.0.0/22.
+func configureTrustedProxies(list string) error {
+	proxies := []*net.IPNet{}
+	for _, p := range strings.Split(list, ",") {
+		if p = strings.TrimSpace(p); p == "" {
+			continue
+		}
+
+		if !strings.Contains(p, "/") {
+			ip := net.ParseIP(p)
+			if ip == nil {
+				return fmt.Errorf("%q is not an IP address", p)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(p)
+		if err != nil {
+			return err
+		}
+		proxies = append(proxies, network)
+	}
+
+	trustedProxies = proxies
+	return nil
+}
+
+// clientIP returns the IP address of the client that made the request,
+// without a port. Rate limits, backoffs and anything else counted per
+// client are keyed by it.
+func clientIP(r *http.Request) string {
+	return clientAddr(r.RemoteAddr, r.Header.Values("X-Forwarded-For"))
+}
+
+// clientAddr returns the client's IP address, given the address of the peer
+// that connected and any X-Forwarded-For values it sent. If the peer is a
+// trusted proxy, the client is the nearest address in X-Forwarded-For that
+// isn't one, since clients can put anything at the start of it.
+func clientAddr(remoteAddr string, forwardedFor []string) string {
+	addr := remoteAddr
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		addr = host
+	}
+	if !trustedProxy(addr) {
+		return addr
+	}
+
+	hops := []string{}
+	for _, v := range forwardedFor {
+		hops = append(hops, strings.Split(v, ",")...)
+	}
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		ip := net.ParseIP(strings.TrimSpace(hops[i]))
+		if ip == nil {
+			break
+		}
+
+		addr = ip.String()
+		if !trustedProxy(addr) {
+			break
+		}
+	}
+
+	return addr
+}
+
+func trustedProxy(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
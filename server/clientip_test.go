@@ -0,0 +1,32 @@
+package main
+
+import "testing"
+
+func TestClientAddr(t *testing.T) {
+	if err := configureTrustedProxies("10.0.0.0/8, 192.0.2.1"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { trustedProxies = nil })
+
+	for _, tc := range []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor []string
+		want         string
+	}{
+		{"port is dropped", "203.0.113.5:41234", nil, "203.0.113.5"},
+		{"ipv6 port is dropped", "[2001:db8::1]:41234", nil, "2001:db8::1"},
+		{"untrusted peers can't forward", "203.0.113.5:41234", []string{"198.51.100.7"}, "203.0.113.5"},
+		{"trusted proxy", "10.1.2.3:41234", []string{"198.51.100.7"}, "198.51.100.7"},
+		{"spoofed first hop", "10.1.2.3:41234", []string{"1.2.3.4, 198.51.100.7"}, "198.51.100.7"},
+		{"chain of proxies", "192.0.2.1:41234", []string{"1.2.3.4, 198.51.100.7", "10.9.9.9"}, "198.51.100.7"},
+		{"garbage hop", "10.1.2.3:41234", []string{"198.51.100.7, nonsense"}, "10.1.2.3"},
+		{"proxy without header", "10.1.2.3:41234", nil, "10.1.2.3"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := clientAddr(tc.remoteAddr, tc.forwardedFor); got != tc.want {
+				t.Errorf("clientAddr(%q, %q) = %q, want %q", tc.remoteAddr, tc.forwardedFor, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package main
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+)
+
+var (
+	compressionRatio = stats.Float64("graphql/compression/ratio", "Compressed size of compressed responses, divided by their uncompressed size", stats.UnitDimensionless)
+	compressionSaved = stats.Int64("graphql/compression/saved_bytes", "Bytes saved by compressing responses", stats.UnitBytes)
+
+	// compressors are the encodings responses can be compressed with.
+	compressors = map[string]func(w io.Writer) (compressWriter, error){
+		"gzip": func(w io.Writer) (compressWriter, error) {
+			return gzip.NewWriterLevel(w, gzip.DefaultCompression)
+		},
+		"deflate": func(w io.Writer) (compressWriter, error) {
+			return flate.NewWriter(w, flate.DefaultCompression)
+		},
+	}
+
+	// compressionPreference breaks ties between encodings the client likes
+	// equally.
+	compressionPreference = []string{"gzip", "deflate"}
+)
+
+// compressWriter is a compressing writer, like a gzip.Writer.
+type compressWriter interface {
+	io.WriteCloser
+	Flush() error
+}
+
+// compressHandler compresses responses with the best encoding the client
+// accepts. Responses are only compressed if they are at least minSize bytes,
+// or flushed before then, and have a text, JSON, JavaScript or XML content
+// type, so already compressed downloads, like exports, are left alone.
+// Flushes go straight to the client, so streamed responses keep streaming,
+// and upgraded connections, like websockets, are never touched.
+func compressHandler(minSize int) func(http.Handler) http.Handler {
+	if err := view.Register(
+		&view.View{
+			Name:        compressionRatio.Name(),
+			Description: compressionRatio.Description(),
+			Measure:     compressionRatio,
+			Aggregation: view.Distribution(0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1),
+		},
+		&view.View{
+			Name:        compressionSaved.Name(),
+			Description: compressionSaved.Description(),
+			Measure:     compressionSaved,
+			Aggregation: view.Sum(),
+		},
+	); err != nil {
+		log.Fatalf("Failed to register the compression views: %v", err)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+			if encoding == "" || r.Method == http.MethodHead || r.Header.Get("Upgrade") != "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// If next panics, nothing buffered is written, so recoverer
+			// can still send a 500.
+			cw := &compressResponseWriter{ResponseWriter: w, r: r, encoding: encoding, minSize: minSize}
+			next.ServeHTTP(cw, r)
+			cw.close()
+		})
+	}
+}
+
+// negotiateEncoding returns the encoding in an Accept-Encoding header with
+// the highest quality that responses can be compressed with, or "" if there
+// isn't one.
+func negotiateEncoding(accept string) string {
+	qualities := map[string]float64{}
+	wildcard := -1.0
+	for _, part := range strings.Split(accept, ",") {
+		fields := strings.Split(part, ";")
+		name := strings.ToLower(strings.TrimSpace(fields[0]))
+		q := 1.0
+		for _, f := range fields[1:] {
+			f = strings.TrimSpace(f)
+			if strings.HasPrefix(f, "q=") {
+				if v, err := strconv.ParseFloat(f[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+
+		if name == "*" {
+			wildcard = q
+		} else if name != "" {
+			qualities[name] = q
+		}
+	}
+
+	best, bestQ := "", 0.0
+	for _, name := range compressionPreference {
+		q, ok := qualities[name]
+		if !ok {
+			q = wildcard
+		}
+		if q > bestQ {
+			best, bestQ = name, q
+		}
+	}
+
+	return best
+}
+
+// compressibleType returns true for content types that compress well.
+func compressibleType(contentType string) bool {
+	t, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	switch {
+	case strings.HasPrefix(t, "text/"),
+		t == "application/json", t == "application/javascript", t == "application/xml",
+		strings.HasSuffix(t, "+json"), strings.HasSuffix(t, "+xml"):
+		return true
+	}
+
+	return false
+}
+
+// compressResponseWriter buffers the start of a response until it knows
+// whether to compress it: when minSize bytes have been written, the handler
+// flushes, or the handler is done.
+type compressResponseWriter struct {
+	http.ResponseWriter
+	r        *http.Request
+	encoding string
+	minSize  int
+
+	status  int
+	buf     []byte
+	decided bool
+
+	// cw is nil if the response isn't compressed.
+	cw      compressWriter
+	counter *countingWriter
+	written int64
+}
+
+// countingWriter counts the bytes written to w.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func (c *compressResponseWriter) WriteHeader(status int) {
+	if c.decided {
+		c.ResponseWriter.WriteHeader(status)
+		return
+	}
+	if c.status == 0 {
+		c.status = status
+	}
+}
+
+func (c *compressResponseWriter) Write(p []byte) (int, error) {
+	if c.decided {
+		return c.write(p)
+	}
+
+	c.buf = append(c.buf, p...)
+	if len(c.buf) >= c.minSize {
+		if err := c.decide(true); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(p), nil
+}
+
+func (c *compressResponseWriter) write(p []byte) (int, error) {
+	if c.cw == nil {
+		return c.ResponseWriter.Write(p)
+	}
+
+	c.written += int64(len(p))
+	return c.cw.Write(p)
+}
+
+// decide writes the headers, compressing the rest of the response if
+// compress is true and the response can be, then writes what was buffered.
+func (c *compressResponseWriter) decide(compress bool) error {
+	c.decided = true
+	if c.status == 0 {
+		c.status = http.StatusOK
+	}
+
+	h := c.Header()
+	if h.Get("Content-Type") == "" && len(c.buf) > 0 {
+		h.Set("Content-Type", http.DetectContentType(c.buf))
+	}
+
+	bodyless := c.status < 200 || c.status == http.StatusNoContent || c.status == http.StatusNotModified
+	if !bodyless && h.Get("Content-Encoding") == "" && compressibleType(h.Get("Content-Type")) {
+		h.Add("Vary", "Accept-Encoding")
+		if compress {
+			c.counter = &countingWriter{w: c.ResponseWriter}
+			cw, err := compressors[c.encoding](c.counter)
+			if err != nil {
+				return err
+			}
+			c.cw = cw
+
+			h.Set("Content-Encoding", c.encoding)
+			h.Del("Content-Length")
+
+			// The compressed bytes differ, so the ETag can only be weak.
+			if etag := h.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+				h.Set("ETag", "W/"+etag)
+			}
+		}
+	}
+
+	c.ResponseWriter.WriteHeader(c.status)
+	buf := c.buf
+	c.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+
+	_, err := c.write(buf)
+	return err
+}
+
+// Flush sends everything written so far to the client. A response flushed
+// before it reaches minSize is sent uncompressed.
+func (c *compressResponseWriter) Flush() {
+	if !c.decided {
+		if err := c.decide(len(c.buf) >= c.minSize); err != nil {
+			log.Printf("could not start compressing the response: %+v", err)
+			return
+		}
+	}
+
+	if c.cw != nil {
+		if err := c.cw.Flush(); err != nil {
+			log.Printf("could not flush the compressed response: %+v", err)
+			return
+		}
+	}
+	if f, ok := c.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// close finishes the response, and records how well it compressed.
+func (c *compressResponseWriter) close() {
+	if !c.decided {
+		if c.status == 0 && len(c.buf) == 0 {
+			return
+		}
+		if err := c.decide(false); err != nil {
+			log.Printf("could not write the response: %+v", err)
+			return
+		}
+	}
+
+	if c.cw == nil {
+		return
+	}
+
+	if err := c.cw.Close(); err != nil {
+		log.Printf("could not finish the compressed response: %+v", err)
+		return
+	}
+
+	if c.written > 0 {
+		stats.Record(c.r.Context(),
+			compressionRatio.M(float64(c.counter.n)/float64(c.written)),
+			compressionSaved.M(c.written-c.counter.n))
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+
+	"github.com/go-chi/chi"
+	"github.com/icco/graphql"
+)
+
+// debugRouter serves Go's profiles, expvar and a snapshot of the runtime, for
+// investigating performance in production. It has no auth of its own, so it
+// is only mounted behind AdminOnly, or on the address in -debug-addr.
+func debugRouter() http.Handler {
+	r := chi.NewRouter()
+
+	r.Get("/pprof/", pprof.Index)
+	r.Get("/pprof/cmdline", pprof.Cmdline)
+	r.Get("/pprof/profile", pprof.Profile)
+	r.Get("/pprof/symbol", pprof.Symbol)
+	r.Post("/pprof/symbol", pprof.Symbol)
+	r.Get("/pprof/trace", pprof.Trace)
+	r.Get("/pprof/{profile}", func(w http.ResponseWriter, r *http.Request) {
+		pprof.Handler(chi.URLParam(r, "profile")).ServeHTTP(w, r)
+	})
+
+	r.Handle("/vars", expvar.Handler())
+
+	r.Get("/runtime", func(w http.ResponseWriter, r *http.Request) {
+		goroutines, err := graphql.Goroutines()
+		if err != nil {
+			appErrorf(w, err, "could not count goroutines: %v", err)
+			return
+		}
+
+		var mem runtime.MemStats
+		runtime.ReadMemStats(&mem)
+
+		Renderer.JSON(w, http.StatusOK, map[string]interface{}{
+			"info":       graphql.GetDebugInfo(),
+			"goroutines": goroutines,
+			"memory":     mem,
+		})
+	})
+
+	return r
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/icco/graphql"
+)
+
+type devicePageData struct {
+	Title     string
+	UserCode  string
+	CSRFToken string
+	Message   string
+}
+
+// baseURL guesses the public URL of the server from a request.
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+
+	return fmt.Sprintf("%s://%s", scheme, r.Host)
+}
+
+// deviceCodeHandler starts a device login, returning a code for the user to
+// enter in their browser. See RFC 8628.
+func deviceCodeHandler(w http.ResponseWriter, r *http.Request) {
+	d, err := graphql.NewDeviceCode(r.Context())
+	if err != nil {
+		appErrorf(w, err, "could not create device code: %v", err)
+		return
+	}
+
+	Renderer.JSON(w, http.StatusOK, map[string]interface{}{
+		"device_code":               d.DeviceCode,
+		"user_code":                 d.UserCode,
+		"verification_uri":          baseURL(r) + "/device",
+		"verification_uri_complete": baseURL(r) + "/device?code=" + d.UserCode,
+		"expires_in":                int(time.Until(d.Expires).Seconds()),
+		"interval":                  graphql.DeviceCodeInterval,
+	})
+}
+
+// deviceTokenHandler is polled by the device until the user has approved the
+// code.
+func deviceTokenHandler(w http.ResponseWriter, r *http.Request) {
+	tok, err := graphql.ExchangeDeviceCode(r.Context(), r.FormValue("device_code"))
+	switch err {
+	case nil:
+		Renderer.JSON(w, http.StatusOK, map[string]string{
+			"access_token": tok.Token,
+			"token_type":   "bearer",
+		})
+	case graphql.ErrAuthorizationPending, graphql.ErrSlowDown, graphql.ErrExpiredToken:
+		Renderer.JSON(w, http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	default:
+		log.Printf("could not exchange device code: %+v", err)
+		graphql.RecordAuthFailure(clientIP(r))
+		Renderer.JSON(w, http.StatusBadRequest, map[string]string{
+			"error": "invalid_grant",
+		})
+	}
+}
+
+// deviceHandler shows the page where a logged in user enters a device code.
+func deviceHandler(w http.ResponseWriter, r *http.Request) {
+	if graphql.ForContext(r.Context()) == nil {
+		http.Redirect(w, r, "/login?redirect="+url.QueryEscape(r.URL.RequestURI()), http.StatusFound)
+		return
+	}
+
+	token, err := csrfToken(w, r)
+	if err != nil {
+		appErrorf(w, err, "could not save session: %v", err)
+		return
+	}
+
+	Renderer.HTML(w, http.StatusOK, "device", &devicePageData{
+		Title:     "Connect a Device",
+		UserCode:  r.FormValue("code"),
+		CSRFToken: token,
+	})
+}
+
+// deviceApproveHandler approves a device code for the logged in user.
+func deviceApproveHandler(w http.ResponseWriter, r *http.Request) {
+	user := graphql.ForContext(r.Context())
+	if user == nil || !validCSRFToken(r) {
+		http.Error(w, http.StatusText(403), 403)
+		return
+	}
+
+	data := &devicePageData{
+		Title:   "Connect a Device",
+		Message: "Your device is connected. You can close this window.",
+	}
+
+	if err := graphql.ApproveDeviceCode(r.Context(), r.FormValue("code"), user); err != nil {
+		log.Printf("could not approve device code: %+v", err)
+		data.UserCode = r.FormValue("code")
+		data.CSRFToken = r.FormValue("csrf_token")
+		data.Message = "That code is invalid or has expired."
+	}
+
+	Renderer.HTML(w, http.StatusOK, "device", data)
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/99designs/gqlgen/handler"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/cors"
+	"github.com/icco/graphql"
+)
+
+// snapshotHandler serves a snapshot of public content to edges. Edges
+// authenticate with SNAPSHOT_TOKEN.
+func snapshotHandler(token string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if token == "" || r.Header.Get("Authorization") != "Bearer "+token {
+			http.Error(w, http.StatusText(401), 401)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/gzip")
+		err := stream(w, func(sw io.Writer) error {
+			return graphql.WriteSnapshot(r.Context(), sw)
+		})
+		if err != nil {
+			log.Printf("could not write snapshot: %+v", err)
+		}
+	}
+}
+
+// edge serves public read queries from a snapshot pulled from a primary,
+// without a database.
+func edge() {
+	source := os.Getenv("SNAPSHOT_SOURCE")
+	if source == "" {
+		log.Fatalf("SNAPSHOT_SOURCE is empty! Set it to the primary's /snapshot URL.")
+	}
+	token := os.Getenv("SNAPSHOT_TOKEN")
+
+	path := os.Getenv("SNAPSHOT_PATH")
+	if path == "" {
+		path = "snapshot.db"
+	}
+
+	store, err := graphql.OpenSnapshotStore(path)
+	if err != nil {
+		log.Fatalf("Failed to open snapshot store: %v", err)
+	}
+	defer store.Close()
+
+	// Serve whatever snapshot we have if the primary is down, but don't
+	// start empty.
+	if err := graphql.PullSnapshot(context.Background(), source, token, store); err != nil {
+		created, cerr := store.Created()
+		if cerr != nil {
+			log.Fatalf("Failed to pull a snapshot: %v", err)
+		}
+		log.Printf("Failed to pull a snapshot, serving the one from %s: %v", created, err)
+	}
+	graphql.StartSnapshotPuller(context.Background(), source, token, store, envDuration("SNAPSHOT_INTERVAL", time.Minute))
+
+	persisted := persistedQueries{}
+	if path := os.Getenv("GRAPHQL_PERSISTED_QUERIES"); path != "" {
+		pq, err := loadPersistedQueries(path)
+		if err != nil {
+			log.Fatalf("Failed to load persisted queries: %v", err)
+		}
+		persisted = pq
+	}
+
+	cache, err := newResponseCache(
+		envInt("GRAPHQL_CACHE_SIZE", 1024),
+		cachePolicy{TTL: envInt("GRAPHQL_GET_MAX_AGE", 60), Stale: envInt("GRAPHQL_GET_STALE", 300)},
+		nil)
+	if err != nil {
+		log.Fatalf("Failed to create response cache: %v", err)
+	}
+
+	port := "8080"
+	if fromEnv := os.Getenv("PORT"); fromEnv != "" {
+		port = fromEnv
+	}
+	log.Printf("Starting edge on http://localhost:%s", port)
+
+	flushPanics := initPanicReporting("graphql-edge")
+	defer flushPanics()
+
+	isDev := os.Getenv("NAT_ENV") != "production"
+	schema := graphql.NewExecutableSchema(graphql.NewSnapshotConfig(store))
+
+	r := chi.NewRouter()
+	r.Use(middleware.RequestID)
+	r.Use(requestIDHeader)
+	r.Use(middleware.RealIP)
+	r.Use(middleware.Logger)
+	r.Use(recoverer)
+	r.Use(compressHandler(envInt("COMPRESSION_MIN_SIZE", 1024)))
+	r.Use(cors.New(cors.Options{
+		AllowedOrigins: corsOrigins(isDev),
+		AllowedMethods: []string{"GET", "POST", "OPTIONS"},
+		AllowedHeaders: []string{"Accept", "Content-Type"},
+		MaxAge:         300,
+	}).Handler)
+	r.Use(middleware.GetHead)
+	r.MethodNotAllowed(methodNotAllowedHandler)
+
+	r.Get("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		created, err := store.Created()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+
+		Renderer.JSON(w, http.StatusOK, map[string]string{
+			"healthy":  "true",
+			"snapshot": created.Format(time.RFC3339),
+		})
+	})
+	r.Get("/schema", schemaHandler(schema, isDev || !disableIntrospection))
+
+	gqlHandler := handler.GraphQL(
+		schema,
+		handler.RecoverFunc(recoverResolver),
+		handler.ErrorPresenter(graphql.ErrorPresenter),
+		handler.ResolverMiddleware(graphql.IntrospectionMiddleware(isDev || !disableIntrospection)),
+		handler.ResolverMiddleware(graphql.SnapshotMiddleware),
+		handler.ResolverMiddleware(graphql.LastModifiedMiddleware),
+	)
+	graphqlRoutes(r.With(limitGraphQLBody(maxRequestSize)), "/graphql", batchHandler(
+		errorCodeHandler(getHandler(gqlHandler, persisted, os.Getenv("GRAPHQL_GET_PERSISTED_ONLY") == "true", cache)),
+		envInt("GRAPHQL_BATCH_MAX_SIZE", 20),
+		envInt("GRAPHQL_BATCH_CONCURRENCY", 4)))
+
+	log.Fatal(http.ListenAndServe(":"+port, r))
+}
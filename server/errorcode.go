@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/icco/graphql"
+	"github.com/vektah/gqlparser/gqlerror"
+)
+
+// errorCodeWriter holds back error responses, so errorCodeHandler can add
+// codes to them. Everything else goes straight through.
+type errorCodeWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (e *errorCodeWriter) WriteHeader(status int) {
+	e.status = status
+	if status < http.StatusBadRequest {
+		e.ResponseWriter.WriteHeader(status)
+	}
+}
+
+func (e *errorCodeWriter) Write(p []byte) (int, error) {
+	if e.status < http.StatusBadRequest {
+		return e.ResponseWriter.Write(p)
+	}
+	return e.body.Write(p)
+}
+
+// errorCodeHandler gives every error in GraphQL responses with an error
+// status the code and retryable extensions. Errors from resolvers already
+// have them, but not those gqlgen and our handlers respond with before any
+// resolver runs, like for queries that don't parse. Their code comes from the
+// status.
+func errorCodeHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ew := &errorCodeWriter{ResponseWriter: w}
+		next.ServeHTTP(ew, r)
+		if ew.status < http.StatusBadRequest {
+			return
+		}
+
+		body := ew.body.Bytes()
+		var res map[string]json.RawMessage
+		var errs []*gqlerror.Error
+		if json.Unmarshal(body, &res) == nil && json.Unmarshal(res["errors"], &errs) == nil {
+			for _, e := range errs {
+				graphql.AddCodeExtensions(e, graphql.CodeForHTTPStatus(ew.status))
+			}
+			if b, err := json.Marshal(errs); err == nil {
+				res["errors"] = b
+				if b, err := json.Marshal(res); err == nil {
+					body = b
+				}
+			}
+		}
+
+		w.Header().Del("Content-Length")
+		w.WriteHeader(ew.status)
+		w.Write(body)
+	})
+}
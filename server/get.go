@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/icco/graphql"
+)
+
+// persistedQueries maps operation IDs to the query they run.
+type persistedQueries map[string]string
+
+// loadPersistedQueries reads a JSON object of operation IDs to queries, like
+// the ones extracted by most GraphQL client build tools.
+func loadPersistedQueries(path string) (persistedQueries, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	pq := persistedQueries{}
+	if err := json.Unmarshal(data, &pq); err != nil {
+		return nil, fmt.Errorf("could not parse %s: %v", path, err)
+	}
+
+	return pq, nil
+}
+
+// contains returns true if query is exactly one of the persisted queries.
+func (pq persistedQueries) contains(query string) bool {
+	for _, q := range pq {
+		if q == query {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getHandler handles GraphQL queries sent with GET, with the query and
+// variables in the URL. An id parameter runs that persisted query. If
+// persistedOnly is set, only persisted queries can be run with GET.
+//
+// Successful responses to anonymous requests are cached, both by cache and
+// by CDNs, following the query's cache policy. They have an ETag and, if
+// they include anything with a modification time, a Last-Modified header,
+// so polling clients get a 304 if nothing changed. Everything else is passed
+// straight to next, which only allows queries, not mutations, over GET.
+func getHandler(next http.Handler, pq persistedQueries, persistedOnly bool, cache *responseCache) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		policy := cache.policyFor(r)
+		params := r.URL.Query()
+		if id := params.Get("id"); id != "" {
+			query, ok := pq[id]
+			if !ok {
+				Renderer.JSON(w, http.StatusNotFound, map[string]interface{}{
+					"errors": []map[string]string{{"message": "unknown persisted query"}},
+				})
+				return
+			}
+
+			params.Set("query", query)
+			params.Del("id")
+			r.URL.RawQuery = params.Encode()
+		} else if persistedOnly && !pq.contains(params.Get("query")) {
+			Renderer.JSON(w, http.StatusForbidden, map[string]interface{}{
+				"errors": []map[string]string{{"message": "only persisted queries are allowed with GET"}},
+			})
+			return
+		}
+
+		// Only cache responses that are the same for everyone on the site,
+		// in the same time zone.
+		key := graphql.SiteForContext(r.Context()).ID + " " + r.Header.Get("X-Timezone") + "?" + r.URL.RawQuery
+		public := anonymous(r)
+		refresh := func(ctx context.Context) *cachedResponse {
+			rw, ok := runQuery(next, r.WithContext(ctx), policy)
+			if !ok {
+				return nil
+			}
+
+			return &cachedResponse{header: rw.header, body: rw.body.Bytes(), stored: time.Now(), policy: policy}
+		}
+
+		if public && cache.serve(w, r, key, refresh) {
+			return
+		}
+
+		rw, ok := runQuery(next, r, policy)
+		if public && ok {
+			cache.store(key, &cachedResponse{header: rw.header, body: rw.body.Bytes(), stored: time.Now(), policy: policy})
+			w.Header().Set("X-Cache", "MISS")
+		} else {
+			rw.header.Set("Cache-Control", "private, no-store")
+			rw.header.Del("ETag")
+			rw.header.Del("Last-Modified")
+		}
+
+		for k, vs := range rw.header {
+			w.Header()[k] = vs
+		}
+		if public && ok && notModified(r, rw.header) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.WriteHeader(rw.status)
+		w.Write(rw.body.Bytes())
+	})
+}
+
+// runQuery runs a GET query through next, and returns the response and
+// whether it succeeded, in which case it has policy's Cache-Control header,
+// an ETag, and a Last-Modified header if it has a modification time.
+func runQuery(next http.Handler, r *http.Request, policy cachePolicy) (*bufferedResponseWriter, bool) {
+	ctx := graphql.WithLastModified(r.Context())
+	rw := &bufferedResponseWriter{header: http.Header{}, status: http.StatusOK}
+	next.ServeHTTP(rw, r.WithContext(ctx))
+	rw.header.Add("Vary", "Authorization")
+	rw.header.Add("Vary", "Cookie")
+	rw.header.Add("Vary", "Host")
+	rw.header.Add("Vary", "X-Forwarded-Host")
+	rw.header.Add("Vary", "X-Timezone")
+
+	var res struct {
+		Errors []json.RawMessage `json:"errors"`
+	}
+	json.Unmarshal(rw.body.Bytes(), &res)
+
+	if rw.status != http.StatusOK || len(res.Errors) > 0 {
+		return rw, false
+	}
+
+	rw.header.Set("Cache-Control", policy.cacheControl())
+	sum := sha256.Sum256(rw.body.Bytes())
+	rw.header.Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+	if t := graphql.LastModified(ctx); !t.IsZero() {
+		rw.header.Set("Last-Modified", t.UTC().Format(http.TimeFormat))
+	}
+	return rw, true
+}
+
+// notModified returns true if the client already has the response with
+// header, going by its If-None-Match or, failing that, If-Modified-Since
+// header.
+func notModified(r *http.Request, header http.Header) bool {
+	if match := r.Header.Get("If-None-Match"); match != "" {
+		etag := header.Get("ETag")
+		for _, m := range strings.Split(match, ",") {
+			m = strings.TrimPrefix(strings.TrimSpace(m), "W/")
+			if etag != "" && (m == etag || m == "*") {
+				return true
+			}
+		}
+		return false
+	}
+
+	since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+
+	modified, err := http.ParseTime(header.Get("Last-Modified"))
+	return err == nil && !modified.After(since)
+}
+
+// anonymous returns true if the request has no credentials.
+func anonymous(r *http.Request) bool {
+	if r.Header.Get("Authorization") != "" {
+		return false
+	}
+
+	_, err := r.Cookie(defaultSessionID)
+	return err == http.ErrNoCookie
+}
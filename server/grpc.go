@@ -0,0 +1,304 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/icco/graphql"
+	"github.com/icco/graphql/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// grpcServer implements the read only gRPC API in rpc/graphql.proto, on top of
+// the same data layer as the GraphQL API.
+type grpcServer struct{}
+
+// newGRPCServer returns a gRPC server with the Posts, Links and Users
+// services registered.
+func newGRPCServer() *grpc.Server {
+	s := grpc.NewServer(grpc.UnaryInterceptor(grpcAuthInterceptor))
+	rpc.RegisterPostsServer(s, &grpcServer{})
+	rpc.RegisterLinksServer(s, &grpcServer{})
+	rpc.RegisterUsersServer(s, &grpcServer{})
+
+	return s
+}
+
+// grpcAuthInterceptor authenticates calls with "authorization: Bearer <token>"
+// metadata, the same way ContextMiddleware does for HTTP. Calls without it are
+// anonymous.
+func grpcAuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, auth := range md.Get("authorization") {
+		if !strings.HasPrefix(auth, "Bearer ") {
+			continue
+		}
+
+		client := grpcClientIP(ctx, md)
+		if wait := graphql.AuthBackoff(client); wait > 0 {
+			return nil, status.Errorf(codes.ResourceExhausted, "too many failed authentications, retry in %s", wait.Round(time.Second))
+		}
+
+		authed, err := bearerContext(ctx, strings.TrimPrefix(auth, "Bearer "))
+		if err != nil {
+			log.Printf("%+v", err)
+			graphql.RecordAuthFailure(client)
+			return nil, status.Error(codes.Unauthenticated, "invalid token")
+		}
+
+		ctx = authed
+		break
+	}
+
+	return handler(ctx, req)
+}
+
+// grpcClientIP returns the IP address of the client that made the call, like
+// clientIP does for HTTP requests.
+func grpcClientIP(ctx context.Context, md metadata.MD) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	return clientAddr(p.Addr.String(), md.Get("x-forwarded-for"))
+}
+
+// grpcError turns a data layer error into a gRPC status with the code for
+// its error code. Internal errors are logged, and their details hidden.
+func grpcError(err error) error {
+	e := graphql.ClassifyError(err, graphql.CodeInternal)
+	switch e.Code {
+	case graphql.CodeNotFound:
+		return status.Error(codes.NotFound, e.Message)
+	case graphql.CodeUnauthorized:
+		return status.Error(codes.PermissionDenied, e.Message)
+	case graphql.CodeValidation:
+		return status.Error(codes.InvalidArgument, e.Message)
+	case graphql.CodeTooLarge:
+		return status.Error(codes.ResourceExhausted, e.Message)
+	case graphql.CodeMethodNotAllowed:
+		return status.Error(codes.Unimplemented, e.Message)
+	case graphql.CodeConflict:
+		return status.Error(codes.Aborted, e.Message)
+	case graphql.CodeRateLimited:
+		return status.Error(codes.ResourceExhausted, e.Message)
+	case graphql.CodeUnavailable:
+		log.Printf("grpc error: %+v", err)
+		return status.Error(codes.Unavailable, "unavailable, try again later")
+	}
+
+	log.Printf("grpc error: %+v", err)
+	return status.Error(codes.Internal, "internal error")
+}
+
+// pageArgs returns limit and offset, with def for a limit of 0. Limits are
+// at most graphql.MaxPageSize, like in the GraphQL API.
+func pageArgs(limit, offset int32, def int) (int, int, error) {
+	if limit < 0 || offset < 0 {
+		return 0, 0, status.Error(codes.InvalidArgument, "limit and offset can't be negative")
+	}
+
+	if limit == 0 {
+		return def, int(offset), nil
+	}
+	if limit > graphql.MaxPageSize {
+		return graphql.MaxPageSize, int(offset), nil
+	}
+
+	return int(limit), int(offset), nil
+}
+
+// timestampProto converts t, returning nil for a nil or unrepresentable time.
+func timestampProto(t *time.Time) *tspb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	ts, err := ptypes.TimestampProto(*t)
+	if err != nil {
+		return nil
+	}
+
+	return ts
+}
+
+func postProto(p *graphql.Post) *rpc.Post {
+	return &rpc.Post{
+		Id:       p.ID,
+		Title:    p.Title,
+		Content:  p.Content,
+		Html:     p.HTML(),
+		Tags:     p.Tags,
+		Draft:    p.Draft,
+		Datetime: timestampProto(&p.Datetime),
+		Created:  timestampProto(&p.Created),
+		Modified: timestampProto(&p.Modified),
+	}
+}
+
+func linkProto(l *graphql.Link) *rpc.Link {
+	pb := &rpc.Link{
+		Id:          l.ID,
+		Title:       l.Title,
+		Url:         l.URI,
+		Description: l.Description,
+		Screenshot:  l.Screenshot,
+		Tags:        l.Tags,
+		Dead:        l.Dead,
+		Checked:     timestampProto(l.Checked),
+		Created:     timestampProto(&l.Created),
+		Modified:    timestampProto(&l.Modified),
+	}
+	if l.StatusCode != nil {
+		pb.StatusCode = int32(*l.StatusCode)
+	}
+
+	return pb
+}
+
+func userProto(u *graphql.User) *rpc.User {
+	return &rpc.User{
+		Id:          u.ID,
+		Role:        u.Role,
+		Created:     timestampProto(&u.Created),
+		Modified:    timestampProto(&u.Modified),
+		Deactivated: timestampProto(u.Deactivated),
+	}
+}
+
+// GetPost implements rpc.PostsServer. Drafts and protected posts are only
+// returned to admins.
+func (s *grpcServer) GetPost(ctx context.Context, req *rpc.GetPostRequest) (*rpc.Post, error) {
+	id, err := strconv.ParseInt(req.Id, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid post id %q", req.Id)
+	}
+
+	p, err := graphql.GetPost(ctx, id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	if p.Draft && !graphql.HasRole(ctx, graphql.RoleAdmin) {
+		return nil, status.Errorf(codes.NotFound, "No post with id %d", id)
+	}
+	if err := p.CanRead(ctx, ""); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return postProto(p), nil
+}
+
+// ListPosts implements rpc.PostsServer.
+func (s *grpcServer) ListPosts(ctx context.Context, req *rpc.ListPostsRequest) (*rpc.ListPostsResponse, error) {
+	site, err := graphql.GetSiteSettings(ctx)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	limit, offset, err := pageArgs(req.Limit, req.Offset, site.PostsPerPage)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := graphql.PublishedPosts(ctx, limit, offset)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	res := &rpc.ListPostsResponse{Posts: make([]*rpc.Post, 0, len(posts))}
+	for _, p := range posts {
+		res.Posts = append(res.Posts, postProto(p))
+	}
+
+	return res, nil
+}
+
+// GetLink implements rpc.LinksServer.
+func (s *grpcServer) GetLink(ctx context.Context, req *rpc.GetLinkRequest) (*rpc.Link, error) {
+	l, err := graphql.GetLink(ctx, req.Id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return linkProto(l), nil
+}
+
+// ListLinks implements rpc.LinksServer.
+func (s *grpcServer) ListLinks(ctx context.Context, req *rpc.ListLinksRequest) (*rpc.ListLinksResponse, error) {
+	limit, offset, err := pageArgs(req.Limit, req.Offset, 50)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := graphql.LinkFilter{}
+	if req.Tag != "" {
+		filter.Tag = &req.Tag
+	}
+	if req.Search != "" {
+		filter.Search = &req.Search
+	}
+
+	links, err := graphql.Links(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	res := &rpc.ListLinksResponse{Links: make([]*rpc.Link, 0, len(links))}
+	for _, l := range links {
+		res.Links = append(res.Links, linkProto(l))
+	}
+
+	return res, nil
+}
+
+// GetUser implements rpc.UsersServer. It is only available to admins.
+func (s *grpcServer) GetUser(ctx context.Context, req *rpc.GetUserRequest) (*rpc.User, error) {
+	if !graphql.HasRole(ctx, graphql.RoleAdmin) {
+		return nil, status.Error(codes.PermissionDenied, "Forbidden")
+	}
+
+	u, err := graphql.FindUser(ctx, req.Id)
+	if err == graphql.ErrUserNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return userProto(u), nil
+}
+
+// ListUsers implements rpc.UsersServer. It is only available to admins.
+func (s *grpcServer) ListUsers(ctx context.Context, req *rpc.ListUsersRequest) (*rpc.ListUsersResponse, error) {
+	if !graphql.HasRole(ctx, graphql.RoleAdmin) {
+		return nil, status.Error(codes.PermissionDenied, "Forbidden")
+	}
+
+	limit, offset, err := pageArgs(req.Limit, req.Offset, 50)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := graphql.Users(ctx, graphql.UserFilter{}, limit, offset)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	res := &rpc.ListUsersResponse{Users: make([]*rpc.User, 0, len(users))}
+	for _, u := range users {
+		res.Users = append(res.Users, userProto(u))
+	}
+
+	return res, nil
+}
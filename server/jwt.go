@@ -0,0 +1,39 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/icco/graphql"
+)
+
+// jwksHandler publishes the public keys JWTs are signed with, so other
+// services can verify them.
+func jwksHandler(w http.ResponseWriter, r *http.Request) {
+	Renderer.JSON(w, http.StatusOK, graphql.JWKS())
+}
+
+// jwtHandler mints a JWT for the logged in user.
+func jwtHandler(w http.ResponseWriter, r *http.Request) {
+	if !graphql.JWTEnabled() {
+		http.Error(w, http.StatusText(404), 404)
+		return
+	}
+
+	user := graphql.ForContext(r.Context())
+	if user == nil {
+		http.Error(w, http.StatusText(401), 401)
+		return
+	}
+
+	tok, err := user.JWT()
+	if err != nil {
+		appErrorf(w, err, "could not mint jwt: %v", err)
+		return
+	}
+
+	Renderer.JSON(w, http.StatusOK, map[string]interface{}{
+		"token":      tok,
+		"token_type": "bearer",
+		"expires_in": int(graphql.JWTExpiry.Seconds()),
+	})
+}
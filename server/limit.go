@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/icco/graphql"
+)
+
+var (
+	// maxRequestSize is the largest body accepted by GraphQL and other
+	// endpoints that take small requests.
+	maxRequestSize = int64(envInt("MAX_REQUEST_SIZE", 1<<20))
+
+	// maxUploadSize is the largest upload, like an import archive.
+	maxUploadSize = int64(envInt("MAX_UPLOAD_SIZE", 64<<20))
+)
+
+// bodyTooLarge returns whether err came from reading past the limit of an
+// http.MaxBytesReader.
+func bodyTooLarge(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "http: request body too large")
+}
+
+// graphqlTooLarge responds to a GraphQL request whose body is over limit
+// bytes with a 413, and an error with the REQUEST_TOO_LARGE code.
+func graphqlTooLarge(w http.ResponseWriter, limit int64) {
+	ext := (&graphql.Error{Code: graphql.CodeTooLarge}).Extensions()
+	ext["limit"] = limit
+	Renderer.JSON(w, http.StatusRequestEntityTooLarge, map[string]interface{}{
+		"errors": []map[string]interface{}{{
+			"message":    fmt.Sprintf("Request body is larger than %d bytes", limit),
+			"extensions": ext,
+		}},
+	})
+}
+
+// limitGraphQLBody refuses GraphQL requests with bodies over limit bytes.
+// GraphQL bodies are read whole anyway, so the body is read here, and
+// requests that are too large never reach the handler.
+func limitGraphQLBody(limit int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Body == nil || r.Method == http.MethodGet {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if r.ContentLength > limit {
+				graphqlTooLarge(w, limit)
+				return
+			}
+
+			body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, limit))
+			if bodyTooLarge(err) {
+				graphqlTooLarge(w, limit)
+				return
+			}
+			if err != nil {
+				http.Error(w, "could not read body", http.StatusBadRequest)
+				return
+			}
+
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// limitBody caps request bodies at limit bytes. Handlers see an error when
+// they read past it, which bodyTooLarge recognizes.
+func limitBody(limit int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > limit {
+				http.Error(w, fmt.Sprintf("Request body is larger than %d bytes", limit), http.StatusRequestEntityTooLarge)
+				return
+			}
+
+			if r.Body != nil {
+				r.Body = http.MaxBytesReader(w, r.Body, limit)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/icco/graphql"
+)
+
+// logsGeoJSONHandler exports the logged in user's logs that have a location
+// as GeoJSON. The optional from and to parameters, in RFC 3339, default to
+// everything.
+func logsGeoJSONHandler(w http.ResponseWriter, r *http.Request) {
+	user := graphql.ForContext(r.Context())
+	if user == nil {
+		http.Error(w, http.StatusText(401), 401)
+		return
+	}
+
+	from, to := time.Time{}, time.Now()
+	for _, p := range []struct {
+		name string
+		t    *time.Time
+	}{{"from", &from}, {"to", &to}} {
+		v := r.FormValue(p.name)
+		if v == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			http.Error(w, p.name+" must be an RFC 3339 time, like 2006-01-02T15:04:05Z", http.StatusBadRequest)
+			return
+		}
+		*p.t = t
+	}
+
+	logs, err := graphql.UserLogs(r.Context(), user, from, to)
+	if err != nil {
+		appErrorf(w, err, "could not get logs: %v", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/geo+json")
+	w.Header().Set("Cache-Control", "private, no-store")
+	json.NewEncoder(w).Encode(graphql.LogsGeoJSON(logs))
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/icco/graphql"
+)
+
+const (
+	mergeSessionID      = "graphql.merge"
+	mergeGoogleIDKey    = "google_id"
+	mergeEmailKey       = "email"
+	mergeInviteKey      = "invite"
+	mergeSessionTimeout = 10 * 60 // 10 minutes
+)
+
+type mergePageData struct {
+	Title     string
+	Email     string
+	Invite    string
+	LoggedIn  bool
+	CSRFToken string
+}
+
+// startMerge remembers a Google account that logged in for the first time
+// with the email of an existing user, and asks whoever logged in whether to
+// link it to that user.
+func startMerge(w http.ResponseWriter, r *http.Request, googleID, email, invite string) {
+	session, err := SessionStore.New(r, mergeSessionID)
+	if err != nil {
+		appErrorf(w, err, "could not create merge session: %v", err)
+		return
+	}
+	session.Options.MaxAge = mergeSessionTimeout
+	session.Values[mergeGoogleIDKey] = googleID
+	session.Values[mergeEmailKey] = email
+	session.Values[mergeInviteKey] = invite
+
+	if err := session.Save(r, w); err != nil {
+		appErrorf(w, err, "could not save session: %v", err)
+		return
+	}
+
+	http.Redirect(w, r, "/link/merge", http.StatusFound)
+}
+
+// mergeHandler asks to link the Google account from startMerge to an
+// existing user. Control of that user is proven by logging in as it, then
+// confirming. Otherwise a separate user can be created.
+func mergeHandler(w http.ResponseWriter, r *http.Request) {
+	session, err := SessionStore.Get(r, mergeSessionID)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusFound)
+		return
+	}
+
+	googleID, _ := session.Values[mergeGoogleIDKey].(string)
+	if googleID == "" {
+		http.Redirect(w, r, "/", http.StatusFound)
+		return
+	}
+
+	user := graphql.ForContext(r.Context())
+	if r.Method != http.MethodPost {
+		token, err := csrfToken(w, r)
+		if err != nil {
+			appErrorf(w, err, "could not save session: %v", err)
+			return
+		}
+
+		email, _ := session.Values[mergeEmailKey].(string)
+		invite, _ := session.Values[mergeInviteKey].(string)
+		Renderer.HTML(w, http.StatusOK, "merge", &mergePageData{
+			Title:     "Link Accounts",
+			Email:     email,
+			Invite:    invite,
+			LoggedIn:  user != nil,
+			CSRFToken: token,
+		})
+		return
+	}
+
+	if user == nil || !validCSRFToken(r) {
+		http.Error(w, http.StatusText(403), 403)
+		return
+	}
+
+	if !linkGoogleAccount(w, r, user, googleID) {
+		return
+	}
+
+	session.Options.MaxAge = -1
+	if err := session.Save(r, w); err != nil {
+		appErrorf(w, err, "could not save session: %v", err)
+		return
+	}
+
+	http.Redirect(w, r, "/", http.StatusFound)
+}
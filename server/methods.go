@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"github.com/icco/graphql"
+)
+
+// routeMethods are the methods routes are looked up with, to tell clients
+// which a path allows.
+var routeMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+}
+
+// allowedMethods returns the methods there are routes for at r's path. Paths
+// with a GET route allow HEAD too, and every path allows OPTIONS.
+func allowedMethods(r *http.Request) []string {
+	path := r.URL.RawPath
+	if path == "" {
+		path = r.URL.Path
+	}
+
+	allowed := []string{}
+	rctx := chi.RouteContext(r.Context())
+	get := false
+	for _, m := range routeMethods {
+		ok := rctx != nil && rctx.Routes.Match(chi.NewRouteContext(), m, path)
+		if m == http.MethodGet {
+			get = ok
+		}
+		if ok || (m == http.MethodHead && get) {
+			allowed = append(allowed, m)
+		}
+	}
+
+	return append(allowed, http.MethodOptions)
+}
+
+// methodNotAllowedHandler answers requests with a method their path has no
+// route for. OPTIONS gets a 204 with the allowed methods in the Allow header.
+// Anything else gets a 405 with the same header, and a body shaped like a
+// GraphQL error with the METHOD_NOT_ALLOWED code, so clients can handle it
+// like any other error.
+func methodNotAllowedHandler(w http.ResponseWriter, r *http.Request) {
+	allowed := allowedMethods(r)
+	w.Header().Set("Allow", strings.Join(allowed, ", "))
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	ext := (&graphql.Error{Code: graphql.CodeMethodNotAllowed}).Extensions()
+	ext["allow"] = allowed
+	Renderer.JSON(w, http.StatusMethodNotAllowed, map[string]interface{}{
+		"errors": []map[string]interface{}{{
+			"message":    fmt.Sprintf("Method %s is not allowed, use %s", r.Method, strings.Join(allowed, ", ")),
+			"extensions": ext,
+		}},
+	})
+}
+
+// graphqlHeadHandler answers HEAD requests for a GraphQL endpoint with the
+// headers of a response, without running the query.
+func graphqlHeadHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Add("Vary", "Authorization")
+	w.Header().Add("Vary", "Cookie")
+	w.WriteHeader(http.StatusOK)
+}
+
+// graphqlRoutes routes GraphQL requests at pattern to h: queries with GET,
+// anything with POST, and HEAD without running anything. Other methods get
+// methodNotAllowedHandler.
+func graphqlRoutes(r chi.Router, pattern string, h http.Handler) {
+	r.Method(http.MethodGet, pattern, h)
+	r.Method(http.MethodPost, pattern, h)
+	r.Head(pattern, graphqlHeadHandler)
+}
@@ -0,0 +1,432 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/icco/graphql"
+)
+
+// micropubPath is where the Micropub endpoint is served.
+const micropubPath = "/micropub"
+
+// nonWordRegex matches what can't be in a hashtag.
+var nonWordRegex = regexp.MustCompile(`\W+`)
+
+// micropubRequest is a Micropub request, in the JSON syntax. Form encoded
+// requests are converted to it.
+type micropubRequest struct {
+	Type       []string                 `json:"type"`
+	Properties map[string][]interface{} `json:"properties"`
+	Action     string                   `json:"action"`
+	URL        string                   `json:"url"`
+	Replace    map[string][]interface{} `json:"replace"`
+	Add        map[string][]interface{} `json:"add"`
+	Delete     interface{}              `json:"delete"`
+}
+
+// micropubHandler implements Micropub (https://www.w3.org/TR/micropub/), so
+// IndieWeb clients like Quill can create, update and delete posts. Admins can
+// use their usual credentials. Everyone else needs an IndieAuth token for the
+// site's URL, which is verified with tokenEndpoint.
+//
+// Categories are added to the post as hashtags, and photos as images. Media
+// uploads aren't supported, since media isn't stored here. Deleting a post
+// turns it back into a draft, so it can be undeleted.
+func micropubHandler(tokenEndpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			micropubQuery(w, r, tokenEndpoint)
+		case http.MethodPost:
+			micropubPost(w, r, tokenEndpoint)
+		default:
+			methodNotAllowedHandler(w, r)
+		}
+	}
+}
+
+// micropubQuery answers configuration and source queries.
+func micropubQuery(w http.ResponseWriter, r *http.Request, tokenEndpoint string) {
+	if !micropubAuthorized(w, r, tokenEndpoint, "") {
+		return
+	}
+
+	params := r.URL.Query()
+	switch params.Get("q") {
+	case "config", "syndicate-to":
+		Renderer.JSON(w, http.StatusOK, map[string]interface{}{
+			"syndicate-to": []interface{}{},
+		})
+	case "source":
+		p, err := micropubPostFromURL(r.Context(), params.Get("url"))
+		if err != nil {
+			micropubError(w, http.StatusBadRequest, "invalid_request", err.Error())
+			return
+		}
+
+		tags, err := graphql.ParseTags(" " + p.Content)
+		if err != nil {
+			micropubError(w, http.StatusInternalServerError, "server_error", err.Error())
+			return
+		}
+
+		status := "published"
+		if p.Draft {
+			status = "draft"
+		}
+
+		Renderer.JSON(w, http.StatusOK, map[string]interface{}{
+			"type": []string{"h-entry"},
+			"properties": map[string]interface{}{
+				"name":        []string{p.Title},
+				"content":     []string{p.Content},
+				"published":   []string{p.Datetime.Format(time.RFC3339)},
+				"post-status": []string{status},
+				"category":    tags,
+			},
+		})
+	default:
+		micropubError(w, http.StatusBadRequest, "invalid_request", "q must be config, syndicate-to or source")
+	}
+}
+
+// micropubPost creates, updates, deletes or undeletes a post.
+func micropubPost(w http.ResponseWriter, r *http.Request, tokenEndpoint string) {
+	req, err := parseMicropubRequest(r)
+	if bodyTooLarge(err) {
+		micropubError(w, http.StatusRequestEntityTooLarge, "invalid_request", fmt.Sprintf("request is larger than %d bytes", maxRequestSize))
+		return
+	}
+	if err != nil {
+		micropubError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+
+	scope := req.Action
+	if scope == "" {
+		scope = "create"
+	}
+	if !micropubAuthorized(w, r, tokenEndpoint, scope) {
+		return
+	}
+
+	ctx := r.Context()
+	if req.Action == "" {
+		if len(req.Type) > 0 && req.Type[0] != "h-entry" {
+			micropubError(w, http.StatusBadRequest, "invalid_request", "only h-entry posts can be created")
+			return
+		}
+
+		p := &graphql.Post{Datetime: time.Now(), Created: time.Now()}
+		if err := setMicropubProperties(p, req.Properties, true); err != nil {
+			micropubError(w, http.StatusBadRequest, "invalid_request", err.Error())
+			return
+		}
+
+		if err := p.Save(ctx); err != nil {
+			log.Printf("micropub could not create post: %+v", err)
+			micropubError(w, http.StatusInternalServerError, "server_error", "could not save post")
+			return
+		}
+
+		permalink, err := p.Permalink(ctx)
+		if err != nil {
+			log.Printf("micropub could not get permalink: %+v", err)
+		}
+		w.Header().Set("Location", permalink)
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+
+	p, err := micropubPostFromURL(ctx, req.URL)
+	if err != nil {
+		micropubError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+
+	switch req.Action {
+	case "update":
+		if req.Delete != nil {
+			micropubError(w, http.StatusBadRequest, "invalid_request", "properties can't be deleted")
+			return
+		}
+		if err := setMicropubProperties(p, req.Replace, true); err != nil {
+			micropubError(w, http.StatusBadRequest, "invalid_request", err.Error())
+			return
+		}
+		if err := setMicropubProperties(p, req.Add, false); err != nil {
+			micropubError(w, http.StatusBadRequest, "invalid_request", err.Error())
+			return
+		}
+	case "delete":
+		p.Draft = true
+	case "undelete":
+		p.Draft = false
+	default:
+		micropubError(w, http.StatusBadRequest, "invalid_request", fmt.Sprintf("unknown action %q", req.Action))
+		return
+	}
+
+	if _, err := p.SaveRevision(ctx); err != nil {
+		log.Printf("micropub could not save revision: %+v", err)
+		micropubError(w, http.StatusInternalServerError, "server_error", "could not save post")
+		return
+	}
+	if err := p.Save(ctx); err != nil {
+		if graphql.ClassifyError(err, graphql.CodeInternal).Code == graphql.CodeConflict {
+			micropubError(w, http.StatusConflict, "conflict", "the post was changed while it was being updated")
+			return
+		}
+		log.Printf("micropub could not save post: %+v", err)
+		micropubError(w, http.StatusInternalServerError, "server_error", "could not save post")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseMicropubRequest reads a JSON, form encoded or multipart request.
+func parseMicropubRequest(r *http.Request) (*micropubRequest, error) {
+	req := &micropubRequest{}
+	contentType := r.Header.Get("Content-Type")
+	switch {
+	case strings.HasPrefix(contentType, "application/json"):
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			return nil, fmt.Errorf("could not parse JSON: %v", err)
+		}
+		return req, nil
+	case strings.HasPrefix(contentType, "multipart/form-data"):
+		if err := parseMicropubMultipart(r); err != nil {
+			return nil, err
+		}
+	default:
+		if err := r.ParseForm(); err != nil {
+			return nil, err
+		}
+	}
+
+	req.Properties = map[string][]interface{}{}
+	for key, vs := range r.PostForm {
+		switch key {
+		case "h":
+			req.Type = []string{"h-" + vs[0]}
+		case "action":
+			req.Action = vs[0]
+		case "url":
+			req.URL = vs[0]
+		case "access_token":
+		default:
+			name := strings.TrimSuffix(key, "[]")
+			for _, v := range vs {
+				req.Properties[name] = append(req.Properties[name], v)
+			}
+		}
+	}
+
+	return req, nil
+}
+
+// parseMicropubMultipart reads the fields of a multipart request into
+// r.PostForm as they stream in. Files are refused as soon as they start, so
+// they are never buffered.
+func parseMicropubMultipart(r *http.Request) error {
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{}
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if part.FileName() != "" {
+			return fmt.Errorf("media uploads aren't supported, link to photos by URL instead")
+		}
+
+		value, err := ioutil.ReadAll(part)
+		if err != nil {
+			return err
+		}
+		form.Add(part.FormName(), string(value))
+	}
+
+	r.Form, r.PostForm = form, form
+	return nil
+}
+
+// micropubAuthorized returns true if r may do scope. Admins may do anything.
+// Otherwise r needs an IndieAuth token with scope, in the Authorization
+// header or the access_token form field. An empty scope only needs a valid
+// token. It writes an error if r isn't authorized.
+func micropubAuthorized(w http.ResponseWriter, r *http.Request, tokenEndpoint, scope string) bool {
+	ctx := r.Context()
+	if user := graphql.ForContext(ctx); user != nil {
+		if graphql.HasRole(ctx, graphql.RoleAdmin) {
+			return true
+		}
+
+		micropubError(w, http.StatusForbidden, "forbidden", "only admins can post")
+		return false
+	}
+
+	token := r.PostFormValue("access_token")
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		token = strings.TrimPrefix(auth, "Bearer ")
+	}
+	if token == "" {
+		micropubError(w, http.StatusUnauthorized, "unauthorized", "an access token is required")
+		return false
+	}
+
+	t, err := graphql.VerifyIndieAuthToken(ctx, tokenEndpoint, token)
+	if err != nil {
+		log.Printf("micropub token verification error: %+v", err)
+		graphql.RecordAuthFailure(clientIP(r))
+		micropubError(w, http.StatusUnauthorized, "unauthorized", "the access token is invalid")
+		return false
+	}
+
+	// Older clients ask for post instead of create.
+	if scope != "" && !t.HasScope(scope) && !(scope == "create" && t.HasScope("post")) {
+		micropubError(w, http.StatusForbidden, "insufficient_scope", fmt.Sprintf("the access token doesn't have the %s scope", scope))
+		return false
+	}
+
+	return true
+}
+
+// micropubPostFromURL returns the post at a permalink, like
+// https://example.com/post/123.
+func micropubPostFromURL(ctx context.Context, rawURL string) (*graphql.Post, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || rawURL == "" {
+		return nil, fmt.Errorf("url must be a post's URL")
+	}
+
+	path := strings.TrimSuffix(u.Path, "/")
+	i := strings.LastIndex(path, "/post/")
+	if i < 0 {
+		return nil, fmt.Errorf("url must be a post's URL")
+	}
+
+	id, err := strconv.ParseInt(path[i+len("/post/"):], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("url must be a post's URL")
+	}
+
+	return graphql.GetPost(ctx, id)
+}
+
+// setMicropubProperties sets the properties of p, or adds to them if replace
+// is false. Categories and photos can only be added to a post, and are added
+// after the content is set. Other properties can only be replaced. Unknown
+// properties are ignored.
+func setMicropubProperties(p *graphql.Post, props map[string][]interface{}, replace bool) error {
+	for name, vs := range props {
+		if name == "category" || name == "photo" {
+			continue
+		}
+		if err := setMicropubProperty(p, name, vs, replace); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range []string{"category", "photo"} {
+		if err := setMicropubProperty(p, name, props[name], replace); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setMicropubProperty sets, or adds to, one property of p.
+func setMicropubProperty(p *graphql.Post, name string, vs []interface{}, replace bool) error {
+	switch name {
+	case "category":
+		for _, v := range vs {
+			tag := nonWordRegex.ReplaceAllString(micropubString(v), "")
+			if tag != "" {
+				p.Content = strings.TrimRight(p.Content, "\n") + " #" + tag
+			}
+		}
+		return nil
+	case "photo":
+		for _, v := range vs {
+			alt := ""
+			if m, ok := v.(map[string]interface{}); ok {
+				alt, _ = m["alt"].(string)
+			}
+			p.Content = strings.TrimRight(p.Content, "\n") + fmt.Sprintf("\n\n![%s](%s)", alt, micropubString(v))
+		}
+		return nil
+	}
+
+	if !replace {
+		return fmt.Errorf("%s can't be added to", name)
+	}
+	if len(vs) == 0 {
+		return nil
+	}
+
+	value := micropubString(vs[0])
+	switch name {
+	case "name":
+		p.Title = value
+	case "content":
+		p.Content = value
+	case "published":
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return fmt.Errorf("published must be an RFC 3339 time")
+		}
+		p.Datetime = t
+	case "post-status":
+		p.Draft = value == "draft"
+	}
+
+	return nil
+}
+
+// micropubString returns a property value as a string. Values can be objects,
+// like {"html": "<p>Hi</p>"} for content or {"value": "...", "alt": "..."}
+// for photos.
+func micropubString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case map[string]interface{}:
+		for _, key := range []string{"value", "html"} {
+			if s, ok := v[key].(string); ok {
+				return s
+			}
+		}
+	}
+
+	return ""
+}
+
+// micropubError writes a Micropub error response.
+func micropubError(w http.ResponseWriter, status int, code, description string) {
+	Renderer.JSON(w, status, map[string]string{
+		"error":             code,
+		"error_description": description,
+	})
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/icco/graphql"
+)
+
+// migrate runs the migrate subcommands: up, down [steps] and status.
+func migrate(args []string) {
+	if len(args) == 0 {
+		log.Fatalf("Usage: migrate up|down [steps]|status")
+	}
+
+	graphql.InitDB(dbURL)
+
+	switch args[0] {
+	case "up":
+		if err := graphql.MigrateUp(); err != nil {
+			log.Fatalf("Failed to migrate up: %v", err)
+		}
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			var err error
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps <= 0 {
+				log.Fatalf("Steps must be a positive number, not %q", args[1])
+			}
+		}
+
+		if err := graphql.MigrateDown(context.Background(), steps); err != nil {
+			log.Fatalf("Failed to migrate down: %v", err)
+		}
+	case "status":
+		infos, err := graphql.Migrations()
+		if err != nil {
+			log.Fatalf("Failed to get migration status: %v", err)
+		}
+
+		tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "VERSION\tDESCRIPTION\tAPPLIED")
+		for _, info := range infos {
+			applied := "pending"
+			if info.Applied != nil {
+				applied = info.Applied.Format(time.RFC3339)
+			}
+			fmt.Fprintf(tw, "%d\t%s\t%s\n", info.Version, info.Description, applied)
+		}
+		tw.Flush()
+		return
+	default:
+		log.Fatalf("Unknown migrate command %q, expected up, down or status", args[0])
+	}
+
+	log.Printf("Migrated the database")
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/icco/graphql"
+)
+
+// oidcUserInfoPath is where the OpenID Connect userinfo endpoint is served.
+const oidcUserInfoPath = "/oauth/userinfo"
+
+// oidcDiscoveryHandler serves the OpenID Connect discovery document, which
+// tells clients where everything is.
+func oidcDiscoveryHandler(w http.ResponseWriter, r *http.Request) {
+	if !graphql.JWTEnabled() {
+		http.Error(w, http.StatusText(404), 404)
+		return
+	}
+
+	issuer := graphql.JWTIssuer()
+	Renderer.JSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                issuer,
+		"authorization_endpoint":                issuer + "/oauth/authorize",
+		"token_endpoint":                        issuer + "/oauth/token",
+		"userinfo_endpoint":                     issuer + oidcUserInfoPath,
+		"jwks_uri":                              issuer + "/.well-known/jwks.json",
+		"response_types_supported":              []string{"code"},
+		"grant_types_supported":                 []string{"authorization_code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"scopes_supported":                      []string{"openid"},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post"},
+		"claims_supported":                      []string{"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce", "roles"},
+	})
+}
+
+// oidcAuthorizeHandler logs the user in, if needed, and sends them back to the
+// client with an authorization code.
+func oidcAuthorizeHandler(w http.ResponseWriter, r *http.Request) {
+	if !graphql.JWTEnabled() {
+		http.Error(w, http.StatusText(404), 404)
+		return
+	}
+
+	client, err := graphql.GetOIDCClient(r.Context(), r.FormValue("client_id"))
+	if err != nil {
+		log.Printf("oidc authorize: %+v", err)
+		http.Error(w, "unknown client_id", http.StatusBadRequest)
+		return
+	}
+
+	// Never redirect to a URI we don't know about, even with an error.
+	redirectURI := r.FormValue("redirect_uri")
+	if !client.AllowsRedirect(redirectURI) {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+
+	redirect, err := url.Parse(redirectURI)
+	if err != nil {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+	q := redirect.Query()
+	if state := r.FormValue("state"); state != "" {
+		q.Set("state", state)
+	}
+
+	switch {
+	case r.FormValue("response_type") != "code":
+		q.Set("error", "unsupported_response_type")
+	case !hasScope(r.FormValue("scope"), "openid"):
+		q.Set("error", "invalid_scope")
+	default:
+		user := graphql.ForContext(r.Context())
+		if user == nil {
+			http.Redirect(w, r, "/login?redirect="+url.QueryEscape(r.URL.RequestURI()), http.StatusFound)
+			return
+		}
+
+		code, err := client.NewCode(r.Context(), user, redirectURI, r.FormValue("nonce"))
+		if err != nil {
+			appErrorf(w, err, "could not create authorization code: %v", err)
+			return
+		}
+		q.Set("code", code)
+	}
+
+	redirect.RawQuery = q.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+// oidcTokenHandler exchanges an authorization code for an ID token and an
+// access token.
+func oidcTokenHandler(w http.ResponseWriter, r *http.Request) {
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID = r.FormValue("client_id")
+		clientSecret = r.FormValue("client_secret")
+	}
+
+	client, err := graphql.GetOIDCClient(r.Context(), clientID)
+	if err != nil || !client.VerifySecret(clientSecret) {
+		graphql.RecordAuthFailure(clientIP(r))
+		oauthError(w, http.StatusUnauthorized, "invalid_client")
+		return
+	}
+
+	if r.FormValue("grant_type") != "authorization_code" {
+		oauthError(w, http.StatusBadRequest, "unsupported_grant_type")
+		return
+	}
+
+	user, nonce, authTime, err := client.ExchangeCode(r.Context(), r.FormValue("code"), r.FormValue("redirect_uri"))
+	if err != nil {
+		log.Printf("oidc token: %+v", err)
+		oauthError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	}
+
+	idToken, err := user.IDToken(client.ID, nonce, authTime)
+	if err != nil {
+		appErrorf(w, err, "could not mint id token: %v", err)
+		return
+	}
+
+	accessToken, err := user.AccessToken(client.ID)
+	if err != nil {
+		appErrorf(w, err, "could not mint access token: %v", err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	Renderer.JSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   int(graphql.JWTExpiry.Seconds()),
+		"id_token":     idToken,
+	})
+}
+
+// oidcUserInfoHandler returns the claims for the user an access token was
+// issued to. Access tokens aren't API credentials, so ContextMiddleware
+// leaves them for this handler to verify.
+func oidcUserInfoHandler(w http.ResponseWriter, r *http.Request) {
+	user, err := graphql.UserFromAccessToken(r.Context(), strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+	if err != nil {
+		log.Printf("oidc userinfo: %+v", err)
+		graphql.RecordAuthFailure(clientIP(r))
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		http.Error(w, http.StatusText(401), 401)
+		return
+	}
+
+	Renderer.JSON(w, http.StatusOK, map[string]interface{}{
+		"sub":   user.ID,
+		"roles": []string{user.Role},
+	})
+}
+
+func oauthError(w http.ResponseWriter, status int, code string) {
+	Renderer.JSON(w, status, map[string]string{
+		"error": code,
+	})
+}
+
+func hasScope(scopes, scope string) bool {
+	for _, s := range strings.Fields(scopes) {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"runtime/debug"
+
+	"cloud.google.com/go/errorreporting"
+	"github.com/go-chi/chi/middleware"
+	"github.com/icco/graphql"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+)
+
+var (
+	panics = stats.Int64("graphql/panics", "Number of panics recovered while serving requests", stats.UnitDimensionless)
+
+	// errorReporter sends panics to Google Error Reporting, if
+	// ENABLE_ERROR_REPORTING is set.
+	errorReporter *errorreporting.Client
+)
+
+// initPanicReporting registers the panic metric, and connects to Google Error
+// Reporting if ENABLE_ERROR_REPORTING is set. The returned func flushes
+// reports, and should be called before exiting.
+func initPanicReporting(service string) func() {
+	if err := view.Register(&view.View{
+		Name:        panics.Name(),
+		Description: panics.Description(),
+		Measure:     panics,
+		Aggregation: view.Count(),
+	}); err != nil {
+		log.Fatalf("Failed to register the panic view: %v", err)
+	}
+
+	if os.Getenv("ENABLE_ERROR_REPORTING") == "" {
+		return func() {}
+	}
+
+	project := os.Getenv("ERROR_REPORTING_PROJECT")
+	if project == "" {
+		project = "icco-cloud"
+	}
+
+	c, err := errorreporting.NewClient(context.Background(), project, errorreporting.Config{
+		ServiceName: service,
+	})
+	if err != nil {
+		log.Fatalf("Failed to create the Error Reporting client: %v", err)
+	}
+	errorReporter = c
+
+	return func() {
+		if err := c.Close(); err != nil {
+			log.Printf("could not close error reporting: %+v", err)
+		}
+	}
+}
+
+// reportPanic logs a recovered panic with its stack, counts it, and reports
+// it to Error Reporting. r may be nil.
+func reportPanic(ctx context.Context, r *http.Request, p interface{}, stack []byte) {
+	log.Printf("panic in request %s: %v\n%s", middleware.GetReqID(ctx), p, stack)
+	stats.Record(ctx, panics.M(1))
+
+	if errorReporter != nil {
+		e := errorreporting.Entry{Error: fmt.Errorf("%v", p), Req: r, Stack: stack}
+		if u := graphql.ForContext(ctx); u != nil {
+			e.User = u.ID
+		}
+		errorReporter.Report(e)
+	}
+}
+
+// recoverer turns panics into 500s, with the request ID so they can be found
+// in the logs, and reports them.
+func recoverer(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			p := recover()
+			if p == nil {
+				return
+			}
+
+			// Aborted handlers are how net/http cancels responses.
+			if p == http.ErrAbortHandler {
+				panic(p)
+			}
+
+			reportPanic(r.Context(), r, p, debug.Stack())
+			http.Error(w, fmt.Sprintf("Internal server error, request %s", middleware.GetReqID(r.Context())), http.StatusInternalServerError)
+		}()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// recoverResolver is a gqlgen recover func. Panics in resolvers are reported
+// like any other, and become internal errors, which have the request ID.
+func recoverResolver(ctx context.Context, p interface{}) error {
+	reportPanic(ctx, nil, p, debug.Stack())
+	return graphql.Internalf("Panic: %v", p)
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"github.com/icco/graphql"
+)
+
+// restPostFields are the post fields returned by the REST API.
+const restPostFields = "id title summary readtime html tags datetime created modified canonicalURL originallyPublishedAt license { id spdx name url text }"
+
+// restRouter serves a small read only REST API, for clients that can't or
+// won't speak GraphQL. Each endpoint runs the equivalent GraphQL query
+// through gql as a GET, so responses are cached exactly like GraphQL GETs,
+// and then unwraps the result.
+func restRouter(gql http.Handler) http.Handler {
+	r := chi.NewRouter()
+	r.Get("/posts", restPostsHandler(gql))
+	r.Get("/posts/{id}", restPostHandler(gql))
+	r.Get("/tags", restTagsHandler(gql))
+
+	return r
+}
+
+// restPostsHandler returns a page of posts. It takes the same limit and
+// offset as the posts query, and links to the next and previous pages with a
+// Link header.
+func restPostsHandler(gql http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit, offset, ok := restPage(w, r)
+		if !ok {
+			return
+		}
+
+		var posts []json.RawMessage
+		query := fmt.Sprintf("query RestPosts($limit: Int, $offset: Int) { posts(limit: $limit, offset: $offset) { %s } }", restPostFields)
+		if !restQuery(gql, w, r, "RestPosts", query, map[string]interface{}{"limit": limit, "offset": offset}, "posts", &posts) {
+			return
+		}
+
+		links := []string{}
+		if len(posts) == limit {
+			links = append(links, restPageLink(r, limit, offset+limit, "next"))
+		}
+		if offset > 0 {
+			prev := offset - limit
+			if prev < 0 {
+				prev = 0
+			}
+			links = append(links, restPageLink(r, limit, prev, "prev"))
+		}
+		if len(links) > 0 {
+			w.Header().Set("Link", strings.Join(links, ", "))
+		}
+
+		Renderer.JSON(w, http.StatusOK, posts)
+	}
+}
+
+// restPostHandler returns a single post.
+func restPostHandler(gql http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var post *json.RawMessage
+		query := fmt.Sprintf("query RestPost($id: ID!) { post(id: $id) { %s } }", restPostFields)
+		if !restQuery(gql, w, r, "RestPost", query, map[string]interface{}{"id": chi.URLParam(r, "id")}, "post", &post) {
+			return
+		}
+
+		if post == nil {
+			restError(w, http.StatusNotFound, "post not found")
+			return
+		}
+
+		// Cross-posts point search engines at where they were first
+		// published, and licenses are linked for crawlers.
+		var links struct {
+			CanonicalURL *string `json:"canonicalURL"`
+			License      *struct {
+				URL *string `json:"url"`
+			} `json:"license"`
+		}
+		if err := json.Unmarshal(*post, &links); err == nil {
+			if links.CanonicalURL != nil {
+				w.Header().Add("Link", fmt.Sprintf(`<%s>; rel="canonical"`, *links.CanonicalURL))
+			}
+			if links.License != nil && links.License.URL != nil {
+				w.Header().Add("Link", fmt.Sprintf(`<%s>; rel="license"`, *links.License.URL))
+			}
+		}
+
+		Renderer.JSON(w, http.StatusOK, post)
+	}
+}
+
+// restTagsHandler returns the tags on published posts, with how many posts
+// have each, most used first.
+func restTagsHandler(gql http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var tags []json.RawMessage
+		if !restQuery(gql, w, r, "RestTags", "query RestTags { tags { tag count } }", nil, "tags", &tags) {
+			return
+		}
+
+		Renderer.JSON(w, http.StatusOK, tags)
+	}
+}
+
+// restPage returns the limit and offset parameters. The limit defaults to the
+// postsPerPage site setting. It writes an error and returns false if either
+// is invalid.
+func restPage(w http.ResponseWriter, r *http.Request) (int, int, bool) {
+	params := r.URL.Query()
+
+	limit := 0
+	if l := params.Get("limit"); l != "" {
+		n, err := strconv.Atoi(l)
+		if err != nil || n < 1 {
+			restError(w, http.StatusBadRequest, "limit must be a positive integer")
+			return 0, 0, false
+		}
+		limit = n
+	} else {
+		site, err := graphql.GetSiteSettings(r.Context())
+		if err != nil {
+			restError(w, http.StatusInternalServerError, "could not load site settings")
+			return 0, 0, false
+		}
+		limit = site.PostsPerPage
+	}
+
+	offset := 0
+	if o := params.Get("offset"); o != "" {
+		n, err := strconv.Atoi(o)
+		if err != nil || n < 0 {
+			restError(w, http.StatusBadRequest, "offset must be a non-negative integer")
+			return 0, 0, false
+		}
+		offset = n
+	}
+
+	return limit, offset, true
+}
+
+// restPageLink returns a Link header entry for another page of r.
+func restPageLink(r *http.Request, limit, offset int, rel string) string {
+	params := r.URL.Query()
+	params.Set("limit", strconv.Itoa(limit))
+	params.Set("offset", strconv.Itoa(offset))
+
+	return fmt.Sprintf(`<%s?%s>; rel="%s"`, r.URL.Path, params.Encode(), rel)
+}
+
+// restQuery runs the operation in query with variables through gql as a GET,
+// with r's credentials, and unmarshals the field of its data into v. The
+// response's caching headers are copied to w. If the query fails, it writes
+// an error and returns false.
+func restQuery(gql http.Handler, w http.ResponseWriter, r *http.Request, operation, query string, variables map[string]interface{}, field string, v interface{}) bool {
+	params := url.Values{}
+	params.Set("operationName", operation)
+	params.Set("query", query)
+	if variables != nil {
+		vars, err := json.Marshal(variables)
+		if err != nil {
+			restError(w, http.StatusInternalServerError, "could not encode variables")
+			return false
+		}
+		params.Set("variables", string(vars))
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "/graphql?"+params.Encode(), nil)
+	if err != nil {
+		restError(w, http.StatusInternalServerError, "could not build query")
+		return false
+	}
+	// REST responses aren't the GraphQL response, so they can't share its
+	// ETag, and conditional requests would get a 304 with no data.
+	req.Header = r.Header.Clone()
+	req.Header.Del("If-None-Match")
+	req.Header.Del("If-Modified-Since")
+	req.RemoteAddr = r.RemoteAddr
+	req = req.WithContext(r.Context())
+
+	rw := &bufferedResponseWriter{header: http.Header{}, status: http.StatusOK}
+	gql.ServeHTTP(rw, req)
+
+	for _, h := range []string{"Age", "Cache-Control", "Last-Modified", "Vary", "X-Cache"} {
+		if vs, ok := rw.header[h]; ok {
+			w.Header()[h] = vs
+		}
+	}
+
+	var res struct {
+		Data   map[string]json.RawMessage `json:"data"`
+		Errors []struct {
+			Message    string `json:"message"`
+			Extensions struct {
+				Code  graphql.ErrorCode `json:"code"`
+				Field string            `json:"field"`
+			} `json:"extensions"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(rw.body.Bytes(), &res); err != nil {
+		restError(w, http.StatusBadGateway, "could not parse query response")
+		return false
+	}
+
+	if len(res.Errors) > 0 {
+		status := rw.status
+		if code := res.Errors[0].Extensions.Code; code != "" {
+			status = code.HTTPStatus()
+		} else if status == http.StatusOK {
+			status = http.StatusBadRequest
+		}
+		restError(w, status, res.Errors[0].Message)
+		return false
+	}
+
+	if err := json.Unmarshal(res.Data[field], v); err != nil {
+		restError(w, http.StatusBadGateway, "could not parse query response")
+		return false
+	}
+
+	return true
+}
+
+// restError writes an error in the same shape as GraphQL errors.
+func restError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Cache-Control", "private, no-store")
+	Renderer.JSON(w, status, map[string]interface{}{
+		"errors": []map[string]string{{"message": message}},
+	})
+}
@@ -2,18 +2,25 @@ package main
 
 import (
 	"context"
-	"errors"
+	"flag"
 	"html/template"
 	"log"
+	"net"
 	"net/http"
 	"os"
-	"runtime/debug"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
 
 	"contrib.go.opencensus.io/exporter/stackdriver"
+	gqlgen "github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/handler"
 	"github.com/go-chi/chi"
 	"github.com/go-chi/chi/middleware"
 	"github.com/go-chi/cors"
+	"github.com/gorilla/sessions"
 	"github.com/icco/graphql"
 	"go.opencensus.io/exporter/prometheus"
 	"go.opencensus.io/plugin/ochttp"
@@ -41,19 +48,189 @@ var (
 	})
 
 	dbURL = os.Getenv("DATABASE_URL")
+
+	// productionLocked disables all interactive tooling, like the GraphQL
+	// playground.
+	productionLocked = os.Getenv("PRODUCTION_LOCKED") == "true"
+
+	// disableIntrospection limits schema introspection to admins in
+	// production.
+	disableIntrospection = os.Getenv("DISABLE_INTROSPECTION") == "true"
+
+	// streamStallTimeout is how long a streamed response, like an export, waits
+	// on a client that has stopped reading before giving up.
+	streamStallTimeout = envDuration("STREAM_STALL_TIMEOUT", 30*time.Second)
+
+	// countryHeader is the request header a proxy, like a CDN, puts the
+	// client's country in. Logins from new countries are only noticed if it
+	// is set.
+	countryHeader = os.Getenv("COUNTRY_HEADER")
 )
 
 func main() {
-	if dbURL == "" {
+	cmd, args := "serve", []string{}
+	if len(os.Args) > 1 {
+		cmd, args = os.Args[1], os.Args[2:]
+	}
+
+	// Edges don't have a database.
+	if dbURL == "" && cmd != "edge" {
 		log.Panicf("DATABASE_URL is empty!")
 	}
 
+	switch cmd {
+	case "serve":
+		serve(args)
+	case "migrate":
+		migrate(args)
+	case "edge":
+		edge()
+	default:
+		log.Fatalf("Unknown command %q, expected serve, migrate or edge", cmd)
+	}
+}
+
+func serve(args []string) {
+	flags := flag.NewFlagSet("serve", flag.ExitOnError)
+	runMigrations := flags.Bool("migrate", false, "apply pending database migrations before serving")
+	grpcPort := flags.String("grpc-port", "", "also serve the read only gRPC API on this port")
+	debugAddr := flags.String("debug-addr", "", "also serve pprof and runtime diagnostics, without auth, on this address, like localhost:6060")
+	flags.Parse(args)
+
 	graphql.InitDB(dbURL)
+	if replicaURL := os.Getenv("DATABASE_REPLICA_URL"); replicaURL != "" {
+		if err := graphql.InitReplica(replicaURL); err != nil {
+			log.Fatalf("Failed to connect to the read replica: %v", err)
+		}
+	}
+	graphql.ConfigurePool(
+		envInt("DATABASE_MAX_OPEN_CONNS", 0),
+		envInt("DATABASE_MAX_IDLE_CONNS", 0),
+		envDuration("DATABASE_CONN_MAX_LIFETIME", 0))
+
+	if *runMigrations {
+		if err := graphql.MigrateUp(); err != nil {
+			log.Fatalf("Failed to migrate the database: %v", err)
+		}
+	}
+
+	if err := graphql.CheckMigrations(); err != nil {
+		log.Fatalf("Refusing to serve: %v. Run serve --migrate or migrate up.", err)
+	}
+
+	if os.Getenv("SESSION_SECRET") == "" {
+		secret, err := graphql.SessionSecret(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to load session secret: %v", err)
+		}
+		SessionStore = sessions.NewCookieStore([]byte(secret))
+	}
+
+	if exts := os.Getenv("MARKDOWN_EXTENSIONS"); exts != "" {
+		if err := graphql.ConfigureMarkdown(strings.Split(exts, ",")); err != nil {
+			log.Fatalf("Failed to configure markdown: %v", err)
+		}
+	}
+	if err := configureTrustedProxies(os.Getenv("TRUSTED_PROXIES")); err != nil {
+		log.Fatalf("Failed to configure trusted proxies: %v", err)
+	}
+
 	OAuthConfig = configureOAuthClient(
 		os.Getenv("OAUTH2_CLIENTID"),
 		os.Getenv("OAUTH2_SECRET"),
 		os.Getenv("OAUTH2_REDIRECT"))
 
+	if policy := os.Getenv("SIGNUP_POLICY"); policy != "" {
+		if err := graphql.ConfigureSignup(policy, strings.Split(os.Getenv("SIGNUP_DOMAINS"), ",")); err != nil {
+			log.Fatalf("Failed to configure signup policy: %v", err)
+		}
+	}
+
+	// METADATA_KEYS is a comma separated list of name:type pairs, like
+	// talk_date:time,venue:string.
+	if keys := os.Getenv("METADATA_KEYS"); keys != "" {
+		for _, k := range strings.Split(keys, ",") {
+			parts := strings.SplitN(strings.TrimSpace(k), ":", 2)
+			if len(parts) != 2 {
+				log.Fatalf("Failed to register metadata key %q: expected name:type", k)
+			}
+			if err := graphql.RegisterMetadataKey(parts[0], graphql.MetadataType(parts[1]), ""); err != nil {
+				log.Fatalf("Failed to register metadata key: %v", err)
+			}
+		}
+	}
+
+	if policy := os.Getenv("LINK_CHECK"); policy != "" {
+		if err := graphql.ConfigureLinkCheck(policy); err != nil {
+			log.Fatalf("Failed to configure link checking: %v", err)
+		}
+	}
+
+	// The SQL console is off unless it is asked for, and then needs a role
+	// that can only read.
+	if os.Getenv("SQL_CONSOLE") == "true" {
+		if err := graphql.ConfigureSQLConsole(os.Getenv("SQL_CONSOLE_ROLE"), envDuration("SQL_CONSOLE_TIMEOUT", 5*time.Second)); err != nil {
+			log.Fatalf("Failed to configure the SQL console: %v", err)
+		}
+	}
+
+	if key := os.Getenv("JWT_PRIVATE_KEY"); key != "" {
+		issuer := os.Getenv("JWT_ISSUER")
+		if issuer == "" {
+			issuer = "https://graphql.natwelch.com"
+		}
+
+		if err := graphql.ConfigureJWT([]byte(key), issuer); err != nil {
+			log.Fatalf("Failed to configure JWT signing: %v", err)
+		}
+	}
+
+	if key := os.Getenv("ACTIVITYPUB_PRIVATE_KEY"); key != "" {
+		baseURL := os.Getenv("ACTIVITYPUB_URL")
+		if baseURL == "" {
+			baseURL = "https://graphql.natwelch.com"
+		}
+		username := os.Getenv("ACTIVITYPUB_USERNAME")
+		if username == "" {
+			username = "blog"
+		}
+
+		if err := graphql.ConfigureActivityPub([]byte(key), baseURL, username); err != nil {
+			log.Fatalf("Failed to configure ActivityPub: %v", err)
+		}
+	}
+
+	persisted := persistedQueries{}
+	if path := os.Getenv("GRAPHQL_PERSISTED_QUERIES"); path != "" {
+		pq, err := loadPersistedQueries(path)
+		if err != nil {
+			log.Fatalf("Failed to load persisted queries: %v", err)
+		}
+		persisted = pq
+	}
+
+	cachePolicies := map[string]cachePolicy{}
+	if path := os.Getenv("GRAPHQL_CACHE_POLICIES"); path != "" {
+		policies, err := loadCachePolicies(path)
+		if err != nil {
+			log.Fatalf("Failed to load cache policies: %v", err)
+		}
+		cachePolicies = policies
+	}
+
+	cache, err := newResponseCache(
+		envInt("GRAPHQL_CACHE_SIZE", 1024),
+		cachePolicy{TTL: envInt("GRAPHQL_GET_MAX_AGE", 60), Stale: envInt("GRAPHQL_GET_STALE", 300)},
+		cachePolicies)
+	if err != nil {
+		log.Fatalf("Failed to create response cache: %v", err)
+	}
+
+	schema := graphql.NewExecutableSchema(graphql.New())
+	if err := graphql.CheckDeprecations(schema.Schema(), time.Now()); err != nil {
+		log.Fatalf("Schema deprecation check failed: %v", err)
+	}
+
 	port := "8080"
 	if fromEnv := os.Getenv("PORT"); fromEnv != "" {
 		port = fromEnv
@@ -68,6 +245,54 @@ func main() {
 	}
 	view.RegisterExporter(pe)
 
+	if err := graphql.RecordDBStats(10 * time.Second); err != nil {
+		log.Fatalf("Failed to record database stats: %v", err)
+	}
+
+	flushPanics := initPanicReporting("graphql")
+	defer flushPanics()
+
+	graphql.StartWebhookWorker(context.Background(), envDuration("WEBHOOK_INTERVAL", 10*time.Second))
+	if graphql.ActivityPubEnabled() {
+		graphql.StartActivityPubWorker(context.Background(), envDuration("ACTIVITYPUB_INTERVAL", 10*time.Second))
+	}
+
+	switch {
+	case os.Getenv("CLOUDFLARE_TOKEN") != "":
+		graphql.ConfigurePurger(&graphql.Cloudflare{ZoneID: os.Getenv("CLOUDFLARE_ZONE_ID"), Token: os.Getenv("CLOUDFLARE_TOKEN")})
+	case os.Getenv("FASTLY_TOKEN") != "":
+		graphql.ConfigurePurger(&graphql.Fastly{Token: os.Getenv("FASTLY_TOKEN")})
+	}
+
+	switch {
+	case os.Getenv("SENDGRID_TOKEN") != "":
+		graphql.ConfigureMailer(&graphql.SendGrid{Token: os.Getenv("SENDGRID_TOKEN"), From: os.Getenv("EMAIL_FROM")})
+	case os.Getenv("SMTP_ADDR") != "":
+		graphql.ConfigureMailer(&graphql.SMTP{
+			Addr:     os.Getenv("SMTP_ADDR"),
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     os.Getenv("EMAIL_FROM"),
+		})
+	}
+	if err := graphql.StartCron(context.Background()); err != nil {
+		log.Fatalf("Failed to start cron: %+v", err)
+	}
+	graphql.StartNotificationWorker(context.Background(), envDuration("NOTIFICATION_INTERVAL", 5*time.Minute))
+	graphql.StartJobWorkers(context.Background(), envInt("JOB_WORKERS", 4), envDuration("JOB_INTERVAL", 10*time.Second))
+	graphql.StartAnalyticsWorker(context.Background(), envDuration("ANALYTICS_INTERVAL", time.Hour))
+	graphql.StartLinkWorker(context.Background(), envDuration("LINK_CHECK_INTERVAL", time.Hour))
+
+	syndicators := []graphql.Syndicator{}
+	if token := os.Getenv("MASTODON_TOKEN"); token != "" {
+		syndicators = append(syndicators, &graphql.Mastodon{Instance: os.Getenv("MASTODON_URL"), Token: token})
+	}
+	if token := os.Getenv("TWITTER_TOKEN"); token != "" {
+		syndicators = append(syndicators, &graphql.Twitter{Token: token})
+	}
+	graphql.ConfigureSyndication(syndicators...)
+	graphql.StartSyndicationWorker(context.Background(), envDuration("SYNDICATION_INTERVAL", time.Minute))
+
 	if os.Getenv("ENABLE_STACKDRIVER") != "" {
 		sd, err := stackdriver.NewExporter(stackdriver.Options{
 			ProjectID:    "icco-cloud",
@@ -87,22 +312,29 @@ func main() {
 	r := chi.NewRouter()
 
 	r.Use(middleware.RequestID)
-	r.Use(middleware.RealIP)
+	r.Use(requestIDHeader)
 	r.Use(middleware.Logger)
-	r.Use(middleware.Recoverer)
-	r.Use(ContextMiddleware)
+	r.Use(recoverer)
+	r.Use(compressHandler(envInt("COMPRESSION_MIN_SIZE", 1024)))
+	r.Use(labelRequests)
+	r.Use(middleware.GetHead)
 
+	// CORS runs before auth, so preflights and auth failures still get CORS
+	// headers. Preflights are answered here and never reach the handlers.
 	r.Use(cors.New(cors.Options{
 		AllowCredentials:   true,
-		OptionsPassthrough: true,
-		AllowedOrigins:     []string{"*"},
+		OptionsPassthrough: false,
+		AllowedOrigins:     corsOrigins(isDev),
 		AllowedMethods:     []string{"GET", "POST", "OPTIONS"},
-		AllowedHeaders:     []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:     []string{"Link"},
+		AllowedHeaders:     []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-Timezone"},
+		ExposedHeaders:     []string{"Link", "X-Cost", "X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset", "X-Request-Id"},
 		MaxAge:             300, // Maximum value not ignored by any of major browsers
 	}).Handler)
 
-	r.NotFound(notFoundHandler)
+	r.Use(SiteMiddleware)
+	r.Use(ContextMiddleware)
+
+	r.NotFound(redirectHandler)
 
 	// Stuff that does not ssl redirect
 	r.Group(func(r chi.Router) {
@@ -116,11 +348,32 @@ func main() {
 		}).Handler)
 
 		r.Get("/healthz", healthCheckHandler)
+		r.Get("/readyz", readyHandler)
+		r.Get("/beacon", beaconHandler)
+		r.Post("/beacon", beaconHandler)
+		r.Head("/beacon", beaconHeadHandler)
 		r.Handle("/metrics", pe)
+
+		r.Get("/schema", schemaHandler(schema, isDev || !disableIntrospection))
+		r.Get("/schema/changelog", schemaChangelogHandler(schema))
+		r.Get("/snapshot", snapshotHandler(os.Getenv("SNAPSHOT_TOKEN")))
+
+		r.Get("/.well-known/jwks.json", jwksHandler)
+		r.Get("/.well-known/openid-configuration", oidcDiscoveryHandler)
+		r.Get("/.well-known/webfinger", webfingerHandler)
 	})
 
+	// Health checks and metrics above are never turned away, so a saturated
+	// server isn't restarted for it.
+	admit := newAdmission(
+		envInt("MAX_IN_FLIGHT", 512),
+		envInt("MAX_IN_FLIGHT_QUERIES", 256),
+		envInt("MAX_IN_FLIGHT_MUTATIONS", 64),
+		envDuration("SHED_RETRY_AFTER", time.Second))
+
 	// Everything that does SSL only
 	r.Group(func(r chi.Router) {
+		r.Use(admit.limitAll)
 		r.Use(secure.New(secure.Options{
 			BrowserXssFilter:     true,
 			ContentTypeNosniff:   true,
@@ -136,22 +389,87 @@ func main() {
 
 		r.Mount("/admin", adminRouter())
 
-		r.Handle("/", handler.Playground("graphql", "/graphql"))
-		r.Handle("/graphql", handler.GraphQL(
-			graphql.NewExecutableSchema(graphql.New()),
-			handler.RecoverFunc(func(ctx context.Context, err interface{}) error {
-				log.Print(err)
-				debug.PrintStack()
-				return errors.New("Panic message seen when processing request")
-			}),
-		))
+		// First-run setup
+		r.With(setupOnly).Get("/setup", setupHandler)
+		r.With(setupOnly).Post("/setup", setupSaveHandler)
+
+		if !productionLocked {
+			r.Get("/", http.RedirectHandler("/play", http.StatusFound).ServeHTTP)
+			r.With(AdminOnly).Get("/play", handler.Playground("graphql admin", "/graphql"))
+		}
+		gqlHandler := handler.GraphQL(
+			schema,
+			handler.RecoverFunc(recoverResolver),
+			handler.ErrorPresenter(graphql.ErrorPresenter),
+			handler.ResolverMiddleware(graphql.IntrospectionMiddleware(isDev || !disableIntrospection)),
+			handler.ResolverMiddleware(graphql.TimeoutMiddleware(envDuration("QUERY_TIMEOUT", 10*time.Second))),
+			handler.ResolverMiddleware(graphql.ConstraintMiddleware),
+			handler.ResolverMiddleware(graphql.LastModifiedMiddleware),
+			handler.RequestMiddleware(graphql.ReplicaMiddleware(envDuration("DATABASE_REPLICA_PIN", 5*time.Second))),
+			handler.RequestMiddleware(graphql.CostMiddleware(schema, envInt("COST_BUDGET", 10000))),
+		)
+		graphqlRoutes(r.With(limitGraphQLBody(maxRequestSize)), "/graphql", batchHandler(
+			errorCodeHandler(getHandler(admit.limitOperations(responseHeaderHandler(gqlHandler)), persisted, os.Getenv("GRAPHQL_GET_PERSISTED_ONLY") == "true", cache)),
+			envInt("GRAPHQL_BATCH_MAX_SIZE", 20),
+			envInt("GRAPHQL_BATCH_CONCURRENCY", 4)))
+
+		// The public API is a restricted schema for third parties. Requests
+		// run anonymously, with a smaller budget charged per address.
+		public := graphql.PublicSchema(schema)
+		publicHandler := handler.GraphQL(
+			public,
+			handler.RecoverFunc(recoverResolver),
+			handler.ErrorPresenter(graphql.ErrorPresenter),
+			handler.ResolverMiddleware(graphql.PublicIntrospectionMiddleware(public)),
+			handler.ResolverMiddleware(graphql.TimeoutMiddleware(envDuration("QUERY_TIMEOUT", 10*time.Second))),
+			handler.RequestMiddleware(graphql.PublicCostMiddleware(public, envInt("PUBLIC_COST_BUDGET", 1000))),
+		)
+		graphqlRoutes(r.With(limitGraphQLBody(maxRequestSize)), "/public/graphql", anonymousHandler(errorCodeHandler(responseHeaderHandler(publicHandler))))
+
+		// REST for clients that don't speak GraphQL. Its queries are fixed, so
+		// they are allowed even if GET is limited to persisted queries.
+		r.Mount("/api/v1", restRouter(errorCodeHandler(getHandler(admit.limitOperations(responseHeaderHandler(gqlHandler)), persisted, false, cache))))
+
+		r.Get("/s/{slug}", shortLinkHandler)
+		r.Head("/s/{slug}", shortLinkHeadHandler)
+
+		// IndieWeb publishing
+		indieAuthEndpoint := os.Getenv("INDIEAUTH_TOKEN_ENDPOINT")
+		if indieAuthEndpoint == "" {
+			indieAuthEndpoint = graphql.DefaultIndieAuthTokenEndpoint
+		}
+		r.With(limitBody(maxRequestSize)).Get(micropubPath, micropubHandler(indieAuthEndpoint))
+		r.With(limitBody(maxRequestSize)).Post(micropubPath, micropubHandler(indieAuthEndpoint))
+		r.Mount("/activity", activityRouter())
 
 		// Auth stuff
 		r.HandleFunc("/login", loginHandler)
 		r.HandleFunc("/logout", logoutHandler)
-		r.HandleFunc("/callback", callbackHandler)
+		r.With(authBackoff).HandleFunc("/callback", callbackHandler)
+		r.Get("/link", linkHandler)
+		r.HandleFunc("/link/merge", mergeHandler)
+
+		// Device login for the CLI
+		r.Post("/device/code", deviceCodeHandler)
+		r.With(authBackoff).Post("/device/token", deviceTokenHandler)
+		r.Get("/device", deviceHandler)
+		r.Post("/device", deviceApproveHandler)
+
+		// Short lived tokens for other services
+		r.Get("/jwt", jwtHandler)
+
+		// Logs, for mapping
+		r.Get("/logs.geojson", logsGeoJSONHandler)
+
+		// OpenID Connect provider
+		r.Get("/oauth/authorize", oidcAuthorizeHandler)
+		r.With(authBackoff).Post("/oauth/token", oidcTokenHandler)
+		r.HandleFunc(oidcUserInfoPath, oidcUserInfoHandler)
 	})
 
+	// Set after everything is routed, so routers mounted in groups get it too.
+	r.MethodNotAllowed(methodNotAllowedHandler)
+
 	h := &ochttp.Handler{
 		Handler:          r,
 		IsPublicEndpoint: true,
@@ -160,15 +478,218 @@ func main() {
 		log.Fatal("Failed to register ochttp.DefaultServerViews")
 	}
 
+	if os.Getenv("WARMUP") == "true" {
+		ctx, cancel := context.WithTimeout(context.Background(), envDuration("WARMUP_TIMEOUT", time.Minute))
+		graphql.GoTask("warmup", func() {
+			defer cancel()
+			warmUp(ctx, r, persisted, envInt("WARMUP_POSTS", 20), envInt("WARMUP_CONNECTIONS", envInt("DATABASE_MAX_IDLE_CONNS", 2)))
+		})
+	} else {
+		atomic.StoreInt32(&ready, 1)
+	}
+
+	if *grpcPort != "" {
+		lis, err := net.Listen("tcp", ":"+*grpcPort)
+		if err != nil {
+			log.Fatalf("Failed to listen for gRPC: %+v", err)
+		}
+		graphql.GoTask("grpc", func() {
+			log.Fatal(newGRPCServer().Serve(lis))
+		})
+		log.Printf("Starting gRPC on localhost:%s", *grpcPort)
+	}
+
+	if *debugAddr != "" {
+		debug := chi.NewRouter()
+		debug.Mount("/debug", debugRouter())
+		graphql.GoTask("debug", func() {
+			log.Fatal(http.ListenAndServe(*debugAddr, debug))
+		})
+		log.Printf("Starting diagnostics on http://%s/debug/pprof/", *debugAddr)
+	}
+
 	log.Fatal(http.ListenAndServe(":"+port, h))
 }
 
+// envInt returns the positive integer in the environment variable name, or def
+// if it is unset or invalid.
+func envInt(name string, def int) int {
+	i, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || i <= 0 {
+		return def
+	}
+
+	return i
+}
+
+// envDuration returns the positive duration, like 30s, in the environment
+// variable name, or def if it is unset or invalid.
+func envDuration(name string, def time.Duration) time.Duration {
+	d, err := time.ParseDuration(os.Getenv(name))
+	if err != nil || d <= 0 {
+		return def
+	}
+
+	return d
+}
+
+// corsOrigins returns the origins allowed to make cross origin requests, from
+// the comma separated CORS_ORIGINS. Origins can contain one wildcard, like
+// https://*.natwelch.com. If unset, development allows everything and
+// production allows only natwelch.com.
+func corsOrigins(isDev bool) []string {
+	if fromEnv := os.Getenv("CORS_ORIGINS"); fromEnv != "" {
+		origins := []string{}
+		for _, o := range strings.Split(fromEnv, ",") {
+			if o = strings.TrimSpace(o); o != "" {
+				origins = append(origins, o)
+			}
+		}
+		return origins
+	}
+
+	if isDev {
+		return []string{"*"}
+	}
+
+	return []string{"https://natwelch.com", "https://*.natwelch.com"}
+}
+
+// labelRequests labels each request's goroutine, and any it starts, with the
+// first segment of the request's path, like http=/graphql, for the goroutines
+// query.
+func labelRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		segment := "/" + strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)[0]
+		pprof.Do(r.Context(), pprof.Labels("http", segment), func(ctx context.Context) {
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	})
+}
+
+// requestIDHeader returns the request's ID in X-Request-Id, so clients can
+// find the jobs, webhook deliveries and emails their requests caused.
+func requestIDHeader(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", middleware.GetReqID(r.Context()))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// SiteMiddleware stores the site the request is for, by its host, in the
+// current context. Frontends that call the API from their servers should set
+// X-Forwarded-Host to the host they are serving.
+func SiteMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Header.Get("X-Forwarded-Host")
+		if host == "" {
+			host = r.Host
+		}
+
+		site, err := graphql.SiteForHost(r.Context(), host)
+		if err != nil {
+			appErrorf(w, err, "could not look up site: %v", err)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), graphql.SiteCtxKey, site)))
+	})
+}
+
+// responseHeaderHandler lets GraphQL middleware set response headers, like
+// the cost budget headers.
+func responseHeaderHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(graphql.WithResponseHeader(r.Context(), w.Header())))
+	})
+}
+
+// anonymousHandler serves requests as if nobody were logged in, whatever
+// cookies or tokens they came with.
+func anonymousHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), graphql.UserCtxKey, (*graphql.User)(nil))
+		ctx = context.WithValue(ctx, graphql.ServiceAccountCtxKey, (*graphql.ServiceAccount)(nil))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 func healthCheckHandler(w http.ResponseWriter, r *http.Request) {
 	Renderer.JSON(w, http.StatusOK, map[string]string{
 		"healthy": "true",
 	})
 }
 
+// redirectHandler serves any redirect for the path, and 404s otherwise.
+func redirectHandler(w http.ResponseWriter, r *http.Request) {
+	redirect, err := graphql.FollowRedirect(r.Context(), r.URL.Path)
+	if err == graphql.ErrRedirectNotFound {
+		notFoundHandler(w, r)
+		return
+	}
+	if err != nil {
+		appErrorf(w, err, "could not look up redirect: %v", err)
+		return
+	}
+
+	http.Redirect(w, r, redirect.To, redirect.Status)
+}
+
+// shortLinkHandler redirects /s/{slug} to the short link's URL, counting the
+// click.
+func shortLinkHandler(w http.ResponseWriter, r *http.Request) {
+	link, err := graphql.FollowShortLink(r.Context(), chi.URLParam(r, "slug"))
+	if err == graphql.ErrShortLinkNotFound {
+		notFoundHandler(w, r)
+		return
+	}
+	if err != nil {
+		appErrorf(w, err, "could not look up short link: %v", err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	http.Redirect(w, r, link.URL, http.StatusFound)
+}
+
+// shortLinkHeadHandler answers HEAD /s/{slug} like shortLinkHandler, without
+// counting a click.
+func shortLinkHeadHandler(w http.ResponseWriter, r *http.Request) {
+	link, err := graphql.GetShortLink(r.Context(), chi.URLParam(r, "slug"))
+	if err != nil {
+		if graphql.ClassifyError(err, graphql.CodeInternal).Code == graphql.CodeNotFound {
+			notFoundHandler(w, r)
+			return
+		}
+		appErrorf(w, err, "could not look up short link: %v", err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	http.Redirect(w, r, link.URL, http.StatusFound)
+}
+
 func notFoundHandler(w http.ResponseWriter, r *http.Request) {
 	Renderer.HTML(w, http.StatusNotFound, "404", struct{ Title string }{Title: "404: This page could not be found"})
 }
+
+func schemaHandler(schema gqlgen.ExecutableSchema, allowAll bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !allowAll && !graphql.HasRole(r.Context(), graphql.RoleAdmin) {
+			http.Error(w, http.StatusText(403), 403)
+			return
+		}
+
+		w.Header().Set("X-Schema-Version", graphql.SchemaVersion(schema.Schema()))
+		Renderer.Text(w, http.StatusOK, graphql.SchemaSDL(schema.Schema()))
+	}
+}
+
+func schemaChangelogHandler(schema gqlgen.ExecutableSchema) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		Renderer.JSON(w, http.StatusOK, map[string]interface{}{
+			"version":      graphql.SchemaVersion(schema.Schema()),
+			"deprecations": graphql.Deprecations,
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/icco/graphql"
+)
+
+type setupPageData struct {
+	Title     string
+	SiteTitle string
+	SiteURL   string
+	Message   string
+}
+
+// setupOnly 404s once setup is complete, and sends anonymous users to log in
+// first, since the person running setup becomes the first admin.
+func setupOnly(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		done, err := graphql.SetupComplete(r.Context())
+		if err != nil {
+			appErrorf(w, err, "could not check setup: %v", err)
+			return
+		}
+
+		if done {
+			notFoundHandler(w, r)
+			return
+		}
+
+		if graphql.ForContext(r.Context()) == nil {
+			http.Redirect(w, r, "/login?redirect=/setup", http.StatusFound)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// setupHandler shows the first-run setup form.
+func setupHandler(w http.ResponseWriter, r *http.Request) {
+	Renderer.HTML(w, http.StatusOK, "setup", &setupPageData{
+		Title:   "Setup",
+		SiteURL: baseURL(r),
+	})
+}
+
+// setupSaveHandler completes setup, making the logged in user an admin.
+func setupSaveHandler(w http.ResponseWriter, r *http.Request) {
+	s := &graphql.Setup{
+		SiteTitle: r.FormValue("title"),
+		SiteURL:   r.FormValue("url"),
+	}
+
+	err := graphql.CompleteSetup(r.Context(), graphql.ForContext(r.Context()), s)
+	switch err {
+	case nil:
+		http.Redirect(w, r, "/admin/", http.StatusFound)
+	case graphql.ErrSetupComplete:
+		notFoundHandler(w, r)
+	default:
+		Renderer.HTML(w, http.StatusBadRequest, "setup", &setupPageData{
+			Title:     "Setup",
+			SiteTitle: s.SiteTitle,
+			SiteURL:   s.SiteURL,
+			Message:   err.Error(),
+		})
+	}
+}
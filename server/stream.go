@@ -0,0 +1,138 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// streamBuffers is how many chunks of a streamed response can be waiting
+	// to be written to the client.
+	streamBuffers = 8
+
+	// streamChunkSize is the most a streamed response writes to the client
+	// at once.
+	streamChunkSize = 32 * 1024
+)
+
+// errSlowClient is returned when a client stops reading a streamed response.
+var errSlowClient = errors.New("client stopped reading the response")
+
+// streamWriter writes large responses, like exports, to a client that may
+// read them slowly. Writes are copied into at most streamBuffers chunks,
+// which are written to the client in the background. If the client falls so
+// far behind that a chunk can't be queued within timeout, writes fail with
+// errSlowClient, so the handler stops and frees whatever it was writing from,
+// and a slow client pins at most the queued chunks in memory.
+type streamWriter struct {
+	w       io.Writer
+	timeout time.Duration
+	chunks  chan []byte
+	done    chan struct{}
+
+	mu  sync.Mutex
+	err error
+}
+
+// newStreamWriter starts writing to w in the background. Close must be called
+// before the handler returns.
+func newStreamWriter(w io.Writer, timeout time.Duration) *streamWriter {
+	s := &streamWriter{
+		w:       w,
+		timeout: timeout,
+		chunks:  make(chan []byte, streamBuffers),
+		done:    make(chan struct{}),
+	}
+	go s.run()
+
+	return s
+}
+
+func (s *streamWriter) run() {
+	defer close(s.done)
+
+	flusher, _ := s.w.(http.Flusher)
+	for c := range s.chunks {
+		if s.error() != nil {
+			continue
+		}
+
+		if _, err := s.w.Write(c); err != nil {
+			s.fail(err)
+			continue
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
+func (s *streamWriter) error() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+func (s *streamWriter) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// Write queues p to be written to the client.
+func (s *streamWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		if err := s.error(); err != nil {
+			return n, err
+		}
+
+		size := len(p)
+		if size > streamChunkSize {
+			size = streamChunkSize
+		}
+		chunk := make([]byte, size)
+		copy(chunk, p)
+
+		t := time.NewTimer(s.timeout)
+		select {
+		case s.chunks <- chunk:
+			t.Stop()
+		case <-t.C:
+			s.fail(errSlowClient)
+			return n, errSlowClient
+		}
+
+		n += size
+		p = p[size:]
+	}
+
+	return n, nil
+}
+
+// Close waits for the queued chunks to be written, and returns the first
+// error writing to the client. After errSlowClient, it waits for the write in
+// progress to fail, which happens when the connection times out.
+func (s *streamWriter) Close() error {
+	close(s.chunks)
+	<-s.done
+
+	return s.error()
+}
+
+// stream runs write with a streamWriter for w, so a slow client can't pin a
+// large response in memory.
+func stream(w http.ResponseWriter, write func(io.Writer) error) error {
+	s := newStreamWriter(w, streamStallTimeout)
+	err := write(s)
+	if cerr := s.Close(); err == nil {
+		err = cerr
+	}
+
+	return err
+}
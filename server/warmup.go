@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"github.com/icco/graphql"
+)
+
+// ready is set to 1 once the server has warmed up, see readyHandler.
+var ready int32
+
+// readyHandler reports whether the server has finished warming up, so load
+// balancers can hold off sending traffic to a new instance until it has.
+func readyHandler(w http.ResponseWriter, r *http.Request) {
+	if atomic.LoadInt32(&ready) == 0 {
+		Renderer.JSON(w, http.StatusServiceUnavailable, map[string]string{
+			"ready": "false",
+		})
+		return
+	}
+
+	Renderer.JSON(w, http.StatusOK, map[string]string{
+		"ready": "true",
+	})
+}
+
+// warmUp opens conns database connections, renders the HTML of the posts most
+// popular posts, and runs every persisted query through h as an anonymous GET,
+// which fills gqlgen's query cache and the response cache. Persisted queries
+// that need variables are parsed, but fail, so their responses aren't cached.
+// The server is marked ready when it is done, even if some of it failed.
+func warmUp(ctx context.Context, h http.Handler, pq persistedQueries, posts, conns int) {
+	defer atomic.StoreInt32(&ready, 1)
+	start := time.Now()
+
+	if err := graphql.WarmConnections(ctx, conns); err != nil {
+		log.Printf("could not warm database connections: %+v", err)
+	}
+
+	rendered, err := graphql.WarmPosts(ctx, posts)
+	if err != nil {
+		log.Printf("could not warm posts: %+v", err)
+	}
+
+	cached := 0
+	for id := range pq {
+		req, err := http.NewRequest(http.MethodGet, "/graphql?id="+url.QueryEscape(id), nil)
+		if err != nil {
+			log.Printf("could not warm persisted query %s: %+v", id, err)
+			continue
+		}
+		req.Header.Set("X-Forwarded-Proto", "https")
+
+		rw := &bufferedResponseWriter{header: http.Header{}, status: http.StatusOK}
+		h.ServeHTTP(rw, req.WithContext(ctx))
+		if rw.header.Get("X-Cache") == "MISS" {
+			cached++
+		}
+	}
+
+	log.Printf("Warmed up in %s: %d connections, %d posts, %d of %d persisted queries", time.Since(start), conns, rendered, cached, len(pq))
+}
@@ -0,0 +1,240 @@
+package graphql
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// MaxServiceAccountSecrets is how many secrets a service account can have at
+// once. Two lets a new secret be rolled out before the old one is revoked.
+const MaxServiceAccountSecrets = 2
+
+// ServiceAccount is a non-user principal, used by other services to talk to
+// this one.
+type ServiceAccount struct {
+	ID       string     `json:"id"`
+	Name     string     `json:"name"`
+	Scopes   []string   `json:"scopes"`
+	Expires  *time.Time `json:"expires"`
+	LastUsed *time.Time `json:"last_used"`
+	Created  time.Time  `json:"created"`
+	Modified time.Time  `json:"modified"`
+}
+
+// ServiceAccountSecret is a credential for a service account. Only a hash of
+// the secret is stored.
+type ServiceAccountSecret struct {
+	ID       string     `json:"id"`
+	LastUsed *time.Time `json:"last_used"`
+	Created  time.Time  `json:"created"`
+}
+
+// HasScope returns true if the service account has been granted scope.
+func (s *ServiceAccount) HasScope(scope string) bool {
+	for _, sc := range s.Scopes {
+		if sc == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func nullTimePtr(nt pq.NullTime) *time.Time {
+	if !nt.Valid {
+		return nil
+	}
+
+	return &nt.Time
+}
+
+// CreateServiceAccount stores a new service account and returns it with its
+// first secret.
+func CreateServiceAccount(ctx context.Context, name string, scopes []string, expires *time.Time) (*ServiceAccount, string, error) {
+	s := &ServiceAccount{
+		Name:     name,
+		Scopes:   scopes,
+		Expires:  expires,
+		Created:  time.Now(),
+		Modified: time.Now(),
+	}
+
+	row := db.QueryRowContext(ctx, "INSERT INTO service_accounts (name, scopes, expires_at, created_at, modified_at) VALUES ($1, $2, $3, $4, $5) RETURNING id", s.Name, pq.Array(s.Scopes), s.Expires, s.Created, s.Modified)
+	if err := row.Scan(&s.ID); err != nil {
+		return nil, "", err
+	}
+
+	secret, err := s.RotateSecret(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return s, secret, nil
+}
+
+// RotateSecret adds a new secret to the service account, revoking the oldest
+// secrets so no more than MaxServiceAccountSecrets remain.
+func (s *ServiceAccount) RotateSecret(ctx context.Context) (string, error) {
+	secret, err := randomHex(32)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := db.ExecContext(ctx, "INSERT INTO service_account_secrets (service_account_id, secret_hash, created_at) VALUES ($1, $2, $3)", s.ID, hashSecret(secret), time.Now()); err != nil {
+		return "", err
+	}
+
+	if _, err := db.ExecContext(ctx, `
+DELETE FROM service_account_secrets
+WHERE service_account_id = $1
+AND id NOT IN (SELECT id FROM service_account_secrets WHERE service_account_id = $1 ORDER BY created_at DESC LIMIT $2);
+`, s.ID, MaxServiceAccountSecrets); err != nil {
+		return "", err
+	}
+
+	return secret, nil
+}
+
+// Secrets returns the active secrets of a service account, newest first.
+func (s *ServiceAccount) Secrets(ctx context.Context) ([]*ServiceAccountSecret, error) {
+	rows, err := db.QueryContext(ctx, "SELECT id, last_used_at, created_at FROM service_account_secrets WHERE service_account_id = $1 ORDER BY created_at DESC", s.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	secrets := make([]*ServiceAccountSecret, 0)
+	for rows.Next() {
+		secret := new(ServiceAccountSecret)
+		var lastUsed pq.NullTime
+		err := rows.Scan(&secret.ID, &lastUsed, &secret.Created)
+		if err != nil {
+			return nil, err
+		}
+		secret.LastUsed = nullTimePtr(lastUsed)
+		secrets = append(secrets, secret)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return secrets, nil
+}
+
+// RevokeServiceAccountSecret deletes a single secret.
+func RevokeServiceAccountSecret(ctx context.Context, id string) error {
+	_, err := db.ExecContext(ctx, "DELETE FROM service_account_secrets WHERE id = $1", id)
+	return err
+}
+
+// Delete removes a service account and all of its secrets.
+func (s *ServiceAccount) Delete(ctx context.Context) error {
+	if _, err := db.ExecContext(ctx, "DELETE FROM service_account_secrets WHERE service_account_id = $1", s.ID); err != nil {
+		return err
+	}
+
+	_, err := db.ExecContext(ctx, "DELETE FROM service_accounts WHERE id = $1", s.ID)
+	return err
+}
+
+const serviceAccountColumns = `
+service_accounts.id,
+service_accounts.name,
+service_accounts.scopes,
+service_accounts.expires_at,
+(SELECT MAX(last_used_at) FROM service_account_secrets WHERE service_account_id = service_accounts.id),
+service_accounts.created_at,
+service_accounts.modified_at`
+
+func scanServiceAccount(row interface {
+	Scan(dest ...interface{}) error
+}) (*ServiceAccount, error) {
+	s := new(ServiceAccount)
+	var expires, lastUsed pq.NullTime
+	if err := row.Scan(&s.ID, &s.Name, pq.Array(&s.Scopes), &expires, &lastUsed, &s.Created, &s.Modified); err != nil {
+		return nil, err
+	}
+	s.Expires = nullTimePtr(expires)
+	s.LastUsed = nullTimePtr(lastUsed)
+
+	return s, nil
+}
+
+// GetServiceAccount gets a service account by ID from the database.
+func GetServiceAccount(ctx context.Context, id string) (*ServiceAccount, error) {
+	row := db.QueryRowContext(ctx, "SELECT "+serviceAccountColumns+" FROM service_accounts WHERE id = $1", id)
+	s, err := scanServiceAccount(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, NotFound("No service account with id %s", id)
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return s, nil
+	}
+}
+
+// ServiceAccounts returns all service accounts, ordered by name.
+func ServiceAccounts(ctx context.Context) ([]*ServiceAccount, error) {
+	rows, err := db.QueryContext(ctx, "SELECT "+serviceAccountColumns+" FROM service_accounts ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	accounts := make([]*ServiceAccount, 0)
+	for rows.Next() {
+		s, err := scanServiceAccount(rows)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// GetServiceAccountBySecret returns the unexpired service account a secret
+// belongs to, and records that the secret was used.
+func GetServiceAccountBySecret(ctx context.Context, secret string) (*ServiceAccount, error) {
+	row := db.QueryRowContext(ctx, `
+UPDATE service_account_secrets
+SET last_used_at = $2
+WHERE secret_hash = $1
+RETURNING service_account_id;
+`, hashSecret(secret), time.Now())
+
+	var id string
+	err := row.Scan(&id)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, fmt.Errorf("Invalid secret")
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	}
+
+	s, err := GetServiceAccount(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Expires != nil && time.Now().After(*s.Expires) {
+		return nil, fmt.Errorf("Service account %s expired at %s", s.Name, s.Expires)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,150 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+const (
+	// SessionTTL is how long a session lasts without being used. It matches
+	// the session cookie's lifetime.
+	SessionTTL = 30 * 24 * time.Hour
+
+	// sessionTouchInterval is how often a session's last seen time is
+	// updated, so every request isn't a write.
+	sessionTouchInterval = time.Minute
+)
+
+// ErrSessionRevoked is returned for sessions that have been revoked, logged
+// out or have expired.
+var ErrSessionRevoked = fmt.Errorf("Session is revoked")
+
+// Session is a browser logged in as a user. The ID is stored in the signed
+// session cookie, so deleting the session logs the browser out.
+type Session struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	UserAgent string    `json:"user_agent"`
+	IP        string    `json:"ip"`
+	Country   string    `json:"country"`
+	Created   time.Time `json:"created"`
+	LastSeen  time.Time `json:"last_seen"`
+}
+
+const sessionColumns = "id, user_id, user_agent, ip, COALESCE(country, ''), created_at, last_seen_at"
+
+func scanSession(row interface {
+	Scan(dest ...interface{}) error
+}) (*Session, error) {
+	s := new(Session)
+	if err := row.Scan(&s.ID, &s.UserID, &s.UserAgent, &s.IP, &s.Country, &s.Created, &s.LastSeen); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// NewSession records a new session for the user, and forgets their expired
+// ones. country is empty if it isn't known.
+func NewSession(ctx context.Context, u *User, userAgent, ip, country string) (*Session, error) {
+	id, err := randomHex(16)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if _, err := db.ExecContext(ctx, "DELETE FROM sessions WHERE user_id = $1 AND last_seen_at < $2", u.ID, now.Add(-SessionTTL)); err != nil {
+		return nil, err
+	}
+
+	row := db.QueryRowContext(ctx, "INSERT INTO sessions (id, user_id, user_agent, ip, country, created_at, last_seen_at) VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $6) RETURNING "+sessionColumns, id, u.ID, userAgent, ip, country, now)
+	return scanSession(row)
+}
+
+// TouchSession returns a session, and records that it was just used from ip.
+// Sessions that don't exist or have expired return ErrSessionRevoked.
+func TouchSession(ctx context.Context, id, ip string) (*Session, error) {
+	row := db.QueryRowContext(ctx, "SELECT "+sessionColumns+" FROM sessions WHERE id = $1", id)
+	s, err := scanSession(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, ErrSessionRevoked
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	}
+
+	now := time.Now()
+	if now.Sub(s.LastSeen) >= SessionTTL {
+		return nil, ErrSessionRevoked
+	}
+
+	if now.Sub(s.LastSeen) >= sessionTouchInterval || s.IP != ip {
+		if _, err := db.ExecContext(ctx, "UPDATE sessions SET last_seen_at = $2, ip = $3 WHERE id = $1", s.ID, now, ip); err != nil {
+			return nil, err
+		}
+		s.LastSeen, s.IP = now, ip
+	}
+
+	return s, nil
+}
+
+// UserSessions returns the user's sessions, most recently used first.
+func UserSessions(ctx context.Context, u *User) ([]*Session, error) {
+	rows, err := db.QueryContext(ctx, "SELECT "+sessionColumns+" FROM sessions WHERE user_id = $1 AND last_seen_at >= $2 ORDER BY last_seen_at DESC", u.ID, time.Now().Add(-SessionTTL))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := make([]*Session, 0)
+	for rows.Next() {
+		s, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// RevokeSession logs one of the user's sessions out.
+func RevokeSession(ctx context.Context, u *User, id string) error {
+	res, err := db.ExecContext(ctx, "DELETE FROM sessions WHERE id = $1 AND user_id = $2", id, u.ID)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return NotFound("No session with id %s", id)
+	}
+
+	return nil
+}
+
+// RevokeOtherSessions logs out all of the user's sessions except current,
+// and returns how many were logged out.
+func RevokeOtherSessions(ctx context.Context, u *User, current string) (int, error) {
+	res, err := db.ExecContext(ctx, "DELETE FROM sessions WHERE user_id = $1 AND id <> $2", u.ID, current)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+// DeleteSession forgets a session, when its browser logs out.
+func DeleteSession(ctx context.Context, id string) error {
+	_, err := db.ExecContext(ctx, "DELETE FROM sessions WHERE id = $1", id)
+	return err
+}
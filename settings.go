@@ -0,0 +1,229 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	siteTitleSetting        = "site_title"
+	siteDescriptionSetting  = "site_description"
+	siteURLSetting          = "site_url"
+	siteFooterTextSetting   = "site_footer_text"
+	sitePostsPerPageSetting = "site_posts_per_page"
+	siteSocialLinksSetting  = "site_social_links"
+	siteLicenseSetting      = "site_license"
+	siteLicenseTextSetting  = "site_license_text"
+)
+
+// SiteSettingsCacheTTL is how long site settings are cached for. Updates
+// clear the cache right away on the server that made them, other servers
+// catch up within the TTL.
+const SiteSettingsCacheTTL = time.Minute
+
+// Site settings are cached by site ID.
+var (
+	siteSettingsMu       sync.Mutex
+	siteSettingsCache    = map[string]*SiteSettings{}
+	siteSettingsCachedAt = map[string]time.Time{}
+)
+
+// SiteSettings are presentation settings for the site, which admins can
+// change without a deploy.
+type SiteSettings struct {
+	Title        string       `json:"title"`
+	Description  string       `json:"description"`
+	URL          string       `json:"url"`
+	FooterText   string       `json:"footer_text"`
+	PostsPerPage int          `json:"posts_per_page"`
+	SocialLinks  []SocialLink `json:"social_links"`
+
+	// License is the default license of posts.
+	License *License `json:"license"`
+}
+
+// SocialLink is a link to the site owner somewhere else, like Twitter.
+type SocialLink struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// defaultSiteSettings are used for any setting that has not been saved.
+func defaultSiteSettings() *SiteSettings {
+	return &SiteSettings{
+		Title:        "Nat? Nat. Nat!",
+		PostsPerPage: 20,
+		SocialLinks:  []SocialLink{},
+	}
+}
+
+// Validate checks that the settings make sense.
+func (s *SiteSettings) Validate() error {
+	if s.Title == "" {
+		return Validation("title", "Title is required")
+	}
+
+	if s.PostsPerPage < 1 || s.PostsPerPage > 100 {
+		return Validation("postsPerPage", "Posts per page must be between 1 and 100")
+	}
+
+	if s.URL != "" {
+		if u, err := url.Parse(s.URL); err != nil || !u.IsAbs() {
+			return Validation("url", "URL must be an absolute URL")
+		}
+	}
+
+	for _, l := range s.SocialLinks {
+		if u, err := url.Parse(l.URL); err != nil || !u.IsAbs() {
+			return fmt.Errorf("Social link %q must have an absolute URL", l.Name)
+		}
+	}
+
+	if s.License != nil {
+		if err := s.License.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSiteSettings returns the settings of the site the request is for. The
+// result is shared, so don't modify it.
+func GetSiteSettings(ctx context.Context) (*SiteSettings, error) {
+	siteSettingsMu.Lock()
+	defer siteSettingsMu.Unlock()
+
+	site := siteID(ctx)
+	if s, ok := siteSettingsCache[site]; ok && time.Since(siteSettingsCachedAt[site]) < SiteSettingsCacheTTL {
+		return s, nil
+	}
+
+	s, err := loadSiteSettings(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	siteSettingsCache[site] = s
+	siteSettingsCachedAt[site] = time.Now()
+	return s, nil
+}
+
+// invalidateSiteSettings clears the site settings cache of every site.
+func invalidateSiteSettings() {
+	siteSettingsMu.Lock()
+	defer siteSettingsMu.Unlock()
+
+	siteSettingsCache = map[string]*SiteSettings{}
+	siteSettingsCachedAt = map[string]time.Time{}
+}
+
+func loadSiteSettings(ctx context.Context, site string) (*SiteSettings, error) {
+	keys := []string{siteTitleSetting, siteDescriptionSetting, siteURLSetting, siteFooterTextSetting, sitePostsPerPageSetting, siteSocialLinksSetting, siteLicenseSetting, siteLicenseTextSetting}
+	rows, err := db.QueryContext(ctx, "SELECT key, value FROM settings WHERE site_id = $1 AND key = ANY($2)", site, pq.Array(keys))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	s := defaultSiteSettings()
+	var license LicenseId
+	var licenseText *string
+	for rows.Next() {
+		var key, value string
+		if err := rows.Scan(&key, &value); err != nil {
+			return nil, err
+		}
+
+		switch key {
+		case siteTitleSetting:
+			s.Title = value
+		case siteDescriptionSetting:
+			s.Description = value
+		case siteURLSetting:
+			s.URL = value
+		case siteFooterTextSetting:
+			s.FooterText = value
+		case sitePostsPerPageSetting:
+			if s.PostsPerPage, err = strconv.Atoi(value); err != nil {
+				return nil, Internalf("Error parsing %s: %+v", key, err)
+			}
+		case siteSocialLinksSetting:
+			if err := json.Unmarshal([]byte(value), &s.SocialLinks); err != nil {
+				return nil, Internalf("Error parsing %s: %+v", key, err)
+			}
+		case siteLicenseSetting:
+			license = LicenseId(value)
+		case siteLicenseTextSetting:
+			if value != "" {
+				licenseText = &value
+			}
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if license != "" {
+		s.License = &License{ID: license, Text: licenseText}
+	}
+	return s, nil
+}
+
+// UpdateSiteSettings saves all of the settings of the site the request is
+// for.
+func UpdateSiteSettings(ctx context.Context, s *SiteSettings) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	links, err := json.Marshal(s.SocialLinks)
+	if err != nil {
+		return err
+	}
+
+	var license, licenseText string
+	if s.License != nil {
+		license = string(s.License.ID)
+		if s.License.Text != nil {
+			licenseText = *s.License.Text
+		}
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	for key, value := range map[string]string{
+		siteTitleSetting:        s.Title,
+		siteDescriptionSetting:  s.Description,
+		siteURLSetting:          s.URL,
+		siteFooterTextSetting:   s.FooterText,
+		sitePostsPerPageSetting: strconv.Itoa(s.PostsPerPage),
+		siteSocialLinksSetting:  string(links),
+		siteLicenseSetting:      license,
+		siteLicenseTextSetting:  licenseText,
+	} {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO settings (site_id, key, value, modified_at) VALUES ($1, $2, $3, $4) ON CONFLICT (site_id, key) DO UPDATE SET value = $3, modified_at = $4", siteID(ctx), key, value, now); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	invalidateSiteSettings()
+	return nil
+}
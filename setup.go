@@ -0,0 +1,158 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	setupCompletedSetting = "setup_completed_at"
+	sessionSecretSetting  = "session_secret"
+)
+
+// ErrSetupComplete is returned when trying to run setup a second time.
+var ErrSetupComplete = fmt.Errorf("Setup has already been completed")
+
+// Setup is what an admin fills in when setting up a fresh database.
+type Setup struct {
+	SiteTitle string
+	SiteURL   string
+}
+
+// Validate checks that the setup has everything we need.
+func (s *Setup) Validate() error {
+	s.SiteTitle = strings.TrimSpace(s.SiteTitle)
+	s.SiteURL = strings.TrimSpace(s.SiteURL)
+
+	if s.SiteTitle == "" {
+		return fmt.Errorf("Site title is required")
+	}
+
+	u, err := url.Parse(s.SiteURL)
+	if err != nil || !u.IsAbs() || u.Host == "" {
+		return fmt.Errorf("Site URL must be an absolute URL")
+	}
+
+	return nil
+}
+
+// getSetting returns the value of one of a site's settings, and false if it
+// is not set. Settings for the whole server, like secrets, are the default
+// site's.
+func getSetting(ctx context.Context, site, key string) (string, bool, error) {
+	var value string
+	err := db.QueryRowContext(ctx, "SELECT value FROM settings WHERE site_id = $1 AND key = $2", site, key).Scan(&value)
+	switch {
+	case err == sql.ErrNoRows:
+		return "", false, nil
+	case err != nil:
+		return "", false, Internalf("Error running get query: %+v", err)
+	default:
+		return value, true, nil
+	}
+}
+
+// SetupComplete returns true once the first admin has claimed the site, or
+// if there is an admin already, like on databases from before setup.
+func SetupComplete(ctx context.Context) (bool, error) {
+	_, ok, err := getSetting(ctx, DefaultSiteID, setupCompletedSetting)
+	if err != nil || ok {
+		return ok, err
+	}
+
+	if err := db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM users WHERE role = $1)", string(RoleAdmin)).Scan(&ok); err != nil {
+		return false, Internalf("Error running get query: %+v", err)
+	}
+
+	return ok, nil
+}
+
+// SessionSecret returns the secret used to sign session cookies, generating
+// and storing one if there isn't one yet.
+func SessionSecret(ctx context.Context) (string, error) {
+	return secretSetting(ctx, sessionSecretSetting)
+}
+
+// secretSetting returns a random secret stored as the setting key,
+// generating and storing one if there isn't one yet.
+func secretSetting(ctx context.Context, key string) (string, error) {
+	secret, err := randomHex(32)
+	if err != nil {
+		return "", err
+	}
+
+	// Only the first secret stored is ever used, so concurrent servers agree.
+	if _, err := db.ExecContext(ctx, "INSERT INTO settings (site_id, key, value, modified_at) VALUES ($1, $2, $3, $4) ON CONFLICT (site_id, key) DO NOTHING", DefaultSiteID, key, secret, time.Now()); err != nil {
+		return "", err
+	}
+
+	secret, _, err = getSetting(ctx, DefaultSiteID, key)
+	return secret, err
+}
+
+// CompleteSetup makes u the first admin and saves the site settings. It can
+// only succeed once, and only if there are no admins yet; otherwise it
+// returns ErrSetupComplete.
+func CompleteSetup(ctx context.Context, u *User, s *Setup) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	res, err := tx.ExecContext(ctx, "INSERT INTO settings (site_id, key, value, modified_at) VALUES ($1, $2, $3, $3) ON CONFLICT (site_id, key) DO NOTHING", DefaultSiteID, setupCompletedSetting, now)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return ErrSetupComplete
+	}
+
+	// Databases from before setup have admins but no setup_completed_at.
+	var admins bool
+	if err := tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM users WHERE role = $1)", string(RoleAdmin)).Scan(&admins); err != nil {
+		return err
+	}
+
+	if admins {
+		return ErrSetupComplete
+	}
+
+	for key, value := range map[string]string{siteTitleSetting: s.SiteTitle, siteURLSetting: s.SiteURL} {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO settings (site_id, key, value, modified_at) VALUES ($1, $2, $3, $4) ON CONFLICT (site_id, key) DO UPDATE SET value = $3, modified_at = $4", siteID(ctx), key, value, now); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE users SET role = $2, modified_at = $3 WHERE id = $1", u.ID, string(RoleAdmin), now); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	invalidateSiteSettings()
+
+	// Make sure there is a session secret for the next time the server starts.
+	if _, err := SessionSecret(ctx); err != nil {
+		return err
+	}
+
+	u.Role = string(RoleAdmin)
+	return nil
+}
@@ -0,0 +1,173 @@
+package graphql
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// shortLinkAlphabet is used for generated slugs. It leaves out characters
+	// that are easy to confuse, like 0, O, 1 and l.
+	shortLinkAlphabet = "23456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"
+
+	// shortLinkLength is the length of generated slugs.
+	shortLinkLength = 6
+
+	// shortLinkAttempts is how many generated slugs we try before giving up.
+	shortLinkAttempts = 5
+)
+
+// ErrShortLinkNotFound is returned by FollowShortLink when there is no short
+// link for a slug.
+var ErrShortLinkNotFound = NotFound("No short link for slug")
+
+var shortLinkSlugRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// ShortLink is a short URL on this server, /s/{slug}, that redirects to a
+// longer one.
+type ShortLink struct {
+	ID        string     `json:"id"`
+	Slug      string     `json:"slug"`
+	URL       string     `json:"url"`
+	Clicks    int        `json:"clicks"`
+	LastClick *time.Time `json:"last_click"`
+	Created   time.Time  `json:"created"`
+}
+
+// Path is the path on this server that redirects to the short link's URL.
+func (s *ShortLink) Path() string {
+	return "/s/" + s.Slug
+}
+
+func scanShortLink(row interface {
+	Scan(dest ...interface{}) error
+}) (*ShortLink, error) {
+	s := new(ShortLink)
+	var lastClick pq.NullTime
+	if err := row.Scan(&s.ID, &s.Slug, &s.URL, &s.Clicks, &lastClick, &s.Created); err != nil {
+		return nil, err
+	}
+	s.LastClick = nullTimePtr(lastClick)
+
+	return s, nil
+}
+
+// randomSlug returns a random slug of shortLinkLength characters.
+func randomSlug() (string, error) {
+	max := big.NewInt(int64(len(shortLinkAlphabet)))
+	b := make([]byte, shortLinkLength)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = shortLinkAlphabet[n.Int64()]
+	}
+
+	return string(b), nil
+}
+
+// CreateShortLink stores a new short link to uri. If slug is empty, a random
+// one is generated, retrying if it is already taken.
+func CreateShortLink(ctx context.Context, uri, slug string) (*ShortLink, error) {
+	uri = strings.TrimSpace(uri)
+	u, err := url.Parse(uri)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, Validation("url", "URL must be an absolute http or https URL")
+	}
+
+	slug = strings.TrimSpace(slug)
+	if slug != "" {
+		if !shortLinkSlugRegex.MatchString(slug) {
+			return nil, Validation("slug", "Slug must be 1 to 64 letters, numbers, dashes or underscores")
+		}
+
+		s, err := insertShortLink(ctx, slug, uri)
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("Slug %s is already taken", slug)
+		}
+		return s, err
+	}
+
+	for i := 0; i < shortLinkAttempts; i++ {
+		slug, err := randomSlug()
+		if err != nil {
+			return nil, err
+		}
+
+		s, err := insertShortLink(ctx, slug, uri)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		return s, err
+	}
+
+	return nil, fmt.Errorf("Could not find a free slug after %d attempts", shortLinkAttempts)
+}
+
+// insertShortLink stores a short link, returning sql.ErrNoRows if the slug is
+// taken.
+func insertShortLink(ctx context.Context, slug, uri string) (*ShortLink, error) {
+	row := db.QueryRowContext(ctx, "INSERT INTO short_links (slug, url, created_at) VALUES ($1, $2, $3) ON CONFLICT (slug) DO NOTHING RETURNING id, slug, url, clicks, last_click_at, created_at", slug, uri, time.Now())
+	return scanShortLink(row)
+}
+
+// GetShortLink returns a short link by slug.
+func GetShortLink(ctx context.Context, slug string) (*ShortLink, error) {
+	row := db.QueryRowContext(ctx, "SELECT id, slug, url, clicks, last_click_at, created_at FROM short_links WHERE slug = $1", slug)
+	s, err := scanShortLink(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, NotFound("No short link with slug %s", slug)
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return s, nil
+	}
+}
+
+// ShortLinks returns all short links, most clicked first.
+func ShortLinks(ctx context.Context) ([]*ShortLink, error) {
+	rows, err := db.QueryContext(ctx, "SELECT id, slug, url, clicks, last_click_at, created_at FROM short_links ORDER BY clicks DESC, created_at DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	links := make([]*ShortLink, 0)
+	for rows.Next() {
+		s, err := scanShortLink(rows)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
+// FollowShortLink returns the short link for a slug, counting the click.
+func FollowShortLink(ctx context.Context, slug string) (*ShortLink, error) {
+	row := db.QueryRowContext(ctx, "UPDATE short_links SET clicks = clicks + 1, last_click_at = $2 WHERE slug = $1 RETURNING id, slug, url, clicks, last_click_at, created_at", slug, time.Now())
+	s, err := scanShortLink(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, ErrShortLinkNotFound
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return s, nil
+	}
+}
@@ -0,0 +1,169 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Signup policies control who can become a user the first time they log in.
+const (
+	// SignupOpen lets anyone sign up.
+	SignupOpen = "open"
+
+	// SignupDomain lets anyone with an email address in an allowed domain sign
+	// up.
+	SignupDomain = "domain"
+
+	// SignupInvite requires an unused invite code to sign up.
+	SignupInvite = "invite"
+
+	// SignupClosed only lets existing users log in.
+	SignupClosed = "closed"
+)
+
+// ErrSignupNotAllowed is returned when the signup policy does not let a new
+// user sign up.
+var ErrSignupNotAllowed = fmt.Errorf("Signup is not allowed")
+
+var (
+	signupPolicy  = SignupOpen
+	signupDomains = []string{}
+)
+
+// Invite is a single use code that lets someone sign up when the signup
+// policy is SignupInvite.
+type Invite struct {
+	Code    string     `json:"code"`
+	Created time.Time  `json:"created"`
+	Used    *time.Time `json:"used"`
+	UsedBy  *string    `json:"used_by"`
+}
+
+// ConfigureSignup sets the signup policy. Domains are only used by
+// SignupDomain.
+func ConfigureSignup(policy string, domains []string) error {
+	switch policy {
+	case SignupOpen, SignupInvite, SignupClosed:
+	case SignupDomain:
+		if len(domains) == 0 {
+			return fmt.Errorf("The %s signup policy needs at least one domain", policy)
+		}
+	default:
+		return fmt.Errorf("Unknown signup policy %q", policy)
+	}
+
+	signupPolicy = policy
+	signupDomains = domains
+	return nil
+}
+
+// Signup creates a new user, if the signup policy allows it. Invite codes are
+// redeemed as part of signup.
+func Signup(ctx context.Context, id, email, invite string) (*User, error) {
+	// Anyone can sign up until setup is complete, so the first admin can
+	// claim the site.
+	done, err := SetupComplete(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !done {
+		return GetUser(ctx, id)
+	}
+
+	switch signupPolicy {
+	case SignupOpen:
+	case SignupDomain:
+		if !emailInDomains(email, signupDomains) {
+			return nil, ErrSignupNotAllowed
+		}
+	case SignupInvite:
+		if err := RedeemInvite(ctx, invite, id); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrSignupNotAllowed
+	}
+
+	return GetUser(ctx, id)
+}
+
+func emailInDomains(email string, domains []string) bool {
+	i := strings.LastIndex(email, "@")
+	if i < 0 {
+		return false
+	}
+
+	domain := strings.ToLower(email[i+1:])
+	for _, d := range domains {
+		if domain == strings.ToLower(strings.TrimSpace(d)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewInvite creates and stores a new invite code.
+func NewInvite(ctx context.Context) (*Invite, error) {
+	code, err := randomHex(8)
+	if err != nil {
+		return nil, err
+	}
+
+	i := &Invite{Code: code, Created: time.Now()}
+	if _, err := db.ExecContext(ctx, "INSERT INTO invites (code, created_at) VALUES ($1, $2)", i.Code, i.Created); err != nil {
+		return nil, err
+	}
+
+	return i, nil
+}
+
+// RedeemInvite marks an invite as used by userID. Each invite can only be
+// redeemed once.
+func RedeemInvite(ctx context.Context, code, userID string) error {
+	res, err := db.ExecContext(ctx, "UPDATE invites SET used_by = $2, used_at = $3 WHERE code = $1 AND used_by IS NULL", code, userID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return ErrSignupNotAllowed
+	}
+
+	return nil
+}
+
+// Invites returns all invites, newest first.
+func Invites(ctx context.Context) ([]*Invite, error) {
+	rows, err := db.QueryContext(ctx, "SELECT code, created_at, used_at, used_by FROM invites ORDER BY created_at DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	invites := make([]*Invite, 0)
+	for rows.Next() {
+		i := new(Invite)
+		var used pq.NullTime
+		err := rows.Scan(&i.Code, &i.Created, &used, &i.UsedBy)
+		if err != nil {
+			return nil, err
+		}
+		i.Used = nullTimePtr(used)
+		invites = append(invites, i)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return invites, nil
+}
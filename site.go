@@ -0,0 +1,235 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// DefaultSiteID is the ID of the default site, which serves every host that
+// isn't another site's. Everything from before there were sites is on it, as
+// is anything done outside of a request, like digests.
+const DefaultSiteID = "1"
+
+// SitesCacheTTL is how long the sites are cached for, to route requests by
+// host without a query each time.
+const SitesCacheTTL = time.Minute
+
+var (
+	sitesMu       sync.Mutex
+	sitesCache    map[string]*Site
+	sitesCachedAt time.Time
+)
+
+// Site is a domain served by this server. Posts, pages and settings belong to
+// a site. If AllowedUsers is not empty, only users with those email
+// addresses, and admins, can log in on the site.
+type Site struct {
+	ID           string    `json:"id"`
+	Host         *string   `json:"host"`
+	AllowedUsers []string  `json:"allowed_users"`
+	Created      time.Time `json:"created"`
+}
+
+const siteColumns = "id, host, allowed_users, created_at"
+
+func scanSite(row interface {
+	Scan(dest ...interface{}) error
+}) (*Site, error) {
+	s := new(Site)
+	if err := row.Scan(&s.ID, &s.Host, pq.Array(&s.AllowedUsers), &s.Created); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// SiteForContext returns the site the request is for, or the default site.
+// Requires server.SiteMiddleware to have run.
+func SiteForContext(ctx context.Context) *Site {
+	if s, ok := ctx.Value(SiteCtxKey).(*Site); ok && s != nil {
+		return s
+	}
+
+	return &Site{ID: DefaultSiteID, AllowedUsers: []string{}}
+}
+
+// siteID returns the ID of the site the request is for.
+func siteID(ctx context.Context) string {
+	return SiteForContext(ctx).ID
+}
+
+// Allows returns whether someone with the email address can log in on the
+// site.
+func (s *Site) Allows(u *User, email string) bool {
+	if len(s.AllowedUsers) == 0 || (u != nil && u.Role == string(RoleAdmin)) {
+		return true
+	}
+
+	for _, a := range s.AllowedUsers {
+		if strings.EqualFold(a, email) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeHost lower cases host and removes any port.
+func normalizeHost(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+}
+
+// SiteForHost returns the site that serves host, or the default site.
+func SiteForHost(ctx context.Context, host string) (*Site, error) {
+	sitesMu.Lock()
+	defer sitesMu.Unlock()
+
+	if sitesCache == nil || time.Since(sitesCachedAt) >= SitesCacheTTL {
+		sites, err := Sites(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		sitesCache = map[string]*Site{}
+		for _, s := range sites {
+			if s.Host == nil {
+				sitesCache[""] = s
+				continue
+			}
+			sitesCache[*s.Host] = s
+		}
+		sitesCachedAt = time.Now()
+	}
+
+	if s, ok := sitesCache[normalizeHost(host)]; ok {
+		return s, nil
+	}
+	if s, ok := sitesCache[""]; ok {
+		return s, nil
+	}
+
+	return SiteForContext(ctx), nil
+}
+
+// invalidateSites clears the sites cache.
+func invalidateSites() {
+	sitesMu.Lock()
+	defer sitesMu.Unlock()
+
+	sitesCache = nil
+}
+
+// Sites returns every site, the default site first.
+func Sites(ctx context.Context) ([]*Site, error) {
+	rows, err := db.QueryContext(ctx, "SELECT "+siteColumns+" FROM sites ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sites := make([]*Site, 0)
+	for rows.Next() {
+		s, err := scanSite(rows)
+		if err != nil {
+			return nil, err
+		}
+		sites = append(sites, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return sites, nil
+}
+
+// GetSite returns a site by ID.
+func GetSite(ctx context.Context, id string) (*Site, error) {
+	row := db.QueryRowContext(ctx, "SELECT "+siteColumns+" FROM sites WHERE id = $1", id)
+	s, err := scanSite(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, NotFound("No site with id %s", id)
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return s, nil
+	}
+}
+
+// validSiteHost checks and normalizes the host of a new or updated site.
+func validSiteHost(host string) (string, error) {
+	host = normalizeHost(host)
+	if host == "" || strings.ContainsAny(host, "/:@ ") {
+		return "", Validation("host", "Host must be a domain name, like example.com")
+	}
+
+	return host, nil
+}
+
+// CreateSite adds a site for host. It has the default settings until an
+// admin on the site changes them.
+func CreateSite(ctx context.Context, host string, allowedUsers []string) (*Site, error) {
+	host, err := validSiteHost(host)
+	if err != nil {
+		return nil, err
+	}
+	if allowedUsers == nil {
+		allowedUsers = []string{}
+	}
+
+	row := db.QueryRowContext(ctx, "INSERT INTO sites (host, allowed_users, created_at) VALUES ($1, $2, $3) RETURNING "+siteColumns, host, pq.Array(allowedUsers), time.Now())
+	s, err := scanSite(row)
+	if err, ok := err.(*pq.Error); ok && err.Code.Name() == "unique_violation" {
+		return nil, Validation("host", "%s already has a site", host)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateSites()
+	return s, nil
+}
+
+// UpdateSite changes a site's host or allowed users. A nil value keeps the
+// current one. The default site can't have a host.
+func UpdateSite(ctx context.Context, id string, host *string, allowedUsers []string) (*Site, error) {
+	s, err := GetSite(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if host != nil {
+		if s.ID == DefaultSiteID {
+			return nil, Validation("host", "The default site serves every other host, so it can't have one")
+		}
+		h, err := validSiteHost(*host)
+		if err != nil {
+			return nil, err
+		}
+		s.Host = &h
+	}
+	if allowedUsers != nil {
+		s.AllowedUsers = allowedUsers
+	}
+
+	_, err = db.ExecContext(ctx, "UPDATE sites SET host = $2, allowed_users = $3 WHERE id = $1", s.ID, s.Host, pq.Array(s.AllowedUsers))
+	if err, ok := err.(*pq.Error); ok && err.Code.Name() == "unique_violation" {
+		return nil, Validation("host", "%s already has a site", *s.Host)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateSites()
+	return s, nil
+}
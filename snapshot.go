@@ -0,0 +1,544 @@
+package graphql
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/lib/pq"
+	bolt "go.etcd.io/bbolt"
+)
+
+// SnapshotVersion is the version of the snapshot format. Edges refuse
+// snapshots with a different version.
+const SnapshotVersion = 1
+
+var (
+	snapshotPostsBucket = []byte("posts")
+	snapshotDatesBucket = []byte("dates")
+	snapshotMetaBucket  = []byte("meta")
+
+	snapshotClient = &http.Client{Timeout: time.Minute}
+
+	// snapshotQueries are the queries an edge can answer from a snapshot.
+	snapshotQueries = map[string]bool{
+		"allPosts":     true,
+		"posts":        true,
+		"post":         true,
+		"nextPost":     true,
+		"prevPost":     true,
+		"sitemapPosts": true,
+		"siteSettings": true,
+		"theme":        true,
+	}
+)
+
+// Snapshot is everything the public reads from the site: published posts
+// and presentation settings. Edges serve public queries from a snapshot
+// instead of Postgres.
+type Snapshot struct {
+	Version      int                 `json:"version"`
+	Created      time.Time           `json:"created"`
+	Posts        []*Post             `json:"posts"`
+	Syndications map[string][]string `json:"syndications"`
+	Site         *SiteSettings       `json:"site"`
+	Theme        *Theme              `json:"theme"`
+
+	// Metadata is by post ID. Snapshots from before post metadata don't
+	// have it.
+	Metadata map[string][]MetadataEntry `json:"metadata,omitempty"`
+}
+
+// BuildSnapshot reads a snapshot from the database.
+func BuildSnapshot(ctx context.Context) (*Snapshot, error) {
+	posts, err := AllPosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	site, err := GetSiteSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	theme, err := GetTheme(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := allPostMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Snapshot{
+		Version:      SnapshotVersion,
+		Created:      time.Now(),
+		Posts:        posts,
+		Syndications: map[string][]string{},
+		Site:         site,
+		Theme:        theme,
+		Metadata:     metadata,
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT post_id, array_agg(url ORDER BY service) FROM syndications WHERE url IS NOT NULL GROUP BY post_id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		var urls []string
+		if err := rows.Scan(&id, pq.Array(&urls)); err != nil {
+			return nil, err
+		}
+		s.Syndications[id] = urls
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// WriteSnapshot writes a snapshot of the database to w as gzipped JSON.
+func WriteSnapshot(ctx context.Context, w io.Writer) error {
+	s, err := BuildSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(w)
+	if err := json.NewEncoder(gz).Encode(s); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+// ReadSnapshot reads a snapshot written by WriteSnapshot.
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	s := new(Snapshot)
+	if err := json.NewDecoder(gz).Decode(s); err != nil {
+		return nil, err
+	}
+
+	if s.Version != SnapshotVersion {
+		return nil, fmt.Errorf("Snapshot is version %d, expected %d", s.Version, SnapshotVersion)
+	}
+
+	return s, nil
+}
+
+// SnapshotStore keeps the latest snapshot in a bbolt file, so an edge can
+// serve it after a restart without reaching the primary.
+type SnapshotStore struct {
+	db *bolt.DB
+}
+
+// snapshotPost is how posts are stored.
+type snapshotPost struct {
+	Post         *Post           `json:"post"`
+	Syndications []string        `json:"syndications"`
+	Metadata     []MetadataEntry `json:"metadata"`
+}
+
+// OpenSnapshotStore opens or creates the store at path.
+func OpenSnapshotStore(path string) (*SnapshotStore, error) {
+	b, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 10 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SnapshotStore{db: b}, nil
+}
+
+// Close closes the store.
+func (s *SnapshotStore) Close() error {
+	return s.db.Close()
+}
+
+// dateKey sorts posts by date, then ID.
+func dateKey(p *Post) []byte {
+	key := make([]byte, 8, 8+len(p.ID))
+	binary.BigEndian.PutUint64(key, uint64(p.Datetime.UnixNano())^(1<<63))
+	return append(key, p.ID...)
+}
+
+// Replace swaps the stored snapshot for snap.
+func (s *SnapshotStore) Replace(snap *Snapshot) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{snapshotPostsBucket, snapshotDatesBucket, snapshotMetaBucket} {
+			if err := tx.DeleteBucket(name); err != nil && err != bolt.ErrBucketNotFound {
+				return err
+			}
+			if _, err := tx.CreateBucket(name); err != nil {
+				return err
+			}
+		}
+
+		posts := tx.Bucket(snapshotPostsBucket)
+		dates := tx.Bucket(snapshotDatesBucket)
+		for _, p := range snap.Posts {
+			data, err := json.Marshal(&snapshotPost{Post: p, Syndications: snap.Syndications[p.ID], Metadata: snap.Metadata[p.ID]})
+			if err != nil {
+				return err
+			}
+
+			if err := posts.Put([]byte(p.ID), data); err != nil {
+				return err
+			}
+			if err := dates.Put(dateKey(p), []byte(p.ID)); err != nil {
+				return err
+			}
+		}
+
+		meta := tx.Bucket(snapshotMetaBucket)
+		for key, v := range map[string]interface{}{"site": snap.Site, "theme": snap.Theme, "created": snap.Created} {
+			data, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			if err := meta.Put([]byte(key), data); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// meta decodes the meta value key into v.
+func (s *SnapshotStore) meta(key string, v interface{}) error {
+	return s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(snapshotMetaBucket)
+		if b == nil || b.Get([]byte(key)) == nil {
+			return fmt.Errorf("No snapshot has been loaded")
+		}
+
+		return json.Unmarshal(b.Get([]byte(key)), v)
+	})
+}
+
+// Created returns when the stored snapshot was taken.
+func (s *SnapshotStore) Created() (time.Time, error) {
+	var t time.Time
+	err := s.meta("created", &t)
+	return t, err
+}
+
+// SiteSettings returns the snapshot's site settings.
+func (s *SnapshotStore) SiteSettings() (*SiteSettings, error) {
+	site := defaultSiteSettings()
+	return site, s.meta("site", site)
+}
+
+// Theme returns the snapshot's theme.
+func (s *SnapshotStore) Theme() (*Theme, error) {
+	t := defaultTheme()
+	return t, s.meta("theme", t)
+}
+
+func getSnapshotPost(tx *bolt.Tx, id []byte) (*snapshotPost, error) {
+	b := tx.Bucket(snapshotPostsBucket)
+	if b == nil {
+		return nil, fmt.Errorf("No snapshot has been loaded")
+	}
+
+	data := b.Get(id)
+	if data == nil {
+		return nil, NotFound("No post with id %s", id)
+	}
+
+	p := new(snapshotPost)
+	return p, json.Unmarshal(data, p)
+}
+
+// Post returns a published post by ID.
+func (s *SnapshotStore) Post(id string) (*Post, error) {
+	var p *snapshotPost
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		p, err = getSnapshotPost(tx, []byte(id))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Post, nil
+}
+
+// SyndicationURLs returns the syndication URLs of a post.
+func (s *SnapshotStore) SyndicationURLs(id string) ([]string, error) {
+	urls := []string{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		p, err := getSnapshotPost(tx, []byte(id))
+		if err == nil && p.Syndications != nil {
+			urls = p.Syndications
+		}
+		return err
+	})
+
+	return urls, err
+}
+
+// Metadata returns the post's metadata.
+func (s *SnapshotStore) Metadata(id string) ([]MetadataEntry, error) {
+	metadata := []MetadataEntry{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		p, err := getSnapshotPost(tx, []byte(id))
+		if err == nil && p.Metadata != nil {
+			metadata = p.Metadata
+		}
+		return err
+	})
+
+	return metadata, err
+}
+
+// Posts returns published posts, newest first. A limit of zero means no
+// limit.
+func (s *SnapshotStore) Posts(limit, offset int) ([]*Post, error) {
+	posts := make([]*Post, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		dates := tx.Bucket(snapshotDatesBucket)
+		if dates == nil {
+			return fmt.Errorf("No snapshot has been loaded")
+		}
+
+		c := dates.Cursor()
+		i := 0
+		for k, id := c.Last(); k != nil && (limit <= 0 || len(posts) < limit); k, id = c.Prev() {
+			if i++; i <= offset {
+				continue
+			}
+
+			p, err := getSnapshotPost(tx, id)
+			if err != nil {
+				return err
+			}
+			posts = append(posts, p.Post)
+		}
+
+		return nil
+	})
+
+	return posts, err
+}
+
+// AdjacentPost returns the published post after the post with id, or before
+// it if next is false. It returns nil if there isn't one.
+func (s *SnapshotStore) AdjacentPost(id string, next bool) (*Post, error) {
+	var adjacent *Post
+	err := s.db.View(func(tx *bolt.Tx) error {
+		p, err := getSnapshotPost(tx, []byte(id))
+		if err != nil {
+			return err
+		}
+
+		c := tx.Bucket(snapshotDatesBucket).Cursor()
+		c.Seek(dateKey(p.Post))
+
+		var otherID []byte
+		if next {
+			_, otherID = c.Next()
+		} else {
+			_, otherID = c.Prev()
+		}
+		if otherID == nil {
+			return nil
+		}
+
+		other, err := getSnapshotPost(tx, otherID)
+		if err != nil {
+			return err
+		}
+		adjacent = other.Post
+		return nil
+	})
+
+	return adjacent, err
+}
+
+// PullSnapshot downloads a snapshot from a primary's /snapshot endpoint and
+// stores it.
+func PullSnapshot(ctx context.Context, source, token string, store *SnapshotStore) error {
+	req, err := http.NewRequest(http.MethodGet, source, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := snapshotClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s responded with %s", source, resp.Status)
+	}
+
+	snap, err := ReadSnapshot(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	return store.Replace(snap)
+}
+
+// StartSnapshotPuller pulls a fresh snapshot every interval, until ctx is
+// done.
+func StartSnapshotPuller(ctx context.Context, source, token string, store *SnapshotStore, interval time.Duration) {
+	startWorker(ctx, "snapshot", interval, func(ctx context.Context) (bool, error) {
+		return false, PullSnapshot(ctx, source, token, store)
+	})
+}
+
+// NewSnapshotConfig returns a Config that answers public queries about posts
+// and site settings from a snapshot, without a database. Everything else is
+// refused.
+func NewSnapshotConfig(store *SnapshotStore) Config {
+	c := New()
+	c.Resolvers = &snapshotResolver{Resolver: &Resolver{}, store: store}
+	return c
+}
+
+// SnapshotMiddleware is a gqlgen resolver middleware that refuses queries
+// and mutations that can't be answered from a snapshot.
+func SnapshotMiddleware(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	rctx := graphql.GetResolverContext(ctx)
+	if rctx == nil || (rctx.Object != "Query" && rctx.Object != "Mutation") || rctx.Field.Name == "__typename" || rctx.Field.Name == "__schema" || rctx.Field.Name == "__type" {
+		return next(ctx)
+	}
+
+	if rctx.Object == "Query" && snapshotQueries[rctx.Field.Name] {
+		return next(ctx)
+	}
+
+	return nil, fmt.Errorf("%s is not available on read only edges", rctx.Field.Name)
+}
+
+type snapshotResolver struct {
+	*Resolver
+	store *SnapshotStore
+}
+
+func (r *snapshotResolver) Query() QueryResolver {
+	return &snapshotQueryResolver{queryResolver: &queryResolver{r.Resolver}, store: r.store}
+}
+
+func (r *snapshotResolver) Post() PostResolver {
+	return &snapshotPostResolver{store: r.store}
+}
+
+type snapshotQueryResolver struct {
+	*queryResolver
+	store *SnapshotStore
+}
+
+func (r *snapshotQueryResolver) AllPosts(ctx context.Context) ([]*Post, error) {
+	return r.store.Posts(0, 0)
+}
+
+func (r *snapshotQueryResolver) Posts(ctx context.Context, limit *int, offset *int) ([]*Post, error) {
+	l, o := 0, 0
+	if limit != nil {
+		l = *limit
+	} else {
+		site, err := r.store.SiteSettings()
+		if err != nil {
+			return nil, err
+		}
+		l = site.PostsPerPage
+	}
+	if offset != nil {
+		o = *offset
+	}
+
+	return r.store.Posts(l, o)
+}
+
+func (r *snapshotQueryResolver) Post(ctx context.Context, id string, unlock *string) (*Post, error) {
+	return r.store.Post(id)
+}
+
+func (r *snapshotQueryResolver) NextPost(ctx context.Context, id string) (*Post, error) {
+	return r.store.AdjacentPost(id, true)
+}
+
+func (r *snapshotQueryResolver) PrevPost(ctx context.Context, id string) (*Post, error) {
+	return r.store.AdjacentPost(id, false)
+}
+
+func (r *snapshotQueryResolver) SitemapPosts(ctx context.Context) ([]*Post, error) {
+	site, err := r.store.SiteSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := r.store.Posts(0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return sitemapPosts(posts, site.URL), nil
+}
+
+func (r *snapshotQueryResolver) SiteSettings(ctx context.Context) (SiteSettings, error) {
+	site, err := r.store.SiteSettings()
+	if err != nil {
+		return SiteSettings{}, err
+	}
+
+	return *site, nil
+}
+
+func (r *snapshotQueryResolver) Theme(ctx context.Context) (Theme, error) {
+	t, err := r.store.Theme()
+	if err != nil {
+		return Theme{}, err
+	}
+
+	return *t, nil
+}
+
+type snapshotPostResolver struct {
+	store *SnapshotStore
+}
+
+// Revisions are not in snapshots.
+func (r *snapshotPostResolver) Revisions(ctx context.Context, obj *Post, limit *int, offset *int) ([]*Revision, error) {
+	return []*Revision{}, nil
+}
+
+func (r *snapshotPostResolver) SyndicationUrls(ctx context.Context, obj *Post) ([]string, error) {
+	return r.store.SyndicationURLs(obj.ID)
+}
+
+func (r *snapshotPostResolver) Metadata(ctx context.Context, obj *Post) ([]MetadataEntry, error) {
+	return r.store.Metadata(obj.ID)
+}
+
+func (r *snapshotPostResolver) License(ctx context.Context, obj *Post) (*License, error) {
+	site, err := r.store.SiteSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.licenseOn(site), nil
+}
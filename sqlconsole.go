@@ -0,0 +1,160 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// AuditSQLConsole is the audit log action for SQL console statements.
+	AuditSQLConsole = "sql_console"
+
+	// maxSQLConsoleRows is the most rows the SQL console returns.
+	maxSQLConsoleRows = 1000
+
+	// maxSQLConsoleQueryLength is the longest statement the SQL console runs.
+	maxSQLConsoleQueryLength = 10000
+)
+
+var (
+	sqlConsoleRole    string
+	sqlConsoleTimeout time.Duration
+)
+
+// SQLResult is the result of a statement run in the SQL console. Rows has at
+// most maxSQLConsoleRows rows, and Truncated says whether there were more.
+type SQLResult struct {
+	Columns   []string        `json:"columns"`
+	Rows      [][]interface{} `json:"rows"`
+	Truncated bool            `json:"truncated"`
+}
+
+// ConfigureSQLConsole turns on the SQL console. Statements run as role, which
+// should only be able to read, in a read only transaction, and are cancelled
+// after timeout.
+func ConfigureSQLConsole(role string, timeout time.Duration) error {
+	if role == "" {
+		return fmt.Errorf("The SQL console needs a read only role")
+	}
+	if timeout <= 0 {
+		return fmt.Errorf("The SQL console needs a statement timeout")
+	}
+
+	sqlConsoleRole = role
+	sqlConsoleTimeout = timeout
+	return nil
+}
+
+// SQLConsoleEnabled returns whether ConfigureSQLConsole has been called.
+func SQLConsoleEnabled() bool {
+	return sqlConsoleRole != ""
+}
+
+// RecordAudit adds an entry to the audit log, as the user of ctx and with its
+// request ID.
+func RecordAudit(ctx context.Context, action, detail string) error {
+	var userID sql.NullString
+	if u := ForContext(ctx); u != nil {
+		userID = sql.NullString{String: u.ID, Valid: true}
+	}
+
+	if _, err := db.ExecContext(ctx, "INSERT INTO audit_log (user_id, action, detail, request_id, created_at) VALUES ($1, $2, $3, NULLIF($4, ''), $5)", userID, action, detail, RequestID(ctx), time.Now()); err != nil {
+		return Internalf("Could not write to the audit log: %+v", err)
+	}
+
+	return nil
+}
+
+// RunSQL runs one read only statement for an admin, and returns what it
+// selects. The statement is written to the audit log before it runs, and
+// isn't run if it can't be. Mistakes in the statement are validation errors,
+// so admins can see them.
+func RunSQL(ctx context.Context, query string) (*SQLResult, error) {
+	if !SQLConsoleEnabled() {
+		return nil, NotFound("The SQL console is disabled")
+	}
+
+	if u := ForContext(ctx); u == nil || u.Role != "admin" {
+		return nil, ErrForbidden
+	}
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, Validation("query", "query is required")
+	}
+	if len(query) > maxSQLConsoleQueryLength {
+		return nil, Validation("query", "query must be at most %d characters", maxSQLConsoleQueryLength)
+	}
+
+	if err := RecordAudit(ctx, AuditSQLConsole, query); err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, Internalf("Could not start transaction: %+v", err)
+	}
+	// Nothing the statement did is ever kept.
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "SET LOCAL ROLE "+pq.QuoteIdentifier(sqlConsoleRole)); err != nil {
+		return nil, Internalf("Could not switch to the SQL console role: %+v", err)
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL statement_timeout = %d", sqlConsoleTimeout/time.Millisecond)); err != nil {
+		return nil, Internalf("Could not set the SQL console timeout: %+v", err)
+	}
+
+	// A prepared statement is a single statement, so it can't end the
+	// transaction and run more after it.
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, Validation("query", "%v", err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx)
+	if err != nil {
+		return nil, Validation("query", "%v", err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, Validation("query", "%v", err)
+	}
+
+	res := &SQLResult{Columns: columns, Rows: make([][]interface{}, 0)}
+	for rows.Next() {
+		if len(res.Rows) == maxSQLConsoleRows {
+			res.Truncated = true
+			break
+		}
+
+		values := make([]interface{}, len(columns))
+		dest := make([]interface{}, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, Validation("query", "%v", err)
+		}
+
+		// Text comes back as bytes, which would be base64 in JSON.
+		for i, v := range values {
+			if b, ok := v.([]byte); ok {
+				values[i] = string(b)
+			}
+		}
+		res.Rows = append(res.Rows, values)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, Validation("query", "%v", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,182 @@
+package graphql
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// SuggestionsCacheTTL is how long post suggestions for a missing path are
+// cached for.
+const SuggestionsCacheTTL = 10 * time.Minute
+
+var (
+	suggestionsCache *lru.Cache
+
+	slugRegex = regexp.MustCompile(`[^a-z0-9]+`)
+	wordRegex = regexp.MustCompile(`[a-z]{3,}|[0-9]+`)
+)
+
+type suggestionsEntry struct {
+	ids    []int64
+	cached time.Time
+}
+
+func init() {
+	var err error
+	suggestionsCache, err = lru.New(1024)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Slugify turns a title into a URL friendly slug, like "Hello, World!" into
+// "hello-world".
+func Slugify(title string) string {
+	return strings.Trim(slugRegex.ReplaceAllString(strings.ToLower(title), "-"), "-")
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			cur[j] = cur[j-1] + 1
+			if prev[j]+1 < cur[j] {
+				cur[j] = prev[j] + 1
+			}
+			if prev[j-1]+cost < cur[j] {
+				cur[j] = prev[j-1] + cost
+			}
+		}
+		prev = cur
+	}
+
+	return prev[len(b)]
+}
+
+// SuggestPosts returns published posts that the missing path probably meant,
+// best match first. Posts are scored by how close their slug is to the last
+// part of the path, plus a full text search for the words in the path.
+func SuggestPosts(ctx context.Context, path string, limit int) ([]*Post, error) {
+	ids, err := suggestPostIDs(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make([]*Post, 0, limit)
+	for _, id := range ids {
+		if len(posts) >= limit {
+			break
+		}
+
+		p, err := GetPost(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		// The post may have become a draft since we cached it.
+		if !p.Draft {
+			posts = append(posts, p)
+		}
+	}
+
+	return posts, nil
+}
+
+// suggestPostIDs returns the IDs of all posts that are a decent match for
+// path, best first. Results are cached per site and path.
+func suggestPostIDs(ctx context.Context, path string) ([]int64, error) {
+	path = strings.ToLower(NormalizeRedirectPath(path))
+	key := siteID(ctx) + path
+	if e, ok := suggestionsCache.Get(key); ok && time.Since(e.(*suggestionsEntry).cached) < SuggestionsCacheTTL {
+		return e.(*suggestionsEntry).ids, nil
+	}
+
+	segments := strings.Split(path, "/")
+	slug := Slugify(segments[len(segments)-1])
+
+	words := []string{}
+	numbers := map[int64]bool{}
+	for _, w := range wordRegex.FindAllString(path, -1) {
+		if n, err := strconv.ParseInt(w, 10, 64); err == nil {
+			numbers[n] = true
+		} else {
+			words = append(words, w)
+		}
+	}
+
+	// Words are only letters, so they are safe to use in a tsquery.
+	query := strings.Join(words, " | ")
+
+	rows, err := db.QueryContext(ctx, "SELECT id, title, ts_rank(to_tsvector('english', title || ' ' || content), to_tsquery('english', $1)) FROM posts WHERE site_id = $2 AND draft = false AND password_hash IS NULL", query, siteID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	type scored struct {
+		id    int64
+		score float64
+	}
+	matches := []scored{}
+	for rows.Next() {
+		var id int64
+		var title string
+		var rank float64
+		if err := rows.Scan(&id, &title, &rank); err != nil {
+			return nil, err
+		}
+
+		score := rank * 10
+		if score > 1 {
+			score = 1
+		}
+
+		if titleSlug := Slugify(title); slug != "" && titleSlug != "" {
+			longest := len(slug)
+			if len(titleSlug) > longest {
+				longest = len(titleSlug)
+			}
+			score += 1 - float64(levenshtein(slug, titleSlug))/float64(longest)
+		}
+
+		if numbers[id] {
+			score++
+		}
+
+		if score > 0.5 {
+			matches = append(matches, scored{id, score})
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(matches, func(i, j int) bool { return matches[i].score > matches[j].score })
+
+	ids := make([]int64, len(matches))
+	for i, m := range matches {
+		ids[i] = m.id
+	}
+
+	suggestionsCache.Add(key, &suggestionsEntry{ids: ids, cached: time.Now()})
+	return ids, nil
+}
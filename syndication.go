@@ -0,0 +1,253 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// MaxSyndicationAttempts is how many times we try to syndicate a post to
+	// a service before giving up.
+	MaxSyndicationAttempts = 5
+
+	// syndicationBackoff is the wait after the first failed attempt. It
+	// doubles after each failure.
+	syndicationBackoff = time.Minute
+)
+
+var (
+	syndicators      = map[string]Syndicator{}
+	syndicatorClient = &http.Client{Timeout: 30 * time.Second}
+)
+
+// Syndicator posts a link to a post somewhere else, like Twitter, and returns
+// the URL of what it posted. See https://indieweb.org/POSSE.
+type Syndicator interface {
+	Name() string
+	Syndicate(ctx context.Context, p *Post, link string) (string, error)
+}
+
+// ConfigureSyndication sets the services published posts are syndicated to.
+func ConfigureSyndication(s ...Syndicator) {
+	syndicators = map[string]Syndicator{}
+	for _, sy := range s {
+		syndicators[sy.Name()] = sy
+	}
+}
+
+// syndicationText is what we post about a post.
+func syndicationText(p *Post, link string) string {
+	return fmt.Sprintf("%s %s", p.Title, link)
+}
+
+// postJSON POSTs body as JSON with a bearer token, and decodes the JSON
+// response into v.
+func postJSON(ctx context.Context, rawurl, token string, body, v interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, rawurl, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := syndicatorClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s responded with %s", req.URL.Host, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// Mastodon syndicates posts as statuses on a Mastodon account.
+type Mastodon struct {
+	// Instance is the URL of the Mastodon server, like https://mastodon.social.
+	Instance string
+
+	// Token is an access token with the write:statuses scope.
+	Token string
+}
+
+// Name implements Syndicator.
+func (m *Mastodon) Name() string {
+	return "mastodon"
+}
+
+// Syndicate implements Syndicator.
+func (m *Mastodon) Syndicate(ctx context.Context, p *Post, link string) (string, error) {
+	var status struct {
+		URL string `json:"url"`
+	}
+
+	err := postJSON(ctx, strings.TrimRight(m.Instance, "/")+"/api/v1/statuses", m.Token, map[string]string{
+		"status": syndicationText(p, link),
+	}, &status)
+	return status.URL, err
+}
+
+// Twitter syndicates posts as tweets.
+type Twitter struct {
+	// Token is an OAuth 2.0 user access token with the tweet.write scope.
+	Token string
+}
+
+// Name implements Syndicator.
+func (t *Twitter) Name() string {
+	return "twitter"
+}
+
+// Syndicate implements Syndicator.
+func (t *Twitter) Syndicate(ctx context.Context, p *Post, link string) (string, error) {
+	var tweet struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+
+	if err := postJSON(ctx, "https://api.twitter.com/2/tweets", t.Token, map[string]string{
+		"text": syndicationText(p, link),
+	}, &tweet); err != nil {
+		return "", err
+	}
+
+	return "https://twitter.com/i/web/status/" + url.PathEscape(tweet.Data.ID), nil
+}
+
+// queueSyndication schedules the post to be syndicated to every configured
+// service, or only the configured services in only if it isn't nil. Posts are
+// only ever syndicated once per service.
+func (p *Post) queueSyndication(ctx context.Context, only []string) error {
+	services := []string{}
+	for name := range syndicators {
+		if only == nil || containsString(only, name) {
+			services = append(services, name)
+		}
+	}
+
+	if len(services) == 0 {
+		return nil
+	}
+
+	_, err := db.ExecContext(ctx, `
+INSERT INTO syndications (post_id, service, next_attempt_at, created_at)
+SELECT $1, unnest($2::text[]), $3, $3
+ON CONFLICT (post_id, service) DO NOTHING
+`, p.ID, pq.Array(services), time.Now())
+	return err
+}
+
+// SyndicationURLs returns the URLs the post has been syndicated to.
+func (p *Post) SyndicationURLs(ctx context.Context) ([]string, error) {
+	rows, err := db.QueryContext(ctx, "SELECT url FROM syndications WHERE post_id = $1 AND url IS NOT NULL ORDER BY service", p.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	urls := make([]string, 0)
+	for rows.Next() {
+		var u string
+		if err := rows.Scan(&u); err != nil {
+			return nil, err
+		}
+		urls = append(urls, u)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return urls, nil
+}
+
+// StartSyndicationWorker syndicates newly published posts every interval,
+// until ctx is done. It is safe to run on several servers at once.
+func StartSyndicationWorker(ctx context.Context, interval time.Duration) {
+	startWorker(ctx, "syndication", interval, syndicateNext)
+}
+
+// syndicateNext tries one due syndication. It returns false when there was
+// nothing to do.
+func syndicateNext(ctx context.Context) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var id, service string
+	var postID int64
+	var attempts int
+	err = tx.QueryRowContext(ctx, `
+SELECT id, post_id, service, attempts
+FROM syndications
+WHERE syndicated_at IS NULL AND next_attempt_at <= $1
+ORDER BY next_attempt_at
+LIMIT 1
+FOR UPDATE SKIP LOCKED
+`, time.Now()).Scan(&id, &postID, &service, &attempts)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, Internalf("Error running get query: %+v", err)
+	}
+
+	var syndicatedURL, errMsg, next, syndicated interface{}
+	attempts++
+
+	sy, ok := syndicators[service]
+	if !ok {
+		// The service has been turned off since the post was published.
+		errMsg = fmt.Sprintf("%s is not configured", service)
+	} else {
+		u, err := syndicate(ctx, sy, postID)
+		if err != nil {
+			errMsg = err.Error()
+			if attempts < MaxSyndicationAttempts {
+				next = time.Now().Add(syndicationBackoff << uint(attempts-1))
+			}
+		} else {
+			syndicatedURL = u
+			syndicated = time.Now()
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE syndications SET url = $2, attempts = $3, error = $4, next_attempt_at = $5, syndicated_at = $6 WHERE id = $1", id, syndicatedURL, attempts, errMsg, next, syndicated); err != nil {
+		return true, err
+	}
+
+	return true, tx.Commit()
+}
+
+func syndicate(ctx context.Context, sy Syndicator, postID int64) (string, error) {
+	p, err := GetPost(ctx, postID)
+	if err != nil {
+		return "", err
+	}
+
+	link, err := p.Permalink(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return sy.Syndicate(ctx, p, link)
+}
@@ -0,0 +1,139 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sync"
+	"time"
+)
+
+const themeSetting = "theme"
+
+// Themes are cached by site ID.
+var (
+	themeMu       sync.Mutex
+	themeCache    = map[string]*Theme{}
+	themeCachedAt = map[string]time.Time{}
+
+	colorRegex = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+)
+
+// Theme is the visual appearance of the frontend, which admins can change
+// without a frontend deploy. It is cached like SiteSettings.
+type Theme struct {
+	AccentColor string    `json:"accent_color"`
+	LightImage  string    `json:"light_image"`
+	DarkImage   string    `json:"dark_image"`
+	NavItems    []NavItem `json:"nav_items"`
+}
+
+// NavItem is a link in the site navigation.
+type NavItem struct {
+	Label string `json:"label"`
+	URL   string `json:"url"`
+}
+
+// defaultTheme is used until an admin saves a theme.
+func defaultTheme() *Theme {
+	return &Theme{
+		AccentColor: "#001b44",
+		NavItems:    []NavItem{},
+	}
+}
+
+// Validate checks that the theme makes sense.
+func (t *Theme) Validate() error {
+	if !colorRegex.MatchString(t.AccentColor) {
+		return Validation("accentColor", "Accent color must be a hex color, like #001b44")
+	}
+
+	for _, img := range []string{t.LightImage, t.DarkImage} {
+		if img == "" {
+			continue
+		}
+
+		if u, err := url.Parse(img); err != nil || !u.IsAbs() {
+			return fmt.Errorf("Image %q must be an absolute URL", img)
+		}
+	}
+
+	for _, n := range t.NavItems {
+		if n.Label == "" {
+			return fmt.Errorf("Nav items need a label")
+		}
+
+		if _, err := url.Parse(n.URL); err != nil || n.URL == "" {
+			return fmt.Errorf("Nav item %q must have a URL", n.Label)
+		}
+	}
+
+	return nil
+}
+
+// GetTheme returns the theme of the site the request is for. The result is
+// shared, so don't modify it.
+func GetTheme(ctx context.Context) (*Theme, error) {
+	themeMu.Lock()
+	defer themeMu.Unlock()
+
+	site := siteID(ctx)
+	if t, ok := themeCache[site]; ok && time.Since(themeCachedAt[site]) < SiteSettingsCacheTTL {
+		return t, nil
+	}
+
+	t := defaultTheme()
+	value, ok, err := getSetting(ctx, site, themeSetting)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		if err := json.Unmarshal([]byte(value), t); err != nil {
+			return nil, Internalf("Error parsing %s: %+v", themeSetting, err)
+		}
+	}
+
+	themeCache[site] = t
+	themeCachedAt[site] = time.Now()
+	return t, nil
+}
+
+// SaveTheme replaces the theme of the site the request is for.
+func SaveTheme(ctx context.Context, t *Theme) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+
+	value, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+
+	if _, err := db.ExecContext(ctx, "INSERT INTO settings (site_id, key, value, modified_at) VALUES ($1, $2, $3, $4) ON CONFLICT (site_id, key) DO UPDATE SET value = $3, modified_at = $4", siteID(ctx), themeSetting, string(value), time.Now()); err != nil {
+		return err
+	}
+
+	invalidateTheme()
+	return nil
+}
+
+// ResetTheme takes the site the request is for back to the default theme.
+func ResetTheme(ctx context.Context) (*Theme, error) {
+	if _, err := db.ExecContext(ctx, "DELETE FROM settings WHERE site_id = $1 AND key = $2", siteID(ctx), themeSetting); err != nil {
+		return nil, err
+	}
+
+	invalidateTheme()
+	return defaultTheme(), nil
+}
+
+func invalidateTheme() {
+	themeMu.Lock()
+	defer themeMu.Unlock()
+
+	themeCache = map[string]*Theme{}
+	themeCachedAt = map[string]time.Time{}
+}
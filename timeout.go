@@ -0,0 +1,25 @@
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// TimeoutMiddleware is a gqlgen resolver middleware that gives each resolver
+// at most d to run. Database queries use the resolver's context, so a slow
+// query is cancelled instead of holding the request open forever.
+func TimeoutMiddleware(d time.Duration) graphql.FieldMiddleware {
+	return func(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+		ctx, cancel := context.WithTimeout(ctx, d)
+		defer cancel()
+
+		res, err := next(ctx)
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, Unavailable("Timed out after %s", d)
+		}
+
+		return res, err
+	}
+}
@@ -0,0 +1,96 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// MarshalTime writes times as RFC 3339 in UTC, like 2019-01-02T15:04:05Z,
+// whatever zone they were read or created in.
+func MarshalTime(t time.Time) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		io.WriteString(w, strconv.Quote(t.UTC().Format(time.RFC3339)))
+	})
+}
+
+// UnmarshalTime reads an RFC 3339 time, which must have an offset, like
+// 2019-01-02T15:04:05Z or 2019-01-02T07:04:05-08:00, and returns it in UTC.
+func UnmarshalTime(v interface{}) (time.Time, error) {
+	s, ok := v.(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("time must be an RFC 3339 string, like 2019-01-02T15:04:05Z")
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		if _, naive := time.Parse("2006-01-02T15:04:05", s); naive == nil {
+			return time.Time{}, fmt.Errorf("time %q has no offset; add one, like %sZ or %s-08:00", s, s, s)
+		}
+		return time.Time{}, fmt.Errorf("time %q is not RFC 3339, like 2019-01-02T15:04:05Z", s)
+	}
+
+	return t.UTC(), nil
+}
+
+// Location returns the time zone of the request: the one in its X-Timezone
+// header, or else the logged in user's time zone from their notification
+// settings, or else UTC. Resolvers that group by day use it to decide where
+// days start.
+func Location(ctx context.Context) (*time.Location, error) {
+	if name, ok := ctx.Value(TimezoneCtxKey).(string); ok && name != "" {
+		loc, err := loadLocation(name)
+		if err != nil {
+			return nil, Validation("X-Timezone", "%v", err)
+		}
+		return loc, nil
+	}
+
+	if u := ForContext(ctx); u != nil {
+		var name string
+		err := db.QueryRowContext(ctx, "SELECT timezone FROM notification_settings WHERE user_id = $1", u.ID).Scan(&name)
+		switch {
+		case err == sql.ErrNoRows:
+		case err != nil:
+			return nil, Internalf("Error running get query: %+v", err)
+		default:
+			if loc, err := loadLocation(name); err == nil {
+				return loc, nil
+			}
+		}
+	}
+
+	return time.UTC, nil
+}
+
+// loadLocation returns the IANA time zone name, like America/Los_Angeles.
+// Local isn't one, since it depends on the server.
+func loadLocation(name string) (*time.Location, error) {
+	loc, err := time.LoadLocation(strings.TrimSpace(name))
+	if err != nil || loc == time.Local {
+		return nil, fmt.Errorf("%q is not a time zone, like America/Los_Angeles or UTC", name)
+	}
+
+	return loc, nil
+}
+
+// dayRange returns the start of from's day and the end of to's day in the
+// request's time zone, so a DateRange includes both days whole.
+func dayRange(ctx context.Context, from, to time.Time) (time.Time, time.Time, error) {
+	loc, err := Location(ctx)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	from = from.In(loc)
+	to = to.In(loc)
+	start := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc)
+	end := time.Date(to.Year(), to.Month(), to.Day()+1, 0, 0, 0, 0, loc).Add(-time.Nanosecond)
+	return start.UTC(), end.UTC(), nil
+}
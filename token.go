@@ -0,0 +1,72 @@
+package graphql
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// Token is an API token that authenticates requests as a user.
+type Token struct {
+	Token   string
+	UserID  string
+	Created time.Time
+}
+
+// randomHex returns n random bytes encoded as hex.
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// NewToken creates and stores a new API token for the user.
+func (u *User) NewToken(ctx context.Context) (*Token, error) {
+	tok, err := randomHex(32)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Token{
+		Token:   tok,
+		UserID:  u.ID,
+		Created: time.Now(),
+	}
+
+	if _, err := db.ExecContext(ctx, "INSERT INTO tokens (token, user_id, created_at) VALUES ($1, $2, $3)", t.Token, t.UserID, t.Created); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// RotateTokens revokes all of the user's API tokens, and returns a new one
+// that replaces them.
+func (u *User) RotateTokens(ctx context.Context) (*Token, error) {
+	if _, err := db.ExecContext(ctx, "DELETE FROM tokens WHERE user_id = $1", u.ID); err != nil {
+		return nil, err
+	}
+
+	return u.NewToken(ctx)
+}
+
+// GetUserByToken returns the user an API token belongs to.
+func GetUserByToken(ctx context.Context, token string) (*User, error) {
+	var user User
+	row := db.QueryRowContext(ctx, "SELECT users.id, users.role, users.created_at, users.modified_at FROM users JOIN tokens ON tokens.user_id = users.id WHERE tokens.token = $1 AND users.deactivated_at IS NULL", token)
+	err := row.Scan(&user.ID, &user.Role, &user.Created, &user.Modified)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, fmt.Errorf("Invalid token")
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return &user, nil
+	}
+}
@@ -0,0 +1,208 @@
+package graphql
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	postUnlockSecretSetting = "post_unlock_secret"
+
+	// PostUnlockTTL is how long a token from Unlock can read its post.
+	PostUnlockTTL = 7 * 24 * time.Hour
+
+	// MaxUnlockAttempts is how many wrong passwords one source can try for
+	// a post within AuthFailureWindow.
+	MaxUnlockAttempts = 5
+
+	// maxUnlockSources is how many post and source pairs have their wrong
+	// passwords remembered. The least recently used are forgotten first.
+	maxUnlockSources = 4096
+)
+
+var (
+	// ErrPostLocked is returned when reading a protected post without a
+	// valid unlock token.
+	ErrPostLocked = fmt.Errorf("Post is password protected")
+
+	// ErrTooManyAttempts is returned by Unlock when a source has tried too
+	// many wrong passwords.
+	ErrTooManyAttempts = fmt.Errorf("Too many attempts, try again later")
+
+	// unlockAttempts maps post ID|source to the times of its wrong passwords,
+	// oldest first. unlockAttemptsMu guards updating an entry.
+	unlockAttemptsMu sync.Mutex
+	unlockAttempts   *lru.Cache
+
+	postUnlockSecretMu sync.Mutex
+	postUnlockSecret   string
+)
+
+func init() {
+	var err error
+	unlockAttempts, err = lru.New(maxUnlockSources)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// SetPassword protects the post with password, which makes it unlisted, or
+// removes its protection if password is empty. Changing the password
+// invalidates existing unlock tokens.
+func (p *Post) SetPassword(ctx context.Context, password string) error {
+	var hash interface{}
+	if password != "" {
+		h, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			return err
+		}
+		hash = string(h)
+	}
+
+	if err := db.QueryRowContext(ctx, "UPDATE posts SET password_hash = $2, modified_at = $3, version = version + 1 WHERE id = $1 RETURNING version", p.ID, hash, time.Now()).Scan(&p.Version); err != nil {
+		return err
+	}
+
+	p.Protected = password != ""
+	purgeCache(ctx, p.purgePaths()...)
+	return nil
+}
+
+// Unlock checks password against the post's, and returns a token that can
+// read the post for PostUnlockTTL. Wrong passwords count against source, like
+// an IP address.
+func (p *Post) Unlock(ctx context.Context, password, source string) (string, error) {
+	if !allowUnlockAttempt(p.ID, source) {
+		return "", ErrTooManyAttempts
+	}
+
+	hash, err := p.passwordHash(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hash == "" {
+		return "", fmt.Errorf("Post is not password protected")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)); err != nil {
+		recordUnlockFailure(p.ID, source)
+		RecordAuthFailure(source)
+		return "", fmt.Errorf("Wrong password")
+	}
+
+	expires := strconv.FormatInt(time.Now().Add(PostUnlockTTL).Unix(), 10)
+	sig, err := unlockSignature(ctx, p.ID, expires, hash)
+	if err != nil {
+		return "", err
+	}
+
+	return expires + "." + sig, nil
+}
+
+// CanRead returns nil if the post isn't protected, the reader is an admin, or
+// token is a valid unlock token for the post. Otherwise it returns
+// ErrPostLocked.
+func (p *Post) CanRead(ctx context.Context, token string) error {
+	if !p.Protected || HasRole(ctx, RoleAdmin) {
+		return nil
+	}
+
+	parts := strings.SplitN(token, ".", 2)
+	if len(parts) != 2 {
+		return ErrPostLocked
+	}
+
+	expires, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || time.Now().Unix() > expires {
+		return ErrPostLocked
+	}
+
+	hash, err := p.passwordHash(ctx)
+	if err != nil {
+		return err
+	}
+
+	want, err := unlockSignature(ctx, p.ID, parts[0], hash)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal([]byte(want), []byte(parts[1])) {
+		return ErrPostLocked
+	}
+
+	return nil
+}
+
+func (p *Post) passwordHash(ctx context.Context) (string, error) {
+	var hash sql.NullString
+	if err := db.QueryRowContext(ctx, "SELECT password_hash FROM posts WHERE id = $1", p.ID).Scan(&hash); err != nil {
+		return "", Internalf("Error running get query: %+v", err)
+	}
+
+	return hash.String, nil
+}
+
+// unlockSignature signs a post ID and expiry. The password hash is included,
+// so changing the password invalidates old tokens.
+func unlockSignature(ctx context.Context, postID, expires, hash string) (string, error) {
+	postUnlockSecretMu.Lock()
+	defer postUnlockSecretMu.Unlock()
+
+	if postUnlockSecret == "" {
+		secret, err := secretSetting(ctx, postUnlockSecretSetting)
+		if err != nil {
+			return "", err
+		}
+		postUnlockSecret = secret
+	}
+
+	mac := hmac.New(sha256.New, []byte(postUnlockSecret))
+	fmt.Fprintf(mac, "%s|%s|%s", postID, expires, hash)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// allowUnlockAttempt returns false if source has tried MaxUnlockAttempts
+// wrong passwords for the post within AuthFailureWindow.
+func allowUnlockAttempt(postID, source string) bool {
+	unlockAttemptsMu.Lock()
+	defer unlockAttemptsMu.Unlock()
+
+	return len(recentUnlockFailures(postID+"|"+source)) < MaxUnlockAttempts
+}
+
+func recordUnlockFailure(postID, source string) {
+	key := postID + "|" + source
+
+	unlockAttemptsMu.Lock()
+	defer unlockAttemptsMu.Unlock()
+
+	unlockAttempts.Add(key, append(recentUnlockFailures(key), time.Now()))
+}
+
+// recentUnlockFailures returns the key's wrong passwords within
+// AuthFailureWindow. unlockAttemptsMu must be held.
+func recentUnlockFailures(key string) []time.Time {
+	v, ok := unlockAttempts.Get(key)
+	if !ok {
+		return nil
+	}
+
+	// Failures are oldest first, so only the start can be stale.
+	failures := v.([]time.Time)
+	for len(failures) > 0 && time.Since(failures[0]) >= AuthFailureWindow {
+		failures = failures[1:]
+	}
+
+	return failures
+}
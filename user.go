@@ -4,15 +4,28 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
+
+	"github.com/lib/pq"
+)
+
+var (
+	// ErrUserDeactivated is returned when looking up a user that an admin has
+	// deactivated.
+	ErrUserDeactivated = fmt.Errorf("User is deactivated")
+
+	// ErrUserNotFound is returned by FindUser when there is no such user.
+	ErrUserNotFound = fmt.Errorf("User not found")
 )
 
 // User is a database object based off of what we get back from Google OAuth.
 type User struct {
-	ID       string
-	Role     string
-	Created  time.Time
-	Modified time.Time
+	ID          string
+	Role        string
+	Created     time.Time
+	Modified    time.Time
+	Deactivated *time.Time
 }
 
 // Save is an upsert based operation for User.
@@ -33,11 +46,18 @@ func (u *User) Save(ctx context.Context) error {
 }
 
 // GetUser returns a user from the database. If the User does not exist, we
-// create it.
+// create it. Deactivated users return ErrUserDeactivated. IDs of identities
+// that have been linked to another user return that user.
 func GetUser(ctx context.Context, id string) (*User, error) {
+	id, err := resolveUserID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
 	var user User
-	row := db.QueryRowContext(ctx, "SELECT id, role, created_at, modified_at FROM users WHERE id = $1", id)
-	err := row.Scan(&user.ID, &user.Role, &user.Created, &user.Modified)
+	var deactivated pq.NullTime
+	row := db.QueryRowContext(ctx, "SELECT id, role, created_at, modified_at, deactivated_at FROM users WHERE id = $1", id)
+	err = row.Scan(&user.ID, &user.Role, &user.Created, &user.Modified, &deactivated)
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -45,10 +65,310 @@ func GetUser(ctx context.Context, id string) (*User, error) {
 		user.Role = "normal"
 		user.Created = time.Now()
 		user.Modified = time.Now()
-		return &user, (&user).Save(ctx)
+		if err := (&user).Save(ctx); err != nil {
+			return nil, err
+		}
+
+		triggerWebhooks(ctx, EventUserCreated, &user)
+		return &user, nil
 	case err != nil:
-		return nil, fmt.Errorf("Error running get query: %+v", err)
+		return nil, Internalf("Error running get query: %+v", err)
+	case deactivated.Valid:
+		return nil, ErrUserDeactivated
 	default:
 		return &user, (&user).Save(ctx)
 	}
 }
+
+// ProvisionUser creates or updates a user before they have logged in. It
+// does not reactivate deactivated users.
+func ProvisionUser(ctx context.Context, id string, role Role) (*User, error) {
+	u := &User{
+		ID:       id,
+		Role:     string(role),
+		Created:  time.Now(),
+		Modified: time.Now(),
+	}
+
+	if err := u.Save(ctx); err != nil {
+		return nil, err
+	}
+
+	return FindUser(ctx, id)
+}
+
+// FindUser returns a user from the database, including deactivated users,
+// without creating or modifying them.
+func FindUser(ctx context.Context, id string) (*User, error) {
+	row := db.QueryRowContext(ctx, "SELECT id, role, created_at, modified_at, deactivated_at FROM users WHERE id = $1", id)
+	u, err := scanUser(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, ErrUserNotFound
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return u, nil
+	}
+}
+
+// FindUserByEmail returns the oldest active user whose notifications go to
+// email, ignoring case.
+func FindUserByEmail(ctx context.Context, email string) (*User, error) {
+	if email == "" {
+		return nil, ErrUserNotFound
+	}
+
+	row := db.QueryRowContext(ctx, `
+SELECT u.id, u.role, u.created_at, u.modified_at, u.deactivated_at
+FROM users u
+JOIN notification_settings n ON n.user_id = u.id
+WHERE lower(n.email) = lower($1) AND u.deactivated_at IS NULL
+ORDER BY u.created_at ASC
+LIMIT 1
+`, email)
+	u, err := scanUser(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, ErrUserNotFound
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return u, nil
+	}
+}
+
+func scanUser(row interface {
+	Scan(dest ...interface{}) error
+}) (*User, error) {
+	u := new(User)
+	var deactivated pq.NullTime
+	if err := row.Scan(&u.ID, &u.Role, &u.Created, &u.Modified, &deactivated); err != nil {
+		return nil, err
+	}
+	u.Deactivated = nullTimePtr(deactivated)
+
+	return u, nil
+}
+
+// Users returns users matching filter, ordered by creation time.
+func Users(ctx context.Context, filter UserFilter, limit, offset int) ([]*User, error) {
+	where := []string{"TRUE"}
+	args := []interface{}{}
+	if filter.Role != nil {
+		args = append(args, string(*filter.Role))
+		where = append(where, fmt.Sprintf("role = $%d", len(args)))
+	}
+	if filter.Active != nil {
+		if *filter.Active {
+			where = append(where, "deactivated_at IS NULL")
+		} else {
+			where = append(where, "deactivated_at IS NOT NULL")
+		}
+	}
+	args = append(args, limit, offset)
+
+	query := fmt.Sprintf("SELECT id, role, created_at, modified_at, deactivated_at FROM users WHERE %s ORDER BY created_at ASC LIMIT $%d OFFSET $%d", strings.Join(where, " AND "), len(args)-1, len(args))
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]*User, 0)
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// Deactivate stops a user from logging in, and revokes all of their tokens
+// and pending logins. Existing sessions stop working on their next request.
+func (u *User) Deactivate(ctx context.Context) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	if _, err := tx.ExecContext(ctx, "UPDATE users SET deactivated_at = $2, modified_at = $2 WHERE id = $1", u.ID, now); err != nil {
+		return err
+	}
+
+	for _, table := range []string{"tokens", "sessions", "device_codes", "oidc_codes"} {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE user_id = $1", table), u.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	u.Deactivated = &now
+	return nil
+}
+
+// Reactivate lets a deactivated user log in again.
+func (u *User) Reactivate(ctx context.Context) error {
+	if _, err := db.ExecContext(ctx, "UPDATE users SET deactivated_at = NULL, modified_at = $2 WHERE id = $1", u.ID, time.Now()); err != nil {
+		return err
+	}
+
+	u.Deactivated = nil
+	return nil
+}
+
+// bulkPageSize is how many users bulk jobs load at once.
+const bulkPageSize = 100
+
+// DeactivateMatchingUsers deactivates every active user matching filter, in a
+// background job started by admin. The admin is never deactivated, so they
+// can't lock themselves out.
+func DeactivateMatchingUsers(ctx context.Context, admin *User, filter UserFilter) (*Job, error) {
+	active := true
+	filter.Active = &active
+
+	return startJob(ctx, admin, "deactivate_users", func(ctx context.Context, j *Job) error {
+		// Find everyone first, as deactivating users changes who matches.
+		users := []*User{}
+		for offset := 0; ; offset += bulkPageSize {
+			page, err := Users(ctx, filter, bulkPageSize, offset)
+			if err != nil {
+				return err
+			}
+			for _, u := range page {
+				if u.ID != admin.ID {
+					users = append(users, u)
+				}
+			}
+			if len(page) < bulkPageSize {
+				break
+			}
+		}
+
+		if err := j.progress(ctx, 0, len(users)); err != nil {
+			return err
+		}
+		for i, u := range users {
+			if err := u.Deactivate(ctx); err != nil {
+				return err
+			}
+			if err := j.progress(ctx, i+1, len(users)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ReassignUserContent moves everything the user from has written to the user
+// to, in a background job started by admin. Posts don't have an owner, so
+// this is only logs.
+func ReassignUserContent(ctx context.Context, admin *User, from, to string) (*Job, error) {
+	if err := checkUserPair(ctx, from, to); err != nil {
+		return nil, err
+	}
+
+	return startJob(ctx, admin, "reassign_content", func(ctx context.Context, j *Job) error {
+		res, err := db.ExecContext(ctx, "UPDATE logs SET user_id = $2 WHERE user_id = $1", from, to)
+		if err != nil {
+			return err
+		}
+
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		return j.progress(ctx, int(n), int(n))
+	})
+}
+
+// MergeUsers folds the duplicate user from into the user into, in a
+// background job started by admin. Logs, tokens, used invites, linked
+// identities and groups are moved over, as are notification settings if into
+// has none. If from is an admin, into becomes one. Then from is deactivated,
+// so it can't log in again.
+func MergeUsers(ctx context.Context, admin *User, from, into string) (*Job, error) {
+	if from == admin.ID {
+		return nil, fmt.Errorf("You can't merge yourself into another user")
+	}
+	if err := checkUserPair(ctx, from, into); err != nil {
+		return nil, err
+	}
+
+	return startJob(ctx, admin, "merge_users", func(ctx context.Context, j *Job) error {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback()
+
+		for _, q := range []string{
+			"UPDATE logs SET user_id = $2 WHERE user_id = $1",
+			"UPDATE tokens SET user_id = $2 WHERE user_id = $1",
+			"UPDATE invites SET used_by = $2 WHERE used_by = $1",
+			"UPDATE identities SET user_id = $2 WHERE user_id = $1",
+			"UPDATE groups SET owner_id = $2 WHERE owner_id = $1",
+			"UPDATE group_members SET user_id = $2 WHERE user_id = $1 AND group_id NOT IN (SELECT group_id FROM group_members WHERE user_id = $2)",
+			"UPDATE notification_settings SET user_id = $2 WHERE user_id = $1 AND NOT EXISTS (SELECT 1 FROM notification_settings WHERE user_id = $2)",
+			"UPDATE pending_notifications SET user_id = $2 WHERE user_id = $1",
+			"UPDATE read_later r SET user_id = $2 WHERE r.user_id = $1 AND NOT EXISTS (SELECT 1 FROM read_later o WHERE o.user_id = $2 AND (o.url = r.url OR o.post_id = r.post_id))",
+			`INSERT INTO reading_progress (user_id, post_id, percent, modified_at)
+SELECT $2, post_id, percent, modified_at FROM reading_progress WHERE user_id = $1
+ON CONFLICT (user_id, post_id) DO UPDATE SET (percent, modified_at) = (EXCLUDED.percent, EXCLUDED.modified_at)
+WHERE reading_progress.modified_at < EXCLUDED.modified_at`,
+			"UPDATE highlights SET user_id = $2 WHERE user_id = $1",
+			"UPDATE users SET role = 'admin', modified_at = now() WHERE id = $2 AND EXISTS (SELECT 1 FROM users WHERE id = $1 AND role = 'admin')",
+		} {
+			if _, err := tx.ExecContext(ctx, q, from, into); err != nil {
+				return err
+			}
+		}
+
+		for _, q := range []string{
+			"DELETE FROM notification_settings WHERE user_id = $1",
+			"DELETE FROM group_members WHERE user_id = $1",
+			"DELETE FROM read_later WHERE user_id = $1",
+			"DELETE FROM reading_progress WHERE user_id = $1",
+			"DELETE FROM sessions WHERE user_id = $1",
+			"DELETE FROM device_codes WHERE user_id = $1",
+			"DELETE FROM oidc_codes WHERE user_id = $1",
+			"UPDATE users SET deactivated_at = now(), modified_at = now() WHERE id = $1",
+		} {
+			if _, err := tx.ExecContext(ctx, q, from); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+		return j.progress(ctx, 1, 1)
+	})
+}
+
+// checkUserPair returns an error unless from and to are two users that exist.
+func checkUserPair(ctx context.Context, from, to string) error {
+	if from == to {
+		return fmt.Errorf("Users must be different")
+	}
+
+	for _, id := range []string{from, to} {
+		if _, err := FindUser(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
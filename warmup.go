@@ -0,0 +1,100 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// PopularPosts returns the n published posts with the most page views in the
+// last days days, most viewed first. If fewer than n posts have been viewed,
+// the newest posts fill in the rest.
+func PopularPosts(ctx context.Context, n, days int) ([]*Post, error) {
+	rows, err := db.QueryContext(ctx, `
+    SELECT p.id, p.title, p.content, p.date, p.created_at, p.modified_at, p.tags, p.draft, p.canonical_url, p.originally_published_at, p.license, p.license_text, p.version
+    FROM posts p
+    LEFT JOIN (
+      SELECT path, SUM(views) AS views FROM page_view_days WHERE day >= $2 GROUP BY path
+    ) v ON v.path = '/post/' || p.id
+    WHERE p.site_id = $3 AND p.draft = false AND p.password_hash IS NULL
+    ORDER BY COALESCE(v.views, 0) DESC, p.date DESC
+    LIMIT $1`, n, time.Now().AddDate(0, 0, -days), siteID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	posts := make([]*Post, 0)
+	for rows.Next() {
+		p := new(Post)
+		if err := rows.Scan(&p.ID, &p.Title, &p.Content, &p.Datetime, &p.Created, &p.Modified, pq.Array(&p.Tags), &p.Draft, &p.CanonicalURL, &p.OriginallyPublishedAt, &p.LicenseID, &p.LicenseText, &p.Version); err != nil {
+			return nil, err
+		}
+		posts = append(posts, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// WarmPosts renders the HTML of the n most popular posts, so the first
+// requests for them are served from the markdown cache. It returns how many
+// posts were rendered.
+func WarmPosts(ctx context.Context, n int) (int, error) {
+	posts, err := PopularPosts(ctx, n, 30)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, p := range posts {
+		p.HTML()
+	}
+
+	return len(posts), nil
+}
+
+// WarmConnections opens n connections to the primary database, and to the
+// replica if there is one, so requests don't wait on new connections. The
+// connections are returned to the pool, so the pool's idle limit must be at
+// least n to keep them all open.
+func WarmConnections(ctx context.Context, n int) error {
+	for _, pool := range []*sql.DB{db.DB, db.replica} {
+		if pool == nil {
+			continue
+		}
+
+		if err := warmPool(ctx, pool, n); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// warmPool holds n connections from pool open at once, then returns them.
+func warmPool(ctx context.Context, pool *sql.DB, n int) error {
+	conns := make([]*sql.Conn, 0, n)
+	defer func() {
+		for _, conn := range conns {
+			conn.Close()
+		}
+	}()
+
+	for i := 0; i < n; i++ {
+		conn, err := pool.Conn(ctx)
+		if err != nil {
+			return err
+		}
+		conns = append(conns, conn)
+
+		if err := conn.PingContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
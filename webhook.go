@@ -0,0 +1,342 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Webhook events.
+const (
+	EventPostPublished  = "post.published"
+	EventCommentCreated = "comment.created"
+	EventUserCreated    = "user.created"
+)
+
+// WebhookEvents are all of the events webhooks can subscribe to.
+var WebhookEvents = []string{EventPostPublished, EventCommentCreated, EventUserCreated}
+
+const (
+	// MaxWebhookAttempts is how many times a delivery is tried before giving
+	// up.
+	MaxWebhookAttempts = 8
+
+	// webhookBackoff is the wait after the first failed attempt. It doubles
+	// after each failure.
+	webhookBackoff = 30 * time.Second
+)
+
+var webhookClient = &http.Client{Timeout: 10 * time.Second}
+
+// Webhook is a URL that is sent a signed JSON payload when events happen.
+type Webhook struct {
+	ID       string    `json:"id"`
+	URL      string    `json:"url"`
+	Events   []string  `json:"events"`
+	Created  time.Time `json:"created"`
+	Modified time.Time `json:"modified"`
+
+	secret string
+}
+
+// WebhookDelivery is an event being sent to a webhook, and how that's going.
+// RequestID is the request that caused the event, if one did.
+type WebhookDelivery struct {
+	ID          string     `json:"id"`
+	WebhookID   string     `json:"webhook_id"`
+	Event       string     `json:"event"`
+	Payload     string     `json:"payload"`
+	RequestID   *string    `json:"request_id"`
+	Attempts    int        `json:"attempts"`
+	StatusCode  *int       `json:"status_code"`
+	Error       *string    `json:"error"`
+	NextAttempt *time.Time `json:"next_attempt"`
+	Delivered   *time.Time `json:"delivered"`
+	Created     time.Time  `json:"created"`
+}
+
+// CreateWebhook stores a new webhook. The returned secret is used to sign
+// payloads, and is only returned here.
+func CreateWebhook(ctx context.Context, rawurl string, events []string) (*Webhook, string, error) {
+	if u, err := url.Parse(rawurl); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, "", Validation("url", "Webhook URL must be an http or https URL")
+	}
+
+	if len(events) == 0 {
+		return nil, "", fmt.Errorf("Webhooks need at least one event")
+	}
+
+	for _, e := range events {
+		if !validWebhookEvent(e) {
+			return nil, "", fmt.Errorf("Unknown webhook event %q", e)
+		}
+	}
+
+	secret, err := randomHex(32)
+	if err != nil {
+		return nil, "", err
+	}
+
+	w := &Webhook{
+		URL:      rawurl,
+		Events:   events,
+		Created:  time.Now(),
+		Modified: time.Now(),
+		secret:   secret,
+	}
+
+	row := db.QueryRowContext(ctx, "INSERT INTO webhooks (url, events, secret, created_at, modified_at) VALUES ($1, $2, $3, $4, $5) RETURNING id", w.URL, pq.Array(w.Events), w.secret, w.Created, w.Modified)
+	if err := row.Scan(&w.ID); err != nil {
+		return nil, "", err
+	}
+
+	return w, secret, nil
+}
+
+func validWebhookEvent(event string) bool {
+	for _, e := range WebhookEvents {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+func scanWebhook(row interface {
+	Scan(dest ...interface{}) error
+}) (*Webhook, error) {
+	w := new(Webhook)
+	if err := row.Scan(&w.ID, &w.URL, pq.Array(&w.Events), &w.secret, &w.Created, &w.Modified); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// GetWebhook returns a webhook by ID.
+func GetWebhook(ctx context.Context, id string) (*Webhook, error) {
+	row := db.QueryRowContext(ctx, "SELECT id, url, events, secret, created_at, modified_at FROM webhooks WHERE id = $1", id)
+	w, err := scanWebhook(row)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, NotFound("No webhook with id %s", id)
+	case err != nil:
+		return nil, Internalf("Error running get query: %+v", err)
+	default:
+		return w, nil
+	}
+}
+
+// Webhooks returns all webhooks, oldest first.
+func Webhooks(ctx context.Context) ([]*Webhook, error) {
+	rows, err := db.QueryContext(ctx, "SELECT id, url, events, secret, created_at, modified_at FROM webhooks ORDER BY created_at ASC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	webhooks := make([]*Webhook, 0)
+	for rows.Next() {
+		w, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, w)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// Delete removes the webhook and its deliveries.
+func (w *Webhook) Delete(ctx context.Context) error {
+	_, err := db.ExecContext(ctx, "DELETE FROM webhooks WHERE id = $1", w.ID)
+	return err
+}
+
+// TriggerWebhooks queues a delivery of event to every webhook subscribed to
+// it. Deliveries are sent by the webhook worker, so this doesn't wait on
+// other servers. They carry the request ID of ctx.
+func TriggerWebhooks(ctx context.Context, event string, data interface{}) error {
+	payload, err := json.Marshal(map[string]interface{}{
+		"event":   event,
+		"created": time.Now(),
+		"data":    data,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecContext(ctx, `
+INSERT INTO webhook_deliveries (webhook_id, event, payload, request_id, next_attempt_at, created_at)
+SELECT id, $1, $2, NULLIF($4, ''), $3, $3 FROM webhooks WHERE $1 = ANY(events)
+`, event, string(payload), time.Now(), RequestID(ctx))
+	return err
+}
+
+// triggerWebhooks is TriggerWebhooks for places where a webhook failing to
+// queue shouldn't fail the request.
+func triggerWebhooks(ctx context.Context, event string, data interface{}) {
+	if err := TriggerWebhooks(ctx, event, data); err != nil {
+		log.Printf("could not trigger %s webhooks: %+v", event, err)
+	}
+}
+
+// WebhookDeliveries returns the most recent deliveries, newest first,
+// optionally only for one webhook, only ones caused by one request, or only
+// ones that have not been delivered.
+func WebhookDeliveries(ctx context.Context, webhookID, requestID *string, failed bool, limit int) ([]*WebhookDelivery, error) {
+	rows, err := db.QueryContext(ctx, `
+SELECT id, webhook_id, event, payload, request_id, attempts, status_code, error, next_attempt_at, delivered_at, created_at
+FROM webhook_deliveries
+WHERE ($1::integer IS NULL OR webhook_id = $1) AND (NOT $2 OR (delivered_at IS NULL AND attempts > 0)) AND ($4::text IS NULL OR request_id = $4)
+ORDER BY created_at DESC
+LIMIT $3
+`, webhookID, failed, limit, requestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := make([]*WebhookDelivery, 0)
+	for rows.Next() {
+		d := new(WebhookDelivery)
+		var statusCode sql.NullInt64
+		var errMsg, requestID sql.NullString
+		var next, delivered pq.NullTime
+		if err := rows.Scan(&d.ID, &d.WebhookID, &d.Event, &d.Payload, &requestID, &d.Attempts, &statusCode, &errMsg, &next, &delivered, &d.Created); err != nil {
+			return nil, err
+		}
+
+		if statusCode.Valid {
+			code := int(statusCode.Int64)
+			d.StatusCode = &code
+		}
+		if errMsg.Valid {
+			d.Error = &errMsg.String
+		}
+		if requestID.Valid {
+			d.RequestID = &requestID.String
+		}
+		d.NextAttempt = nullTimePtr(next)
+		d.Delivered = nullTimePtr(delivered)
+
+		deliveries = append(deliveries, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// StartWebhookWorker sends due webhook deliveries every interval, until ctx
+// is done. It is safe to run on several servers at once.
+func StartWebhookWorker(ctx context.Context, interval time.Duration) {
+	startWorker(ctx, "webhook", interval, deliverNextWebhook)
+}
+
+// deliverNextWebhook tries to send one due delivery. It returns false when
+// there was nothing to send.
+func deliverNextWebhook(ctx context.Context) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var id, event, payload, rawurl, secret string
+	var requestID sql.NullString
+	var attempts int
+	err = tx.QueryRowContext(ctx, `
+SELECT d.id, d.event, d.payload, d.request_id, d.attempts, w.url, w.secret
+FROM webhook_deliveries d JOIN webhooks w ON w.id = d.webhook_id
+WHERE d.delivered_at IS NULL AND d.next_attempt_at <= $1
+ORDER BY d.next_attempt_at
+LIMIT 1
+FOR UPDATE OF d SKIP LOCKED
+`, time.Now()).Scan(&id, &event, &payload, &requestID, &attempts, &rawurl, &secret)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, Internalf("Error running get query: %+v", err)
+	}
+
+	status, sendErr := sendWebhook(WithRequestID(ctx, requestID.String), rawurl, secret, id, event, []byte(payload))
+	attempts++
+
+	var statusCode, errMsg, next, delivered interface{}
+	if status != 0 {
+		statusCode = status
+	}
+	if sendErr != nil {
+		errMsg = sendErr.Error()
+		if attempts < MaxWebhookAttempts {
+			next = time.Now().Add(webhookBackoff << uint(attempts-1))
+		}
+	} else {
+		delivered = time.Now()
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE webhook_deliveries SET attempts = $2, status_code = $3, error = $4, next_attempt_at = $5, delivered_at = $6 WHERE id = $1", id, attempts, statusCode, errMsg, next, delivered); err != nil {
+		return true, err
+	}
+
+	return true, tx.Commit()
+}
+
+// SignWebhookPayload returns the signature of a payload, sent in the
+// X-Webhook-Signature header. Receivers should compute it themselves with
+// their secret and compare.
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// sendWebhook POSTs a payload, returning the response status code. The
+// request ID of ctx, if it has one, is sent in X-Request-Id.
+func sendWebhook(ctx context.Context, rawurl, secret, id, event string, payload []byte) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, rawurl, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Event", event)
+	req.Header.Set("X-Webhook-Delivery", id)
+	req.Header.Set("X-Webhook-Signature", SignWebhookPayload(secret, payload))
+	if requestID := RequestID(ctx); requestID != "" {
+		req.Header.Set("X-Request-Id", requestID)
+	}
+
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("Webhook responded with %s", resp.Status)
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,157 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// wxrDateFormat is how WordPress exports write dates.
+const wxrDateFormat = "2006-01-02 15:04:05"
+
+// wxr is the part of a WordPress export (WXR) file that is imported. Tags
+// without a namespace match WordPress's wp: elements of any WXR version.
+type wxr struct {
+	Channel struct {
+		// Links has the channel's atom:link too, which has no text.
+		Links   []string  `xml:"link"`
+		BaseURL string    `xml:"base_blog_url"`
+		Items   []wxrItem `xml:"item"`
+	} `xml:"channel"`
+}
+
+type wxrItem struct {
+	Title      string `xml:"title"`
+	Link       string `xml:"link"`
+	PubDate    string `xml:"pubDate"`
+	Content    string `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	ID         string `xml:"post_id"`
+	Date       string `xml:"post_date"`
+	DateGMT    string `xml:"post_date_gmt"`
+	Name       string `xml:"post_name"`
+	Status     string `xml:"status"`
+	Type       string `xml:"post_type"`
+	Password   string `xml:"post_password"`
+	Categories []struct {
+		Domain   string `xml:"domain,attr"`
+		Nicename string `xml:"nicename,attr"`
+		Name     string `xml:",chardata"`
+	} `xml:"category"`
+}
+
+// shortcodeWrappers are WordPress shortcodes that only wrap what's in them,
+// like captions around images.
+var shortcodeWrappers = regexp.MustCompile(`\[/?(caption|embed)[^\]]*\]`)
+
+// ImportWordPress imports the posts and pages in a WordPress export (WXR)
+// file in a background job started by admin, and redirects their old
+// permalinks to them. Drafts, pending and private posts are imported as
+// drafts, and password protected posts as drafts too, since their passwords
+// can't be. The job's report is an ImportReport.
+func ImportWordPress(ctx context.Context, admin *User, data []byte, opts ImportOptions) (*Job, error) {
+	var export wxr
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	// Exports are UTF-8, but some claim to be something else.
+	dec.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) { return input, nil }
+	if err := dec.Decode(&export); err != nil {
+		return nil, Validation("file", "File is not a WordPress export: %v", err)
+	}
+
+	if opts.BaseURL == "" {
+		opts.BaseURL = export.Channel.BaseURL
+	}
+	for _, l := range export.Channel.Links {
+		if opts.BaseURL == "" {
+			opts.BaseURL = strings.TrimSpace(l)
+		}
+	}
+	base, err := url.Parse(strings.TrimRight(opts.BaseURL, "/") + "/")
+	if err != nil || !base.IsAbs() {
+		return nil, Validation("baseURL", "Base URL must be an absolute URL, like https://example.com")
+	}
+
+	posts := []*importedPost{}
+	problems := []string{}
+	for _, item := range export.Channel.Items {
+		p, err := wordPressPost(item, base)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("WordPress post %s: %v", item.ID, err))
+			continue
+		}
+		if p != nil {
+			posts = append(posts, p)
+		}
+	}
+
+	return startImport(ctx, admin, "import_wordpress", posts, problems, opts)
+}
+
+// wordPressPost returns the post or page item is, or nil if it is neither,
+// like an attachment, or was trashed.
+func wordPressPost(item wxrItem, base *url.URL) (*importedPost, error) {
+	p := &importedPost{
+		Source:  "WordPress post " + item.ID,
+		Title:   strings.TrimSpace(item.Title),
+		OldURLs: []string{item.Link},
+	}
+
+	switch item.Type {
+	case "post":
+	case "page":
+		p.Page = true
+		p.Slug = item.Name
+		if !pageSlugRegex.MatchString(p.Slug) {
+			return nil, fmt.Errorf("page slug %q is not a valid slug", item.Name)
+		}
+	default:
+		return nil, nil
+	}
+
+	switch item.Status {
+	case "publish", "future":
+	case "draft", "pending", "private":
+		p.Draft = true
+	default:
+		return nil, nil
+	}
+	if item.Password != "" {
+		p.Draft = true
+	}
+
+	var err error
+	switch {
+	case item.DateGMT != "" && !strings.HasPrefix(item.DateGMT, "0000"):
+		p.Date, err = time.Parse(wxrDateFormat, item.DateGMT)
+	case item.Date != "" && !strings.HasPrefix(item.Date, "0000"):
+		p.Date, err = time.Parse(wxrDateFormat, item.Date)
+	case item.PubDate != "":
+		p.Date, err = time.Parse(time.RFC1123Z, item.PubDate)
+	default:
+		p.Date = time.Now()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not parse date: %v", err)
+	}
+
+	for _, c := range item.Categories {
+		if c.Domain != "category" && c.Domain != "post_tag" {
+			continue
+		}
+		if c.Nicename == "uncategorized" {
+			continue
+		}
+		p.Tags = append(p.Tags, c.Name)
+	}
+
+	if p.Content, err = htmlToMarkdown(shortcodeWrappers.ReplaceAllString(item.Content, ""), base); err != nil {
+		return nil, fmt.Errorf("could not convert content: %v", err)
+	}
+
+	return p, nil
+}
@@ -0,0 +1,36 @@
+package graphql
+
+import (
+	"context"
+	"log"
+	"runtime/pprof"
+	"time"
+)
+
+// startWorker runs work every interval, until ctx is done. Each run calls
+// work until it returns false, meaning there was nothing left to do, or an
+// error. The worker's goroutine is labelled worker=name.
+func startWorker(ctx context.Context, name string, interval time.Duration, work func(context.Context) (bool, error)) {
+	go pprof.Do(ctx, pprof.Labels("worker", name), func(ctx context.Context) {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+				for {
+					more, err := work(ctx)
+					if err != nil {
+						log.Printf("%s worker error: %+v", name, err)
+						break
+					}
+					if !more {
+						break
+					}
+				}
+			}
+		}
+	})
+}